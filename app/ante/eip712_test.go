@@ -507,6 +507,24 @@ func (suite *EIP712TestSuite) SetupTest() {
 				{Name: "amount", Type: "Coin[]"},
 			},
 		},
+		{
+			MsgTypeUrl:       "/kava.pricefeed.v1beta1.MsgPostPrices",
+			MsgValueTypeName: "MsgValuePricefeedPostPrices",
+			ValueTypes: []evmtypes.EIP712MsgAttrType{
+				{Name: "from", Type: "string"},
+				{Name: "prices", Type: "PostPriceEntry[]"},
+			},
+			NestedTypes: []evmtypes.EIP712NestedMsgType{
+				{
+					Name: "PostPriceEntry",
+					Attrs: []evmtypes.EIP712MsgAttrType{
+						{Name: "market_id", Type: "string"},
+						{Name: "price", Type: "string"},
+						{Name: "expiry", Type: "string"},
+					},
+				},
+			},
+		},
 	}
 	err = evmKeeper.SetParams(suite.ctx, params)
 	suite.Require().NoError(err)
@@ -1072,6 +1090,47 @@ func (suite *EIP712TestSuite) TestEIP712Tx_DepositAndWithdraw() {
 	suite.Require().Equal(suite.getEVMAmount(50_000).BigInt(), coinBal)
 }
 
+func (suite *EIP712TestSuite) TestEIP712Tx_PostPrices() {
+	encodingConfig := app.MakeEncodingConfig()
+
+	pricefeedKeeper := suite.tApp.GetPriceFeedKeeper()
+	params := pricefeedKeeper.GetParams(suite.ctx)
+	for i := range params.Markets {
+		params.Markets[i].Oracles = []sdk.AccAddress{suite.testAddr}
+	}
+	pricefeedKeeper.SetParams(suite.ctx, params)
+
+	expiry := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+	postPricesMsg := pricefeedtypes.NewMsgPostPrices(suite.testAddr.String(), pricefeedtypes.PostPriceEntries{
+		pricefeedtypes.NewPostPriceEntry("usdx:usd", sdk.MustNewDecFromStr("0.99"), expiry),
+		pricefeedtypes.NewPostPriceEntry("usdc:usd", sdk.MustNewDecFromStr("1.01"), expiry),
+	})
+
+	gasAmt := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(20)))
+	txBuilder := suite.createTestEIP712CosmosTxBuilder(
+		suite.testAddr,
+		suite.testPrivKey,
+		ChainID,
+		gasAmt,
+		[]sdk.Msg{postPricesMsg},
+	)
+	txBytes, err := encodingConfig.TxConfig.TxEncoder()(txBuilder.GetTx())
+	suite.Require().NoError(err)
+	resDeliverTx := suite.tApp.DeliverTx(
+		abci.RequestDeliverTx{
+			Tx: txBytes,
+		},
+	)
+	suite.Require().Equal(resDeliverTx.Code, uint32(0), resDeliverTx.Log)
+
+	for _, entry := range postPricesMsg.Prices {
+		suite.Require().Contains(
+			pricefeedKeeper.GetRawPrices(suite.ctx, entry.MarketID),
+			pricefeedtypes.NewPostedPrice(entry.MarketID, suite.testAddr, entry.Price, entry.Expiry),
+		)
+	}
+}
+
 func TestEIP712Suite(t *testing.T) {
 	suite.Run(t, new(EIP712TestSuite))
 }
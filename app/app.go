@@ -107,7 +107,8 @@ import (
 
 	"github.com/kava-labs/kava/app/ante"
 	kavaparams "github.com/kava-labs/kava/app/params"
-	_ "github.com/kava-labs/kava/precompile/registry" // Ensure precompiles are registered when using the app module
+	"github.com/kava-labs/kava/precompile/framework"
+	"github.com/kava-labs/kava/precompile/registry" // Ensure precompiles are registered when using the app module
 	"github.com/kava-labs/kava/x/auction"
 	auctionkeeper "github.com/kava-labs/kava/x/auction/keeper"
 	auctiontypes "github.com/kava-labs/kava/x/auction/types"
@@ -241,29 +242,31 @@ var (
 	// If these are changed, the permissions stored in accounts
 	// must also be migrated during a chain upgrade.
 	mAccPerms = map[string][]string{
-		authtypes.FeeCollectorName:      nil,
-		distrtypes.ModuleName:           nil,
-		stakingtypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:             {authtypes.Burner},
-		ibctransfertypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
-		evmtypes.ModuleName:             {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
-		evmutiltypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
-		kavadisttypes.KavaDistMacc:      {authtypes.Minter},
-		auctiontypes.ModuleName:         nil,
-		issuancetypes.ModuleAccountName: {authtypes.Minter, authtypes.Burner},
-		bep3types.ModuleName:            {authtypes.Burner, authtypes.Minter},
-		swaptypes.ModuleName:            nil,
-		cdptypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
-		cdptypes.LiquidatorMacc:         {authtypes.Minter, authtypes.Burner},
-		hardtypes.ModuleAccountName:     {authtypes.Minter},
-		savingstypes.ModuleAccountName:  nil,
-		liquidtypes.ModuleAccountName:   {authtypes.Minter, authtypes.Burner},
-		earntypes.ModuleAccountName:     nil,
-		kavadisttypes.FundModuleAccount: nil,
-		minttypes.ModuleName:            {authtypes.Minter},
-		communitytypes.ModuleName:       nil,
-		precisebanktypes.ModuleName:     {authtypes.Minter, authtypes.Burner}, // used for reserve account to back fractional amounts
+		authtypes.FeeCollectorName:       nil,
+		distrtypes.ModuleName:            nil,
+		stakingtypes.BondedPoolName:      {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:   {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:              {authtypes.Burner},
+		ibctransfertypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
+		evmtypes.ModuleName:              {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		evmutiltypes.ModuleName:          {authtypes.Minter, authtypes.Burner},
+		kavadisttypes.KavaDistMacc:       {authtypes.Minter},
+		auctiontypes.ModuleName:          nil,
+		issuancetypes.ModuleAccountName:  {authtypes.Minter, authtypes.Burner},
+		bep3types.ModuleName:             {authtypes.Burner, authtypes.Minter},
+		swaptypes.ModuleName:             nil,
+		swaptypes.ProtocolFeeAccountName: nil,
+		cdptypes.ModuleName:              {authtypes.Minter, authtypes.Burner},
+		cdptypes.LiquidatorMacc:          {authtypes.Minter, authtypes.Burner},
+		cdptypes.PegStabilityMacc:        {authtypes.Minter, authtypes.Burner},
+		hardtypes.ModuleAccountName:      {authtypes.Minter},
+		savingstypes.ModuleAccountName:   nil,
+		liquidtypes.ModuleAccountName:    {authtypes.Minter, authtypes.Burner},
+		earntypes.ModuleAccountName:      nil,
+		kavadisttypes.FundModuleAccount:  nil,
+		minttypes.ModuleName:             {authtypes.Minter},
+		communitytypes.ModuleName:        nil,
+		precisebanktypes.ModuleName:      {authtypes.Minter, authtypes.Burner}, // used for reserve account to back fractional amounts
 	}
 )
 
@@ -565,7 +568,7 @@ func NewApp(
 		app.precisebankKeeper, // x/precisebank in place of x/bank
 		app.stakingKeeper,
 		app.feeMarketKeeper,
-		vm.NewEVM,
+		vm.NewEVMWithEnabledPrecompiles,
 		options.EVMTrace,
 		evmSubspace,
 	)
@@ -617,13 +620,6 @@ func NewApp(
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
 	app.ibcKeeper.SetRouter(ibcRouter)
 
-	app.auctionKeeper = auctionkeeper.NewKeeper(
-		appCodec,
-		keys[auctiontypes.StoreKey],
-		auctionSubspace,
-		app.bankKeeper,
-		app.accountKeeper,
-	)
 	app.issuanceKeeper = issuancekeeper.NewKeeper(
 		appCodec,
 		keys[issuancetypes.StoreKey],
@@ -644,6 +640,14 @@ func NewApp(
 		keys[pricefeedtypes.StoreKey],
 		pricefeedSubspace,
 	)
+	app.auctionKeeper = auctionkeeper.NewKeeper(
+		appCodec,
+		keys[auctiontypes.StoreKey],
+		auctionSubspace,
+		app.bankKeeper,
+		app.accountKeeper,
+		app.pricefeedKeeper,
+	)
 	swapKeeper := swapkeeper.NewKeeper(
 		appCodec,
 		keys[swaptypes.StoreKey],
@@ -787,6 +791,21 @@ func NewApp(
 	app.savingsKeeper = savingsKeeper // savings incentive hooks disabled
 	app.earnKeeper = *earnKeeper.SetHooks(app.incentiveKeeper.Hooks())
 
+	// provide the keepers used by precompiles that interact with module state
+	registry.SetKeepers(app.evmKeeper, registry.Keepers{
+		BankKeeper:      app.bankKeeper,
+		SwapKeeper:      &app.swapKeeper,
+		PricefeedKeeper: app.pricefeedKeeper,
+		LiquidKeeper:    app.liquidKeeper,
+		RouterKeeper:    app.routerKeeper,
+		StakingKeeper:   app.stakingKeeper,
+		EvmutilKeeper:   &app.evmutilKeeper,
+	})
+
+	// record precompile calls with the metrics of the x/metrics module
+	metricsModule := metrics.NewAppModule(options.TelemetryOptions, app.pricefeedKeeper)
+	framework.SetMetrics(app.evmKeeper, metricsModule.Metrics())
+
 	// create gov keeper with router
 	// NOTE this must be done after any keepers referenced in the gov router (ie committee) are defined
 	govRouter := govv1beta1.NewRouter()
@@ -863,7 +882,7 @@ func NewApp(
 		// nil InflationCalculationFn, use SDK's default inflation function
 		mint.NewAppModule(appCodec, app.mintKeeper, app.accountKeeper, nil, mintSubspace),
 		community.NewAppModule(app.communityKeeper, app.accountKeeper),
-		metrics.NewAppModule(options.TelemetryOptions),
+		metricsModule,
 		precisebank.NewAppModule(app.precisebankKeeper, app.bankKeeper, app.accountKeeper),
 	)
 
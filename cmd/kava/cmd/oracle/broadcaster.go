@@ -0,0 +1,71 @@
+package oracle
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TxBroadcaster signs transactions with a keyring key and broadcasts them to a node.
+type TxBroadcaster struct {
+	clientCtx client.Context
+	txf       tx.Factory
+}
+
+var _ Broadcaster = &TxBroadcaster{}
+
+// NewTxBroadcaster returns a new TxBroadcaster signing with the client context's from key
+func NewTxBroadcaster(clientCtx client.Context, txf tx.Factory) *TxBroadcaster {
+	return &TxBroadcaster{clientCtx: clientCtx, txf: txf}
+}
+
+// Broadcast implements Broadcaster. The account sequence is tracked locally so a transaction can be broadcast before
+// the previous one is committed, and queried again after a failure.
+func (b *TxBroadcaster) Broadcast(msgs ...sdk.Msg) error {
+	clientCtx := b.clientCtx
+	txf, err := b.txf.Prepare(clientCtx)
+	if err != nil {
+		return err
+	}
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(clientCtx, txf, msgs...)
+		if err != nil {
+			b.resetSequence()
+			return err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	txBuilder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return err
+	}
+	if err := tx.Sign(txf, clientCtx.GetFromName(), txBuilder, true); err != nil {
+		return err
+	}
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		b.resetSequence()
+		return err
+	}
+	if res.Code != 0 {
+		b.resetSequence()
+		return fmt.Errorf("transaction %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+
+	b.txf = txf.WithSequence(txf.Sequence() + 1)
+	return nil
+}
+
+// resetSequence makes the next broadcast query the account sequence again
+func (b *TxBroadcaster) resetSequence() {
+	b.txf = b.txf.WithSequence(0)
+}
@@ -0,0 +1,123 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+)
+
+// NewCmd returns the oracle command, for running a pricefeed oracle.
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle",
+		Short: "Run a pricefeed oracle",
+	}
+
+	cmd.AddCommand(newRunCmd())
+
+	return cmd
+}
+
+func newRunCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run [config-file]",
+		Short: "Post prices for pricefeed markets from the sources in a config file",
+		Long: `Fetch the prices of pricefeed markets from the sources in a TOML config file every interval, and post them
+in a single MsgPostPrices signed by the --from key. Prices expire the configured expiry after they are posted.
+
+Sources can be http JSON endpoints, JSON files that are read again every interval, or mock sources with fixed prices.
+Each market looks up its price in its source's JSON by a dot separated path.
+
+Example config:
+
+    interval = "30s"
+    expiry = "2m"
+    metrics_address = "127.0.0.1:9300"
+
+    [[sources]]
+    name = "coingecko"
+    type = "http"
+    url = "https://api.coingecko.com/api/v3/simple/price?ids=kava,hard-protocol&vs_currencies=usd"
+    timeout = "10s"
+
+    [[sources]]
+    name = "fixed"
+    type = "file"
+    path = "/etc/kava-oracle/prices.json"
+
+    [[markets]]
+    market_id = "kava:usd"
+    source = "coingecko"
+    path = "kava.usd"
+
+    [[markets]]
+    market_id = "hard:usd"
+    source = "coingecko"
+    path = "hard-protocol.usd"
+
+    [[markets]]
+    market_id = "usdx:usd"
+    source = "fixed"
+    path = "usdx"
+`,
+		Example: "kava oracle run oracle.toml --from oracle --chain-id kava_2222-10 --gas auto --gas-adjustment 1.5 --gas-prices 0.001ukava",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := LoadConfig(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientCtx = clientCtx.WithSkipConfirmation(true)
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			logger := server.GetServerContextFromCmd(cmd).Logger.With("module", "oracle")
+			metrics := NewMetrics()
+			daemon, err := NewDaemon(cfg, clientCtx.GetFromAddress(), NewTxBroadcaster(clientCtx, txf), metrics, logger)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			if cfg.MetricsAddress != "" {
+				metricsServer := &http.Server{
+					Addr:              cfg.MetricsAddress,
+					Handler:           metrics.Handler(),
+					ReadHeaderTimeout: 10 * time.Second,
+				}
+				go func() {
+					if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+						logger.Error("failed to serve metrics", "err", err)
+					}
+				}()
+				defer metricsServer.Close()
+				logger.Info("serving metrics", "address", cfg.MetricsAddress)
+			}
+
+			return daemon.Run(ctx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,153 @@
+package oracle
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// Source types supported in the config
+const (
+	SourceTypeHTTP = "http"
+	SourceTypeFile = "file"
+	SourceTypeMock = "mock"
+)
+
+// Config defines the markets an oracle posts prices for, and where the prices come from.
+type Config struct {
+	// Interval is how often prices are posted
+	Interval Duration `toml:"interval"`
+	// Expiry is how long posted prices are valid for, from the time they are posted
+	Expiry Duration `toml:"expiry"`
+	// MetricsAddress is the address the prometheus metrics are served on, empty disables it
+	MetricsAddress string `toml:"metrics_address"`
+
+	Sources []SourceConfig `toml:"sources"`
+	Markets []MarketConfig `toml:"markets"`
+}
+
+// SourceConfig defines where prices are fetched from.
+type SourceConfig struct {
+	Name string `toml:"name"`
+	// Type is one of http, file or mock
+	Type string `toml:"type"`
+	// URL is the JSON endpoint of an http source
+	URL string `toml:"url"`
+	// Timeout is how long requests to an http source can take
+	Timeout Duration `toml:"timeout"`
+	// Path is the JSON file of a file source
+	Path string `toml:"path"`
+	// Prices are the prices of a mock source, keyed by the path markets look them up by
+	Prices map[string]string `toml:"prices"`
+}
+
+// MarketConfig defines a market to post prices for.
+type MarketConfig struct {
+	MarketID string `toml:"market_id"`
+	// Source is the name of the source the price is fetched from
+	Source string `toml:"source"`
+	// Path is the dot separated location of the price in the source's JSON, such as "kava.usd" or "data.0.price"
+	Path string `toml:"path"`
+}
+
+// Duration is a time.Duration read from strings such as "30s" in the config
+type Duration struct {
+	time.Duration
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (d *Duration) UnmarshalText(text []byte) error {
+	duration, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	d.Duration = duration
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// LoadConfig reads and validates a TOML config file
+func LoadConfig(path string) (Config, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	return ParseConfig(bz)
+}
+
+// ParseConfig parses and validates a TOML config
+func ParseConfig(bz []byte) (Config, error) {
+	var cfg Config
+	if err := toml.Unmarshal(bz, &cfg); err != nil {
+		return Config{}, fmt.Errorf("invalid oracle config: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return Config{}, fmt.Errorf("invalid oracle config: %w", err)
+	}
+	return cfg, nil
+}
+
+// Validate checks the config is complete and consistent
+func (cfg Config) Validate() error {
+	if cfg.Interval.Duration <= 0 {
+		return errors.New("interval must be positive")
+	}
+	if cfg.Expiry.Duration <= cfg.Interval.Duration {
+		return fmt.Errorf("expiry %s must be longer than the interval %s", cfg.Expiry, cfg.Interval)
+	}
+
+	seenSources := make(map[string]bool)
+	for _, source := range cfg.Sources {
+		if strings.TrimSpace(source.Name) == "" {
+			return errors.New("source name cannot be blank")
+		}
+		if seenSources[source.Name] {
+			return fmt.Errorf("duplicated source %s", source.Name)
+		}
+		seenSources[source.Name] = true
+
+		switch source.Type {
+		case SourceTypeHTTP:
+			if source.URL == "" {
+				return fmt.Errorf("http source %s must have a url", source.Name)
+			}
+		case SourceTypeFile:
+			if source.Path == "" {
+				return fmt.Errorf("file source %s must have a path", source.Name)
+			}
+		case SourceTypeMock:
+		default:
+			return fmt.Errorf("source %s has invalid type %q", source.Name, source.Type)
+		}
+	}
+
+	if len(cfg.Markets) == 0 {
+		return errors.New("at least one market is required")
+	}
+	seenMarkets := make(map[string]bool)
+	for _, market := range cfg.Markets {
+		if strings.TrimSpace(market.MarketID) == "" {
+			return errors.New("market id cannot be blank")
+		}
+		if seenMarkets[market.MarketID] {
+			return fmt.Errorf("duplicated market %s", market.MarketID)
+		}
+		seenMarkets[market.MarketID] = true
+
+		if !seenSources[market.Source] {
+			return fmt.Errorf("source %s of market %s does not exist", market.Source, market.MarketID)
+		}
+		if market.Path == "" {
+			return fmt.Errorf("market %s must have a path", market.MarketID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package oracle_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/cmd/kava/cmd/oracle"
+)
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := oracle.ParseConfig([]byte(`
+interval = "30s"
+expiry = "2m"
+metrics_address = "127.0.0.1:9300"
+
+[[sources]]
+name = "api"
+type = "http"
+url = "http://localhost/prices"
+timeout = "5s"
+
+[[sources]]
+name = "mock"
+type = "mock"
+[sources.prices]
+"usdx" = "1.00"
+
+[[markets]]
+market_id = "kava:usd"
+source = "api"
+path = "kava.usd"
+
+[[markets]]
+market_id = "usdx:usd"
+source = "mock"
+path = "usdx"
+`))
+	require.NoError(t, err)
+	require.Equal(t, 30*time.Second, cfg.Interval.Duration)
+	require.Equal(t, 2*time.Minute, cfg.Expiry.Duration)
+	require.Equal(t, "127.0.0.1:9300", cfg.MetricsAddress)
+	require.Equal(t, []oracle.SourceConfig{
+		{Name: "api", Type: oracle.SourceTypeHTTP, URL: "http://localhost/prices", Timeout: oracle.Duration{Duration: 5 * time.Second}},
+		{Name: "mock", Type: oracle.SourceTypeMock, Prices: map[string]string{"usdx": "1.00"}},
+	}, cfg.Sources)
+	require.Equal(t, []oracle.MarketConfig{
+		{MarketID: "kava:usd", Source: "api", Path: "kava.usd"},
+		{MarketID: "usdx:usd", Source: "mock", Path: "usdx"},
+	}, cfg.Markets)
+}
+
+func TestConfigValidate(t *testing.T) {
+	validConfig := func() oracle.Config {
+		return oracle.Config{
+			Interval: oracle.Duration{Duration: time.Minute},
+			Expiry:   oracle.Duration{Duration: 5 * time.Minute},
+			Sources: []oracle.SourceConfig{
+				{Name: "api", Type: oracle.SourceTypeHTTP, URL: "http://localhost/prices"},
+				{Name: "file", Type: oracle.SourceTypeFile, Path: "prices.json"},
+			},
+			Markets: []oracle.MarketConfig{
+				{MarketID: "kava:usd", Source: "api", Path: "kava.usd"},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		modify func(*oracle.Config)
+		expErr string
+	}{
+		{"valid", func(*oracle.Config) {}, ""},
+		{"zero interval", func(cfg *oracle.Config) { cfg.Interval.Duration = 0 }, "interval must be positive"},
+		{"expiry not after interval", func(cfg *oracle.Config) { cfg.Expiry = cfg.Interval }, "must be longer than the interval"},
+		{"blank source name", func(cfg *oracle.Config) { cfg.Sources[0].Name = " " }, "source name cannot be blank"},
+		{"duplicated source", func(cfg *oracle.Config) { cfg.Sources[1].Name = "api" }, "duplicated source api"},
+		{"invalid source type", func(cfg *oracle.Config) { cfg.Sources[0].Type = "ftp" }, "invalid type"},
+		{"http source without url", func(cfg *oracle.Config) { cfg.Sources[0].URL = "" }, "must have a url"},
+		{"file source without path", func(cfg *oracle.Config) { cfg.Sources[1].Path = "" }, "must have a path"},
+		{"no markets", func(cfg *oracle.Config) { cfg.Markets = nil }, "at least one market"},
+		{"duplicated market", func(cfg *oracle.Config) { cfg.Markets = append(cfg.Markets, cfg.Markets[0]) }, "duplicated market kava:usd"},
+		{"missing source", func(cfg *oracle.Config) { cfg.Markets[0].Source = "other" }, "source other of market kava:usd does not exist"},
+		{"market without path", func(cfg *oracle.Config) { cfg.Markets[0].Path = "" }, "market kava:usd must have a path"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := validConfig()
+			tc.modify(&cfg)
+			err := cfg.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
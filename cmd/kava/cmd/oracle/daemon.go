@@ -0,0 +1,142 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// Broadcaster signs and broadcasts a transaction containing messages
+type Broadcaster interface {
+	Broadcast(msgs ...sdk.Msg) error
+}
+
+// Daemon posts the prices of the configured markets every interval.
+type Daemon struct {
+	config      Config
+	oracle      sdk.AccAddress
+	sources     map[string]Source
+	broadcaster Broadcaster
+	metrics     *Metrics
+	logger      log.Logger
+}
+
+// NewDaemon returns a new Daemon posting prices as the oracle, with sources created from the config
+func NewDaemon(cfg Config, oracle sdk.AccAddress, broadcaster Broadcaster, metrics *Metrics, logger log.Logger) (*Daemon, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if oracle.Empty() {
+		return nil, errors.New("oracle address cannot be empty")
+	}
+
+	sources := make(map[string]Source, len(cfg.Sources))
+	for _, sourceCfg := range cfg.Sources {
+		source, err := NewSource(sourceCfg)
+		if err != nil {
+			return nil, err
+		}
+		sources[sourceCfg.Name] = source
+	}
+
+	return &Daemon{
+		config:      cfg,
+		oracle:      oracle,
+		sources:     sources,
+		broadcaster: broadcaster,
+		metrics:     metrics,
+		logger:      logger,
+	}, nil
+}
+
+// Run posts prices immediately and then every interval, until the context is done.
+func (d *Daemon) Run(ctx context.Context) error {
+	ticker := time.NewTicker(d.config.Interval.Duration)
+	defer ticker.Stop()
+
+	for {
+		if err := d.PostPrices(ctx, time.Now()); err != nil {
+			d.logger.Error("failed to post prices", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// PostPrices fetches the price of every market and broadcasts them in one message, expiring the config's expiry
+// after now. Markets whose price can't be fetched are left out.
+func (d *Daemon) PostPrices(ctx context.Context, now time.Time) error {
+	prices := d.FetchPrices(ctx)
+	if len(prices) == 0 {
+		return errors.New("no prices were fetched")
+	}
+
+	marketIDs := make([]string, 0, len(prices))
+	for marketID := range prices {
+		marketIDs = append(marketIDs, marketID)
+	}
+	sort.Strings(marketIDs)
+
+	expiry := now.Add(d.config.Expiry.Duration).UTC()
+	entries := make(pricefeedtypes.PostPriceEntries, 0, len(marketIDs))
+	for _, marketID := range marketIDs {
+		entries = append(entries, pricefeedtypes.NewPostPriceEntry(marketID, prices[marketID], expiry))
+	}
+
+	if err := d.broadcaster.Broadcast(pricefeedtypes.NewMsgPostPrices(d.oracle.String(), entries)); err != nil {
+		d.metrics.Posts.WithLabelValues("failure").Inc()
+		return fmt.Errorf("failed to broadcast prices: %w", err)
+	}
+	d.metrics.Posts.WithLabelValues("success").Inc()
+	d.metrics.LastPostTime.Set(float64(now.Unix()))
+
+	d.logger.Info("posted prices", "markets", len(entries), "expiry", expiry)
+	return nil
+}
+
+// FetchPrices returns the prices of the markets, keyed by market id. Each source is fetched once, and errors are
+// logged and counted in the metrics.
+func (d *Daemon) FetchPrices(ctx context.Context) map[string]sdk.Dec {
+	data := make(map[string]interface{})
+	failedSources := make(map[string]bool)
+	prices := make(map[string]sdk.Dec)
+
+	for _, market := range d.config.Markets {
+		if failedSources[market.Source] {
+			continue
+		}
+		sourceData, fetched := data[market.Source]
+		if !fetched {
+			var err error
+			sourceData, err = d.sources[market.Source].Fetch(ctx)
+			if err != nil {
+				d.logger.Error("failed to fetch source", "source", market.Source, "err", err)
+				d.metrics.SourceErrors.WithLabelValues(market.Source).Inc()
+				failedSources[market.Source] = true
+				continue
+			}
+			data[market.Source] = sourceData
+		}
+
+		price, err := lookupPrice(sourceData, market.Path)
+		if err != nil {
+			d.logger.Error("failed to look up price", "market_id", market.MarketID, "source", market.Source, "err", err)
+			d.metrics.SourceErrors.WithLabelValues(market.Source).Inc()
+			continue
+		}
+		prices[market.MarketID] = price
+		d.metrics.Price.WithLabelValues(market.MarketID).Set(price.MustFloat64())
+	}
+	return prices
+}
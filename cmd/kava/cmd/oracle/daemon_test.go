@@ -0,0 +1,165 @@
+package oracle_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/cmd/kava/cmd/oracle"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// mockBroadcaster records the messages it is asked to broadcast
+type mockBroadcaster struct {
+	txs [][]sdk.Msg
+	err error
+}
+
+func (b *mockBroadcaster) Broadcast(msgs ...sdk.Msg) error {
+	if b.err != nil {
+		return b.err
+	}
+	b.txs = append(b.txs, msgs)
+	return nil
+}
+
+func TestDaemon_PostPrices(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"kava": {"usd": 0.8123}, "hard": {"usd": "0.25"}, "tiny": [{"usd": 1.5e-05}]}`))
+	}))
+	defer server.Close()
+
+	pricesFile := filepath.Join(t.TempDir(), "prices.json")
+	require.NoError(t, os.WriteFile(pricesFile, []byte(`{"usdx": 1}`), 0o600))
+
+	cfg, err := oracle.ParseConfig([]byte(`
+interval = "1m"
+expiry = "5m"
+
+[[sources]]
+name = "api"
+type = "http"
+url = "` + server.URL + `"
+
+[[sources]]
+name = "file"
+type = "file"
+path = "` + pricesFile + `"
+
+[[sources]]
+name = "mock"
+type = "mock"
+[sources.prices]
+"bnb" = "300.5"
+
+[[markets]]
+market_id = "kava:usd"
+source = "api"
+path = "kava.usd"
+
+[[markets]]
+market_id = "hard:usd"
+source = "api"
+path = "hard.usd"
+
+[[markets]]
+market_id = "tiny:usd"
+source = "api"
+path = "tiny.0.usd"
+
+[[markets]]
+market_id = "usdx:usd"
+source = "file"
+path = "usdx"
+
+[[markets]]
+market_id = "bnb:usd"
+source = "mock"
+path = "bnb"
+
+[[markets]]
+market_id = "missing:usd"
+source = "api"
+path = "missing.usd"
+`))
+	require.NoError(t, err)
+
+	broadcaster := &mockBroadcaster{}
+	metrics := oracle.NewMetrics()
+	daemon, err := oracle.NewDaemon(cfg, addrs[0], broadcaster, metrics, log.NewNopLogger())
+	require.NoError(t, err)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, daemon.PostPrices(context.Background(), now))
+
+	// each source is only fetched once, and markets without a price are left out
+	require.Equal(t, 1, requests)
+	expiry := now.Add(5 * time.Minute)
+	from := addrs[0].String()
+	require.Equal(t, [][]sdk.Msg{{
+		pricefeedtypes.NewMsgPostPrices(from, pricefeedtypes.PostPriceEntries{
+			pricefeedtypes.NewPostPriceEntry("bnb:usd", sdk.MustNewDecFromStr("300.5"), expiry),
+			pricefeedtypes.NewPostPriceEntry("hard:usd", sdk.MustNewDecFromStr("0.25"), expiry),
+			pricefeedtypes.NewPostPriceEntry("kava:usd", sdk.MustNewDecFromStr("0.8123"), expiry),
+			pricefeedtypes.NewPostPriceEntry("tiny:usd", sdk.MustNewDecFromStr("0.000015"), expiry),
+			pricefeedtypes.NewPostPriceEntry("usdx:usd", sdk.OneDec(), expiry),
+		}),
+	}}, broadcaster.txs)
+	require.NoError(t, broadcaster.txs[0][0].ValidateBasic())
+
+	// a failed broadcast is returned and counted
+	broadcaster.err = errors.New("connection refused")
+	require.ErrorContains(t, daemon.PostPrices(context.Background(), now), "connection refused")
+
+	metricsServer := httptest.NewServer(metrics.Handler())
+	defer metricsServer.Close()
+	res, err := http.Get(metricsServer.URL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	require.Contains(t, string(body), `kava_oracle_price{market_id="kava:usd"} 0.8123`)
+	require.Contains(t, string(body), `kava_oracle_source_errors_total{source="api"} 2`)
+	require.Contains(t, string(body), `kava_oracle_posts_total{result="success"} 1`)
+	require.Contains(t, string(body), `kava_oracle_posts_total{result="failure"} 1`)
+	require.Contains(t, string(body), `kava_oracle_last_post_timestamp_seconds 1.7040672e+09`)
+}
+
+func TestDaemon_SourceUnavailable(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "rate limited", http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	cfg := oracle.Config{
+		Interval: oracle.Duration{Duration: time.Minute},
+		Expiry:   oracle.Duration{Duration: 5 * time.Minute},
+		Sources:  []oracle.SourceConfig{{Name: "api", Type: oracle.SourceTypeHTTP, URL: server.URL}},
+		Markets:  []oracle.MarketConfig{{MarketID: "kava:usd", Source: "api", Path: "kava.usd"}},
+	}
+	broadcaster := &mockBroadcaster{}
+	daemon, err := oracle.NewDaemon(cfg, addrs[0], broadcaster, oracle.NewMetrics(), log.NewNopLogger())
+	require.NoError(t, err)
+
+	require.ErrorContains(t, daemon.PostPrices(context.Background(), time.Now()), "no prices were fetched")
+	require.Empty(t, broadcaster.txs)
+}
@@ -0,0 +1,65 @@
+package oracle
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	metricsNamespace = "kava"
+	metricsSubsystem = "oracle"
+)
+
+// Metrics contains the prometheus metrics of a running oracle.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	// The last price fetched for each market, labeled by market_id
+	Price *prometheus.GaugeVec
+	// The number of failed source fetches and price lookups, labeled by source
+	SourceErrors *prometheus.CounterVec
+	// The number of price posting transactions, labeled by result
+	Posts *prometheus.CounterVec
+	// The unix time of the last successful post
+	LastPostTime prometheus.Gauge
+}
+
+// NewMetrics returns metrics registered on a new registry
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		Price: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "price",
+			Help:      "The last price fetched for each market.",
+		}, []string{"market_id"}),
+		SourceErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "source_errors_total",
+			Help:      "The number of failed source fetches and price lookups.",
+		}, []string{"source"}),
+		Posts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "posts_total",
+			Help:      "The number of price posting transactions, by result.",
+		}, []string{"result"}),
+		LastPostTime: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "last_post_timestamp_seconds",
+			Help:      "The unix time of the last successful price posting transaction.",
+		}),
+	}
+	m.registry.MustRegister(m.Price, m.SourceErrors, m.Posts, m.LastPostTime)
+	return m
+}
+
+// Handler returns an http handler serving the metrics
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
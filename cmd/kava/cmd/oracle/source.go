@@ -0,0 +1,186 @@
+package oracle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultHTTPTimeout is how long requests to http sources can take when the config doesn't set a timeout
+const DefaultHTTPTimeout = 10 * time.Second
+
+// Source fetches data that market prices are looked up in by path.
+type Source interface {
+	Fetch(ctx context.Context) (interface{}, error)
+}
+
+// NewSource returns the source for a source config
+func NewSource(cfg SourceConfig) (Source, error) {
+	switch cfg.Type {
+	case SourceTypeHTTP:
+		timeout := cfg.Timeout.Duration
+		if timeout <= 0 {
+			timeout = DefaultHTTPTimeout
+		}
+		return NewHTTPSource(cfg.URL, &http.Client{Timeout: timeout}), nil
+	case SourceTypeFile:
+		return NewFileSource(cfg.Path), nil
+	case SourceTypeMock:
+		return NewMockSource(cfg.Prices), nil
+	default:
+		return nil, fmt.Errorf("invalid source type %q", cfg.Type)
+	}
+}
+
+// HTTPSource fetches JSON from an http endpoint.
+type HTTPSource struct {
+	url    string
+	client *http.Client
+}
+
+var _ Source = HTTPSource{}
+
+// NewHTTPSource returns a new HTTPSource
+func NewHTTPSource(url string, client *http.Client) HTTPSource {
+	return HTTPSource{url: url, client: client}
+}
+
+// Fetch implements Source
+func (s HTTPSource) Fetch(ctx context.Context) (interface{}, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s from %s", res.Status, s.url)
+	}
+	return decodeJSON(res.Body)
+}
+
+// FileSource reads JSON from a file, which is read again on every fetch so it can be updated while running.
+type FileSource struct {
+	path string
+}
+
+var _ Source = FileSource{}
+
+// NewFileSource returns a new FileSource
+func NewFileSource(path string) FileSource {
+	return FileSource{path: path}
+}
+
+// Fetch implements Source
+func (s FileSource) Fetch(_ context.Context) (interface{}, error) {
+	bz, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+	return decodeJSON(bytes.NewReader(bz))
+}
+
+// MockSource returns fixed prices, keyed by path, for testing.
+type MockSource struct {
+	prices map[string]interface{}
+}
+
+var _ Source = MockSource{}
+
+// NewMockSource returns a new MockSource
+func NewMockSource(prices map[string]string) MockSource {
+	data := make(map[string]interface{}, len(prices))
+	for path, price := range prices {
+		data[path] = price
+	}
+	return MockSource{prices: data}
+}
+
+// Fetch implements Source
+func (s MockSource) Fetch(_ context.Context) (interface{}, error) {
+	return s.prices, nil
+}
+
+func decodeJSON(r io.Reader) (interface{}, error) {
+	decoder := json.NewDecoder(r)
+	// numbers are kept as strings so prices don't lose precision as floats
+	decoder.UseNumber()
+
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		return nil, fmt.Errorf("invalid json: %w", err)
+	}
+	return data, nil
+}
+
+// lookupPrice returns the positive price at a dot separated path in data fetched from a source. Path elements index
+// into objects by key and arrays by number.
+func lookupPrice(data interface{}, path string) (sdk.Dec, error) {
+	value := data
+	for _, key := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			next, found := v[key]
+			if !found {
+				return sdk.Dec{}, fmt.Errorf("%s not found at %s", key, path)
+			}
+			value = next
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return sdk.Dec{}, fmt.Errorf("invalid index %s at %s", key, path)
+			}
+			value = v[i]
+		default:
+			return sdk.Dec{}, fmt.Errorf("cannot look up %s in a %T at %s", key, value, path)
+		}
+	}
+
+	var str string
+	switch v := value.(type) {
+	case json.Number:
+		str = v.String()
+	case string:
+		str = v
+	default:
+		return sdk.Dec{}, fmt.Errorf("price at %s is a %T, not a number", path, value)
+	}
+
+	price, err := parseDec(str)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("invalid price at %s: %w", path, err)
+	}
+	if !price.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("price at %s must be positive: %s", path, price)
+	}
+	return price, nil
+}
+
+// parseDec parses a decimal, including numbers in exponent notation such as 1.5e-05
+func parseDec(str string) (sdk.Dec, error) {
+	str = strings.TrimSpace(str)
+	if !strings.ContainsAny(str, "eE") {
+		return sdk.NewDecFromStr(str)
+	}
+	f, ok := new(big.Float).SetPrec(256).SetString(str)
+	if !ok {
+		return sdk.Dec{}, fmt.Errorf("invalid number %s", str)
+	}
+	return sdk.NewDecFromStr(f.Text('f', sdk.Precision))
+}
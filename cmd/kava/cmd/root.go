@@ -28,6 +28,7 @@ import (
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/app/params"
 	"github.com/kava-labs/kava/cmd/kava/cmd/iavlviewer"
+	"github.com/kava-labs/kava/cmd/kava/cmd/oracle"
 	"github.com/kava-labs/kava/cmd/kava/cmd/rocksdb"
 )
 
@@ -142,5 +143,6 @@ func addSubCmds(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, de
 		rocksdb.RocksDBCmd,
 		newShardCmd(opts),
 		iavlviewer.NewCmd(opts),
+		oracle.NewCmd(),
 	)
 }
@@ -238,9 +238,10 @@ replace (
 	// TODO: Tag before release
 	github.com/ethereum/go-ethereum => github.com/Kava-Labs/go-ethereum v1.10.27-0.20240513233504-6e038346780b
 	// Use ethermint fork that respects min-gas-price with NoBaseFee true and london enabled, and includes eip712 support
-	// Tracking kava-labs/etheremint master branch
-	// TODO: Tag before release
-	github.com/evmos/ethermint => github.com/kava-labs/ethermint v0.21.1-0.20240919184235-65384e03c3e7
+	// Vendored from kava-labs/ethermint v0.21.1-0.20240919184235-65384e03c3e7 with the StateDB extensions
+	// required by stateful precompiles, see third_party/ethermint
+	// TODO: Replace with the kava-labs/ethermint version including these changes once tagged, and drop third_party/ethermint
+	github.com/evmos/ethermint => ./third_party/ethermint
 	// See https://github.com/cosmos/cosmos-sdk/pull/10401, https://github.com/cosmos/cosmos-sdk/commit/0592ba6158cd0bf49d894be1cef4faeec59e8320
 	github.com/gin-gonic/gin => github.com/gin-gonic/gin v1.9.0
 	// Downgraded to avoid bugs in following commits which causes "version does not exist" errors
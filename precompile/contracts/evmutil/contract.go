@@ -0,0 +1,226 @@
+package evmutil
+
+import (
+	_ "embed"
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+
+	"github.com/kava-labs/kava/precompile/contractutils"
+	"github.com/kava-labs/kava/precompile/framework"
+	evmutiltypes "github.com/kava-labs/kava/x/evmutil/types"
+)
+
+// Gas schedules of the evmutil precompile functions. Conversions additionally charge the cosmos gas
+// consumed by the keeper calls, which includes the gas used by the ERC20 mint or burn.
+var (
+	ConvertCosmosCoinToERC20Gas      = framework.GasSchedule{Base: contract.ReadGasCostPerSlot}
+	ConvertCosmosCoinFromERC20Gas    = framework.GasSchedule{Base: contract.ReadGasCostPerSlot}
+	GetDeployedCosmosCoinContractGas = framework.GasSchedule{Base: contract.ReadGasCostPerSlot}
+	GetAllowedCosmosDenomsGas        = framework.GasSchedule{Base: 2 * contract.ReadGasCostPerSlot}
+)
+
+var (
+	// RawABI contains the raw ABI of the evmutil precompile
+	//go:embed IEvmutil.abi
+	RawABI string
+
+	// ABI is the parsed ABI of the evmutil precompile
+	ABI = contract.MustParseABI(RawABI)
+)
+
+// EvmutilKeeper defines the expected evmutil keeper used by the precompile
+type EvmutilKeeper interface {
+	ConvertCosmosCoinToERC20(ctx sdk.Context, initiator sdk.AccAddress, receiver evmutiltypes.InternalEVMAddress, amount sdk.Coin) error
+	ConvertCosmosCoinFromERC20(ctx sdk.Context, initiator evmutiltypes.InternalEVMAddress, receiver sdk.AccAddress, coin sdk.Coin) error
+	GetDeployedCosmosCoinContract(ctx sdk.Context, cosmosDenom string) (evmutiltypes.InternalEVMAddress, bool)
+	GetParams(ctx sdk.Context) evmutiltypes.Params
+}
+
+// Keepers contains the keepers used by the evmutil precompile
+type Keepers struct {
+	EvmutilKeeper EvmutilKeeper
+}
+
+// allowedCosmosDenom is the abi representation of an evmutiltypes.AllowedCosmosCoinERC20Token
+type allowedCosmosDenom struct {
+	CosmosDenom string
+	Name        string
+	Symbol      string
+	Decimals    uint8
+}
+
+// evmutilPrecompile implements the functions of the evmutil stateful precompile
+type evmutilPrecompile struct {
+	keepers func(contract.AccessibleState) (Keepers, error)
+}
+
+// NewContract returns a new evmutil stateful precompiled contract.
+//
+// The contract exposes x/evmutil cosmos coin conversions to EVM contracts, allowing a contract to wrap
+// sdk coins it holds into their ERC20 representation, or unwrap ERC20s it holds to a bech32 recipient,
+// within an EVM transaction. The calling EVM address is used as the conversion initiator.
+//
+// The ERC20 mint and burn are run as a separate EVM call within the native action of the conversion,
+// which sees the token state of the transaction and is reverted with it.
+func NewContract(keepers func(contract.AccessibleState) (Keepers, error)) (contract.StatefulPrecompiledContract, error) {
+	p := evmutilPrecompile{keepers: keepers}
+
+	precompile, err := framework.NewContract("evmutil", ABI, []framework.Function{
+		{Method: "convertCosmosCoinToERC20", Gas: ConvertCosmosCoinToERC20Gas, Handler: p.convertCosmosCoinToERC20},
+		{Method: "convertCosmosCoinFromERC20", Gas: ConvertCosmosCoinFromERC20Gas, Handler: p.convertCosmosCoinFromERC20},
+		{Method: "getDeployedCosmosCoinContract", Gas: GetDeployedCosmosCoinContractGas, Handler: p.getDeployedCosmosCoinContract},
+		{Method: "getAllowedCosmosDenoms", Gas: GetAllowedCosmosDenomsGas, Handler: p.getAllowedCosmosDenoms},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate evmutil precompile: %w", err)
+	}
+
+	return precompile, nil
+}
+
+// load returns the context and keepers required to run a precompile function
+func (p evmutilPrecompile) load(call *framework.Call) (sdk.Context, Keepers, error) {
+	keepers, err := p.keepers(call.AccessibleState)
+	if err != nil {
+		return sdk.Context{}, Keepers{}, err
+	}
+
+	ctx, err := call.Context()
+	if err != nil {
+		return sdk.Context{}, Keepers{}, err
+	}
+
+	return ctx, keepers, nil
+}
+
+// newCoin returns a positive sdk.Coin from abi arguments
+func newCoin(denom string, amount *big.Int) (sdk.Coin, error) {
+	coin := sdk.Coin{Denom: denom, Amount: sdkmath.NewIntFromBigInt(amount)}
+	if err := coin.Validate(); err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if !coin.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+
+	return coin, nil
+}
+
+func (p evmutilPrecompile) convertCosmosCoinToERC20(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	coin, err := newCoin(args[0].(string), args[1].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+	receiver := args[2].(common.Address)
+
+	_, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+
+	var token evmutiltypes.InternalEVMAddress
+	err = call.RunMetered(func(ctx sdk.Context) error {
+		if err := keepers.EvmutilKeeper.ConvertCosmosCoinToERC20(
+			ctx,
+			contractutils.AccAddressFromEvm(call.Caller),
+			evmutiltypes.NewInternalEVMAddress(receiver),
+			coin,
+		); err != nil {
+			return err
+		}
+
+		// the contract is deployed by the first conversion of a denom
+		token, _ = keepers.EvmutilKeeper.GetDeployedCosmosCoinContract(ctx, coin.Denom)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := call.EmitEvent(
+		"ConvertCosmosCoinToERC20", call.Caller, receiver, token.Address, coin.Denom, coin.Amount.BigInt(),
+	); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{token.Address}, nil
+}
+
+func (p evmutilPrecompile) convertCosmosCoinFromERC20(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	coin, err := newCoin(args[0].(string), args[1].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+	receiver, err := sdk.AccAddressFromBech32(args[2].(string))
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	_, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+
+	var token evmutiltypes.InternalEVMAddress
+	err = call.RunMetered(func(ctx sdk.Context) error {
+		token, _ = keepers.EvmutilKeeper.GetDeployedCosmosCoinContract(ctx, coin.Denom)
+
+		return keepers.EvmutilKeeper.ConvertCosmosCoinFromERC20(
+			ctx,
+			evmutiltypes.NewInternalEVMAddress(call.Caller),
+			receiver,
+			coin,
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := call.EmitEvent(
+		"ConvertCosmosCoinFromERC20", call.Caller, token.Address, receiver.String(), coin.Denom, coin.Amount.BigInt(),
+	); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{token.Address}, nil
+}
+
+func (p evmutilPrecompile) getDeployedCosmosCoinContract(call *framework.Call, args []interface{}) ([]interface{}, error) {
+
+	ctx, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+
+	token, found := keepers.EvmutilKeeper.GetDeployedCosmosCoinContract(ctx, args[0].(string))
+
+	return []interface{}{token.Address, found}, nil
+}
+
+func (p evmutilPrecompile) getAllowedCosmosDenoms(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	ctx, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := keepers.EvmutilKeeper.GetParams(ctx).AllowedCosmosDenoms
+
+	tokens := make([]allowedCosmosDenom, 0, len(allowed))
+	for _, token := range allowed {
+		tokens = append(tokens, allowedCosmosDenom{
+			CosmosDenom: token.CosmosDenom,
+			Name:        token.Name,
+			Symbol:      token.Symbol,
+			Decimals:    uint8(token.Decimals),
+		})
+	}
+
+	return []interface{}{tokens}, nil
+}
@@ -0,0 +1,302 @@
+package evmutil_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/precompile/contracts/evmutil"
+	"github.com/kava-labs/kava/precompile/contractutils"
+	"github.com/kava-labs/kava/precompile/testutil"
+	evmutiltestutil "github.com/kava-labs/kava/x/evmutil/testutil"
+	evmutiltypes "github.com/kava-labs/kava/x/evmutil/types"
+)
+
+const allowedDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+var (
+	callerAddr   = common.HexToAddress("0xc0ffee254729296a45a3885639AC7E10F9d54979")
+	contractAddr = common.HexToAddress("0x9000000000000000000000000000000000000006")
+)
+
+// TestContractConstructor ensures we have a valid constructor. This will fail
+// if we attempt to define invalid or duplicate function selectors.
+func TestContractConstructor(t *testing.T) {
+	precompile, err := evmutil.NewContract(func(contract.AccessibleState) (evmutil.Keepers, error) { return evmutil.Keepers{}, nil })
+	require.NoError(t, err, "expected precompile not error when created")
+	assert.NotNil(t, precompile, "expected precompile contract to be defined")
+}
+
+type contractTestSuite struct {
+	evmutiltestutil.Suite
+
+	Keepers    evmutil.Keepers
+	StateDB    *statedb.StateDB
+	Precompile contract.StatefulPrecompiledContract
+	Contract   testutil.Contract
+}
+
+func (suite *contractTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.AllowedCosmosDenoms = evmutiltypes.NewAllowedCosmosCoinERC20Tokens(
+		evmutiltypes.NewAllowedCosmosCoinERC20Token(allowedDenom, "Kava EVM Atom", "ATOM", 6),
+	)
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	err := suite.App.FundAccount(suite.Ctx, contractutils.AccAddressFromEvm(callerAddr), sdk.NewCoins(
+		sdk.NewInt64Coin(allowedDenom, 1e10),
+	))
+	suite.Require().NoError(err)
+
+	suite.Keepers = evmutil.Keepers{EvmutilKeeper: &suite.Keeper}
+	precompile, err := evmutil.NewContract(func(contract.AccessibleState) (evmutil.Keepers, error) { return suite.Keepers, nil })
+	suite.Require().NoError(err)
+	suite.Precompile = precompile
+	suite.StateDB = testutil.NewStateDB(suite.Ctx, suite.App.GetEvmKeeper())
+	suite.Contract = testutil.Contract{
+		ABI:        evmutil.ABI,
+		Precompile: precompile,
+		StateDB:    suite.StateDB,
+		Address:    contractAddr,
+		Caller:     callerAddr,
+		Gas:        10_000_000,
+		Commit:     true,
+	}
+}
+
+func TestContractTestSuite(t *testing.T) {
+	suite.Run(t, new(contractTestSuite))
+}
+
+func (suite *contractTestSuite) erc20BalanceOf(token, account common.Address) *big.Int {
+	balance, err := suite.Keeper.QueryERC20BalanceOf(
+		suite.Ctx,
+		evmutiltypes.NewInternalEVMAddress(token),
+		evmutiltypes.NewInternalEVMAddress(account),
+	)
+	suite.Require().NoError(err)
+	return balance
+}
+
+func (suite *contractTestSuite) TestConvertCosmosCoinToERC20() {
+	receiver := evmutiltestutil.RandomEvmAddress()
+
+	ret, _, err := suite.Contract.Run(suite.T(), false, "convertCosmosCoinToERC20", allowedDenom, big.NewInt(6e8), receiver)
+	suite.Require().NoError(err)
+
+	out, err := evmutil.ABI.Unpack("convertCosmosCoinToERC20", ret)
+	suite.Require().NoError(err)
+	token, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, allowedDenom)
+	suite.Require().True(found)
+	suite.Equal(token.Address, out[0])
+
+	suite.Equal(big.NewInt(6e8), suite.erc20BalanceOf(token.Address, receiver))
+	suite.Equal(
+		sdkmath.NewInt(1e10-6e8),
+		suite.BankKeeper.GetBalance(suite.Ctx, contractutils.AccAddressFromEvm(callerAddr), allowedDenom).Amount,
+	)
+
+	logs := suite.StateDB.Logs()
+	suite.Require().Len(logs, 1)
+	suite.Equal(evmutil.ABI.Events["ConvertCosmosCoinToERC20"].ID, logs[0].Topics[0])
+	suite.Equal(common.BytesToHash(receiver.Bytes()), logs[0].Topics[2])
+	suite.Equal(common.BytesToHash(token.Bytes()), logs[0].Topics[3])
+}
+
+func (suite *contractTestSuite) TestConvertCosmosCoinToERC20_Errors() {
+	receiver := evmutiltestutil.RandomEvmAddress()
+
+	ret, _, err := suite.Contract.Run(suite.T(), false, "convertCosmosCoinToERC20", "ukava", big.NewInt(1e6), receiver)
+	testutil.RequireRevert(suite.T(), ret, err, "sdk.Coin not enabled to convert to ERC20 token")
+
+	ret, _, err = suite.Contract.Run(suite.T(), false, "convertCosmosCoinToERC20", allowedDenom, big.NewInt(0), receiver)
+	testutil.RequireRevert(suite.T(), ret, err, "amount must be positive")
+
+	ret, _, err = suite.Contract.Run(suite.T(), false, "convertCosmosCoinToERC20", allowedDenom, big.NewInt(2e10), receiver)
+	testutil.RequireRevert(suite.T(), ret, err, "insufficient funds")
+
+	_, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, allowedDenom)
+	suite.False(found, "expected failed conversion to not deploy a contract")
+	suite.Empty(suite.StateDB.Logs())
+}
+
+func (suite *contractTestSuite) TestConvertCosmosCoinFromERC20() {
+	// wrap coins held by the caller so it holds erc20 tokens
+	_, _, err := suite.Contract.Run(suite.T(), false, "convertCosmosCoinToERC20", allowedDenom, big.NewInt(6e8), callerAddr)
+	suite.Require().NoError(err)
+	token, _ := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, allowedDenom)
+
+	receiver := app.RandomAddress()
+	ret, _, err := suite.Contract.Run(suite.T(), false, "convertCosmosCoinFromERC20", allowedDenom, big.NewInt(2e8), receiver.String())
+	suite.Require().NoError(err)
+
+	out, err := evmutil.ABI.Unpack("convertCosmosCoinFromERC20", ret)
+	suite.Require().NoError(err)
+	suite.Equal(token.Address, out[0])
+
+	suite.Equal(big.NewInt(4e8), suite.erc20BalanceOf(token.Address, callerAddr))
+	suite.Equal(sdkmath.NewInt(2e8), suite.BankKeeper.GetBalance(suite.Ctx, receiver, allowedDenom).Amount)
+
+	logs := suite.StateDB.Logs()
+	suite.Require().Len(logs, 2)
+	suite.Equal(evmutil.ABI.Events["ConvertCosmosCoinFromERC20"].ID, logs[1].Topics[0])
+}
+
+func (suite *contractTestSuite) TestConvertCosmosCoinFromERC20_Errors() {
+	receiver := app.RandomAddress().String()
+
+	ret, _, err := suite.Contract.Run(suite.T(), false, "convertCosmosCoinFromERC20", allowedDenom, big.NewInt(1e6), receiver)
+	testutil.RequireRevert(suite.T(), ret, err, "no erc20 contract found")
+
+	_, _, err = suite.Contract.Run(suite.T(), false, "convertCosmosCoinToERC20", allowedDenom, big.NewInt(6e8), callerAddr)
+	suite.Require().NoError(err)
+
+	ret, _, err = suite.Contract.Run(suite.T(), false, "convertCosmosCoinFromERC20", allowedDenom, big.NewInt(1e6), "invalid")
+	testutil.RequireRevert(suite.T(), ret, err, "invalid address")
+
+	ret, _, err = suite.Contract.Run(suite.T(), false, "convertCosmosCoinFromERC20", allowedDenom, big.NewInt(7e8), receiver)
+	testutil.RequireRevert(suite.T(), ret, err, "insufficient funds")
+}
+
+func (suite *contractTestSuite) TestConvert_TokenStateCached() {
+	_, _, err := suite.Contract.Run(suite.T(), false, "convertCosmosCoinToERC20", allowedDenom, big.NewInt(6e8), callerAddr)
+	suite.Require().NoError(err)
+	token, _ := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, allowedDenom)
+
+	// the transaction writes the token balance of the receiver before converting, the conversion mints
+	// on top of it and is not overwritten by the cached token storage on commit
+	balanceSlot := crypto.Keccak256Hash(common.LeftPadBytes(callerAddr.Bytes(), 32), common.LeftPadBytes(nil, 32))
+	suite.Require().Equal(common.BigToHash(big.NewInt(6e8)), suite.StateDB.GetState(token.Address, balanceSlot))
+	suite.StateDB.SetState(token.Address, balanceSlot, common.BigToHash(big.NewInt(5e8)))
+
+	input, err := evmutil.ABI.Pack("convertCosmosCoinToERC20", allowedDenom, big.NewInt(1e6), callerAddr)
+	suite.Require().NoError(err)
+	_, _, err = suite.Precompile.Run(testutil.NewAccessibleState(suite.StateDB), callerAddr, contractAddr, input, 10_000_000, false)
+	suite.Require().NoError(err)
+	suite.Equal(common.BigToHash(big.NewInt(5e8+1e6)), suite.StateDB.GetState(token.Address, balanceSlot))
+
+	suite.Require().NoError(suite.StateDB.Commit())
+	suite.Equal(big.NewInt(5e8+1e6), suite.erc20BalanceOf(token.Address, callerAddr))
+}
+
+func (suite *contractTestSuite) TestReadOnly() {
+	suite.Contract.RequireWriteProtected(suite.T(), "convertCosmosCoinToERC20", allowedDenom, big.NewInt(1e6), callerAddr)
+	suite.Contract.RequireWriteProtected(suite.T(), "convertCosmosCoinFromERC20", allowedDenom, big.NewInt(1e6), app.RandomAddress().String())
+}
+
+func (suite *contractTestSuite) TestGetDeployedCosmosCoinContract() {
+	ret, _, err := suite.Contract.Run(suite.T(), true, "getDeployedCosmosCoinContract", allowedDenom)
+	suite.Require().NoError(err)
+	out, err := evmutil.ABI.Unpack("getDeployedCosmosCoinContract", ret)
+	suite.Require().NoError(err)
+	suite.Equal(common.Address{}, out[0])
+	suite.Equal(false, out[1])
+
+	_, _, err = suite.Contract.Run(suite.T(), false, "convertCosmosCoinToERC20", allowedDenom, big.NewInt(1e6), callerAddr)
+	suite.Require().NoError(err)
+	token, _ := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, allowedDenom)
+
+	ret, _, err = suite.Contract.Run(suite.T(), true, "getDeployedCosmosCoinContract", allowedDenom)
+	suite.Require().NoError(err)
+	out, err = evmutil.ABI.Unpack("getDeployedCosmosCoinContract", ret)
+	suite.Require().NoError(err)
+	suite.Equal(token.Address, out[0])
+	suite.Equal(true, out[1])
+}
+
+func (suite *contractTestSuite) TestGetAllowedCosmosDenoms() {
+	ret, remainingGas, err := suite.Contract.Run(suite.T(), true, "getAllowedCosmosDenoms")
+	suite.Require().NoError(err)
+	suite.Equal(uint64(10_000_000)-evmutil.GetAllowedCosmosDenomsGas.Base, remainingGas)
+
+	var out struct {
+		Tokens []struct {
+			CosmosDenom string
+			Name        string
+			Symbol      string
+			Decimals    uint8
+		}
+	}
+	err = evmutil.ABI.UnpackIntoInterface(&out, "getAllowedCosmosDenoms", ret)
+	suite.Require().NoError(err)
+	suite.Require().Len(out.Tokens, 1)
+	suite.Equal(allowedDenom, out.Tokens[0].CosmosDenom)
+	suite.Equal("Kava EVM Atom", out.Tokens[0].Name)
+	suite.Equal("ATOM", out.Tokens[0].Symbol)
+	suite.Equal(uint8(6), out.Tokens[0].Decimals)
+}
+
+func (suite *contractTestSuite) TestKeepersNotSet() {
+	testutil.RequireKeepersNotSet(suite.T(), suite.Contract, evmutil.NewContract, "getAllowedCosmosDenoms")
+}
+
+// setupEVM enables the precompile and deploys a forwarder contract calling the target, funded with the allowed denom
+func (suite *contractTestSuite) setupEVM(forwarder common.Address, target common.Address, mode testutil.ForwarderMode) {
+	evmKeeper := suite.App.GetEvmKeeper()
+	suite.Require().NoError(testutil.SetupEVM(suite.Ctx, evmKeeper, contractAddr))
+	suite.Require().NoError(testutil.DeployCode(suite.Ctx, evmKeeper, forwarder, testutil.ForwarderCode(target, mode)))
+	suite.Require().NoError(suite.App.FundAccount(
+		suite.Ctx, contractutils.AccAddressFromEvm(forwarder), sdk.NewCoins(sdk.NewInt64Coin(allowedDenom, 1e10)),
+	))
+}
+
+func (suite *contractTestSuite) TestEVM_Convert() {
+	forwarder := common.HexToAddress("0x00000000000000000000000000000000000000f1")
+	suite.setupEVM(forwarder, contractAddr, testutil.ForwardReturn)
+
+	input, err := evmutil.ABI.Pack("convertCosmosCoinToERC20", allowedDenom, big.NewInt(6e8), forwarder)
+	suite.Require().NoError(err)
+	res, err := testutil.ApplyMessage(suite.Ctx, suite.App.GetEvmKeeper(), callerAddr, forwarder, big.NewInt(0), input)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	token, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, allowedDenom)
+	suite.Require().True(found)
+	suite.Equal(big.NewInt(6e8), suite.erc20BalanceOf(token.Address, forwarder))
+
+	receiver := app.RandomAddress()
+	input, err = evmutil.ABI.Pack("convertCosmosCoinFromERC20", allowedDenom, big.NewInt(2e8), receiver.String())
+	suite.Require().NoError(err)
+	res, err = testutil.ApplyMessage(suite.Ctx, suite.App.GetEvmKeeper(), callerAddr, forwarder, big.NewInt(0), input)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	suite.Equal(big.NewInt(4e8), suite.erc20BalanceOf(token.Address, forwarder))
+	suite.Equal(sdkmath.NewInt(2e8), suite.BankKeeper.GetBalance(suite.Ctx, receiver, allowedDenom).Amount)
+}
+
+func (suite *contractTestSuite) TestEVM_RevertedCall() {
+	// the reverter calls the precompile and reverts, the caller ignores the failure so the transaction succeeds
+	reverter := common.HexToAddress("0x00000000000000000000000000000000000000f1")
+	caller := common.HexToAddress("0x00000000000000000000000000000000000000f2")
+	suite.setupEVM(reverter, contractAddr, testutil.ForwardRevert)
+	suite.Require().NoError(testutil.DeployCode(
+		suite.Ctx, suite.App.GetEvmKeeper(), caller, testutil.ForwarderCode(reverter, testutil.ForwardIgnore),
+	))
+
+	input, err := evmutil.ABI.Pack("convertCosmosCoinToERC20", allowedDenom, big.NewInt(6e8), reverter)
+	suite.Require().NoError(err)
+	res, err := testutil.ApplyMessage(suite.Ctx, suite.App.GetEvmKeeper(), callerAddr, caller, big.NewInt(0), input)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	// the conversion, including the token deployment and mint, is reverted with the call that made it
+	suite.Equal(
+		sdkmath.NewInt(1e10),
+		suite.BankKeeper.GetBalance(suite.Ctx, contractutils.AccAddressFromEvm(reverter), allowedDenom).Amount,
+	)
+	_, found := suite.Keeper.GetDeployedCosmosCoinContract(suite.Ctx, allowedDenom)
+	suite.False(found)
+}
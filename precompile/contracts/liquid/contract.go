@@ -0,0 +1,297 @@
+package liquid
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+
+	"github.com/kava-labs/kava/precompile/contractutils"
+	"github.com/kava-labs/kava/precompile/framework"
+)
+
+// Gas schedules of the liquid precompile functions. Mutating functions additionally charge the cosmos
+// gas consumed by the keeper calls, matching the gas used by the equivalent cosmos messages.
+var (
+	MintDerivativeGas         = framework.GasSchedule{Base: contract.ReadGasCostPerSlot}
+	BurnDerivativeGas         = framework.GasSchedule{Base: contract.ReadGasCostPerSlot}
+	DelegateMintDepositGas    = framework.GasSchedule{Base: contract.ReadGasCostPerSlot}
+	WithdrawBurnUndelegateGas = framework.GasSchedule{Base: contract.ReadGasCostPerSlot}
+	GetDerivativeDenomGas     = framework.GasSchedule{Base: contract.ReadGasCostPerSlot}
+	GetDerivativeValueGas     = framework.GasSchedule{Base: 4 * contract.ReadGasCostPerSlot}
+)
+
+var (
+	// RawABI contains the raw ABI of the liquid precompile
+	//go:embed ILiquid.abi
+	RawABI string
+
+	// ABI is the parsed ABI of the liquid precompile
+	ABI = contract.MustParseABI(RawABI)
+)
+
+// LiquidKeeper defines the expected liquid keeper used by the precompile
+type LiquidKeeper interface {
+	MintDerivative(ctx sdk.Context, delegatorAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (sdk.Coin, error)
+	BurnDerivative(ctx sdk.Context, delegatorAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (sdk.Dec, error)
+	GetLiquidStakingTokenDenom(valAddr sdk.ValAddress) string
+	GetDerivativeValue(ctx sdk.Context, denom string) (sdk.Coin, error)
+}
+
+// RouterKeeper defines the expected router keeper used by the precompile
+type RouterKeeper interface {
+	DelegateMintDeposit(ctx sdk.Context, depositor sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (sdk.Coin, error)
+	WithdrawBurnUndelegate(ctx sdk.Context, from sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (sdk.Dec, time.Time, error)
+}
+
+// StakingKeeper defines the expected staking keeper used by the precompile
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) (res string)
+}
+
+// Keepers contains the keepers used by the liquid precompile
+type Keepers struct {
+	LiquidKeeper  LiquidKeeper
+	RouterKeeper  RouterKeeper
+	StakingKeeper StakingKeeper
+}
+
+// liquidPrecompile implements the functions of the liquid stateful precompile
+type liquidPrecompile struct {
+	keepers func(contract.AccessibleState) (Keepers, error)
+}
+
+// NewContract returns a new liquid stateful precompiled contract.
+//
+// The contract exposes x/liquid derivative minting and burning, and the x/router delegate and
+// undelegate flows, to EVM contracts. The calling EVM address is used as the delegator. Validators
+// are provided as bech32 operator addresses and amounts are in the staking bond denom, except for
+// burnDerivative which accepts an amount of the validator's derivative denom.
+func NewContract(keepers func(contract.AccessibleState) (Keepers, error)) (contract.StatefulPrecompiledContract, error) {
+	p := liquidPrecompile{keepers: keepers}
+
+	precompile, err := framework.NewContract("liquid", ABI, []framework.Function{
+		{Method: "mintDerivative", Gas: MintDerivativeGas, Handler: p.mintDerivative},
+		{Method: "burnDerivative", Gas: BurnDerivativeGas, Handler: p.burnDerivative},
+		{Method: "delegateMintDeposit", Gas: DelegateMintDepositGas, Handler: p.delegateMintDeposit},
+		{Method: "withdrawBurnUndelegate", Gas: WithdrawBurnUndelegateGas, Handler: p.withdrawBurnUndelegate},
+		{Method: "getDerivativeDenom", Gas: GetDerivativeDenomGas, Handler: p.getDerivativeDenom},
+		{Method: "getDerivativeValue", Gas: GetDerivativeValueGas, Handler: p.getDerivativeValue},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate liquid precompile: %w", err)
+	}
+
+	return precompile, nil
+}
+
+// load returns the context and keepers required to run a precompile function
+func (p liquidPrecompile) load(call *framework.Call) (sdk.Context, Keepers, error) {
+	keepers, err := p.keepers(call.AccessibleState)
+	if err != nil {
+		return sdk.Context{}, Keepers{}, err
+	}
+
+	ctx, err := call.Context()
+	if err != nil {
+		return sdk.Context{}, Keepers{}, err
+	}
+
+	return ctx, keepers, nil
+}
+
+// unpackValidatorAmount unpacks the validator and amount arguments shared by the mutating functions
+func unpackValidatorAmount(args []interface{}) (sdk.ValAddress, sdkmath.Int, error) {
+	valAddr, err := sdk.ValAddressFromBech32(args[0].(string))
+	if err != nil {
+		return nil, sdkmath.Int{}, err
+	}
+
+	amount := sdkmath.NewIntFromBigInt(args[1].(*big.Int))
+	if !amount.IsPositive() {
+		return nil, sdkmath.Int{}, errors.New("amount must be positive")
+	}
+
+	return valAddr, amount, nil
+}
+
+func (p liquidPrecompile) mintDerivative(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	valAddr, amount, err := unpackValidatorAmount(args)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+
+	// the bond denom is read outside of the metered call as it is covered by the base gas cost
+	bondDenom := keepers.StakingKeeper.BondDenom(ctx)
+
+	var derivative sdk.Coin
+	err = call.RunMetered(func(ctx sdk.Context) error {
+		derivative, err = keepers.LiquidKeeper.MintDerivative(
+			ctx,
+			contractutils.AccAddressFromEvm(call.Caller),
+			valAddr,
+			sdk.NewCoin(bondDenom, amount),
+		)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := call.EmitEvent(
+		"MintDerivative", call.Caller, valAddr.String(), amount.BigInt(), derivative.Amount.BigInt(),
+	); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{derivative.Amount.BigInt()}, nil
+}
+
+func (p liquidPrecompile) burnDerivative(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	valAddr, amount, err := unpackValidatorAmount(args)
+	if err != nil {
+		return nil, err
+	}
+
+	_, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+
+	var shares sdk.Dec
+	err = call.RunMetered(func(ctx sdk.Context) error {
+		shares, err = keepers.LiquidKeeper.BurnDerivative(
+			ctx,
+			contractutils.AccAddressFromEvm(call.Caller),
+			valAddr,
+			sdk.NewCoin(keepers.LiquidKeeper.GetLiquidStakingTokenDenom(valAddr), amount),
+		)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := call.EmitEvent(
+		"BurnDerivative", call.Caller, valAddr.String(), amount.BigInt(), contractutils.DecToFixedPoint(shares),
+	); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{contractutils.DecToFixedPoint(shares)}, nil
+}
+
+func (p liquidPrecompile) delegateMintDeposit(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	valAddr, amount, err := unpackValidatorAmount(args)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom := keepers.StakingKeeper.BondDenom(ctx)
+
+	var derivative sdk.Coin
+	err = call.RunMetered(func(ctx sdk.Context) error {
+		derivative, err = keepers.RouterKeeper.DelegateMintDeposit(
+			ctx,
+			contractutils.AccAddressFromEvm(call.Caller),
+			valAddr,
+			sdk.NewCoin(bondDenom, amount),
+		)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := call.EmitEvent(
+		"DelegateMintDeposit", call.Caller, valAddr.String(), amount.BigInt(), derivative.Amount.BigInt(),
+	); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{derivative.Amount.BigInt()}, nil
+}
+
+func (p liquidPrecompile) withdrawBurnUndelegate(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	valAddr, amount, err := unpackValidatorAmount(args)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom := keepers.StakingKeeper.BondDenom(ctx)
+
+	var (
+		shares         sdk.Dec
+		completionTime time.Time
+	)
+	err = call.RunMetered(func(ctx sdk.Context) error {
+		shares, completionTime, err = keepers.RouterKeeper.WithdrawBurnUndelegate(
+			ctx,
+			contractutils.AccAddressFromEvm(call.Caller),
+			valAddr,
+			sdk.NewCoin(bondDenom, amount),
+		)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sharesFixedPoint := contractutils.DecToFixedPoint(shares)
+	completion := big.NewInt(completionTime.Unix())
+	if err := call.EmitEvent(
+		"WithdrawBurnUndelegate", call.Caller, valAddr.String(), amount.BigInt(), sharesFixedPoint, completion,
+	); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{sharesFixedPoint, completion}, nil
+}
+
+func (p liquidPrecompile) getDerivativeDenom(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	valAddr, err := sdk.ValAddressFromBech32(args[0].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	keepers, err := p.keepers(call.AccessibleState)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{keepers.LiquidKeeper.GetLiquidStakingTokenDenom(valAddr)}, nil
+}
+
+func (p liquidPrecompile) getDerivativeValue(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	ctx, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := keepers.LiquidKeeper.GetDerivativeValue(ctx, args[0].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{value.Amount.BigInt()}, nil
+}
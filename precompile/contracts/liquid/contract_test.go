@@ -0,0 +1,310 @@
+package liquid_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/precompile/contracts/liquid"
+	"github.com/kava-labs/kava/precompile/contractutils"
+	"github.com/kava-labs/kava/precompile/testutil"
+	earntypes "github.com/kava-labs/kava/x/earn/types"
+	liquidkeeper "github.com/kava-labs/kava/x/liquid/keeper"
+	liquidtypes "github.com/kava-labs/kava/x/liquid/types"
+	routertestutil "github.com/kava-labs/kava/x/router/testutil"
+)
+
+var (
+	callerAddr   = common.HexToAddress("0xc0ffee254729296a45a3885639AC7E10F9d54979")
+	contractAddr = common.HexToAddress("0x9000000000000000000000000000000000000005")
+)
+
+// TestContractConstructor ensures we have a valid constructor. This will fail
+// if we attempt to define invalid or duplicate function selectors.
+func TestContractConstructor(t *testing.T) {
+	precompile, err := liquid.NewContract(func(contract.AccessibleState) (liquid.Keepers, error) { return liquid.Keepers{}, nil })
+	require.NoError(t, err, "expected precompile not error when created")
+	assert.NotNil(t, precompile, "expected precompile contract to be defined")
+}
+
+type contractTestSuite struct {
+	routertestutil.Suite
+
+	Keepers    liquid.Keepers
+	StateDB    *statedb.StateDB
+	Precompile contract.StatefulPrecompiledContract
+	Contract   testutil.Contract
+
+	user            sdk.AccAddress
+	valAddr         sdk.ValAddress
+	derivativeDenom string
+}
+
+func (suite *contractTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	suite.valAddr = sdk.ValAddress(addrs[0])
+	suite.user = contractutils.AccAddressFromEvm(callerAddr)
+	suite.derivativeDenom = fmt.Sprintf("bkava-%s", suite.valAddr)
+
+	balance := sdkmath.NewInt(1e9)
+	suite.CreateAccountWithAddress(addrs[0], suite.NewBondCoins(balance))
+	suite.CreateAccountWithAddress(suite.user, suite.NewBondCoins(balance))
+	suite.CreateNewUnbondedValidator(suite.valAddr, balance)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	suite.CreateVault("bkava", earntypes.StrategyTypes{earntypes.STRATEGY_TYPE_SAVINGS}, false, nil)
+	suite.SetSavingsSupportedDenoms([]string{suite.derivativeDenom})
+
+	suite.Keepers = liquid.Keepers{
+		LiquidKeeper:  suite.App.GetLiquidKeeper(),
+		RouterKeeper:  suite.Keeper,
+		StakingKeeper: suite.StakingKeeper,
+	}
+	precompile, err := liquid.NewContract(func(contract.AccessibleState) (liquid.Keepers, error) { return suite.Keepers, nil })
+	suite.Require().NoError(err)
+	suite.Precompile = precompile
+	suite.StateDB = testutil.NewStateDB(suite.Ctx, suite.App.GetEvmKeeper())
+	suite.Contract = testutil.Contract{
+		ABI:        liquid.ABI,
+		Precompile: precompile,
+		StateDB:    suite.StateDB,
+		Address:    contractAddr,
+		Caller:     callerAddr,
+		Gas:        1_000_000,
+		Commit:     true,
+	}
+}
+
+func TestContractTestSuite(t *testing.T) {
+	suite.Run(t, new(contractTestSuite))
+}
+
+func (suite *contractTestSuite) TestMintDerivative() {
+	suite.CreateDelegation(suite.valAddr, suite.user, sdkmath.NewInt(100e6))
+
+	ret, _, err := suite.Contract.Run(suite.T(), false, "mintDerivative", suite.valAddr.String(), big.NewInt(40e6))
+	suite.Require().NoError(err)
+
+	out, err := liquid.ABI.Unpack("mintDerivative", ret)
+	suite.Require().NoError(err)
+	suite.Equal(big.NewInt(40e6), out[0])
+	suite.AccountBalanceOfEqual(suite.user, suite.derivativeDenom, sdkmath.NewInt(40e6))
+	suite.DelegationSharesEqual(suite.valAddr, suite.user, sdk.NewDec(60e6))
+
+	logs := suite.StateDB.Logs()
+	suite.Require().Len(logs, 1)
+	suite.Equal(liquid.ABI.Events["MintDerivative"].ID, logs[0].Topics[0])
+	suite.Equal(common.BytesToHash(callerAddr.Bytes()), logs[0].Topics[1])
+}
+
+func (suite *contractTestSuite) TestMintDerivative_Errors() {
+	ret, _, err := suite.Contract.Run(suite.T(), false, "mintDerivative", "invalid", big.NewInt(1e6))
+	testutil.RequireRevert(suite.T(), ret, err, "decoding bech32 failed")
+
+	ret, _, err = suite.Contract.Run(suite.T(), false, "mintDerivative", suite.valAddr.String(), big.NewInt(0))
+	testutil.RequireRevert(suite.T(), ret, err, "amount must be positive")
+
+	// no delegation to convert
+	ret, _, err = suite.Contract.Run(suite.T(), false, "mintDerivative", suite.valAddr.String(), big.NewInt(1e6))
+	testutil.RequireRevert(suite.T(), ret, err, "no delegation for (address, validator) tuple")
+	suite.Empty(suite.StateDB.Logs())
+}
+
+func (suite *contractTestSuite) TestBurnDerivative() {
+	suite.CreateDelegation(suite.valAddr, suite.user, sdkmath.NewInt(100e6))
+	_, _, err := suite.Contract.Run(suite.T(), false, "mintDerivative", suite.valAddr.String(), big.NewInt(40e6))
+	suite.Require().NoError(err)
+
+	ret, _, err := suite.Contract.Run(suite.T(), false, "burnDerivative", suite.valAddr.String(), big.NewInt(10e6))
+	suite.Require().NoError(err)
+
+	out, err := liquid.ABI.Unpack("burnDerivative", ret)
+	suite.Require().NoError(err)
+	suite.Equal(contractutils.DecToFixedPoint(sdk.NewDec(10e6)), out[0])
+	suite.AccountBalanceOfEqual(suite.user, suite.derivativeDenom, sdkmath.NewInt(30e6))
+	suite.DelegationSharesEqual(suite.valAddr, suite.user, sdk.NewDec(70e6))
+
+	ret, _, err = suite.Contract.Run(suite.T(), false, "burnDerivative", suite.valAddr.String(), big.NewInt(50e6))
+	testutil.RequireRevert(suite.T(), ret, err, "insufficient funds")
+}
+
+func (suite *contractTestSuite) TestDelegateMintDeposit() {
+	ret, _, err := suite.Contract.Run(suite.T(), false, "delegateMintDeposit", suite.valAddr.String(), big.NewInt(100e6))
+	suite.Require().NoError(err)
+
+	out, err := liquid.ABI.Unpack("delegateMintDeposit", ret)
+	suite.Require().NoError(err)
+	suite.Equal(big.NewInt(100e6), out[0])
+	suite.VaultAccountValueEqual(suite.user, sdk.NewInt64Coin(suite.derivativeDenom, 100e6))
+	suite.AccountBalanceOfEqual(suite.user, "ukava", sdkmath.NewInt(900e6))
+
+	logs := suite.StateDB.Logs()
+	suite.Require().Len(logs, 1)
+	suite.Equal(liquid.ABI.Events["DelegateMintDeposit"].ID, logs[0].Topics[0])
+}
+
+func (suite *contractTestSuite) TestWithdrawBurnUndelegate() {
+	_, _, err := suite.Contract.Run(suite.T(), false, "delegateMintDeposit", suite.valAddr.String(), big.NewInt(100e6))
+	suite.Require().NoError(err)
+
+	ret, _, err := suite.Contract.Run(suite.T(), false, "withdrawBurnUndelegate", suite.valAddr.String(), big.NewInt(60e6))
+	suite.Require().NoError(err)
+
+	out, err := liquid.ABI.Unpack("withdrawBurnUndelegate", ret)
+	suite.Require().NoError(err)
+	suite.Equal(contractutils.DecToFixedPoint(sdk.NewDec(60e6)), out[0])
+	suite.True(out[1].(*big.Int).Int64() > suite.Ctx.BlockTime().Unix())
+	suite.VaultAccountValueEqual(suite.user, sdk.NewInt64Coin(suite.derivativeDenom, 40e6))
+	suite.UnbondingDelegationInDeltaBelow(suite.valAddr, suite.user, sdkmath.NewInt(60e6), sdkmath.ZeroInt())
+}
+
+func (suite *contractTestSuite) TestReadOnly() {
+	for _, method := range []string{"mintDerivative", "burnDerivative", "delegateMintDeposit", "withdrawBurnUndelegate"} {
+		suite.Contract.RequireWriteProtected(suite.T(), method, suite.valAddr.String(), big.NewInt(1e6))
+	}
+}
+
+func (suite *contractTestSuite) TestViews() {
+	ret, _, err := suite.Contract.Run(suite.T(), true, "getDerivativeDenom", suite.valAddr.String())
+	suite.Require().NoError(err)
+	out, err := liquid.ABI.Unpack("getDerivativeDenom", ret)
+	suite.Require().NoError(err)
+	suite.Equal(suite.derivativeDenom, out[0])
+
+	suite.CreateDelegation(suite.valAddr, suite.user, sdkmath.NewInt(100e6))
+	_, _, err = suite.Contract.Run(suite.T(), false, "mintDerivative", suite.valAddr.String(), big.NewInt(40e6))
+	suite.Require().NoError(err)
+
+	ret, _, err = suite.Contract.Run(suite.T(), true, "getDerivativeValue", suite.derivativeDenom)
+	suite.Require().NoError(err)
+	out, err = liquid.ABI.Unpack("getDerivativeValue", ret)
+	suite.Require().NoError(err)
+	suite.Equal(big.NewInt(40e6), out[0])
+
+	ret, _, err = suite.Contract.Run(suite.T(), true, "getDerivativeValue", "ukava")
+	testutil.RequireRevert(suite.T(), ret, err, "invalid derivative denom")
+}
+
+// TestGasMatchesMsg ensures the cosmos gas charged by the precompile is the same as the gas consumed by
+// the equivalent cosmos message
+func (suite *contractTestSuite) TestGasMatchesMsg() {
+	suite.CreateDelegation(suite.valAddr, suite.user, sdkmath.NewInt(100e6))
+
+	msgCtx, _ := suite.Ctx.CacheContext()
+	msgCtx = msgCtx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithKVGasConfig(storetypes.KVGasConfig())
+	msgServer := liquidkeeper.NewMsgServerImpl(suite.App.GetLiquidKeeper())
+	msg := liquidtypes.NewMsgMintDerivative(suite.user, suite.valAddr, suite.NewBondCoin(sdkmath.NewInt(40e6)))
+	_, err := msgServer.MintDerivative(sdk.WrapSDKContext(msgCtx), &msg)
+	suite.Require().NoError(err)
+
+	_, remainingGas, err := suite.Contract.Run(suite.T(), false, "mintDerivative", suite.valAddr.String(), big.NewInt(40e6))
+	suite.Require().NoError(err)
+	suite.Equal(msgCtx.GasMeter().GasConsumed(), 1_000_000-liquid.MintDerivativeGas.Base-remainingGas)
+}
+
+func (suite *contractTestSuite) TestOutOfGas() {
+	suite.CreateDelegation(suite.valAddr, suite.user, sdkmath.NewInt(100e6))
+	input, err := liquid.ABI.Pack("mintDerivative", suite.valAddr.String(), big.NewInt(40e6))
+	suite.Require().NoError(err)
+
+	// enough gas for the base cost but not the cosmos state changes
+	_, remainingGas, err := suite.Precompile.Run(
+		testutil.NewAccessibleState(suite.StateDB), callerAddr, contractAddr, input, liquid.MintDerivativeGas.Base+1000, false,
+	)
+	suite.ErrorIs(err, vm.ErrOutOfGas)
+	suite.Zero(remainingGas)
+	suite.AccountBalanceOfEqual(suite.user, suite.derivativeDenom, sdkmath.ZeroInt())
+}
+
+func (suite *contractTestSuite) TestKeepersNotSet() {
+	testutil.RequireKeepersNotSet(suite.T(), suite.Contract, liquid.NewContract, "mintDerivative", suite.valAddr.String(), big.NewInt(1e6))
+}
+
+// setupEVM enables the precompile and deploys a forwarder contract calling the target, funded with bond coins
+func (suite *contractTestSuite) setupEVM(forwarder common.Address, target common.Address, mode testutil.ForwarderMode) {
+	evmKeeper := suite.App.GetEvmKeeper()
+	suite.Require().NoError(testutil.SetupEVM(suite.Ctx, evmKeeper, contractAddr))
+	suite.Require().NoError(testutil.DeployCode(suite.Ctx, evmKeeper, forwarder, testutil.ForwarderCode(target, mode)))
+	suite.Require().NoError(suite.App.FundAccount(
+		suite.Ctx, contractutils.AccAddressFromEvm(forwarder), suite.NewBondCoins(sdkmath.NewInt(1e9)),
+	))
+}
+
+// applyMessage calls a contract through the evm keeper with 1 akava of value, leaving the balance of the
+// contract cached by the EVM as dirty
+func (suite *contractTestSuite) applyMessage(to common.Address, method string, args ...interface{}) []byte {
+	input, err := liquid.ABI.Pack(method, args...)
+	suite.Require().NoError(err)
+
+	res, err := testutil.ApplyMessage(suite.Ctx, suite.App.GetEvmKeeper(), callerAddr, to, big.NewInt(1), input)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	return res.Ret
+}
+
+func (suite *contractTestSuite) TestEVM_DelegateMintDeposit() {
+	forwarder := common.HexToAddress("0x00000000000000000000000000000000000000f1")
+	suite.setupEVM(forwarder, contractAddr, testutil.ForwardReturn)
+	delegator := contractutils.AccAddressFromEvm(forwarder)
+
+	ret := suite.applyMessage(forwarder, "delegateMintDeposit", suite.valAddr.String(), big.NewInt(100e6))
+	out, err := liquid.ABI.Unpack("delegateMintDeposit", ret)
+	suite.Require().NoError(err)
+	suite.Equal(big.NewInt(100e6), out[0])
+
+	// the delegated ukava is not restored by the commit of the cached akava balance
+	suite.AccountBalanceOfEqual(delegator, "ukava", sdkmath.NewInt(900e6))
+	suite.Equal(
+		sdkmath.NewInt(900e6).Mul(sdkmath.NewInt(1e12)).AddRaw(1),
+		suite.App.GetPrecisebankKeeper().GetBalance(suite.Ctx, delegator, "akava").Amount,
+	)
+	suite.VaultAccountValueEqual(delegator, sdk.NewInt64Coin(suite.derivativeDenom, 100e6))
+
+	ret = suite.applyMessage(forwarder, "withdrawBurnUndelegate", suite.valAddr.String(), big.NewInt(60e6))
+	out, err = liquid.ABI.Unpack("withdrawBurnUndelegate", ret)
+	suite.Require().NoError(err)
+	suite.Equal(contractutils.DecToFixedPoint(sdk.NewDec(60e6)), out[0])
+
+	suite.AccountBalanceOfEqual(delegator, "ukava", sdkmath.NewInt(900e6))
+	suite.Equal(
+		sdkmath.NewInt(900e6).Mul(sdkmath.NewInt(1e12)).AddRaw(2),
+		suite.App.GetPrecisebankKeeper().GetBalance(suite.Ctx, delegator, "akava").Amount,
+	)
+	suite.VaultAccountValueEqual(delegator, sdk.NewInt64Coin(suite.derivativeDenom, 40e6))
+	suite.UnbondingDelegationInDeltaBelow(suite.valAddr, delegator, sdkmath.NewInt(60e6), sdkmath.ZeroInt())
+}
+
+func (suite *contractTestSuite) TestEVM_RevertedCall() {
+	// the reverter calls the precompile and reverts, the caller ignores the failure so the transaction succeeds
+	reverter := common.HexToAddress("0x00000000000000000000000000000000000000f1")
+	caller := common.HexToAddress("0x00000000000000000000000000000000000000f2")
+	suite.setupEVM(reverter, contractAddr, testutil.ForwardRevert)
+	suite.Require().NoError(testutil.DeployCode(
+		suite.Ctx, suite.App.GetEvmKeeper(), caller, testutil.ForwarderCode(reverter, testutil.ForwardIgnore),
+	))
+
+	suite.applyMessage(caller, "delegateMintDeposit", suite.valAddr.String(), big.NewInt(100e6))
+
+	// the delegation is reverted with the call that made it
+	delegator := contractutils.AccAddressFromEvm(reverter)
+	suite.AccountBalanceOfEqual(delegator, "ukava", sdkmath.NewInt(1e9))
+	_, found := suite.StakingKeeper.GetDelegation(suite.Ctx, delegator, suite.valAddr)
+	suite.False(found)
+	suite.AccountBalanceOfEqual(delegator, suite.derivativeDenom, sdkmath.ZeroInt())
+}
@@ -1,23 +1,93 @@
 package noop
 
 import (
+	_ "embed"
+	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/precompile/contract"
+
+	"github.com/kava-labs/kava/precompile/framework"
+)
+
+// Gas schedules of the noop precompile functions
+var (
+	NoopGas             = framework.GasSchedule{Base: 100}
+	EchoGas             = framework.GasSchedule{Base: 100, PerInputWord: 3}
+	RevertWithReasonGas = framework.GasSchedule{Base: 100}
+	EmitEventGas        = framework.GasSchedule{Base: 1_000, PerInputWord: 8}
+	ConsumeGasGas       = framework.GasSchedule{Base: 100}
+	RefundValueGas      = framework.GasSchedule{Base: 2 * contract.ReadGasCostPerSlot}
+)
+
+var (
+	// RawABI contains the raw ABI of the noop precompile
+	//go:embed INoop.abi
+	RawABI string
+
+	// ABI is the parsed ABI of the noop precompile
+	ABI = contract.MustParseABI(RawABI)
 )
 
 // NewContract returns a new noop stateful precompiled contract.
 //
 //	This contract is used for testing purposes only and should not be used on public chains.
-//	The functions of this contract (once implemented), will be used to exercise and test the various aspects of
-//	the EVM such as gas usage, argument parsing, events, etc. The specific operations tested under this contract are
-//	still to be determined.
+//	It is a conformance fixture for the precompile framework, and its functions exercise the various aspects of
+//	the EVM and the framework such as gas usage, argument parsing, reverts, events, read-only calls and value
+//	transfers. It does not read or write any cosmos state.
 func NewContract() (contract.StatefulPrecompiledContract, error) {
-	precompile, err := contract.NewStatefulPrecompileContract([]*contract.StatefulPrecompileFunction{})
-
+	precompile, err := framework.NewContract("noop", ABI, []framework.Function{
+		{Method: "noop", Gas: NoopGas, Handler: noop},
+		{Method: "echo", Gas: EchoGas, Handler: echo},
+		{Method: "revertWithReason", Gas: RevertWithReasonGas, Handler: revertWithReason},
+		{Method: "emitEvent", Gas: EmitEventGas, Handler: emitEvent},
+		{Method: "consumeGas", Gas: ConsumeGasGas, Handler: consumeGas},
+		{Method: "refundValue", Gas: RefundValueGas, Handler: refundValue},
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to instantiate noop precompile: %w", err)
 	}
 
 	return precompile, nil
 }
+
+// noop does nothing
+func noop(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	return nil, nil
+}
+
+// echo returns its arguments
+func echo(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	return args, nil
+}
+
+// revertWithReason reverts with the provided reason
+func revertWithReason(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	return nil, errors.New(args[0].(string))
+}
+
+// emitEvent emits a Noop event with the caller and arguments
+func emitEvent(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	return nil, call.EmitEvent("Noop", call.Caller, args[0], args[1])
+}
+
+// consumeGas consumes the provided amount of gas in addition to the function's gas schedule
+func consumeGas(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	amount := args[0].(*big.Int)
+	if !amount.IsUint64() {
+		return nil, errors.New("amount exceeds uint64")
+	}
+
+	return nil, call.UseGas(amount.Uint64())
+}
+
+// refundValue returns the value sent with the call to the caller
+func refundValue(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	if call.Value.Sign() != 0 {
+		call.StateDB().SubBalance(call.Address, call.Value)
+		call.StateDB().AddBalance(call.Caller, call.Value)
+	}
+
+	return []interface{}{new(big.Int).Set(call.Value)}, nil
+}
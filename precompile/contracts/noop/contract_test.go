@@ -1,12 +1,30 @@
 package noop_test
 
 import (
+	"math/big"
 	"testing"
+	"time"
 
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/evmos/ethermint/x/evm/statedb"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
 
+	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/precompile/contracts/noop"
+	"github.com/kava-labs/kava/precompile/contractutils"
+	"github.com/kava-labs/kava/precompile/framework"
+	"github.com/kava-labs/kava/precompile/testutil"
+)
+
+var (
+	callerAddr   = common.HexToAddress("0xc0ffee254729296a45a3885639AC7E10F9d54979")
+	contractAddr = common.HexToAddress("0x9000000000000000000000000000000000000001")
 )
 
 // TestContractConstructor ensures we have a valid constructor. This will fail
@@ -16,3 +34,209 @@ func TestContractConstructor(t *testing.T) {
 	require.NoError(t, err, "expected precompile not error when created")
 	assert.NotNil(t, precompile, "expected precompile contract to be defined")
 }
+
+type contractTestSuite struct {
+	suite.Suite
+
+	App        app.TestApp
+	Ctx        sdk.Context
+	StateDB    *statedb.StateDB
+	Precompile contract.StatefulPrecompiledContract
+	Contract   testutil.Contract
+}
+
+func (suite *contractTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Unix(1_000_000, 0)})
+
+	precompile, err := noop.NewContract()
+	suite.Require().NoError(err)
+	suite.App = tApp
+	suite.Ctx = ctx
+	suite.Precompile = precompile
+	suite.StateDB = testutil.NewStateDB(ctx, tApp.GetEvmKeeper())
+	suite.Contract = testutil.Contract{
+		ABI:        noop.ABI,
+		Precompile: precompile,
+		StateDB:    suite.StateDB,
+		Address:    contractAddr,
+		Caller:     callerAddr,
+		Gas:        100_000,
+	}
+}
+
+func TestContractTestSuite(t *testing.T) {
+	suite.Run(t, new(contractTestSuite))
+}
+
+func (suite *contractTestSuite) TestNoop() {
+	ret, remainingGas, err := suite.Contract.Run(suite.T(), false, "noop")
+	suite.Require().NoError(err)
+	suite.Empty(ret)
+	suite.Equal(uint64(100_000)-noop.NoopGas.Base, remainingGas)
+}
+
+func (suite *contractTestSuite) TestEcho() {
+	args := []interface{}{
+		big.NewInt(42),
+		"hello precompile",
+		callerAddr,
+		[]byte{0x01, 0x02, 0x03},
+		[]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)},
+	}
+	input, err := noop.ABI.Pack("echo", args...)
+	suite.Require().NoError(err)
+
+	ret, remainingGas, err := suite.Contract.Run(suite.T(), true, "echo", args...)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(100_000)-noop.EchoGas.Cost(input[4:]), remainingGas)
+
+	out, err := noop.ABI.Unpack("echo", ret)
+	suite.Require().NoError(err)
+	suite.Equal(args, out)
+}
+
+func (suite *contractTestSuite) TestEcho_InvalidArguments() {
+	input, err := noop.ABI.Pack("echo", big.NewInt(1), "text", callerAddr, []byte{}, []*big.Int{})
+	suite.Require().NoError(err)
+
+	// truncate the dynamic arguments
+	ret, _, err := suite.Precompile.Run(testutil.NewAccessibleState(suite.StateDB), callerAddr, contractAddr, input[:68], 100_000, true)
+	testutil.RequireRevert(suite.T(), ret, err, "abi")
+}
+
+func (suite *contractTestSuite) TestRevertWithReason() {
+	ret, remainingGas, err := suite.Contract.Run(suite.T(), true, "revertWithReason", "custom reason")
+	testutil.RequireRevert(suite.T(), ret, err, "custom reason")
+	suite.Equal(uint64(100_000)-noop.RevertWithReasonGas.Base, remainingGas, "expected reverts to return remaining gas")
+}
+
+func (suite *contractTestSuite) TestEmitEvent() {
+	_, _, err := suite.Contract.Run(suite.T(), false, "emitEvent", big.NewInt(7), "event data")
+	suite.Require().NoError(err)
+
+	logs := suite.StateDB.Logs()
+	suite.Require().Len(logs, 1)
+	suite.Equal(contractAddr, logs[0].Address)
+	suite.Equal([]common.Hash{
+		noop.ABI.Events["Noop"].ID,
+		common.BytesToHash(callerAddr.Bytes()),
+		common.BigToHash(big.NewInt(7)),
+	}, logs[0].Topics)
+
+	data, err := noop.ABI.Events["Noop"].Inputs.NonIndexed().Unpack(logs[0].Data)
+	suite.Require().NoError(err)
+	suite.Equal([]interface{}{"event data"}, data)
+}
+
+func (suite *contractTestSuite) TestReadOnly() {
+	suite.Contract.RequireWriteProtected(suite.T(), "noop")
+	suite.Contract.RequireWriteProtected(suite.T(), "emitEvent", big.NewInt(7), "event data")
+	suite.Empty(suite.StateDB.Logs())
+}
+
+func (suite *contractTestSuite) TestConsumeGas() {
+	_, remainingGas, err := suite.Contract.Run(suite.T(), true, "consumeGas", big.NewInt(10_000))
+	suite.Require().NoError(err)
+	suite.Equal(uint64(100_000)-noop.ConsumeGasGas.Base-10_000, remainingGas)
+
+	_, remainingGas, err = suite.Contract.Run(suite.T(), true, "consumeGas", big.NewInt(100_000))
+	suite.ErrorIs(err, vm.ErrOutOfGas)
+	suite.Zero(remainingGas)
+}
+
+func (suite *contractTestSuite) TestOutOfGas() {
+	input, err := noop.ABI.Pack("noop")
+	suite.Require().NoError(err)
+
+	_, remainingGas, err := suite.Precompile.Run(
+		testutil.NewAccessibleState(suite.StateDB), callerAddr, contractAddr, input, noop.NoopGas.Base-1, false,
+	)
+	suite.ErrorIs(err, vm.ErrOutOfGas)
+	suite.Zero(remainingGas)
+}
+
+func (suite *contractTestSuite) TestValueTransfers() {
+	suite.StateDB.AddBalance(callerAddr, big.NewInt(1e18))
+
+	// the evm transfers the call value to the precompile before running it, and reverts it if the call fails
+	snapshot := suite.StateDB.Snapshot()
+	statedb.Transfer(suite.StateDB, callerAddr, contractAddr, big.NewInt(1e18))
+	ret, _, err := suite.Contract.Run(suite.T(), false, "noop")
+	testutil.RequireRevert(suite.T(), ret, err, framework.ErrNonPayable.Error())
+	suite.StateDB.RevertToSnapshot(snapshot)
+
+	statedb.Transfer(suite.StateDB, callerAddr, contractAddr, big.NewInt(1e18))
+	ret, _, err = suite.Contract.Run(suite.T(), false, "refundValue")
+	suite.Require().NoError(err)
+	out, err := noop.ABI.Unpack("refundValue", ret)
+	suite.Require().NoError(err)
+	suite.Equal(big.NewInt(1e18), out[0])
+	suite.Equal(big.NewInt(1e18), suite.StateDB.GetBalance(callerAddr))
+	suite.Zero(suite.StateDB.GetBalance(contractAddr).Sign())
+
+	// without value
+	ret, _, err = suite.Contract.Run(suite.T(), false, "refundValue")
+	suite.Require().NoError(err)
+	out, err = noop.ABI.Unpack("refundValue", ret)
+	suite.Require().NoError(err)
+	suite.Zero(out[0].(*big.Int).Sign())
+
+	// a balance sent to the precompile outside of the call frame is not a call value
+	suite.StateDB.AddBalance(contractAddr, big.NewInt(1e18))
+	_, _, err = suite.Contract.Run(suite.T(), false, "noop")
+	suite.Require().NoError(err)
+}
+
+func (suite *contractTestSuite) TestEVM_ValueTransfers() {
+	evmKeeper := suite.App.GetEvmKeeper()
+	suite.Require().NoError(testutil.SetupEVM(suite.Ctx, evmKeeper, contractAddr))
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, contractutils.AccAddressFromEvm(callerAddr), sdk.NewCoins(
+		sdk.NewInt64Coin("ukava", 1),
+	)))
+
+	// the precompile holds a balance committed before the transaction
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, contractutils.AccAddressFromEvm(contractAddr), sdk.NewCoins(
+		sdk.NewInt64Coin("ukava", 1),
+	)))
+
+	noopInput, err := noop.ABI.Pack("noop")
+	suite.Require().NoError(err)
+	refundInput, err := noop.ABI.Pack("refundValue")
+	suite.Require().NoError(err)
+
+	res, err := testutil.ApplyMessage(suite.Ctx, evmKeeper, callerAddr, contractAddr, big.NewInt(0), noopInput)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	res, err = testutil.ApplyMessage(suite.Ctx, evmKeeper, callerAddr, contractAddr, big.NewInt(1), noopInput)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Failed())
+	suite.Equal(vm.ErrExecutionReverted.Error(), res.VmError)
+
+	res, err = testutil.ApplyMessage(suite.Ctx, evmKeeper, callerAddr, contractAddr, big.NewInt(1), refundInput)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	out, err := noop.ABI.Unpack("refundValue", res.Ret)
+	suite.Require().NoError(err)
+	suite.Equal(big.NewInt(1), out[0])
+
+	precisebankKeeper := suite.App.GetPrecisebankKeeper()
+	suite.Equal(
+		sdk.NewInt64Coin("akava", 1e12),
+		precisebankKeeper.GetBalance(suite.Ctx, contractutils.AccAddressFromEvm(contractAddr), "akava"),
+	)
+	suite.Equal(
+		sdk.NewInt64Coin("akava", 1e12),
+		precisebankKeeper.GetBalance(suite.Ctx, contractutils.AccAddressFromEvm(callerAddr), "akava"),
+	)
+}
+
+func (suite *contractTestSuite) TestInvalidSelector() {
+	_, _, err := suite.Precompile.Run(testutil.NewAccessibleState(suite.StateDB), callerAddr, contractAddr, []byte{0x01, 0x02, 0x03, 0x04}, 100_000, false)
+	suite.ErrorContains(err, "invalid function selector")
+
+	_, _, err = suite.Precompile.Run(testutil.NewAccessibleState(suite.StateDB), callerAddr, contractAddr, []byte{0x01}, 100_000, false)
+	suite.ErrorContains(err, "missing function selector")
+}
@@ -0,0 +1,154 @@
+package pricefeed
+
+import (
+	_ "embed"
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+
+	"github.com/kava-labs/kava/precompile/contractutils"
+	"github.com/kava-labs/kava/precompile/framework"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// Gas schedules of the pricefeed precompile functions
+var (
+	GetPriceGas       = framework.GasSchedule{Base: 2 * contract.ReadGasCostPerSlot}
+	GetRawPricesGas   = framework.GasSchedule{Base: 4 * contract.ReadGasCostPerSlot}
+	IsMarketActiveGas = framework.GasSchedule{Base: contract.ReadGasCostPerSlot}
+
+	// GetRawPricesGasPerPrice is charged by getRawPrices for each returned price, in addition to
+	// its gas schedule, as each price is read from its own store entry
+	GetRawPricesGasPerPrice uint64 = 3 * contract.ReadGasCostPerSlot
+)
+
+var (
+	// RawABI contains the raw ABI of the pricefeed precompile
+	//go:embed IPricefeed.abi
+	RawABI string
+
+	// ABI is the parsed ABI of the pricefeed precompile
+	ABI = contract.MustParseABI(RawABI)
+)
+
+// PricefeedKeeper defines the expected pricefeed keeper used by the precompile
+type PricefeedKeeper interface {
+	GetMarket(ctx sdk.Context, marketID string) (pricefeedtypes.Market, bool)
+	GetCurrentPrice(ctx sdk.Context, marketID string) (pricefeedtypes.CurrentPrice, error)
+	GetRawPrices(ctx sdk.Context, marketId string) pricefeedtypes.PostedPrices
+}
+
+// Keepers contains the keepers used by the pricefeed precompile
+type Keepers struct {
+	PricefeedKeeper PricefeedKeeper
+}
+
+// pricefeedPrecompile implements the functions of the pricefeed stateful precompile
+type pricefeedPrecompile struct {
+	keepers func(contract.AccessibleState) (Keepers, error)
+}
+
+// NewContract returns a new pricefeed stateful precompiled contract.
+//
+// The contract exposes the x/pricefeed oracle prices to EVM contracts. Prices are returned as
+// 18 decimal fixed point values, and reads of missing, zeroed or inactive market prices revert.
+func NewContract(keepers func(contract.AccessibleState) (Keepers, error)) (contract.StatefulPrecompiledContract, error) {
+	p := pricefeedPrecompile{keepers: keepers}
+
+	precompile, err := framework.NewContract("pricefeed", ABI, []framework.Function{
+		{Method: "getPrice", Gas: GetPriceGas, Handler: p.getPrice},
+		{Method: "getRawPrices", Gas: GetRawPricesGas, Handler: p.getRawPrices},
+		{Method: "isMarketActive", Gas: IsMarketActiveGas, Handler: p.isMarketActive},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate pricefeed precompile: %w", err)
+	}
+
+	return precompile, nil
+}
+
+// load returns the context and keepers required to run a precompile function
+func (p pricefeedPrecompile) load(call *framework.Call) (sdk.Context, Keepers, error) {
+	keepers, err := p.keepers(call.AccessibleState)
+	if err != nil {
+		return sdk.Context{}, Keepers{}, err
+	}
+
+	ctx, err := call.Context()
+	if err != nil {
+		return sdk.Context{}, Keepers{}, err
+	}
+
+	return ctx, keepers, nil
+}
+
+func (p pricefeedPrecompile) getPrice(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	marketID := args[0].(string)
+
+	ctx, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+
+	market, found := keepers.PricefeedKeeper.GetMarket(ctx, marketID)
+	if !found {
+		return nil, errorsmod.Wrapf(pricefeedtypes.ErrInvalidMarket, "market %s", marketID)
+	}
+	if !market.Active {
+		return nil, errorsmod.Wrapf(pricefeedtypes.ErrInvalidMarket, "market %s is not active", marketID)
+	}
+
+	// GetCurrentPrice returns an error for both missing and zeroed prices
+	currentPrice, err := keepers.PricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "no current price for market %s", marketID)
+	}
+
+	return []interface{}{contractutils.DecToFixedPoint(currentPrice.Price)}, nil
+}
+
+func (p pricefeedPrecompile) getRawPrices(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	marketID := args[0].(string)
+
+	ctx, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := keepers.PricefeedKeeper.GetMarket(ctx, marketID); !found {
+		return nil, errorsmod.Wrapf(pricefeedtypes.ErrInvalidMarket, "market %s", marketID)
+	}
+
+	rawPrices := keepers.PricefeedKeeper.GetRawPrices(ctx, marketID)
+	if err := call.UseGas(uint64(len(rawPrices)) * GetRawPricesGasPerPrice); err != nil {
+		return nil, err
+	}
+
+	oracles := make([]common.Address, 0, len(rawPrices))
+	prices := make([]*big.Int, 0, len(rawPrices))
+	expiries := make([]*big.Int, 0, len(rawPrices))
+	for _, rawPrice := range rawPrices {
+		oracles = append(oracles, common.BytesToAddress(rawPrice.OracleAddress))
+		prices = append(prices, contractutils.DecToFixedPoint(rawPrice.Price))
+		expiries = append(expiries, big.NewInt(rawPrice.Expiry.Unix()))
+	}
+
+	return []interface{}{oracles, prices, expiries}, nil
+}
+
+func (p pricefeedPrecompile) isMarketActive(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	marketID := args[0].(string)
+
+	ctx, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+
+	market, found := keepers.PricefeedKeeper.GetMarket(ctx, marketID)
+
+	return []interface{}{found && market.Active}, nil
+}
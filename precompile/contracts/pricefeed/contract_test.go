@@ -0,0 +1,182 @@
+package pricefeed_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/precompile/contracts/pricefeed"
+	"github.com/kava-labs/kava/precompile/testutil"
+	pricefeedkeeper "github.com/kava-labs/kava/x/pricefeed/keeper"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+var (
+	callerAddr   = common.HexToAddress("0xc0ffee254729296a45a3885639AC7E10F9d54979")
+	contractAddr = common.HexToAddress("0x9000000000000000000000000000000000000004")
+	oracleAddr   = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+)
+
+// TestContractConstructor ensures we have a valid constructor. This will fail
+// if we attempt to define invalid or duplicate function selectors.
+func TestContractConstructor(t *testing.T) {
+	precompile, err := pricefeed.NewContract(func(contract.AccessibleState) (pricefeed.Keepers, error) { return pricefeed.Keepers{}, nil })
+	require.NoError(t, err, "expected precompile not error when created")
+	assert.NotNil(t, precompile, "expected precompile contract to be defined")
+}
+
+type contractTestSuite struct {
+	suite.Suite
+
+	App        app.TestApp
+	Ctx        sdk.Context
+	Keeper     pricefeedkeeper.Keeper
+	StateDB    *statedb.StateDB
+	Precompile contract.StatefulPrecompiledContract
+	Contract   testutil.Contract
+}
+
+func (suite *contractTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates()
+	suite.App = tApp
+	suite.Ctx = tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Unix(1_000_000, 0)})
+
+	oracles := []sdk.AccAddress{sdk.AccAddress(oracleAddr.Bytes())}
+	suite.Keeper = tApp.GetPriceFeedKeeper()
+	suite.Keeper.SetParams(suite.Ctx, pricefeedtypes.NewParams([]pricefeedtypes.Market{
+		pricefeedtypes.NewMarket("btc:usd", "btc", "usd", oracles, true),
+		pricefeedtypes.NewMarket("eth:usd", "eth", "usd", oracles, true),
+		pricefeedtypes.NewMarket("xrp:usd", "xrp", "usd", oracles, false),
+	}, pricefeedtypes.DefaultPriceHistoryLength))
+
+	_, err := suite.Keeper.SetPrice(suite.Ctx, oracles[0], "btc:usd", sdk.MustNewDecFromStr("60000.5"), suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Keeper.SetCurrentPricesForAllMarkets(suite.Ctx)
+
+	precompile, err := pricefeed.NewContract(func(contract.AccessibleState) (pricefeed.Keepers, error) {
+		return pricefeed.Keepers{PricefeedKeeper: suite.Keeper}, nil
+	})
+	suite.Require().NoError(err)
+	suite.Precompile = precompile
+	suite.StateDB = testutil.NewStateDB(suite.Ctx, tApp.GetEvmKeeper())
+	suite.Contract = testutil.Contract{
+		ABI:        pricefeed.ABI,
+		Precompile: precompile,
+		StateDB:    suite.StateDB,
+		Address:    contractAddr,
+		Caller:     callerAddr,
+		Gas:        100_000,
+	}
+}
+
+func TestContractTestSuite(t *testing.T) {
+	suite.Run(t, new(contractTestSuite))
+}
+
+func (suite *contractTestSuite) TestGetPrice() {
+	ret, remainingGas, err := suite.Contract.Run(suite.T(), true, "getPrice", "btc:usd")
+	suite.Require().NoError(err)
+	suite.Equal(uint64(100_000)-pricefeed.GetPriceGas.Base, remainingGas)
+
+	out, err := pricefeed.ABI.Unpack("getPrice", ret)
+	suite.Require().NoError(err)
+	expected, _ := new(big.Int).SetString("60000500000000000000000", 10)
+	suite.Equal(expected, out[0])
+}
+
+func (suite *contractTestSuite) TestGetPrice_Errors() {
+	ret, _, err := suite.Contract.Run(suite.T(), true, "getPrice", "atom:usd")
+	testutil.RequireRevert(suite.T(), ret, err, "market atom:usd: market does not exist")
+
+	ret, _, err = suite.Contract.Run(suite.T(), true, "getPrice", "xrp:usd")
+	testutil.RequireRevert(suite.T(), ret, err, "market xrp:usd is not active")
+
+	// eth:usd has no posted prices, so its current price is zeroed
+	ret, _, err = suite.Contract.Run(suite.T(), true, "getPrice", "eth:usd")
+	testutil.RequireRevert(suite.T(), ret, err, "no current price for market eth:usd")
+
+	// btc:usd price expires, zeroing the current price
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(2 * time.Hour))
+	suite.Keeper.SetCurrentPricesForAllMarkets(suite.Ctx)
+	suite.StateDB = testutil.NewStateDB(suite.Ctx, suite.App.GetEvmKeeper())
+	suite.Contract.StateDB = suite.StateDB
+
+	ret, _, err = suite.Contract.Run(suite.T(), true, "getPrice", "btc:usd")
+	testutil.RequireRevert(suite.T(), ret, err, "no current price for market btc:usd")
+}
+
+func (suite *contractTestSuite) TestGetRawPrices() {
+	ret, remainingGas, err := suite.Contract.Run(suite.T(), true, "getRawPrices", "btc:usd")
+	suite.Require().NoError(err)
+	suite.Equal(uint64(100_000)-pricefeed.GetRawPricesGas.Base-pricefeed.GetRawPricesGasPerPrice, remainingGas)
+
+	out, err := pricefeed.ABI.Unpack("getRawPrices", ret)
+	suite.Require().NoError(err)
+	expectedPrice, _ := new(big.Int).SetString("60000500000000000000000", 10)
+	suite.Equal([]common.Address{oracleAddr}, out[0])
+	suite.Equal([]*big.Int{expectedPrice}, out[1])
+	suite.Equal([]*big.Int{big.NewInt(suite.Ctx.BlockTime().Add(time.Hour).Unix())}, out[2])
+
+	ret, remainingGas, err = suite.Contract.Run(suite.T(), true, "getRawPrices", "eth:usd")
+	suite.Require().NoError(err)
+	suite.Equal(uint64(100_000)-pricefeed.GetRawPricesGas.Base, remainingGas)
+	out, err = pricefeed.ABI.Unpack("getRawPrices", ret)
+	suite.Require().NoError(err)
+	suite.Empty(out[0])
+
+	ret, _, err = suite.Contract.Run(suite.T(), true, "getRawPrices", "atom:usd")
+	testutil.RequireRevert(suite.T(), ret, err, "market does not exist")
+}
+
+func (suite *contractTestSuite) TestIsMarketActive() {
+	for marketID, expected := range map[string]bool{
+		"btc:usd":  true,
+		"eth:usd":  true,
+		"xrp:usd":  false,
+		"atom:usd": false,
+	} {
+		ret, _, err := suite.Contract.Run(suite.T(), true, "isMarketActive", marketID)
+		suite.Require().NoError(err)
+
+		out, err := pricefeed.ABI.Unpack("isMarketActive", ret)
+		suite.Require().NoError(err)
+		suite.Equal(expected, out[0], marketID)
+	}
+}
+
+func (suite *contractTestSuite) TestOutOfGas() {
+	input, err := pricefeed.ABI.Pack("getPrice", "btc:usd")
+	suite.Require().NoError(err)
+
+	_, remainingGas, err := suite.Precompile.Run(
+		testutil.NewAccessibleState(suite.StateDB), callerAddr, contractAddr, input, pricefeed.GetPriceGas.Base-1, true,
+	)
+	suite.ErrorContains(err, "out of gas")
+	suite.Zero(remainingGas)
+
+	// the gas of each returned price is charged in addition to the gas schedule
+	input, err = pricefeed.ABI.Pack("getRawPrices", "btc:usd")
+	suite.Require().NoError(err)
+
+	_, remainingGas, err = suite.Precompile.Run(
+		testutil.NewAccessibleState(suite.StateDB), callerAddr, contractAddr, input,
+		pricefeed.GetRawPricesGas.Base+pricefeed.GetRawPricesGasPerPrice-1, true,
+	)
+	suite.ErrorContains(err, "out of gas")
+	suite.Zero(remainingGas)
+}
+
+func (suite *contractTestSuite) TestKeepersNotSet() {
+	testutil.RequireKeepersNotSet(suite.T(), suite.Contract, pricefeed.NewContract, "getPrice", "btc:usd")
+}
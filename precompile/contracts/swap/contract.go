@@ -0,0 +1,420 @@
+package swap
+
+import (
+	_ "embed"
+	"fmt"
+	"math/big"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+
+	"github.com/kava-labs/kava/precompile/contractutils"
+	"github.com/kava-labs/kava/precompile/framework"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+// Gas schedules of the swap precompile functions. These are fixed so the gas used by a call does
+// not depend on cosmos state and is deterministic across nodes.
+var (
+	DepositGas            = framework.GasSchedule{Base: 10 * contract.WriteGasCostPerSlot}
+	WithdrawGas           = framework.GasSchedule{Base: 10 * contract.WriteGasCostPerSlot}
+	SwapExactForTokensGas = framework.GasSchedule{Base: 8 * contract.WriteGasCostPerSlot}
+	SwapForExactTokensGas = framework.GasSchedule{Base: 8 * contract.WriteGasCostPerSlot}
+	GetPoolReservesGas    = framework.GasSchedule{Base: 2 * contract.ReadGasCostPerSlot}
+	GetDepositorSharesGas = framework.GasSchedule{Base: 2 * contract.ReadGasCostPerSlot}
+	GetTWAPGas            = framework.GasSchedule{Base: 4 * contract.ReadGasCostPerSlot}
+)
+
+var (
+	// RawABI contains the raw ABI of the swap precompile
+	//go:embed ISwap.abi
+	RawABI string
+
+	// ABI is the parsed ABI of the swap precompile
+	ABI = contract.MustParseABI(RawABI)
+)
+
+// SwapKeeper defines the expected swap keeper used by the precompile
+type SwapKeeper interface {
+	Deposit(ctx sdk.Context, depositor sdk.AccAddress, coinA sdk.Coin, coinB sdk.Coin, slippageLimit sdk.Dec) error
+	Withdraw(ctx sdk.Context, owner sdk.AccAddress, shares sdkmath.Int, minCoinA, minCoinB sdk.Coin) error
+	SwapExactForTokens(ctx sdk.Context, requester sdk.AccAddress, exactCoinA, coinB sdk.Coin, slippageLimit sdk.Dec) error
+	SwapForExactTokens(ctx sdk.Context, requester sdk.AccAddress, coinA, exactCoinB sdk.Coin, slippageLimit sdk.Dec) error
+	GetPool(ctx sdk.Context, poolID string) (swaptypes.PoolRecord, bool)
+	GetDepositorSharesAmount(ctx sdk.Context, depositor sdk.AccAddress, poolID string) (sdkmath.Int, bool)
+	TWAP(ctx sdk.Context, poolID string, window time.Duration) (sdk.Dec, sdk.Dec, error)
+}
+
+// BankKeeper defines the expected bank keeper used by the precompile
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// Keepers contains the keepers used by the swap precompile
+type Keepers struct {
+	SwapKeeper SwapKeeper
+	BankKeeper BankKeeper
+}
+
+// swapPrecompile implements the functions of the swap stateful precompile
+type swapPrecompile struct {
+	keepers func(contract.AccessibleState) (Keepers, error)
+}
+
+// NewContract returns a new swap stateful precompiled contract.
+//
+// The contract exposes x/swap pool deposits, withdraws and swaps to EVM contracts. The calling
+// EVM address is used as the depositor or requester of each operation. Since the contract is
+// registered before any app is constructed, the keepers of the app running the EVM are resolved
+// through the keepers function on every call.
+func NewContract(keepers func(contract.AccessibleState) (Keepers, error)) (contract.StatefulPrecompiledContract, error) {
+	p := swapPrecompile{keepers: keepers}
+
+	precompile, err := framework.NewContract("swap", ABI, []framework.Function{
+		{Method: "deposit", Gas: DepositGas, Handler: p.deposit},
+		{Method: "withdraw", Gas: WithdrawGas, Handler: p.withdraw},
+		{Method: "swapExactForTokens", Gas: SwapExactForTokensGas, Handler: p.swapExactForTokens},
+		{Method: "swapForExactTokens", Gas: SwapForExactTokensGas, Handler: p.swapForExactTokens},
+		{Method: "getPoolReserves", Gas: GetPoolReservesGas, Handler: p.getPoolReserves},
+		{Method: "getDepositorShares", Gas: GetDepositorSharesGas, Handler: p.getDepositorShares},
+		{Method: "getTWAP", Gas: GetTWAPGas, Handler: p.getTWAP},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate swap precompile: %w", err)
+	}
+
+	return precompile, nil
+}
+
+// load returns the context and keepers required to run a precompile function
+func (p swapPrecompile) load(call *framework.Call) (sdk.Context, Keepers, error) {
+	keepers, err := p.keepers(call.AccessibleState)
+	if err != nil {
+		return sdk.Context{}, Keepers{}, err
+	}
+
+	ctx, err := call.Context()
+	if err != nil {
+		return sdk.Context{}, Keepers{}, err
+	}
+
+	return ctx, keepers, nil
+}
+
+func (p swapPrecompile) deposit(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	coinA, err := newPositiveCoin(args[0].(string), args[1].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+	coinB, err := newPositiveCoin(args[2].(string), args[3].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+	if coinA.Denom == coinB.Denom {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "denominations can not be equal")
+	}
+	slippageLimit := contractutils.DecFromFixedPoint(args[4].(*big.Int))
+	deadline := args[5].(*big.Int)
+
+	ctx, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDeadline(ctx, deadline); err != nil {
+		return nil, err
+	}
+
+	depositor := contractutils.AccAddressFromEvm(call.Caller)
+	poolID := swaptypes.PoolID(coinA.Denom, coinB.Denom)
+
+	var depositedA, depositedB, shares *big.Int
+	err = call.ExecuteNativeAction(func(ctx sdk.Context) error {
+		sharesBefore, found := keepers.SwapKeeper.GetDepositorSharesAmount(ctx, depositor, poolID)
+		if !found {
+			sharesBefore = sdkmath.ZeroInt()
+		}
+		balanceA := keepers.BankKeeper.GetBalance(ctx, depositor, coinA.Denom)
+		balanceB := keepers.BankKeeper.GetBalance(ctx, depositor, coinB.Denom)
+
+		if err := keepers.SwapKeeper.Deposit(ctx, depositor, coinA, coinB, slippageLimit); err != nil {
+			return err
+		}
+
+		sharesAfter, _ := keepers.SwapKeeper.GetDepositorSharesAmount(ctx, depositor, poolID)
+		depositedA = balanceA.Sub(keepers.BankKeeper.GetBalance(ctx, depositor, coinA.Denom)).Amount.BigInt()
+		depositedB = balanceB.Sub(keepers.BankKeeper.GetBalance(ctx, depositor, coinB.Denom)).Amount.BigInt()
+		shares = sharesAfter.Sub(sharesBefore).BigInt()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := call.EmitEvent(
+		"Deposit", call.Caller, poolID, depositedA, depositedB, shares,
+	); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{depositedA, depositedB, shares}, nil
+}
+
+func (p swapPrecompile) withdraw(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	shares := sdkmath.NewIntFromBigInt(args[0].(*big.Int))
+	minCoinA, err := newCoin(args[1].(string), args[2].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+	minCoinB, err := newCoin(args[3].(string), args[4].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+	deadline := args[5].(*big.Int)
+
+	if !shares.IsPositive() {
+		return nil, errorsmod.Wrap(swaptypes.ErrInvalidShares, "shares must be greater than zero")
+	}
+
+	ctx, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDeadline(ctx, deadline); err != nil {
+		return nil, err
+	}
+
+	owner := contractutils.AccAddressFromEvm(call.Caller)
+
+	var withdrawnA, withdrawnB *big.Int
+	err = call.ExecuteNativeAction(func(ctx sdk.Context) error {
+		balanceA := keepers.BankKeeper.GetBalance(ctx, owner, minCoinA.Denom)
+		balanceB := keepers.BankKeeper.GetBalance(ctx, owner, minCoinB.Denom)
+
+		if err := keepers.SwapKeeper.Withdraw(ctx, owner, shares, minCoinA, minCoinB); err != nil {
+			return err
+		}
+
+		withdrawnA = keepers.BankKeeper.GetBalance(ctx, owner, minCoinA.Denom).Sub(balanceA).Amount.BigInt()
+		withdrawnB = keepers.BankKeeper.GetBalance(ctx, owner, minCoinB.Denom).Sub(balanceB).Amount.BigInt()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := call.EmitEvent(
+		"Withdraw", call.Caller, swaptypes.PoolID(minCoinA.Denom, minCoinB.Denom), withdrawnA, withdrawnB, shares.BigInt(),
+	); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{withdrawnA, withdrawnB}, nil
+}
+
+func (p swapPrecompile) swapExactForTokens(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	exactCoinIn, err := newPositiveCoin(args[0].(string), args[1].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+	coinOut, err := newPositiveCoin(args[2].(string), args[3].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+	slippageLimit := contractutils.DecFromFixedPoint(args[4].(*big.Int))
+	deadline := args[5].(*big.Int)
+
+	ctx, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDeadline(ctx, deadline); err != nil {
+		return nil, err
+	}
+
+	requester := contractutils.AccAddressFromEvm(call.Caller)
+
+	var amountOut *big.Int
+	err = call.ExecuteNativeAction(func(ctx sdk.Context) error {
+		balanceOut := keepers.BankKeeper.GetBalance(ctx, requester, coinOut.Denom)
+
+		if err := keepers.SwapKeeper.SwapExactForTokens(ctx, requester, exactCoinIn, coinOut, slippageLimit); err != nil {
+			return err
+		}
+
+		amountOut = keepers.BankKeeper.GetBalance(ctx, requester, coinOut.Denom).Sub(balanceOut).Amount.BigInt()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := call.EmitEvent(
+		"Swap", call.Caller, swaptypes.PoolID(exactCoinIn.Denom, coinOut.Denom),
+		exactCoinIn.Denom, exactCoinIn.Amount.BigInt(), coinOut.Denom, amountOut,
+	); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{amountOut}, nil
+}
+
+func (p swapPrecompile) swapForExactTokens(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	coinIn, err := newPositiveCoin(args[0].(string), args[1].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+	exactCoinOut, err := newPositiveCoin(args[2].(string), args[3].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+	slippageLimit := contractutils.DecFromFixedPoint(args[4].(*big.Int))
+	deadline := args[5].(*big.Int)
+
+	ctx, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDeadline(ctx, deadline); err != nil {
+		return nil, err
+	}
+
+	requester := contractutils.AccAddressFromEvm(call.Caller)
+
+	var amountIn *big.Int
+	err = call.ExecuteNativeAction(func(ctx sdk.Context) error {
+		balanceIn := keepers.BankKeeper.GetBalance(ctx, requester, coinIn.Denom)
+
+		if err := keepers.SwapKeeper.SwapForExactTokens(ctx, requester, coinIn, exactCoinOut, slippageLimit); err != nil {
+			return err
+		}
+
+		amountIn = balanceIn.Sub(keepers.BankKeeper.GetBalance(ctx, requester, coinIn.Denom)).Amount.BigInt()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := call.EmitEvent(
+		"Swap", call.Caller, swaptypes.PoolID(coinIn.Denom, exactCoinOut.Denom),
+		coinIn.Denom, amountIn, exactCoinOut.Denom, exactCoinOut.Amount.BigInt(),
+	); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{amountIn}, nil
+}
+
+func (p swapPrecompile) getPoolReserves(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	denomA, denomB := args[0].(string), args[1].(string)
+
+	ctx, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+
+	poolID := swaptypes.PoolID(denomA, denomB)
+	record, found := keepers.SwapKeeper.GetPool(ctx, poolID)
+	if !found {
+		return nil, errorsmod.Wrapf(swaptypes.ErrInvalidPool, "pool %s not found", poolID)
+	}
+
+	reserves := record.Reserves()
+	return []interface{}{
+		reserves.AmountOf(denomA).BigInt(),
+		reserves.AmountOf(denomB).BigInt(),
+		record.TotalShares.BigInt(),
+	}, nil
+}
+
+func (p swapPrecompile) getDepositorShares(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	depositor := contractutils.AccAddressFromEvm(args[0].(common.Address))
+	poolID := swaptypes.PoolID(args[1].(string), args[2].(string))
+
+	ctx, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, found := keepers.SwapKeeper.GetDepositorSharesAmount(ctx, depositor, poolID)
+	if !found {
+		shares = sdkmath.ZeroInt()
+	}
+
+	return []interface{}{shares.BigInt()}, nil
+}
+
+func (p swapPrecompile) getTWAP(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	denomA, denomB := args[0].(string), args[1].(string)
+	windowSeconds := args[2].(*big.Int)
+
+	maxSeconds := big.NewInt(int64(swaptypes.MaxTWAPWindow / time.Second))
+	if windowSeconds.Sign() <= 0 || windowSeconds.Cmp(maxSeconds) > 0 {
+		return nil, errorsmod.Wrapf(swaptypes.ErrInvalidTWAPWindow, "window must be between 1 and %s seconds", maxSeconds)
+	}
+	window := time.Duration(windowSeconds.Int64()) * time.Second
+
+	ctx, keepers, err := p.load(call)
+	if err != nil {
+		return nil, err
+	}
+
+	poolID := swaptypes.PoolID(denomA, denomB)
+	priceA, priceB, err := keepers.SwapKeeper.TWAP(ctx, poolID, window)
+	if err != nil {
+		return nil, err
+	}
+
+	// pool prices are ordered by the sorted pool denoms, so reorder them to match the caller
+	if denomB < denomA {
+		priceA, priceB = priceB, priceA
+	}
+
+	return []interface{}{
+		contractutils.DecToFixedPoint(priceA),
+		contractutils.DecToFixedPoint(priceB),
+	}, nil
+}
+
+// newCoin returns a coin from a solidity denom and amount, returning an error if the coin is invalid
+func newCoin(denom string, amount *big.Int) (sdk.Coin, error) {
+	coin := sdk.Coin{Denom: denom, Amount: sdkmath.NewIntFromBigInt(amount)}
+	if err := coin.Validate(); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return coin, nil
+}
+
+// newPositiveCoin returns a coin from a solidity denom and amount, returning an error if the coin is
+// invalid or zero
+func newPositiveCoin(denom string, amount *big.Int) (sdk.Coin, error) {
+	coin, err := newCoin(denom, amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !coin.IsPositive() {
+		return sdk.Coin{}, fmt.Errorf("%s amount must be positive", denom)
+	}
+
+	return coin, nil
+}
+
+// checkDeadline returns an error if the block time is at or beyond the unix deadline
+func checkDeadline(ctx sdk.Context, deadline *big.Int) error {
+	if big.NewInt(ctx.BlockTime().Unix()).Cmp(deadline) >= 0 {
+		return errorsmod.Wrapf(
+			swaptypes.ErrDeadlineExceeded,
+			"block time %d >= deadline %s",
+			ctx.BlockTime().Unix(),
+			deadline,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,376 @@
+package swap_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/precompile/contracts/swap"
+	"github.com/kava-labs/kava/precompile/contractutils"
+	"github.com/kava-labs/kava/precompile/testutil"
+	swaptypes "github.com/kava-labs/kava/x/swap/types"
+)
+
+var (
+	callerAddr   = common.HexToAddress("0xc0ffee254729296a45a3885639AC7E10F9d54979")
+	contractAddr = common.HexToAddress("0x9000000000000000000000000000000000000003")
+)
+
+// TestContractConstructor ensures we have a valid constructor. This will fail
+// if we attempt to define invalid or duplicate function selectors.
+func TestContractConstructor(t *testing.T) {
+	precompile, err := swap.NewContract(func(contract.AccessibleState) (swap.Keepers, error) { return swap.Keepers{}, nil })
+	require.NoError(t, err, "expected precompile not error when created")
+	assert.NotNil(t, precompile, "expected precompile contract to be defined")
+}
+
+type contractTestSuite struct {
+	suite.Suite
+
+	App        app.TestApp
+	Ctx        sdk.Context
+	Keepers    swap.Keepers
+	StateDB    *statedb.StateDB
+	Precompile contract.StatefulPrecompiledContract
+	Contract   testutil.Contract
+}
+
+func (suite *contractTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates()
+	suite.App = tApp
+	suite.Ctx = tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Unix(1_000_000, 0)})
+
+	swapKeeper := tApp.GetSwapKeeper()
+	swapKeeper.SetParams(suite.Ctx, swaptypes.NewParams(
+		swaptypes.AllowedPools{swaptypes.NewAllowedPool("ukava", "usdx")},
+		sdk.MustNewDecFromStr("0.003"),
+		swaptypes.DefaultProtocolFeeFraction,
+		swaptypes.DefaultProtocolFeeSweepInterval,
+	))
+	suite.Keepers = swap.Keepers{SwapKeeper: &swapKeeper, BankKeeper: tApp.GetBankKeeper()}
+
+	precompile, err := swap.NewContract(func(contract.AccessibleState) (swap.Keepers, error) { return suite.Keepers, nil })
+	suite.Require().NoError(err)
+	suite.Precompile = precompile
+	suite.StateDB = testutil.NewStateDB(suite.Ctx, tApp.GetEvmKeeper())
+	suite.Contract = testutil.Contract{
+		ABI:        swap.ABI,
+		Precompile: precompile,
+		StateDB:    suite.StateDB,
+		Address:    contractAddr,
+		Caller:     callerAddr,
+		Gas:        1_000_000,
+		Commit:     true,
+	}
+
+	err = tApp.FundAccount(suite.Ctx, contractutils.AccAddressFromEvm(callerAddr), sdk.NewCoins(
+		sdk.NewInt64Coin("ukava", 100e6),
+		sdk.NewInt64Coin("usdx", 500e6),
+	))
+	suite.Require().NoError(err)
+}
+
+func TestContractTestSuite(t *testing.T) {
+	suite.Run(t, new(contractTestSuite))
+}
+
+func (suite *contractTestSuite) deadline() *big.Int {
+	return big.NewInt(suite.Ctx.BlockTime().Add(time.Hour).Unix())
+}
+
+func (suite *contractTestSuite) balance(denom string) sdkmath.Int {
+	return suite.App.GetBankKeeper().GetBalance(suite.Ctx, contractutils.AccAddressFromEvm(callerAddr), denom).Amount
+}
+
+func (suite *contractTestSuite) deposit() {
+	ret, _, err := suite.Contract.Run(suite.T(), false, "deposit",
+		"ukava", big.NewInt(10e6), "usdx", big.NewInt(50e6), big.NewInt(1e16), suite.deadline(),
+	)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(ret)
+}
+
+func (suite *contractTestSuite) TestDeposit() {
+	ret, remainingGas, err := suite.Contract.Run(suite.T(), false, "deposit",
+		"ukava", big.NewInt(10e6), "usdx", big.NewInt(50e6), big.NewInt(1e16), suite.deadline(),
+	)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(1_000_000)-swap.DepositGas.Base, remainingGas)
+
+	out, err := swap.ABI.Unpack("deposit", ret)
+	suite.Require().NoError(err)
+	suite.Equal(big.NewInt(10e6), out[0])
+	suite.Equal(big.NewInt(50e6), out[1])
+	suite.Equal(big.NewInt(22360679), out[2])
+
+	suite.Equal(sdkmath.NewInt(90e6), suite.balance("ukava"))
+	suite.Equal(sdkmath.NewInt(450e6), suite.balance("usdx"))
+
+	pool, found := suite.Keepers.SwapKeeper.GetPool(suite.Ctx, "ukava:usdx")
+	suite.Require().True(found)
+	suite.Equal(sdk.NewInt64Coin("ukava", 10e6), pool.ReservesA)
+
+	logs := suite.StateDB.Logs()
+	suite.Require().Len(logs, 1)
+	suite.Equal(contractAddr, logs[0].Address)
+	suite.Equal(swap.ABI.Events["Deposit"].ID, logs[0].Topics[0])
+	suite.Equal(common.BytesToHash(callerAddr.Bytes()), logs[0].Topics[1])
+}
+
+func (suite *contractTestSuite) TestDeposit_Errors() {
+	ret, _, err := suite.Contract.Run(suite.T(), false, "deposit",
+		"ukava", big.NewInt(10e6), "usdx", big.NewInt(50e6), big.NewInt(1e16), big.NewInt(suite.Ctx.BlockTime().Unix()),
+	)
+	testutil.RequireRevert(suite.T(), ret, err, "deadline exceeded")
+
+	ret, _, err = suite.Contract.Run(suite.T(), false, "deposit",
+		"ukava", big.NewInt(10e6), "hard", big.NewInt(50e6), big.NewInt(1e16), suite.deadline(),
+	)
+	testutil.RequireRevert(suite.T(), ret, err, "not allowed")
+
+	ret, _, err = suite.Contract.Run(suite.T(), false, "deposit",
+		"ukava", big.NewInt(10e6), "ukava", big.NewInt(50e6), big.NewInt(1e16), suite.deadline(),
+	)
+	testutil.RequireRevert(suite.T(), ret, err, "denominations can not be equal")
+
+	ret, _, err = suite.Contract.Run(suite.T(), false, "deposit",
+		"ukava", big.NewInt(1000e6), "usdx", big.NewInt(50e6), big.NewInt(1e16), suite.deadline(),
+	)
+	testutil.RequireRevert(suite.T(), ret, err, "insufficient funds")
+
+	// failed calls do not modify state
+	suite.Equal(sdkmath.NewInt(100e6), suite.balance("ukava"))
+	_, found := suite.Keepers.SwapKeeper.GetPool(suite.Ctx, "ukava:usdx")
+	suite.False(found)
+	suite.Empty(suite.StateDB.Logs())
+}
+
+func (suite *contractTestSuite) TestWithdraw() {
+	suite.deposit()
+
+	ret, _, err := suite.Contract.Run(suite.T(), false, "withdraw",
+		big.NewInt(22360679), "ukava", big.NewInt(1), "usdx", big.NewInt(1), suite.deadline(),
+	)
+	suite.Require().NoError(err)
+
+	out, err := swap.ABI.Unpack("withdraw", ret)
+	suite.Require().NoError(err)
+	suite.Equal(big.NewInt(10e6), out[0])
+	suite.Equal(big.NewInt(50e6), out[1])
+
+	suite.Equal(sdkmath.NewInt(100e6), suite.balance("ukava"))
+	suite.Equal(sdkmath.NewInt(500e6), suite.balance("usdx"))
+
+	ret, _, err = suite.Contract.Run(suite.T(), false, "withdraw",
+		big.NewInt(1), "ukava", big.NewInt(1), "usdx", big.NewInt(1), suite.deadline(),
+	)
+	testutil.RequireRevert(suite.T(), ret, err, "deposit not found")
+}
+
+func (suite *contractTestSuite) TestSwapExactForTokens() {
+	suite.deposit()
+	usdxBefore := suite.balance("usdx")
+
+	ret, _, err := suite.Contract.Run(suite.T(), false, "swapExactForTokens",
+		"ukava", big.NewInt(1e6), "usdx", big.NewInt(4.5e6), big.NewInt(1e17), suite.deadline(),
+	)
+	suite.Require().NoError(err)
+
+	out, err := swap.ABI.Unpack("swapExactForTokens", ret)
+	suite.Require().NoError(err)
+	amountOut := out[0].(*big.Int)
+	suite.True(amountOut.Sign() > 0)
+	suite.Equal(usdxBefore.Add(sdkmath.NewIntFromBigInt(amountOut)), suite.balance("usdx"))
+	suite.Equal(sdkmath.NewInt(89e6), suite.balance("ukava"))
+
+	ret, _, err = suite.Contract.Run(suite.T(), false, "swapExactForTokens",
+		"ukava", big.NewInt(1e6), "usdx", big.NewInt(10e6), big.NewInt(1e16), suite.deadline(),
+	)
+	testutil.RequireRevert(suite.T(), ret, err, "slippage exceeded")
+}
+
+func (suite *contractTestSuite) TestSwapForExactTokens() {
+	suite.deposit()
+	ukavaBefore := suite.balance("ukava")
+
+	ret, _, err := suite.Contract.Run(suite.T(), false, "swapForExactTokens",
+		"ukava", big.NewInt(1e6), "usdx", big.NewInt(4e6), big.NewInt(1e17), suite.deadline(),
+	)
+	suite.Require().NoError(err)
+
+	out, err := swap.ABI.Unpack("swapForExactTokens", ret)
+	suite.Require().NoError(err)
+	amountIn := out[0].(*big.Int)
+	suite.True(amountIn.Sign() > 0)
+	suite.Equal(ukavaBefore.Sub(sdkmath.NewIntFromBigInt(amountIn)), suite.balance("ukava"))
+	suite.Equal(sdkmath.NewInt(454e6), suite.balance("usdx"))
+}
+
+func (suite *contractTestSuite) TestViews() {
+	ret, _, err := suite.Contract.Run(suite.T(), true, "getPoolReserves", "ukava", "usdx")
+	testutil.RequireRevert(suite.T(), ret, err, "pool ukava:usdx not found")
+
+	suite.deposit()
+
+	ret, remainingGas, err := suite.Contract.Run(suite.T(), true, "getPoolReserves", "usdx", "ukava")
+	suite.Require().NoError(err)
+	suite.Equal(uint64(1_000_000)-swap.GetPoolReservesGas.Base, remainingGas)
+	out, err := swap.ABI.Unpack("getPoolReserves", ret)
+	suite.Require().NoError(err)
+	suite.Equal([]interface{}{big.NewInt(50e6), big.NewInt(10e6), big.NewInt(22360679)}, out)
+
+	ret, _, err = suite.Contract.Run(suite.T(), true, "getDepositorShares", callerAddr, "ukava", "usdx")
+	suite.Require().NoError(err)
+	out, err = swap.ABI.Unpack("getDepositorShares", ret)
+	suite.Require().NoError(err)
+	suite.Equal(big.NewInt(22360679), out[0])
+}
+
+func (suite *contractTestSuite) TestGetTWAP() {
+	ret, _, err := suite.Contract.Run(suite.T(), true, "getTWAP", "ukava", "usdx", big.NewInt(3600))
+	testutil.RequireRevert(suite.T(), ret, err, "pool ukava:usdx not found")
+
+	suite.deposit()
+
+	// advance the block time so the pool has an hour of price history
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	suite.StateDB = testutil.NewStateDB(suite.Ctx, suite.App.GetEvmKeeper())
+	suite.Contract.StateDB = suite.StateDB
+
+	ret, remainingGas, err := suite.Contract.Run(suite.T(), true, "getTWAP", "usdx", "ukava", big.NewInt(1800))
+	suite.Require().NoError(err)
+	suite.Equal(uint64(1_000_000)-swap.GetTWAPGas.Base, remainingGas)
+	out, err := swap.ABI.Unpack("getTWAP", ret)
+	suite.Require().NoError(err)
+	suite.Equal([]interface{}{big.NewInt(2e17), big.NewInt(5e18)}, out)
+
+	ret, _, err = suite.Contract.Run(suite.T(), true, "getTWAP", "usdx", "ukava", big.NewInt(7200))
+	testutil.RequireRevert(suite.T(), ret, err, "no price history")
+
+	ret, _, err = suite.Contract.Run(suite.T(), true, "getTWAP", "usdx", "ukava", big.NewInt(0))
+	testutil.RequireRevert(suite.T(), ret, err, "invalid twap window")
+
+	ret, _, err = suite.Contract.Run(suite.T(), true, "getTWAP", "usdx", "ukava", big.NewInt(int64(swaptypes.MaxTWAPWindow/time.Second)+1))
+	testutil.RequireRevert(suite.T(), ret, err, "invalid twap window")
+}
+
+func (suite *contractTestSuite) TestReadOnly() {
+	suite.Contract.RequireWriteProtected(suite.T(), "deposit",
+		"ukava", big.NewInt(10e6), "usdx", big.NewInt(50e6), big.NewInt(1e16), suite.deadline(),
+	)
+}
+
+func (suite *contractTestSuite) TestOutOfGas() {
+	input, err := swap.ABI.Pack("getPoolReserves", "ukava", "usdx")
+	suite.Require().NoError(err)
+
+	_, remainingGas, err := suite.Precompile.Run(
+		testutil.NewAccessibleState(suite.StateDB), callerAddr, contractAddr, input, swap.GetPoolReservesGas.Base-1, true,
+	)
+	suite.ErrorContains(err, "out of gas")
+	suite.Zero(remainingGas)
+}
+
+func (suite *contractTestSuite) TestUnsupportedStateDB() {
+	// a StateDB that does not expose its cosmos state
+	input, err := swap.ABI.Pack("getPoolReserves", "ukava", "usdx")
+	suite.Require().NoError(err)
+
+	ret, _, err := suite.Precompile.Run(
+		testutil.NewAccessibleState(struct{ contract.StateDB }{suite.StateDB}), callerAddr, contractAddr, input, 1_000_000, true,
+	)
+	testutil.RequireRevert(suite.T(), ret, err, contractutils.ErrUnsupportedStateDB.Error())
+}
+
+func (suite *contractTestSuite) TestKeepersNotSet() {
+	testutil.RequireKeepersNotSet(suite.T(), suite.Contract, swap.NewContract, "getPoolReserves", "ukava", "usdx")
+}
+
+// setupEVM enables the precompile with akava as the evm denom, and deploys a forwarder contract calling
+// the target, funded with pool denoms
+func (suite *contractTestSuite) setupEVM(forwarder common.Address, target common.Address, mode testutil.ForwarderMode) {
+	evmKeeper := suite.App.GetEvmKeeper()
+	suite.Require().NoError(testutil.SetupEVM(suite.Ctx, evmKeeper, contractAddr))
+	suite.Require().NoError(testutil.DeployCode(suite.Ctx, evmKeeper, forwarder, testutil.ForwarderCode(target, mode)))
+
+	err := suite.App.FundAccount(suite.Ctx, contractutils.AccAddressFromEvm(forwarder), sdk.NewCoins(
+		sdk.NewInt64Coin("ukava", 100e6),
+		sdk.NewInt64Coin("usdx", 500e6),
+	))
+	suite.Require().NoError(err)
+}
+
+func (suite *contractTestSuite) TestEVM_Deposit() {
+	forwarder := common.HexToAddress("0x00000000000000000000000000000000000000f1")
+	suite.setupEVM(forwarder, contractAddr, testutil.ForwardReturn)
+
+	input, err := swap.ABI.Pack("deposit",
+		"ukava", big.NewInt(10e6), "usdx", big.NewInt(50e6), big.NewInt(1e16), suite.deadline(),
+	)
+	suite.Require().NoError(err)
+
+	// the value sent to the forwarder leaves its balance cached by the EVM as dirty
+	res, err := testutil.ApplyMessage(suite.Ctx, suite.App.GetEvmKeeper(), callerAddr, forwarder, big.NewInt(1), input)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	out, err := swap.ABI.Unpack("deposit", res.Ret)
+	suite.Require().NoError(err)
+	suite.Equal(big.NewInt(10e6), out[0])
+	suite.Require().Len(res.Logs, 1)
+
+	// the deposited coins are not restored by the commit of the cached balance
+	depositor := contractutils.AccAddressFromEvm(forwarder)
+	bankKeeper := suite.App.GetBankKeeper()
+	suite.Equal(sdk.NewInt64Coin("ukava", 90e6), bankKeeper.GetBalance(suite.Ctx, depositor, "ukava"))
+	suite.Equal(sdk.NewInt64Coin("usdx", 450e6), bankKeeper.GetBalance(suite.Ctx, depositor, "usdx"))
+	suite.Equal(
+		sdkmath.NewInt(90e6).Mul(sdkmath.NewInt(1e12)).AddRaw(1),
+		suite.App.GetPrecisebankKeeper().GetBalance(suite.Ctx, depositor, "akava").Amount,
+	)
+
+	pool, found := suite.Keepers.SwapKeeper.GetPool(suite.Ctx, "ukava:usdx")
+	suite.Require().True(found)
+	suite.Equal(sdk.NewInt64Coin("ukava", 10e6), pool.ReservesA)
+}
+
+func (suite *contractTestSuite) TestEVM_RevertedCall() {
+	// the reverter calls the precompile and reverts, the caller ignores the failure so the transaction succeeds
+	reverter := common.HexToAddress("0x00000000000000000000000000000000000000f1")
+	caller := common.HexToAddress("0x00000000000000000000000000000000000000f2")
+	suite.setupEVM(reverter, contractAddr, testutil.ForwardRevert)
+	suite.Require().NoError(testutil.DeployCode(
+		suite.Ctx, suite.App.GetEvmKeeper(), caller, testutil.ForwarderCode(reverter, testutil.ForwardIgnore),
+	))
+
+	input, err := swap.ABI.Pack("deposit",
+		"ukava", big.NewInt(10e6), "usdx", big.NewInt(50e6), big.NewInt(1e16), suite.deadline(),
+	)
+	suite.Require().NoError(err)
+
+	res, err := testutil.ApplyMessage(suite.Ctx, suite.App.GetEvmKeeper(), callerAddr, caller, big.NewInt(0), input)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Empty(res.Logs)
+
+	// the deposit is reverted with the call that made it
+	depositor := contractutils.AccAddressFromEvm(reverter)
+	bankKeeper := suite.App.GetBankKeeper()
+	suite.Equal(sdk.NewInt64Coin("ukava", 100e6), bankKeeper.GetBalance(suite.Ctx, depositor, "ukava"))
+	suite.Equal(sdk.NewInt64Coin("usdx", 500e6), bankKeeper.GetBalance(suite.Ctx, depositor, "usdx"))
+
+	_, found := suite.Keepers.SwapKeeper.GetPool(suite.Ctx, "ukava:usdx")
+	suite.False(found)
+}
@@ -0,0 +1,88 @@
+package contractutils
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+)
+
+// revertSelector is the selector of the solidity Error(string) type used to encode revert reasons
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+// PackRevertReason encodes the reason as a solidity Error(string) revert payload
+func PackRevertReason(reason string) []byte {
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		panic(err)
+	}
+
+	packed, err := abi.Arguments{{Type: stringType}}.Pack(reason)
+	if err != nil {
+		panic(err)
+	}
+
+	return append(append([]byte{}, revertSelector...), packed...)
+}
+
+// Revert returns the values of a precompile function that reverts with the error as the reason.
+// Reverting, instead of returning the error, returns the remaining gas to the caller and allows
+// solidity callers to read the reason.
+func Revert(remainingGas uint64, err error) ([]byte, uint64, error) {
+	return PackRevertReason(err.Error()), remainingGas, vm.ErrExecutionReverted
+}
+
+// EmitEvent packs the event arguments and adds the resulting log to the StateDB. Indexed
+// arguments must be provided before non-indexed arguments, in the order they are defined.
+func EmitEvent(stateDB contract.StateDB, addr common.Address, event abi.Event, args ...interface{}) error {
+	topics := []common.Hash{event.ID}
+
+	var nonIndexed abi.Arguments
+	var nonIndexedArgs []interface{}
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			nonIndexed = append(nonIndexed, input)
+			nonIndexedArgs = append(nonIndexedArgs, args[i])
+			continue
+		}
+
+		indexedTopics, err := abi.MakeTopics([]interface{}{args[i]})
+		if err != nil {
+			return err
+		}
+		topics = append(topics, indexedTopics[0][0])
+	}
+
+	data, err := nonIndexed.Pack(nonIndexedArgs...)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address: addr,
+		Topics:  topics,
+		Data:    data,
+	})
+
+	return nil
+}
+
+// DecFromFixedPoint converts a solidity 18 decimal fixed point value to an sdk.Dec
+func DecFromFixedPoint(value *big.Int) sdk.Dec {
+	return sdk.NewDecFromBigIntWithPrec(value, sdk.Precision)
+}
+
+// DecToFixedPoint converts an sdk.Dec to a solidity 18 decimal fixed point value
+func DecToFixedPoint(value sdk.Dec) *big.Int {
+	return value.BigInt()
+}
+
+// AccAddressFromEvm returns the cosmos account address of an EVM address
+func AccAddressFromEvm(addr common.Address) sdk.AccAddress {
+	return sdk.AccAddress(addr.Bytes())
+}
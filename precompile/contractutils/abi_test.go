@@ -0,0 +1,34 @@
+package contractutils_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/precompile/contractutils"
+)
+
+func TestRevert(t *testing.T) {
+	ret, remainingGas, err := contractutils.Revert(100, errors.New("pool not found"))
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	assert.Equal(t, uint64(100), remainingGas)
+
+	reason, err := abi.UnpackRevert(ret)
+	require.NoError(t, err)
+	assert.Equal(t, "pool not found", reason)
+}
+
+func TestFixedPointConversion(t *testing.T) {
+	fixedPoint, ok := new(big.Int).SetString("1500000000000000000", 10)
+	require.True(t, ok)
+
+	dec := contractutils.DecFromFixedPoint(fixedPoint)
+	assert.Equal(t, sdk.MustNewDecFromStr("1.5"), dec)
+	assert.Equal(t, fixedPoint, contractutils.DecToFixedPoint(dec))
+}
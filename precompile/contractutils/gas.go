@@ -0,0 +1,35 @@
+package contractutils
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// RunMetered runs fn as a native action of the StateDB, committing its state changes only if it
+// succeeds, see StateDB.ExecuteNativeAction.
+//
+// The cosmos gas consumed by fn is metered with the default KV gas config, matching the gas charged when
+// the same operations are run through a cosmos message, and is deducted from the remaining EVM gas. If fn
+// consumes more gas than remains, vm.ErrOutOfGas is returned with no gas remaining.
+func RunMetered(stateDB StateDB, remainingGas uint64, fn func(ctx sdk.Context) error) (gasLeft uint64, err error) {
+	gasMeter := storetypes.NewGasMeter(remainingGas)
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			gasLeft, err = 0, vm.ErrOutOfGas
+		}
+	}()
+
+	err = stateDB.ExecuteNativeAction(func(ctx sdk.Context) error {
+		return fn(ctx.
+			WithGasMeter(gasMeter).
+			WithKVGasConfig(storetypes.KVGasConfig()).
+			WithTransientKVGasConfig(storetypes.TransientGasConfig()))
+	})
+
+	return remainingGas - gasMeter.GasConsumedToLimit(), err
+}
@@ -0,0 +1,76 @@
+// Package contractutils provides helpers shared by the stateful precompiles that interact with
+// cosmos module state, such as access to the sdk.Context and solidity revert and event encoding.
+package contractutils
+
+import (
+	"errors"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// ErrUnsupportedStateDB is returned when a precompile that requires access to cosmos state
+// is run against a StateDB that does not expose its backing sdk.Context.
+var ErrUnsupportedStateDB = errors.New("precompile requires a statedb with cosmos context access")
+
+// StateDB is an EVM StateDB that exposes the cosmos state backing the EVM execution, such as
+// the ethermint StateDB. Stateful precompiles that read or write cosmos module state require the
+// accessible state to provide a StateDB implementing this interface.
+type StateDB interface {
+	contract.StateDB
+
+	// GetContext returns a branch of the cosmos state of the current EVM execution. Writes to
+	// it are discarded, state must be modified with ExecuteNativeAction.
+	GetContext() sdk.Context
+
+	// ExecuteNativeAction runs action against the cosmos state of the current EVM execution.
+	// The state cached by the StateDB is visible to the action and is reloaded after it, and
+	// the writes of the action are reverted along with the EVM state if an enclosing call
+	// reverts. The writes are discarded if action returns an error.
+	ExecuteNativeAction(action func(ctx sdk.Context) error) error
+
+	// TakeCallValue returns the value transferred to addr by the EVM when entering the current
+	// call frame, and clears it. Precompiles take their call value when they start running, it
+	// is zero for calls that do not transfer value, such as static and delegate calls.
+	TakeCallValue(addr common.Address) *big.Int
+
+	// Keeper returns the evm keeper backing the StateDB. It identifies the app running the EVM,
+	// since precompiles are registered once per process but may be run by the EVM of any app.
+	Keeper() statedb.Keeper
+}
+
+// GetStateDB returns the StateDB of the accessible state, or ErrUnsupportedStateDB if it does
+// not expose its cosmos state.
+func GetStateDB(accessibleState contract.AccessibleState) (StateDB, error) {
+	stateDB, ok := accessibleState.GetStateDB().(StateDB)
+	if !ok {
+		return nil, ErrUnsupportedStateDB
+	}
+
+	return stateDB, nil
+}
+
+// GetContext returns the sdk.Context backing the StateDB of the accessible state, or
+// ErrUnsupportedStateDB if the StateDB does not expose one.
+func GetContext(accessibleState contract.AccessibleState) (sdk.Context, error) {
+	stateDB, err := GetStateDB(accessibleState)
+	if err != nil {
+		return sdk.Context{}, err
+	}
+
+	return stateDB.GetContext(), nil
+}
+
+// GetEVMKeeper returns the evm keeper backing the StateDB of the accessible state, which identifies
+// the app running the EVM, or ErrUnsupportedStateDB if the StateDB does not expose one.
+func GetEVMKeeper(accessibleState contract.AccessibleState) (statedb.Keeper, error) {
+	stateDB, err := GetStateDB(accessibleState)
+	if err != nil {
+		return nil, err
+	}
+
+	return stateDB.Keeper(), nil
+}
@@ -0,0 +1,100 @@
+package framework
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+
+	"github.com/kava-labs/kava/precompile/contractutils"
+)
+
+// Call contains the execution context of a precompile function call
+type Call struct {
+	// AccessibleState is the state accessible to the precompile
+	AccessibleState contract.AccessibleState
+	// Caller is the address calling the precompile
+	Caller common.Address
+	// Address is the address of the precompile
+	Address common.Address
+	// Value is the value sent with the call, it is always zero for functions that are not payable
+	Value *big.Int
+	// ReadOnly is true when the function is a view or the call is made in a static context
+	ReadOnly bool
+
+	abi          abi.ABI
+	remainingGas uint64
+}
+
+// StateDB returns the StateDB of the EVM execution
+func (c *Call) StateDB() contract.StateDB {
+	return c.AccessibleState.GetStateDB()
+}
+
+// RemainingGas returns the gas remaining for the call
+func (c *Call) RemainingGas() uint64 {
+	return c.remainingGas
+}
+
+// UseGas charges gas to the call, returning vm.ErrOutOfGas if not enough gas remains
+func (c *Call) UseGas(amount uint64) error {
+	if c.remainingGas < amount {
+		c.remainingGas = 0
+		return vm.ErrOutOfGas
+	}
+
+	c.remainingGas -= amount
+	return nil
+}
+
+// Context returns the sdk.Context of the EVM execution, see contractutils.GetContext
+func (c *Call) Context() (sdk.Context, error) {
+	return contractutils.GetContext(c.AccessibleState)
+}
+
+// ExecuteNativeAction runs fn against the cosmos state of the EVM execution, see
+// contractutils.StateDB. It returns an error if the call is read-only.
+func (c *Call) ExecuteNativeAction(fn func(ctx sdk.Context) error) error {
+	stateDB, err := c.nativeStateDB()
+	if err != nil {
+		return err
+	}
+
+	return stateDB.ExecuteNativeAction(fn)
+}
+
+// RunMetered runs fn against the cosmos state of the EVM execution and charges the cosmos gas it
+// consumes to the call, see contractutils.RunMetered. It returns an error if the call is read-only.
+func (c *Call) RunMetered(fn func(ctx sdk.Context) error) error {
+	stateDB, err := c.nativeStateDB()
+	if err != nil {
+		return err
+	}
+
+	remainingGas, err := contractutils.RunMetered(stateDB, c.remainingGas, fn)
+	c.remainingGas = remainingGas
+	return err
+}
+
+// nativeStateDB returns the StateDB used to modify cosmos state, or an error if the call is read-only
+func (c *Call) nativeStateDB() (contractutils.StateDB, error) {
+	if c.ReadOnly {
+		return nil, fmt.Errorf("cannot modify state of a read-only call")
+	}
+
+	return contractutils.GetStateDB(c.AccessibleState)
+}
+
+// EmitEvent adds a log of an ABI event of the precompile to the StateDB, see contractutils.EmitEvent
+func (c *Call) EmitEvent(name string, args ...interface{}) error {
+	event, found := c.abi.Events[name]
+	if !found {
+		return fmt.Errorf("event %s is not defined in the abi", name)
+	}
+
+	return contractutils.EmitEvent(c.StateDB(), c.Address, event, args...)
+}
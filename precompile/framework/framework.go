@@ -0,0 +1,242 @@
+// Package framework implements stateful precompiles from an ABI and a set of function handlers.
+//
+// Each function of a precompile is declared by its ABI method, which determines whether it is read-only
+// (view or pure), state-mutating (nonpayable) or accepts value (payable). The framework selects the
+// function, charges its gas schedule, enforces its mutability, unpacks the arguments and packs the
+// results of the handler, reverting with the handler's error as the reason. Calls are recorded in the
+// precompile metrics of x/metrics.
+package framework
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/ethereum/go-ethereum/vmerrs"
+
+	"github.com/kava-labs/kava/precompile/contractutils"
+	metricstypes "github.com/kava-labs/kava/x/metrics/types"
+)
+
+var (
+	// ErrNonPayable is returned when value is sent to a function that is not payable
+	ErrNonPayable = errors.New("function is not payable")
+	// ErrValueNotTransferred is returned when a payable function does not transfer the value it receives
+	ErrValueNotTransferred = errors.New("payable function did not transfer the call value")
+)
+
+// Call result labels recorded by the precompile call metrics
+const (
+	resultSuccess   = "success"
+	resultReverted  = "reverted"
+	resultOutOfGas  = "out_of_gas"
+	resultException = "error"
+)
+
+// GasSchedule defines the gas charged for a precompile function before its handler is run. Handlers
+// may charge additional gas with Call.UseGas or Call.RunMetered.
+type GasSchedule struct {
+	// Base is the fixed gas charged for each call
+	Base uint64
+	// PerInputWord is the gas charged for each 32 byte word of the abi encoded arguments
+	PerInputWord uint64
+}
+
+// Cost returns the gas charged for a call with the abi encoded arguments
+func (g GasSchedule) Cost(args []byte) uint64 {
+	words := uint64(len(args)+31) / 32
+	if g.PerInputWord != 0 && words > (math.MaxUint64-g.Base)/g.PerInputWord {
+		return math.MaxUint64
+	}
+
+	return g.Base + words*g.PerInputWord
+}
+
+// Handler runs a precompile function with its unpacked arguments, returning the values to pack as its
+// outputs. Returned errors revert the call with the error as the reason, except for vm.ErrOutOfGas
+// which consumes all remaining gas.
+type Handler func(call *Call, args []interface{}) ([]interface{}, error)
+
+// Function maps an ABI method of a precompile to its gas schedule and handler
+type Function struct {
+	// Method is the name of the ABI method
+	Method string
+	// Gas is the gas charged before the handler is run
+	Gas GasSchedule
+	// Handler implements the function
+	Handler Handler
+}
+
+// function is a Function resolved against its ABI method
+type function struct {
+	Function
+	method abi.Method
+}
+
+// precompile implements contract.StatefulPrecompiledContract for a set of functions
+type precompile struct {
+	name      string
+	abi       abi.ABI
+	functions map[string]function
+}
+
+// NewContract returns a stateful precompiled contract that implements the methods of an ABI with the
+// provided functions. The name identifies the precompile in metrics. Every method of the ABI must be
+// implemented by exactly one function.
+func NewContract(name string, contractABI abi.ABI, functions []Function) (contract.StatefulPrecompiledContract, error) {
+	p := &precompile{
+		name:      name,
+		abi:       contractABI,
+		functions: make(map[string]function, len(functions)),
+	}
+
+	for _, fn := range functions {
+		method, found := contractABI.Methods[fn.Method]
+		if !found {
+			return nil, fmt.Errorf("method %s of precompile %s is not defined in the abi", fn.Method, name)
+		}
+		if fn.Handler == nil {
+			return nil, fmt.Errorf("method %s of precompile %s has no handler", fn.Method, name)
+		}
+
+		selector := string(method.ID)
+		if _, exists := p.functions[selector]; exists {
+			return nil, fmt.Errorf("method %s of precompile %s is implemented more than once", fn.Method, name)
+		}
+		p.functions[selector] = function{Function: fn, method: method}
+	}
+
+	for methodName, method := range contractABI.Methods {
+		if _, found := p.functions[string(method.ID)]; !found {
+			return nil, fmt.Errorf("method %s of precompile %s is not implemented", methodName, name)
+		}
+	}
+
+	return p, nil
+}
+
+// Run selects the function from the selector of the input and runs it
+func (p *precompile) Run(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	// the call value is taken before any check, so that it is not left to a later call frame
+	value := callValue(accessibleState, addr)
+
+	if len(input) < contract.SelectorLen {
+		return nil, suppliedGas, fmt.Errorf("missing function selector to precompile - input length (%d)", len(input))
+	}
+
+	fn, found := p.functions[string(input[:contract.SelectorLen])]
+	if !found {
+		return nil, suppliedGas, fmt.Errorf("invalid function selector %#x", input[:contract.SelectorLen])
+	}
+
+	defer func() {
+		recordCall(getMetrics(accessibleState), p.name, fn.method.Name, suppliedGas-remainingGas, err)
+	}()
+
+	return p.run(fn, accessibleState, caller, addr, value, input[contract.SelectorLen:], suppliedGas, readOnly)
+}
+
+// run charges the gas schedule of a function, checks its mutability and runs its handler
+func (p *precompile) run(
+	fn function,
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	value *big.Int,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) ([]byte, uint64, error) {
+	remainingGas, err := contract.DeductGas(suppliedGas, fn.Gas.Cost(input))
+	if err != nil {
+		return nil, 0, vm.ErrOutOfGas
+	}
+
+	isReadOnly := fn.method.IsConstant()
+	if readOnly && !isReadOnly {
+		return nil, remainingGas, vmerrs.ErrWriteProtection
+	}
+
+	if value.Sign() != 0 && !fn.method.IsPayable() {
+		return contractutils.Revert(remainingGas, errorsmod.Wrapf(ErrNonPayable, "%s", fn.method.Name))
+	}
+
+	args, err := fn.method.Inputs.Unpack(input)
+	if err != nil {
+		return contractutils.Revert(remainingGas, err)
+	}
+
+	stateDB := accessibleState.GetStateDB()
+	balance := stateDB.GetBalance(addr)
+
+	call := &Call{
+		AccessibleState: accessibleState,
+		Caller:          caller,
+		Address:         addr,
+		Value:           value,
+		ReadOnly:        readOnly || isReadOnly,
+		abi:             p.abi,
+		remainingGas:    remainingGas,
+	}
+
+	outputs, err := fn.Handler(call, args)
+	if err != nil {
+		if errors.Is(err, vm.ErrOutOfGas) {
+			return nil, 0, err
+		}
+		return contractutils.Revert(call.remainingGas, err)
+	}
+
+	// the precompile holds the call value while the handler runs, it must have transferred it out
+	if value.Sign() != 0 && stateDB.GetBalance(addr).Cmp(new(big.Int).Sub(balance, value)) > 0 {
+		return contractutils.Revert(call.remainingGas, ErrValueNotTransferred)
+	}
+
+	ret, err := fn.method.Outputs.Pack(outputs...)
+	if err != nil {
+		return nil, call.remainingGas, err
+	}
+
+	return ret, call.remainingGas, nil
+}
+
+// recordCall records the result and gas used of a precompile function call in the precompile metrics
+func recordCall(m *metricstypes.Metrics, precompileName string, method string, gasUsed uint64, err error) {
+	result := resultSuccess
+	switch {
+	case err == nil:
+	case errors.Is(err, vm.ErrExecutionReverted):
+		result = resultReverted
+	case errors.Is(err, vm.ErrOutOfGas):
+		result = resultOutOfGas
+	default:
+		result = resultException
+	}
+
+	m.PrecompileCalls.With("precompile", precompileName, "function", method, "result", result).Add(1)
+	m.PrecompileGasUsed.With("precompile", precompileName, "function", method).Observe(float64(gasUsed))
+}
+
+// callValue returns the value transferred to the precompile by the EVM call frame running it, see
+// contractutils.StateDB. It is zero if the StateDB does not track call values.
+func callValue(accessibleState contract.AccessibleState, addr common.Address) *big.Int {
+	stateDB, err := contractutils.GetStateDB(accessibleState)
+	if err != nil {
+		return new(big.Int)
+	}
+
+	return stateDB.TakeCallValue(addr)
+}
@@ -0,0 +1,262 @@
+package framework_test
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/go-kit/kit/metrics"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/precompile/framework"
+	"github.com/kava-labs/kava/precompile/testutil"
+	metricstypes "github.com/kava-labs/kava/x/metrics/types"
+)
+
+var (
+	callerAddr   = common.HexToAddress("0xc0ffee254729296a45a3885639AC7E10F9d54979")
+	contractAddr = common.HexToAddress("0x9000000000000000000000000000000000000001")
+
+	testABI = contract.MustParseABI(`[
+		{"type": "function", "name": "get", "stateMutability": "view", "inputs": [], "outputs": [{"name": "value", "type": "uint256"}]},
+		{"type": "function", "name": "keep", "stateMutability": "payable", "inputs": [], "outputs": []}
+	]`)
+)
+
+func noopHandler(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	return nil, nil
+}
+
+func getHandler(call *framework.Call, args []interface{}) ([]interface{}, error) {
+	return []interface{}{big.NewInt(1)}, nil
+}
+
+func TestGasSchedule_Cost(t *testing.T) {
+	gas := framework.GasSchedule{Base: 100, PerInputWord: 10}
+
+	require.Equal(t, uint64(100), gas.Cost(nil))
+	require.Equal(t, uint64(110), gas.Cost(make([]byte, 1)))
+	require.Equal(t, uint64(110), gas.Cost(make([]byte, 32)))
+	require.Equal(t, uint64(120), gas.Cost(make([]byte, 33)))
+	require.Equal(t, uint64(100), framework.GasSchedule{Base: 100}.Cost(make([]byte, 64)))
+	require.Equal(t, uint64(math.MaxUint64), framework.GasSchedule{Base: 1, PerInputWord: math.MaxUint64}.Cost(make([]byte, 64)))
+}
+
+func TestNewContract_Validation(t *testing.T) {
+	testCases := []struct {
+		name      string
+		functions []framework.Function
+		expErr    string
+	}{
+		{
+			name: "valid",
+			functions: []framework.Function{
+				{Method: "get", Handler: getHandler},
+				{Method: "keep", Handler: noopHandler},
+			},
+		},
+		{
+			name: "method not in abi",
+			functions: []framework.Function{
+				{Method: "get", Handler: getHandler},
+				{Method: "keep", Handler: noopHandler},
+				{Method: "set", Handler: noopHandler},
+			},
+			expErr: "method set of precompile test is not defined in the abi",
+		},
+		{
+			name: "missing handler",
+			functions: []framework.Function{
+				{Method: "get"},
+				{Method: "keep", Handler: noopHandler},
+			},
+			expErr: "method get of precompile test has no handler",
+		},
+		{
+			name: "duplicate function",
+			functions: []framework.Function{
+				{Method: "get", Handler: getHandler},
+				{Method: "get", Handler: getHandler},
+				{Method: "keep", Handler: noopHandler},
+			},
+			expErr: "method get of precompile test is implemented more than once",
+		},
+		{
+			name: "unimplemented method",
+			functions: []framework.Function{
+				{Method: "get", Handler: getHandler},
+			},
+			expErr: "method keep of precompile test is not implemented",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			precompile, err := framework.NewContract("test", testABI, tc.functions)
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, precompile)
+		})
+	}
+}
+
+// newStateDB returns a StateDB backed by a new app, funding the precompile with a balance
+func newStateDB(t *testing.T, balance int64) *statedb.StateDB {
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Unix(1_000_000, 0)})
+
+	if balance > 0 {
+		evmDenom := tApp.GetEvmKeeper().GetParams(ctx).EvmDenom
+		err := tApp.FundAccount(ctx, sdk.AccAddress(contractAddr.Bytes()), sdk.NewCoins(sdk.NewInt64Coin(evmDenom, balance)))
+		require.NoError(t, err)
+	}
+
+	return testutil.NewStateDB(ctx, tApp.GetEvmKeeper())
+}
+
+func TestRun_ValueTransfers(t *testing.T) {
+	precompile, err := framework.NewContract("test", testABI, []framework.Function{
+		{Method: "get", Handler: getHandler},
+		// keep does not transfer out the value it receives
+		{Method: "keep", Handler: noopHandler},
+	})
+	require.NoError(t, err)
+
+	stateDB := newStateDB(t, 1e6)
+	require.Equal(t, big.NewInt(1e6), stateDB.GetBalance(contractAddr))
+	stateDB.AddBalance(callerAddr, big.NewInt(2))
+
+	getInput, err := testABI.Pack("get")
+	require.NoError(t, err)
+	keepInput, err := testABI.Pack("keep")
+	require.NoError(t, err)
+
+	// a balance held by the precompile is not a call value
+	_, _, err = precompile.Run(testutil.NewAccessibleState(stateDB), callerAddr, contractAddr, getInput, 100_000, false)
+	require.NoError(t, err)
+	_, _, err = precompile.Run(testutil.NewAccessibleState(stateDB), callerAddr, contractAddr, keepInput, 100_000, false)
+	require.NoError(t, err)
+
+	// value transferred by the evm when entering the call frame
+	statedb.Transfer(stateDB, callerAddr, contractAddr, big.NewInt(1))
+	ret, _, err := precompile.Run(testutil.NewAccessibleState(stateDB), callerAddr, contractAddr, getInput, 100_000, false)
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	reason, err := abi.UnpackRevert(ret)
+	require.NoError(t, err)
+	require.Equal(t, "get: function is not payable", reason)
+
+	statedb.Transfer(stateDB, callerAddr, contractAddr, big.NewInt(1))
+	ret, _, err = precompile.Run(testutil.NewAccessibleState(stateDB), callerAddr, contractAddr, keepInput, 100_000, false)
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	reason, err = abi.UnpackRevert(ret)
+	require.NoError(t, err)
+	require.Equal(t, framework.ErrValueNotTransferred.Error(), reason)
+
+	// the call value is taken by the call it was transferred to
+	_, _, err = precompile.Run(testutil.NewAccessibleState(stateDB), callerAddr, contractAddr, getInput, 100_000, false)
+	require.NoError(t, err)
+
+	// a transfer to another address is not a call value of the precompile
+	statedb.Transfer(stateDB, contractAddr, callerAddr, big.NewInt(1))
+	_, _, err = precompile.Run(testutil.NewAccessibleState(stateDB), callerAddr, contractAddr, getInput, 100_000, false)
+	require.NoError(t, err)
+}
+
+func TestRun_Metrics(t *testing.T) {
+	precompile, err := framework.NewContract("test", testABI, []framework.Function{
+		{Method: "get", Gas: framework.GasSchedule{Base: 1_000}, Handler: getHandler},
+		{Method: "keep", Gas: framework.GasSchedule{Base: 500}, Handler: func(call *framework.Call, args []interface{}) ([]interface{}, error) {
+			return nil, errors.New("failed")
+		}},
+	})
+	require.NoError(t, err)
+
+	// each app sets the metrics of its precompile calls when it is created
+	stateDB := newStateDB(t, 0)
+	otherStateDB := newStateDB(t, 0)
+	calls, gasUsed := &recorder{}, &recorder{}
+	framework.SetMetrics(stateDB.Keeper(), &metricstypes.Metrics{
+		PrecompileCalls:   recordingCounter{recordingMetric{recorder: calls}},
+		PrecompileGasUsed: recordingHistogram{recordingMetric{recorder: gasUsed}},
+	})
+
+	getInput, err := testABI.Pack("get")
+	require.NoError(t, err)
+	keepInput, err := testABI.Pack("keep")
+	require.NoError(t, err)
+
+	_, _, err = precompile.Run(testutil.NewAccessibleState(stateDB), callerAddr, contractAddr, getInput, 100_000, true)
+	require.NoError(t, err)
+	_, _, err = precompile.Run(testutil.NewAccessibleState(stateDB), callerAddr, contractAddr, keepInput, 100_000, false)
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	_, _, err = precompile.Run(testutil.NewAccessibleState(stateDB), callerAddr, contractAddr, getInput, 10, true)
+	require.ErrorIs(t, err, vm.ErrOutOfGas)
+
+	// calls run by the evm of another app are recorded to the metrics of that app
+	_, _, err = precompile.Run(testutil.NewAccessibleState(otherStateDB), callerAddr, contractAddr, getInput, 100_000, true)
+	require.NoError(t, err)
+
+	require.Equal(t, []record{
+		{labels: []string{"precompile", "test", "function", "get", "result", "success"}, value: 1},
+		{labels: []string{"precompile", "test", "function", "keep", "result", "reverted"}, value: 1},
+		{labels: []string{"precompile", "test", "function", "get", "result", "out_of_gas"}, value: 1},
+	}, calls.records)
+	require.Equal(t, []record{
+		{labels: []string{"precompile", "test", "function", "get"}, value: 1_000},
+		{labels: []string{"precompile", "test", "function", "keep"}, value: 500},
+		{labels: []string{"precompile", "test", "function", "get"}, value: 10},
+	}, gasUsed.records)
+}
+
+// record is a value recorded by a metric with its label values
+type record struct {
+	labels []string
+	value  float64
+}
+
+// recorder records the values of a metric with their label values
+type recorder struct {
+	records []record
+}
+
+// recordingMetric implements metrics.Counter and metrics.Histogram, storing values in a shared recorder
+type recordingMetric struct {
+	recorder *recorder
+	labels   []string
+}
+
+func (m recordingMetric) with(labelValues ...string) recordingMetric {
+	return recordingMetric{recorder: m.recorder, labels: append(append([]string{}, m.labels...), labelValues...)}
+}
+
+func (m recordingMetric) record(value float64) {
+	m.recorder.records = append(m.recorder.records, record{labels: m.labels, value: value})
+}
+
+type recordingCounter struct{ recordingMetric }
+
+func (c recordingCounter) With(labelValues ...string) metrics.Counter {
+	return recordingCounter{c.with(labelValues...)}
+}
+func (c recordingCounter) Add(delta float64) { c.record(delta) }
+
+type recordingHistogram struct{ recordingMetric }
+
+func (h recordingHistogram) With(labelValues ...string) metrics.Histogram {
+	return recordingHistogram{h.with(labelValues...)}
+}
+func (h recordingHistogram) Observe(value float64) { h.record(value) }
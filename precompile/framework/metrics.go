@@ -0,0 +1,50 @@
+package framework
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/evmos/ethermint/x/evm/statedb"
+
+	"github.com/kava-labs/kava/precompile/contractutils"
+	metricstypes "github.com/kava-labs/kava/x/metrics/types"
+)
+
+var (
+	noopMetrics = metricstypes.NoopMetrics()
+
+	// appMetrics holds the metrics of each app, keyed by the evm keeper of the app. Precompiles are
+	// registered once per process, so the metrics are resolved from the EVM running the call.
+	appMetricsMu sync.RWMutex
+	appMetrics   = make(map[statedb.Keeper]*metricstypes.Metrics)
+)
+
+// SetMetrics sets the metrics used to record the precompile function calls run by the EVM of an app,
+// identified by its evm keeper. Calls of apps without metrics are recorded to no-op metrics.
+func SetMetrics(evmKeeper statedb.Keeper, m *metricstypes.Metrics) {
+	if evmKeeper == nil || m == nil {
+		panic("precompile metrics require an evm keeper and metrics")
+	}
+
+	appMetricsMu.Lock()
+	defer appMetricsMu.Unlock()
+
+	appMetrics[evmKeeper] = m
+}
+
+// getMetrics returns the metrics used to record the precompile function calls of the app running the EVM
+func getMetrics(accessibleState contract.AccessibleState) *metricstypes.Metrics {
+	evmKeeper, err := contractutils.GetEVMKeeper(accessibleState)
+	if err != nil {
+		return noopMetrics
+	}
+
+	appMetricsMu.RLock()
+	defer appMetricsMu.RUnlock()
+
+	m, found := appMetrics[evmKeeper]
+	if !found {
+		return noopMetrics
+	}
+	return m
+}
@@ -1,13 +1,21 @@
 package registry
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/precompile/contract"
 	"github.com/ethereum/go-ethereum/precompile/modules"
+	"github.com/evmos/ethermint/x/evm/statedb"
 
+	"github.com/kava-labs/kava/precompile/contracts/evmutil"
+	"github.com/kava-labs/kava/precompile/contracts/liquid"
 	"github.com/kava-labs/kava/precompile/contracts/noop"
+	"github.com/kava-labs/kava/precompile/contracts/pricefeed"
+	"github.com/kava-labs/kava/precompile/contracts/swap"
+	"github.com/kava-labs/kava/precompile/contractutils"
 )
 
 const (
@@ -15,13 +23,161 @@ const (
 	NoopContractAddress = "0x9000000000000000000000000000000000000001"
 	// NoopContractAddress2 the secondary noop contract address for testing
 	NoopContractAddress2 = "0x9000000000000000000000000000000000000002"
+	// SwapContractAddress the x/swap pool operations contract address
+	SwapContractAddress = "0x9000000000000000000000000000000000000003"
+	// PricefeedContractAddress the x/pricefeed oracle prices contract address
+	PricefeedContractAddress = "0x9000000000000000000000000000000000000004"
+	// LiquidContractAddress the x/liquid and x/router liquid staking contract address
+	LiquidContractAddress = "0x9000000000000000000000000000000000000005"
+	// EvmutilContractAddress the x/evmutil cosmos coin conversions contract address
+	EvmutilContractAddress = "0x9000000000000000000000000000000000000006"
 )
 
+// Keepers defines the cosmos keepers used by precompiles that interact with module state
+type Keepers struct {
+	BankKeeper      swap.BankKeeper
+	SwapKeeper      swap.SwapKeeper
+	PricefeedKeeper pricefeed.PricefeedKeeper
+	LiquidKeeper    liquid.LiquidKeeper
+	RouterKeeper    liquid.RouterKeeper
+	StakingKeeper   liquid.StakingKeeper
+	EvmutilKeeper   evmutil.EvmutilKeeper
+}
+
+// ErrKeepersNotSet is returned when a precompile is run by the EVM of an app that has not set its keepers
+var ErrKeepersNotSet = errors.New("precompile keepers are not set for the app running the evm")
+
+var (
+	// appKeepers holds the keepers of each app, keyed by the evm keeper of the app. Precompiles are
+	// registered once per process, so the keepers are resolved from the EVM running the call.
+	appKeepersMu sync.RWMutex
+	appKeepers   = make(map[statedb.Keeper]Keepers)
+)
+
+// Validate returns an error if any of the keepers is not set
+func (k Keepers) Validate() error {
+	keepers := []struct {
+		name   string
+		keeper interface{}
+	}{
+		{"bank", k.BankKeeper},
+		{"swap", k.SwapKeeper},
+		{"pricefeed", k.PricefeedKeeper},
+		{"liquid", k.LiquidKeeper},
+		{"router", k.RouterKeeper},
+		{"staking", k.StakingKeeper},
+		{"evmutil", k.EvmutilKeeper},
+	}
+	for _, k := range keepers {
+		if k.keeper == nil {
+			return fmt.Errorf("precompile %s keeper is not set", k.name)
+		}
+	}
+	return nil
+}
+
+// SetKeepers sets the keepers used by the registered precompiles when run by the EVM of an app,
+// identified by its evm keeper. This must be called by the app after its keepers are constructed,
+// since precompiles are registered in init before any app exists. It panics if a keeper is missing.
+func SetKeepers(evmKeeper statedb.Keeper, k Keepers) {
+	if evmKeeper == nil {
+		panic("precompile keepers require an evm keeper")
+	}
+	if err := k.Validate(); err != nil {
+		panic(err)
+	}
+
+	appKeepersMu.Lock()
+	defer appKeepersMu.Unlock()
+
+	appKeepers[evmKeeper] = k
+}
+
+// getKeepers returns the keepers of the app running the EVM of the accessible state
+func getKeepers(accessibleState contract.AccessibleState) (Keepers, error) {
+	evmKeeper, err := contractutils.GetEVMKeeper(accessibleState)
+	if err != nil {
+		return Keepers{}, err
+	}
+
+	appKeepersMu.RLock()
+	defer appKeepersMu.RUnlock()
+
+	k, found := appKeepers[evmKeeper]
+	if !found {
+		return Keepers{}, ErrKeepersNotSet
+	}
+	return k, nil
+}
+
 // init registers stateful precompile contracts with the global precompile registry
 // defined in kava-labs/go-ethereum/precompile/modules
 func init() {
 	register(NoopContractAddress, noop.NewContract)
 	register(NoopContractAddress2, noop.NewContract)
+	register(SwapContractAddress, func() (contract.StatefulPrecompiledContract, error) {
+		return swap.NewContract(swapKeepers)
+	})
+	register(PricefeedContractAddress, func() (contract.StatefulPrecompiledContract, error) {
+		return pricefeed.NewContract(pricefeedKeepers)
+	})
+	register(LiquidContractAddress, func() (contract.StatefulPrecompiledContract, error) {
+		return liquid.NewContract(liquidKeepers)
+	})
+	register(EvmutilContractAddress, func() (contract.StatefulPrecompiledContract, error) {
+		return evmutil.NewContract(evmutilKeepers)
+	})
+}
+
+// swapKeepers returns the keepers used by the swap precompile
+func swapKeepers(accessibleState contract.AccessibleState) (swap.Keepers, error) {
+	keepers, err := getKeepers(accessibleState)
+	if err != nil {
+		return swap.Keepers{}, err
+	}
+
+	return swap.Keepers{
+		SwapKeeper: keepers.SwapKeeper,
+		BankKeeper: keepers.BankKeeper,
+	}, nil
+}
+
+// pricefeedKeepers returns the keepers used by the pricefeed precompile
+func pricefeedKeepers(accessibleState contract.AccessibleState) (pricefeed.Keepers, error) {
+	keepers, err := getKeepers(accessibleState)
+	if err != nil {
+		return pricefeed.Keepers{}, err
+	}
+
+	return pricefeed.Keepers{
+		PricefeedKeeper: keepers.PricefeedKeeper,
+	}, nil
+}
+
+// liquidKeepers returns the keepers used by the liquid precompile
+func liquidKeepers(accessibleState contract.AccessibleState) (liquid.Keepers, error) {
+	keepers, err := getKeepers(accessibleState)
+	if err != nil {
+		return liquid.Keepers{}, err
+	}
+
+	return liquid.Keepers{
+		LiquidKeeper:  keepers.LiquidKeeper,
+		RouterKeeper:  keepers.RouterKeeper,
+		StakingKeeper: keepers.StakingKeeper,
+	}, nil
+}
+
+// evmutilKeepers returns the keepers used by the evmutil precompile
+func evmutilKeepers(accessibleState contract.AccessibleState) (evmutil.Keepers, error) {
+	keepers, err := getKeepers(accessibleState)
+	if err != nil {
+		return evmutil.Keepers{}, err
+	}
+
+	return evmutil.Keepers{
+		EvmutilKeeper: keepers.EvmutilKeeper,
+	}, nil
 }
 
 // register accepts a 0x address string and a stateful precompile contract constructor, instantiates the
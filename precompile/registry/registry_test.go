@@ -2,9 +2,24 @@ package registry_test
 
 import (
 	"testing"
+	"time"
 
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/precompile/modules"
+	"github.com/evmos/ethermint/x/evm/statedb"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/precompile/contracts/pricefeed"
+	"github.com/kava-labs/kava/precompile/contractutils"
+	"github.com/kava-labs/kava/precompile/registry"
+	"github.com/kava-labs/kava/precompile/testutil"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 // TestRegisteredPrecompiles asserts precompiles are registered
@@ -26,8 +41,75 @@ func TestRegisteredPrecompilesAddresses(t *testing.T) {
 		// 0x9 address space used for e2e & integration tests
 		"0x9000000000000000000000000000000000000001", // noop
 		"0x9000000000000000000000000000000000000002", // noop (duplicated for testing)
+		"0x9000000000000000000000000000000000000003", // swap
+		"0x9000000000000000000000000000000000000004", // pricefeed
+		"0x9000000000000000000000000000000000000005", // liquid
+		"0x9000000000000000000000000000000000000006", // evmutil
 	}
 
 	assert.Equal(t, expectedPrecompiles, registeredPrecompiles,
 		"expected registered precompile address list to match to match expected")
 }
+
+// newPricefeedApp returns a StateDB of a new app, with a btc:usd price set when price is not nil
+func newPricefeedApp(t *testing.T, price *sdk.Dec) *statedb.StateDB {
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Unix(1_000_000, 0)})
+
+	if price != nil {
+		oracle := sdk.AccAddress(common.HexToAddress("0xaa").Bytes())
+		keeper := tApp.GetPriceFeedKeeper()
+		keeper.SetParams(ctx, pricefeedtypes.NewParams([]pricefeedtypes.Market{
+			pricefeedtypes.NewMarket("btc:usd", "btc", "usd", []sdk.AccAddress{oracle}, true),
+		}, pricefeedtypes.DefaultPriceHistoryLength))
+		_, err := keeper.SetPrice(ctx, oracle, "btc:usd", *price, ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		keeper.SetCurrentPricesForAllMarkets(ctx)
+	}
+
+	return testutil.NewStateDB(ctx, tApp.GetEvmKeeper())
+}
+
+// TestKeepersPerApp asserts registered precompiles use the keepers of the app running the evm
+func TestKeepersPerApp(t *testing.T) {
+	address := common.HexToAddress(registry.PricefeedContractAddress)
+	module, found := modules.GetPrecompileModuleByAddress(address)
+	require.True(t, found)
+	input, err := pricefeed.ABI.Pack("getPrice", "btc:usd")
+	require.NoError(t, err)
+
+	price := sdk.MustNewDecFromStr("60000")
+	stateDB := newPricefeedApp(t, &price)
+	// the keepers of the last app created are not used by the first one
+	otherStateDB := newPricefeedApp(t, nil)
+
+	ret, _, err := module.Contract.Run(testutil.NewAccessibleState(stateDB), common.Address{}, address, input, 100_000, true)
+	require.NoError(t, err)
+	out, err := pricefeed.ABI.Unpack("getPrice", ret)
+	require.NoError(t, err)
+	require.Equal(t, contractutils.DecToFixedPoint(price), out[0])
+
+	ret, _, err = module.Contract.Run(testutil.NewAccessibleState(otherStateDB), common.Address{}, address, input, 100_000, true)
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	reason, err := abi.UnpackRevert(ret)
+	require.NoError(t, err)
+	require.Contains(t, reason, "market does not exist")
+
+	// an evm keeper without keepers set is rejected
+	unknownStateDB := testutil.NewStateDB(stateDB.GetContext(), struct{ statedb.Keeper }{stateDB.Keeper()})
+	ret, _, err = module.Contract.Run(testutil.NewAccessibleState(unknownStateDB), common.Address{}, address, input, 100_000, true)
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	reason, err = abi.UnpackRevert(ret)
+	require.NoError(t, err)
+	require.Equal(t, registry.ErrKeepersNotSet.Error(), reason)
+}
+
+func TestSetKeepers_Validation(t *testing.T) {
+	require.PanicsWithError(t, "precompile bank keeper is not set", func() {
+		registry.SetKeepers(struct{ statedb.Keeper }{}, registry.Keepers{})
+	})
+	require.PanicsWithValue(t, "precompile keepers require an evm keeper", func() {
+		registry.SetKeepers(nil, registry.Keepers{})
+	})
+}
@@ -0,0 +1,74 @@
+package testutil
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/ethereum/go-ethereum/vmerrs"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/precompile/registry"
+)
+
+// Contract runs method calls against a precompile in tests, from a caller address and with a fixed gas limit
+type Contract struct {
+	ABI        abi.ABI
+	Precompile contract.StatefulPrecompiledContract
+	StateDB    *statedb.StateDB
+	Address    common.Address
+	Caller     common.Address
+	Gas        uint64
+
+	// Commit writes the state of the StateDB to its context after each call, so that it can be checked
+	// with the cosmos keepers
+	Commit bool
+}
+
+// Run packs the method call and runs it against the precompile
+func (c Contract) Run(t require.TestingT, readOnly bool, method string, args ...interface{}) ([]byte, uint64, error) {
+	input, err := c.ABI.Pack(method, args...)
+	require.NoError(t, err)
+
+	ret, remainingGas, err := c.Precompile.Run(NewAccessibleState(c.StateDB), c.Caller, c.Address, input, c.Gas, readOnly)
+	if c.Commit {
+		require.NoError(t, c.StateDB.Commit())
+	}
+
+	return ret, remainingGas, err
+}
+
+// RequireWriteProtected requires the method call to fail with a write protection error when run read-only
+func (c Contract) RequireWriteProtected(t require.TestingT, method string, args ...interface{}) {
+	_, _, err := c.Run(t, true, method, args...)
+	require.ErrorIs(t, err, vmerrs.ErrWriteProtection, method)
+}
+
+// RequireRevert requires the call to have reverted with a reason containing the provided string
+func RequireRevert(t require.TestingT, ret []byte, err error, contains string) {
+	require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	reason, unpackErr := abi.UnpackRevert(ret)
+	require.NoError(t, unpackErr)
+	require.Contains(t, reason, contains)
+}
+
+// RequireKeepersNotSet requires the method call to revert when the keepers of the app running the EVM are not
+// set, using newContract to create the precompile with keepers that cannot be loaded
+func RequireKeepersNotSet[K any](
+	t require.TestingT,
+	c Contract,
+	newContract func(func(contract.AccessibleState) (K, error)) (contract.StatefulPrecompiledContract, error),
+	method string,
+	args ...interface{},
+) {
+	precompile, err := newContract(func(contract.AccessibleState) (K, error) {
+		var keepers K
+		return keepers, registry.ErrKeepersNotSet
+	})
+	require.NoError(t, err)
+	c.Precompile = precompile
+
+	ret, _, err := c.Run(t, false, method, args...)
+	RequireRevert(t, ret, err, registry.ErrKeepersNotSet.Error())
+}
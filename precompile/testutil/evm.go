@@ -0,0 +1,113 @@
+package testutil
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// ForwarderMode is the behavior of a forwarder contract after calling its target, see ForwarderCode
+type ForwarderMode int
+
+const (
+	// ForwardReturn returns the data returned by the target, reverting with it if the call failed
+	ForwardReturn ForwarderMode = iota
+	// ForwardRevert reverts after the call, whether it succeeded or not
+	ForwardRevert
+	// ForwardIgnore stops after the call, whether it succeeded or not
+	ForwardIgnore
+)
+
+// ForwarderCode returns the runtime code of a contract that calls target with its own calldata and no
+// value, allowing precompiles to be called from a contract through the EVM in tests.
+func ForwarderCode(target common.Address, mode ForwarderMode) []byte {
+	code := []byte{
+		0x36, 0x60, 0x00, 0x60, 0x00, 0x37, // CALLDATACOPY(0, 0, CALLDATASIZE)
+		0x60, 0x00, 0x60, 0x00, 0x36, 0x60, 0x00, 0x60, 0x00, // retSize, retOffset, argsSize, argsOffset, value
+		0x73, // PUSH20 target
+	}
+	code = append(code, target.Bytes()...)
+	code = append(code, 0x5a, 0xf1) // CALL(GAS, ...)
+
+	switch mode {
+	case ForwardRevert:
+		return append(code, 0x60, 0x00, 0x60, 0x00, 0xfd) // REVERT(0, 0)
+	case ForwardIgnore:
+		return append(code, 0x00) // STOP
+	default:
+		return append(code,
+			0x3d, 0x60, 0x00, 0x60, 0x00, 0x3e, // RETURNDATACOPY(0, 0, RETURNDATASIZE)
+			0x60, byte(len(code)+13), 0x57, // JUMPI to the return if the call succeeded
+			0x3d, 0x60, 0x00, 0xfd, // REVERT(0, RETURNDATASIZE)
+			0x5b, 0x3d, 0x60, 0x00, 0xf3, // JUMPDEST, RETURN(0, RETURNDATASIZE)
+		)
+	}
+}
+
+// DeployCode sets the runtime code of a contract at addr
+func DeployCode(ctx sdk.Context, keeper *evmkeeper.Keeper, addr common.Address, code []byte) error {
+	stateDB := NewStateDB(ctx, keeper)
+	stateDB.SetCode(addr, code)
+	return stateDB.Commit()
+}
+
+// SetupEVM sets the evm denom to akava, as on kava chains, and enables the stateful precompiles at addrs
+// in the evm params
+func SetupEVM(ctx sdk.Context, keeper *evmkeeper.Keeper, addrs ...common.Address) error {
+	sorted := make([]common.Address, len(addrs))
+	copy(sorted, addrs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Bytes(), sorted[j].Bytes()) < 0
+	})
+
+	params := keeper.GetParams(ctx)
+	params.EvmDenom = "akava"
+	params.EnabledPrecompiles = make([]string, len(sorted))
+	for i, addr := range sorted {
+		params.EnabledPrecompiles[i] = addr.Hex()
+	}
+	return keeper.SetParams(ctx, params)
+}
+
+// ApplyMessage applies a call from an address through the evm keeper, as done when executing an
+// ethereum transaction, committing the resulting state to the context.
+func ApplyMessage(
+	ctx sdk.Context,
+	keeper *evmkeeper.Keeper,
+	from, to common.Address,
+	value *big.Int,
+	input []byte,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	msg := ethtypes.NewMessage(
+		from,
+		&to,
+		0,             // nonce
+		value,         // amount
+		10_000_000,    // gasLimit
+		big.NewInt(0), // gasPrice
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		input,
+		ethtypes.AccessList{},
+		false, // isFake
+	)
+
+	// the config is built without a coinbase, since test contexts have no block proposer
+	params := keeper.GetParams(ctx)
+	ethCfg := params.ChainConfig.EthereumConfig(keeper.ChainID())
+	cfg := &statedb.EVMConfig{
+		Params:      params,
+		ChainConfig: ethCfg,
+		BaseFee:     keeper.GetBaseFee(ctx, ethCfg),
+	}
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	return keeper.ApplyMessageWithConfig(ctx, msg, evmtypes.NewNoOpTracer(), true, cfg, txConfig)
+}
@@ -0,0 +1,34 @@
+package testutil
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/evmos/ethermint/x/evm/statedb"
+
+	"github.com/kava-labs/kava/precompile/contractutils"
+)
+
+var _ contractutils.StateDB = &statedb.StateDB{}
+
+// NewStateDB returns a new ethermint StateDB backed by the provided context and evm keeper, allowing
+// precompiles that interact with cosmos state to be run directly in tests. The state written by the
+// precompiles is written to the context on Commit.
+func NewStateDB(ctx sdk.Context, keeper statedb.Keeper) *statedb.StateDB {
+	return statedb.New(ctx, keeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+}
+
+// AccessibleState implements contract.AccessibleState for running precompiles in tests
+type AccessibleState struct {
+	stateDB contract.StateDB
+}
+
+// NewAccessibleState returns an AccessibleState that provides the StateDB to precompiles
+func NewAccessibleState(stateDB contract.StateDB) AccessibleState {
+	return AccessibleState{stateDB: stateDB}
+}
+
+// GetStateDB returns the StateDB of the accessible state
+func (s AccessibleState) GetStateDB() contract.StateDB {
+	return s.stateDB
+}
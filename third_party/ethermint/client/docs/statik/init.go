@@ -0,0 +1,3 @@
+package statik
+
+// This just for fixing the error in importing empty github.com/evmos/ethermint/client/docs/statik
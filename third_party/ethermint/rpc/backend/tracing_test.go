@@ -0,0 +1,284 @@
+package backend
+
+import (
+	"fmt"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/indexer"
+	"github.com/evmos/ethermint/rpc/backend/mocks"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *BackendTestSuite) TestTraceTransaction() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	msgEthereumTx2, _ := suite.buildEthereumTx()
+
+	txHash := msgEthereumTx.AsTransaction().Hash()
+	txHash2 := msgEthereumTx2.AsTransaction().Hash()
+
+	priv, _ := ethsecp256k1.GenerateKey()
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParamsWithoutHeader(queryClient, 1)
+
+	armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+	suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+	ethSigner := ethtypes.LatestSigner(suite.backend.ChainConfig())
+
+	txEncoder := suite.backend.clientCtx.TxConfig.TxEncoder()
+
+	msgEthereumTx.From = from.String()
+	msgEthereumTx.Sign(ethSigner, suite.signer)
+	tx, _ := msgEthereumTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+	txBz, _ := txEncoder(tx)
+
+	msgEthereumTx2.From = from.String()
+	msgEthereumTx2.Sign(ethSigner, suite.signer)
+	tx2, _ := msgEthereumTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+	txBz2, _ := txEncoder(tx2)
+
+	testCases := []struct {
+		name          string
+		registerMock  func()
+		block         *tmtypes.Block
+		responseBlock []*abci.ResponseDeliverTx
+		expResult     interface{}
+		expPass       bool
+	}{
+		{
+			"fail - tx not found",
+			func() {},
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{}}},
+			[]*abci.ResponseDeliverTx{
+				{
+					Code: 0,
+					Events: []abci.Event{
+						{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+							{Key: "ethereumTxHash", Value: txHash.Hex()},
+							{Key: "txIndex", Value: "0"},
+							{Key: "amount", Value: "1000"},
+							{Key: "txGasUsed", Value: "21000"},
+							{Key: "txHash", Value: ""},
+							{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+						}},
+					},
+				},
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - block not found",
+			func() {
+				// var header metadata.MD
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}},
+			[]*abci.ResponseDeliverTx{
+				{
+					Code: 0,
+					Events: []abci.Event{
+						{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+							{Key: "ethereumTxHash", Value: txHash.Hex()},
+							{Key: "txIndex", Value: "0"},
+							{Key: "amount", Value: "1000"},
+							{Key: "txGasUsed", Value: "21000"},
+							{Key: "txHash", Value: ""},
+							{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+						}},
+					},
+				},
+			},
+			map[string]interface{}{"failed": false, "test": "hello"},
+			false,
+		},
+		{
+			"pass - transaction found in a block with multiple transactions",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockMultipleTxs(client, 1, []tmtypes.Tx{txBz, txBz2})
+				RegisterTraceTransactionWithPredecessors(queryClient, msgEthereumTx, []*evmtypes.MsgEthereumTx{msgEthereumTx})
+			},
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1, ChainID: ChainID}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz, txBz2}}},
+			[]*abci.ResponseDeliverTx{
+				{
+					Code: 0,
+					Events: []abci.Event{
+						{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+							{Key: "ethereumTxHash", Value: txHash.Hex()},
+							{Key: "txIndex", Value: "0"},
+							{Key: "amount", Value: "1000"},
+							{Key: "txGasUsed", Value: "21000"},
+							{Key: "txHash", Value: ""},
+							{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+						}},
+					},
+				},
+				{
+					Code: 0,
+					Events: []abci.Event{
+						{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+							{Key: "ethereumTxHash", Value: txHash2.Hex()},
+							{Key: "txIndex", Value: "1"},
+							{Key: "amount", Value: "1000"},
+							{Key: "txGasUsed", Value: "21000"},
+							{Key: "txHash", Value: ""},
+							{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+						}},
+					},
+				},
+			},
+			map[string]interface{}{"failed": false, "test": "hello"},
+			true,
+		},
+		{
+			"pass - transaction found",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, txBz)
+				RegisterTraceTransaction(queryClient, msgEthereumTx)
+			},
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}},
+			[]*abci.ResponseDeliverTx{
+				{
+					Code: 0,
+					Events: []abci.Event{
+						{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+							{Key: "ethereumTxHash", Value: txHash.Hex()},
+							{Key: "txIndex", Value: "0"},
+							{Key: "amount", Value: "1000"},
+							{Key: "txGasUsed", Value: "21000"},
+							{Key: "txHash", Value: ""},
+							{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+						}},
+					},
+				},
+			},
+			map[string]interface{}{"failed": false, "test": "hello"},
+			true,
+		},
+		// TODO: Actually test trace when out of gas, for BOTH:
+		// - "out of gas in location: block gas meter; gasWanted:"
+		// - "no block gas left to run tx: out of gas" (this is the one is not considered in tx indexer)
+		/*
+			{
+				"pass - out of gas error",
+				func() {
+					queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+					client := suite.backend.clientCtx.Client.(*mocks.Client)
+					RegisterBlock(client, 1, txBz)
+					RegisterTraceTransaction(queryClient, msgEthereumTx)
+				},
+				&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}},
+				[]*abci.ResponseDeliverTx{
+					{
+						Code:      11,
+						GasWanted: 21000,
+						GasUsed:   21000,
+						Log:       rpctypes.ExceedBlockGasLimitError,
+						Events: []abci.Event{
+							{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+								{Key: []byte("ethereumTxHash"), Value: []byte(txHash.Hex())},
+								{Key: []byte("txIndex"), Value: []byte("0")},
+								{Key: []byte("amount"), Value: []byte("1000")},
+								{Key: []byte("txGasUsed"), Value: []byte("21000")},
+								{Key: []byte("txHash"), Value: []byte("")},
+								{Key: []byte("recipient"), Value: []byte("0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7")},
+							}},
+						},
+					},
+				},
+				map[string]interface{}{"failed": true, "test": "hello"},
+				true,
+			},
+		*/
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			db := dbm.NewMemDB()
+			suite.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx)
+
+			err := suite.backend.indexer.IndexBlock(tc.block, tc.responseBlock)
+			suite.Require().NoError(err)
+			txResult, err := suite.backend.TraceTransaction(txHash, nil)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, txResult)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTraceBlock() {
+	msgEthTx, bz := suite.buildEthereumTx()
+	emptyBlock := tmtypes.MakeBlock(1, []tmtypes.Tx{}, nil, nil)
+	emptyBlock.ChainID = ChainID
+	filledBlock := tmtypes.MakeBlock(1, []tmtypes.Tx{bz}, nil, nil)
+	filledBlock.ChainID = ChainID
+	resBlockEmpty := tmrpctypes.ResultBlock{Block: emptyBlock, BlockID: emptyBlock.LastBlockID}
+	resBlockFilled := tmrpctypes.ResultBlock{Block: filledBlock, BlockID: filledBlock.LastBlockID}
+
+	testCases := []struct {
+		name            string
+		registerMock    func()
+		expTraceResults []*evmtypes.TxTraceResult
+		resBlock        *tmrpctypes.ResultBlock
+		config          *evmtypes.TraceConfig
+		expPass         bool
+	}{
+		{
+			"pass - no transaction returning empty array",
+			func() {},
+			[]*evmtypes.TxTraceResult{},
+			&resBlockEmpty,
+			&evmtypes.TraceConfig{},
+			true,
+		},
+		{
+			"fail - cannot unmarshal data",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterTraceBlock(queryClient, []*evmtypes.MsgEthereumTx{msgEthTx})
+			},
+			[]*evmtypes.TxTraceResult{},
+			&resBlockFilled,
+			&evmtypes.TraceConfig{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			traceResults, err := suite.backend.TraceBlock(1, tc.config, tc.resBlock)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expTraceResults, traceResults)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	ethaccounts "github.com/ethereum/go-ethereum/accounts"
+)
+
+var (
+	// Bip44CoinType satisfies EIP84. See https://github.com/ethereum/EIPs/issues/84 for more info.
+	Bip44CoinType uint32 = 60
+
+	// BIP44HDPath is the default BIP44 HD path used on Ethereum.
+	BIP44HDPath = ethaccounts.DefaultBaseDerivationPath.String()
+)
+
+type (
+	HDPathIterator func() ethaccounts.DerivationPath
+)
+
+// HDPathIterator receives a base path as a string and a boolean for the desired iterator type and
+// returns a function that iterates over the base HD path, returning the string.
+func NewHDPathIterator(basePath string, ledgerIter bool) (HDPathIterator, error) {
+	hdPath, err := ethaccounts.ParseDerivationPath(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if ledgerIter {
+		return ethaccounts.LedgerLiveIterator(hdPath), nil
+	}
+
+	return ethaccounts.DefaultIterator(hdPath), nil
+}
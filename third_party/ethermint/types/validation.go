@@ -0,0 +1,57 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// IsEmptyHash returns true if the hash corresponds to an empty ethereum hex hash.
+func IsEmptyHash(hash string) bool {
+	return bytes.Equal(common.HexToHash(hash).Bytes(), common.Hash{}.Bytes())
+}
+
+// IsZeroAddress returns true if the address corresponds to an empty ethereum hex address.
+func IsZeroAddress(address string) bool {
+	return bytes.Equal(common.HexToAddress(address).Bytes(), common.Address{}.Bytes())
+}
+
+// ValidateAddress returns an error if the provided string is either not a hex formatted string address
+func ValidateAddress(address string) error {
+	if !common.IsHexAddress(address) {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidAddress, "address '%s' is not a valid ethereum hex address",
+			address,
+		)
+	}
+	return nil
+}
+
+// ValidateNonZeroAddress returns an error if the provided string is not a hex
+// formatted string address or is equal to zero
+func ValidateNonZeroAddress(address string) error {
+	if IsZeroAddress(address) {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidAddress, "address '%s' must not be zero",
+			address,
+		)
+	}
+	return ValidateAddress(address)
+}
@@ -0,0 +1,50 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package version
+
+import (
+	"fmt"
+	"runtime"
+)
+
+var (
+	AppVersion = ""
+	GitCommit  = ""
+	BuildDate  = ""
+
+	GoVersion = ""
+	GoArch    = ""
+)
+
+func init() {
+	if len(AppVersion) == 0 {
+		AppVersion = "dev"
+	}
+
+	GoVersion = runtime.Version()
+	GoArch = runtime.GOARCH
+}
+
+func Version() string {
+	return fmt.Sprintf(
+		"Version %s (%s)\nCompiled at %s using Go %s (%s)",
+		AppVersion,
+		GitCommit,
+		BuildDate,
+		GoVersion,
+		GoArch,
+	)
+}
@@ -0,0 +1,93 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/x/evm/types"
+	legacytypes "github.com/evmos/ethermint/x/evm/types/legacy"
+)
+
+// GetParams returns the total set of evm parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixParams)
+	if len(bz) == 0 {
+		return k.GetLegacyParams(ctx)
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return
+}
+
+// SetParams sets the EVM params each in their individual key for better get performance
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.KeyPrefixParams, bz)
+	return nil
+}
+
+// GetLegacyParams returns param set for version before migrate
+func (k Keeper) GetLegacyParams(ctx sdk.Context) types.Params {
+	var legacyParams legacytypes.LegacyParams
+	k.ss.GetParamSetIfExists(ctx, &legacyParams)
+
+	newChainConfig := types.ChainConfig{
+		HomesteadBlock:      legacyParams.ChainConfig.HomesteadBlock,
+		DAOForkBlock:        legacyParams.ChainConfig.DAOForkBlock,
+		DAOForkSupport:      legacyParams.ChainConfig.DAOForkSupport,
+		EIP150Block:         legacyParams.ChainConfig.EIP150Block,
+		EIP150Hash:          legacyParams.ChainConfig.EIP150Hash,
+		EIP155Block:         legacyParams.ChainConfig.EIP155Block,
+		EIP158Block:         legacyParams.ChainConfig.EIP158Block,
+		ByzantiumBlock:      legacyParams.ChainConfig.ByzantiumBlock,
+		ConstantinopleBlock: legacyParams.ChainConfig.ConstantinopleBlock,
+		PetersburgBlock:     legacyParams.ChainConfig.PetersburgBlock,
+		IstanbulBlock:       legacyParams.ChainConfig.IstanbulBlock,
+		MuirGlacierBlock:    legacyParams.ChainConfig.MuirGlacierBlock,
+		BerlinBlock:         legacyParams.ChainConfig.BerlinBlock,
+		LondonBlock:         legacyParams.ChainConfig.LondonBlock,
+		ArrowGlacierBlock:   legacyParams.ChainConfig.ArrowGlacierBlock,
+
+		// This is an old field, but renamed from mergeForkBlock
+		MergeNetsplitBlock: legacyParams.ChainConfig.MergeForkBlock,
+
+		// New fields are nil
+		GrayGlacierBlock: nil,
+		ShanghaiBlock:    nil,
+		CancunBlock:      nil,
+	}
+
+	params := types.Params{
+		EvmDenom:            legacyParams.EvmDenom,
+		EnableCreate:        legacyParams.EnableCreate,
+		EnableCall:          legacyParams.EnableCall,
+		ExtraEIPs:           legacyParams.ExtraEIPs,
+		ChainConfig:         newChainConfig,
+		EIP712AllowedMsgs:   legacyParams.EIP712AllowedMsgs,
+		AllowUnprotectedTxs: false, // Upstream v1 to v2
+	}
+
+	return params
+}
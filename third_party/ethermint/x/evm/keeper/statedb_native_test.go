@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// mintAction returns a native action minting amount of the evm denom to addr
+func (suite *KeeperTestSuite) mintAction(addr common.Address, amount int64) func(ctx sdk.Context) error {
+	return func(ctx sdk.Context) error {
+		coins := sdk.NewCoins(sdk.NewCoin(suite.EvmDenom(), sdkmath.NewInt(amount)))
+		if err := suite.app.BankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+		return suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr.Bytes(), coins)
+	}
+}
+
+func (suite *KeeperTestSuite) bankBalance(addr common.Address) *big.Int {
+	return suite.app.BankKeeper.GetBalance(suite.ctx, addr.Bytes(), suite.EvmDenom()).Amount.BigInt()
+}
+
+func (suite *KeeperTestSuite) TestExecuteNativeAction() {
+	addr := common.BigToAddress(big.NewInt(101))
+	key := common.BytesToHash([]byte("key"))
+	value1 := common.BytesToHash([]byte("value1"))
+	value2 := common.BytesToHash([]byte("value2"))
+
+	testCases := []struct {
+		name       string
+		malleate   func(*statedb.StateDB)
+		expBalance int64
+		expState   common.Hash
+	}{
+		{
+			"native writes are visible to the evm and committed",
+			func(vmdb *statedb.StateDB) {
+				suite.Require().Zero(vmdb.GetBalance(addr).Int64())
+				suite.Require().NoError(vmdb.ExecuteNativeAction(suite.mintAction(addr, 100)))
+				suite.Require().Equal(int64(100), vmdb.GetBalance(addr).Int64())
+			},
+			100,
+			common.Hash{},
+		},
+		{
+			"evm writes are visible to the action",
+			func(vmdb *statedb.StateDB) {
+				vmdb.AddBalance(addr, big.NewInt(50))
+				vmdb.SetState(addr, key, value1)
+				suite.Require().NoError(vmdb.ExecuteNativeAction(func(ctx sdk.Context) error {
+					suite.Require().Equal(int64(50), suite.app.BankKeeper.GetBalance(ctx, addr.Bytes(), suite.EvmDenom()).Amount.Int64())
+					suite.Require().Equal(value1, suite.app.EvmKeeper.GetState(ctx, addr, key))
+					suite.app.EvmKeeper.SetState(ctx, addr, key, value2.Bytes())
+					return nil
+				}))
+				suite.Require().Equal(value2, vmdb.GetState(addr, key))
+			},
+			50,
+			value2,
+		},
+		{
+			"failed action is discarded",
+			func(vmdb *statedb.StateDB) {
+				err := vmdb.ExecuteNativeAction(func(ctx sdk.Context) error {
+					suite.Require().NoError(suite.mintAction(addr, 100)(ctx))
+					return errors.New("action failed")
+				})
+				suite.Require().EqualError(err, "action failed")
+				suite.Require().Zero(vmdb.GetBalance(addr).Int64())
+			},
+			0,
+			common.Hash{},
+		},
+		{
+			"journal revert discards native writes",
+			func(vmdb *statedb.StateDB) {
+				revision := vmdb.Snapshot()
+				suite.Require().NoError(vmdb.ExecuteNativeAction(suite.mintAction(addr, 100)))
+				vmdb.RevertToSnapshot(revision)
+				suite.Require().Zero(vmdb.GetBalance(addr).Int64())
+			},
+			0,
+			common.Hash{},
+		},
+		{
+			"journal revert restores dirty storage flushed to the action",
+			func(vmdb *statedb.StateDB) {
+				vmdb.SetState(addr, key, value1)
+				revision := vmdb.Snapshot()
+				suite.Require().NoError(vmdb.ExecuteNativeAction(func(ctx sdk.Context) error {
+					suite.app.EvmKeeper.SetState(ctx, addr, key, value2.Bytes())
+					return nil
+				}))
+				vmdb.RevertToSnapshot(revision)
+				suite.Require().Equal(value1, vmdb.GetState(addr, key))
+			},
+			0,
+			value1,
+		},
+		{
+			"nested snapshot/revert",
+			func(vmdb *statedb.StateDB) {
+				suite.Require().NoError(vmdb.ExecuteNativeAction(suite.mintAction(addr, 100)))
+
+				revision1 := vmdb.Snapshot()
+				vmdb.SetState(addr, key, value1)
+				suite.Require().NoError(vmdb.ExecuteNativeAction(suite.mintAction(addr, 200)))
+				suite.Require().Equal(int64(300), vmdb.GetBalance(addr).Int64())
+
+				vmdb.RevertToSnapshot(revision1)
+				suite.Require().Equal(int64(100), vmdb.GetBalance(addr).Int64())
+				suite.Require().Equal(common.Hash{}, vmdb.GetState(addr, key))
+
+				// actions after a revert are layered on the remaining state
+				suite.Require().NoError(vmdb.ExecuteNativeAction(suite.mintAction(addr, 10)))
+				suite.Require().Equal(int64(110), vmdb.GetBalance(addr).Int64())
+
+				revision2 := vmdb.Snapshot()
+				suite.Require().NoError(vmdb.ExecuteNativeAction(suite.mintAction(addr, 1)))
+				vmdb.RevertToSnapshot(revision2)
+				suite.Require().Equal(int64(110), vmdb.GetBalance(addr).Int64())
+			},
+			110,
+			common.Hash{},
+		},
+		{
+			"jump revert discards all native writes",
+			func(vmdb *statedb.StateDB) {
+				revision1 := vmdb.Snapshot()
+				suite.Require().NoError(vmdb.ExecuteNativeAction(suite.mintAction(addr, 100)))
+				vmdb.Snapshot()
+				suite.Require().NoError(vmdb.ExecuteNativeAction(suite.mintAction(addr, 200)))
+				vmdb.RevertToSnapshot(revision1)
+				suite.Require().Zero(vmdb.GetBalance(addr).Int64())
+			},
+			0,
+			common.Hash{},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			vmdb := suite.StateDB()
+			tc.malleate(vmdb)
+
+			// nothing is written before commit
+			suite.Require().Zero(suite.bankBalance(addr).Int64())
+
+			suite.Require().NoError(vmdb.Commit())
+			suite.Require().Equal(tc.expBalance, suite.bankBalance(addr).Int64())
+			suite.Require().Equal(tc.expState, suite.app.EvmKeeper.GetState(suite.ctx, addr, key))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTransferCallValue() {
+	sender := common.BigToAddress(big.NewInt(101))
+	recipient := common.BigToAddress(big.NewInt(102))
+
+	suite.SetupTest()
+	vmdb := suite.StateDB()
+	vmdb.AddBalance(sender, big.NewInt(100))
+
+	// the value is moved and recorded for the recipient only
+	revision := vmdb.Snapshot()
+	statedb.Transfer(vmdb, sender, recipient, big.NewInt(40))
+	suite.Require().Equal(int64(60), vmdb.GetBalance(sender).Int64())
+	suite.Require().Equal(int64(40), vmdb.GetBalance(recipient).Int64())
+	suite.Require().Zero(vmdb.TakeCallValue(sender).Int64())
+
+	statedb.Transfer(vmdb, sender, recipient, big.NewInt(40))
+	suite.Require().Equal(int64(40), vmdb.TakeCallValue(recipient).Int64())
+	// the call value is taken once
+	suite.Require().Zero(vmdb.TakeCallValue(recipient).Int64())
+
+	// a zero value call does not carry over the previous value
+	statedb.Transfer(vmdb, sender, recipient, big.NewInt(30))
+	statedb.Transfer(vmdb, sender, recipient, big.NewInt(0))
+	suite.Require().Zero(vmdb.TakeCallValue(recipient).Int64())
+
+	// the transfer is journaled
+	vmdb.RevertToSnapshot(revision)
+	suite.Require().Equal(int64(100), vmdb.GetBalance(sender).Int64())
+	suite.Require().Zero(vmdb.GetBalance(recipient).Int64())
+
+	// the transferred value is visible to native actions
+	statedb.Transfer(vmdb, sender, recipient, big.NewInt(25))
+	suite.Require().NoError(vmdb.ExecuteNativeAction(func(ctx sdk.Context) error {
+		suite.Require().Equal(int64(25), suite.app.BankKeeper.GetBalance(ctx, recipient.Bytes(), suite.EvmDenom()).Amount.Int64())
+		return nil
+	}))
+	suite.Require().NoError(vmdb.Commit())
+	suite.Require().Equal(int64(75), suite.bankBalance(sender).Int64())
+	suite.Require().Equal(int64(25), suite.bankBalance(recipient).Int64())
+}
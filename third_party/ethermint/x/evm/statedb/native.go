@@ -0,0 +1,161 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package statedb
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var _ ExtStateDB = &StateDB{}
+
+// nativeLayer is a cache context holding the cosmos state written by a native
+// action, and the function writing it to its parent context.
+type nativeLayer struct {
+	ctx   sdk.Context
+	write func()
+}
+
+// nativeActionChange is the journal entry of a native action, reverting it
+// drops the cosmos state written by the action and by any later one.
+type nativeActionChange struct {
+	layers int
+}
+
+func (ch nativeActionChange) Revert(s *StateDB) {
+	s.nativeLayers = s.nativeLayers[:ch.layers]
+}
+
+func (ch nativeActionChange) Dirtied() *common.Address {
+	return nil
+}
+
+// storageResetChange is the journal entry of the dirty storage of an account
+// being flushed to a native action layer.
+type storageResetChange struct {
+	account *common.Address
+	prev    Storage
+}
+
+func (ch storageResetChange) Revert(s *StateDB) {
+	s.getStateObject(*ch.account).dirtyStorage = ch.prev
+}
+
+func (ch storageResetChange) Dirtied() *common.Address {
+	return ch.account
+}
+
+// AppendJournalEntry appends an entry to the state journal, it is reverted
+// along with the EVM state changes made after it.
+func (s *StateDB) AppendJournalEntry(entry JournalEntry) {
+	s.journal.append(entry)
+}
+
+// GetContext returns a branch of the cosmos state as seen by the EVM, for
+// reading only: writes to it are discarded. Cosmos state must be modified
+// with ExecuteNativeAction.
+func (s *StateDB) GetContext() sdk.Context {
+	ctx, _ := s.currentCtx().CacheContext()
+	return ctx
+}
+
+// ExecuteNativeAction runs action against the cosmos state, as a part of the
+// EVM state transition.
+//
+// The state cached by the StateDB is written to a new cache context before
+// running the action, so the action sees the balances and storage of the EVM
+// execution, and the cached accounts are reloaded after it, so the EVM sees the
+// changes made by the action. The writes of the action are journaled: they are
+// discarded if the EVM reverts to a snapshot taken before it, and are written
+// to the underlying context on Commit otherwise.
+//
+// If the action returns an error, its writes are discarded and the error is
+// returned.
+func (s *StateDB) ExecuteNativeAction(action func(ctx sdk.Context) error) error {
+	ctx, write := s.currentCtx().CacheContext()
+	if err := s.flush(ctx); err != nil {
+		return err
+	}
+	if err := action(ctx); err != nil {
+		return err
+	}
+
+	s.journal.append(nativeActionChange{layers: len(s.nativeLayers)})
+	s.nativeLayers = append(s.nativeLayers, nativeLayer{ctx: ctx, write: write})
+	s.reload(ctx)
+	return nil
+}
+
+// flush writes the dirty accounts and storage of the StateDB to ctx.
+func (s *StateDB) flush(ctx sdk.Context) error {
+	for _, addr := range s.journal.sortedDirties() {
+		obj := s.stateObjects[addr]
+		// suicided accounts are deleted on Commit
+		if obj == nil || obj.suicided {
+			continue
+		}
+		if obj.code != nil && obj.dirtyCode {
+			s.keeper.SetCode(ctx, obj.CodeHash(), obj.code)
+		}
+		if err := s.keeper.SetAccount(ctx, obj.Address(), obj.account); err != nil {
+			return errorsmod.Wrap(err, "failed to set account")
+		}
+		for _, key := range obj.dirtyStorage.SortedKeys() {
+			s.keeper.SetState(ctx, obj.Address(), key, obj.dirtyStorage[key].Bytes())
+		}
+	}
+	return nil
+}
+
+// reload updates the accounts cached by the StateDB with their state in ctx,
+// journaling the changes. The dirty storage has been written to ctx by flush,
+// so it is reset and read from ctx from now on.
+func (s *StateDB) reload(ctx sdk.Context) {
+	addrs := make([]common.Address, 0, len(s.stateObjects))
+	for addr := range s.stateObjects {
+		addrs = append(addrs, addr)
+	}
+	sortAddresses(addrs)
+
+	for _, addr := range addrs {
+		obj := s.stateObjects[addr]
+		if obj.suicided {
+			continue
+		}
+		if len(obj.dirtyStorage) > 0 {
+			s.journal.append(storageResetChange{account: &obj.address, prev: obj.dirtyStorage})
+			obj.dirtyStorage = make(Storage)
+		}
+
+		account := s.keeper.GetAccount(ctx, addr)
+		if account == nil {
+			continue
+		}
+		if obj.account.Balance.Cmp(account.Balance) != 0 {
+			obj.SetBalance(account.Balance)
+		}
+		if obj.account.Nonce != account.Nonce {
+			obj.SetNonce(account.Nonce)
+		}
+		if !bytes.Equal(obj.account.CodeHash, account.CodeHash) {
+			codeHash := common.BytesToHash(account.CodeHash)
+			obj.SetCode(codeHash, s.keeper.GetCode(ctx, codeHash))
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package statedb
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+var _ vm.TransferFunc = Transfer
+
+// callTransfer is the value transferred by the EVM when entering a call frame.
+type callTransfer struct {
+	recipient common.Address
+	amount    *big.Int
+}
+
+// Transfer implements vm.TransferFunc. It moves amount from sender to
+// recipient, and records the transfer as the value of the call frame being
+// entered when db is a StateDB, see TakeCallValue.
+func Transfer(db vm.StateDB, sender, recipient common.Address, amount *big.Int) {
+	db.SubBalance(sender, amount)
+	db.AddBalance(recipient, amount)
+
+	if s, ok := db.(*StateDB); ok {
+		s.callTransfer = &callTransfer{recipient: recipient, amount: new(big.Int).Set(amount)}
+	}
+}
+
+// TakeCallValue returns the value transferred to addr when entering the
+// current call frame, and clears it.
+//
+// The EVM transfers the value of a CALL to a stateful precompile right before
+// running it, so a precompile takes its call value when it starts running.
+// DELEGATECALL, CALLCODE and STATICCALL frames do not transfer value, their
+// call value is zero.
+func (s *StateDB) TakeCallValue(addr common.Address) *big.Int {
+	transfer := s.callTransfer
+	s.callTransfer = nil
+
+	if transfer == nil || transfer.recipient != addr {
+		return new(big.Int)
+	}
+	return transfer.amount
+}
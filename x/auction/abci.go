@@ -11,7 +11,7 @@ import (
 	"github.com/kava-labs/kava/x/auction/types"
 )
 
-// BeginBlocker closes all expired auctions at the end of each block. It panics if
+// BeginBlocker closes all expired auctions at the end of each block, and prunes old bid histories. It panics if
 // there's an error other than ErrAuctionNotFound.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
@@ -20,4 +20,6 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	if err != nil && !errors.Is(err, types.ErrAuctionNotFound) {
 		panic(err)
 	}
+
+	k.PruneBidHistories(ctx)
 }
@@ -27,6 +27,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryAuction(),
 		GetCmdQueryAuctions(),
+		GetCmdQueryBidHistory(),
+		GetCmdQueryBidderAuctions(),
 	}
 
 	for _, cmd := range cmds {
@@ -111,10 +113,10 @@ func GetCmdQueryAuctions() *cobra.Command {
 		Short: "query auctions with optional filters",
 		Long:  "Query for all paginated auctions that match optional filters.",
 		Example: strings.Join([]string{
-			fmt.Sprintf("  $ %s q %s auctions --type=(collateral|surplus|debt)", version.AppName, types.ModuleName),
+			fmt.Sprintf("  $ %s q %s auctions --type=(collateral|dutch_collateral|surplus|debt)", version.AppName, types.ModuleName),
 			fmt.Sprintf("  $ %s q %s auctions --owner=kava1hatdq32u5x4wnxrtv5wzjzmq49sxgjgsj0mffm", version.AppName, types.ModuleName),
 			fmt.Sprintf("  $ %s q %s auctions --denom=bnb", version.AppName, types.ModuleName),
-			fmt.Sprintf("  $ %s q %s auctions --phase=(forward|reverse)", version.AppName, types.ModuleName),
+			fmt.Sprintf("  $ %s q %s auctions --phase=(forward|reverse|dutch)", version.AppName, types.ModuleName),
 			fmt.Sprintf("  $ %s q %s auctions --page=2 --limit=100", version.AppName, types.ModuleName),
 		}, "\n"),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -144,6 +146,7 @@ func GetCmdQueryAuctions() *cobra.Command {
 				auctionType = strings.ToLower(auctionType)
 
 				if auctionType != types.CollateralAuctionType &&
+					auctionType != types.DutchCollateralAuctionType &&
 					auctionType != types.SurplusAuctionType &&
 					auctionType != types.DebtAuctionType {
 					return fmt.Errorf("invalid auction type %s", auctionType)
@@ -151,7 +154,7 @@ func GetCmdQueryAuctions() *cobra.Command {
 			}
 
 			if len(owner) != 0 {
-				if auctionType != types.CollateralAuctionType {
+				if auctionType != types.CollateralAuctionType && auctionType != types.DutchCollateralAuctionType {
 					return fmt.Errorf("cannot apply owner flag to non-collateral auction type")
 				}
 				_, err := sdk.AccAddressFromBech32(owner)
@@ -170,10 +173,10 @@ func GetCmdQueryAuctions() *cobra.Command {
 			if len(phase) != 0 {
 				phase = strings.ToLower(phase)
 
-				if len(auctionType) > 0 && auctionType != types.CollateralAuctionType {
+				if len(auctionType) > 0 && auctionType != types.CollateralAuctionType && auctionType != types.DutchCollateralAuctionType {
 					return fmt.Errorf("cannot apply phase flag to non-collateral auction type")
 				}
-				if phase != types.ForwardAuctionPhase && phase != types.ReverseAuctionPhase {
+				if phase != types.ForwardAuctionPhase && phase != types.ReverseAuctionPhase && phase != types.DutchAuctionPhase {
 					return fmt.Errorf("invalid auction phase %s", phase)
 				}
 			}
@@ -203,10 +206,85 @@ func GetCmdQueryAuctions() *cobra.Command {
 
 	flags.AddPaginationFlagsToCmd(cmd, "auctions")
 
-	cmd.Flags().String(flagType, "", "(optional) filter by auction type, type: collateral, debt, surplus")
+	cmd.Flags().String(flagType, "", "(optional) filter by auction type, type: collateral, dutch_collateral, debt, surplus")
 	cmd.Flags().String(flagOwner, "", "(optional) filter by collateral auction owner")
 	cmd.Flags().String(flagDenom, "", "(optional) filter by auction denom")
-	cmd.Flags().String(flagPhase, "", "(optional) filter by collateral auction phase, phase: forward/reverse")
+	cmd.Flags().String(flagPhase, "", "(optional) filter by collateral auction phase, phase: forward/reverse/dutch")
+
+	return cmd
+}
+
+// GetCmdQueryBidHistory queries the bid history of an open or closed auction
+func GetCmdQueryBidHistory() *cobra.Command {
+	return &cobra.Command{
+		Use:     "bid-history [auction-id]",
+		Short:   "get the bid history of an auction",
+		Long:    "Get the most recent bids placed on an auction. Bid histories are kept after auctions close, until the bid history retention period has passed.",
+		Example: fmt.Sprintf("  $ %s q %s bid-history 34", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			auctionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BidHistory(context.Background(), &types.QueryBidHistoryRequest{
+				AuctionId: auctionID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.BidHistory)
+		},
+	}
+}
+
+// GetCmdQueryBidderAuctions queries the bid histories of the auctions a bidder has bid on
+func GetCmdQueryBidderAuctions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bidder-auctions [bidder]",
+		Short:   "get the bid histories of auctions a bidder has bid on",
+		Long:    "Get the paginated bid histories of open and closed auctions that an address has bid on.",
+		Example: fmt.Sprintf("  $ %s q %s bidder-auctions kava1hatdq32u5x4wnxrtv5wzjzmq49sxgjgsj0mffm --limit=10", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("cannot parse address from bidder %s", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BidderAuctions(context.Background(), &types.QueryBidderAuctionsRequest{
+				Bidder:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "bidder-auctions")
 
 	return cmd
 }
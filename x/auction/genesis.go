@@ -31,6 +31,10 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, bankKeeper types.BankKee
 		totalAuctionCoins = totalAuctionCoins.Add(a.GetModuleAccountCoins()...)
 	}
 
+	for _, h := range gs.BidHistories {
+		keeper.SetBidHistory(ctx, h)
+	}
+
 	// check if the module account exists
 	moduleAcc := accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	if moduleAcc == nil {
@@ -65,7 +69,12 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
-	gs, err := types.NewGenesisState(nextAuctionID, params, genAuctions)
+	bidHistories := keeper.GetAllBidHistories(ctx)
+	if bidHistories == nil {
+		bidHistories = []types.BidHistory{} // return empty list instead of nil if no bid histories
+	}
+
+	gs, err := types.NewGenesisState(nextAuctionID, params, genAuctions, bidHistories)
 	if err != nil {
 		panic(err)
 	}
@@ -28,6 +28,12 @@ var (
 		types.WeightedAddresses{Addresses: testAddrs, Weights: []sdkmath.Int{sdk.OneInt(), sdk.OneInt()}},
 		c("debt", 1000),
 	).WithID(3).(types.GenesisAuction)
+	testBidHistory = types.BidHistory{
+		AuctionID:   3,
+		AuctionType: types.CollateralAuctionType,
+		Bids:        []types.BidRecord{types.NewBidRecord(testAddrs[0], c("biddenom", 10), c("biddenom", 10), c("lotdenom", 10), 1, testTime)},
+		Bidders:     []sdk.AccAddress{testAddrs[0]},
+	}
 )
 
 func TestInitGenesis(t *testing.T) {
@@ -47,6 +53,7 @@ func TestInitGenesis(t *testing.T) {
 			10,
 			types.DefaultParams(),
 			[]types.GenesisAuction{testAuction},
+			[]types.BidHistory{testBidHistory},
 		)
 		require.NoError(t, err)
 
@@ -77,6 +84,8 @@ func TestInitGenesis(t *testing.T) {
 			i++
 			return false
 		})
+
+		require.Equal(t, auctionGS.BidHistories, keeper.GetAllBidHistories(ctx))
 	})
 	t.Run("invalid (invalid nextAuctionID)", func(t *testing.T) {
 		// setup keepers
@@ -94,6 +103,7 @@ func TestInitGenesis(t *testing.T) {
 			0, // next id < testAuction ID
 			types.DefaultParams(),
 			[]types.GenesisAuction{testAuction},
+			[]types.BidHistory{},
 		)
 		require.NoError(t, err)
 
@@ -114,6 +124,7 @@ func TestInitGenesis(t *testing.T) {
 			10,
 			types.DefaultParams(),
 			[]types.GenesisAuction{testAuction},
+			[]types.BidHistory{},
 		)
 		require.NoError(t, err)
 
@@ -156,4 +167,19 @@ func TestExportGenesis(t *testing.T) {
 		expectedGenesisState.Auctions = append(expectedGenesisState.Auctions, packedGenesisAuctions...)
 		require.Equal(t, expectedGenesisState, gs)
 	})
+	t.Run("one bid history", func(t *testing.T) {
+		// setup state
+		tApp := app.NewTestApp()
+		ctx := tApp.NewContext(true, tmproto.Header{Height: 1})
+		tApp.InitializeFromGenesisStates()
+		tApp.GetAuctionKeeper().SetBidHistory(ctx, testBidHistory)
+
+		// export
+		gs := auction.ExportGenesis(ctx, tApp.GetAuctionKeeper())
+
+		// check state matches
+		expectedGenesisState := types.DefaultGenesisState()
+		expectedGenesisState.BidHistories = []types.BidHistory{testBidHistory}
+		require.Equal(t, expectedGenesisState, gs)
+	})
 }
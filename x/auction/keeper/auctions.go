@@ -130,6 +130,66 @@ func (k Keeper) StartCollateralAuction(
 	return auctionID, nil
 }
 
+// StartDutchCollateralAuction starts a new dutch collateral (descending price) auction.
+// The lot starts at a premium above the price that raises the max bid from the whole lot.
+func (k Keeper) StartDutchCollateralAuction(
+	ctx sdk.Context, seller string, lot, maxBid sdk.Coin,
+	lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdkmath.Int, debt sdk.Coin,
+) (uint64, error) {
+	weightedAddresses, err := types.NewWeightedAddresses(lotReturnAddrs, lotReturnWeights)
+	if err != nil {
+		return 0, err
+	}
+	if !lot.IsPositive() {
+		return 0, errorsmod.Wrapf(types.ErrLotTooSmall, "%s ≤ 0%s", lot, lot.Denom)
+	}
+
+	params := k.GetParams(ctx)
+	startPrice := sdk.NewDecFromInt(maxBid.Amount).QuoInt(lot.Amount).Mul(sdk.OneDec().Add(params.DutchAuctionStartPremium))
+	auction := types.NewDutchCollateralAuction(
+		seller,
+		lot,
+		ctx.BlockTime(),
+		ctx.BlockTime().Add(params.DutchAuctionDuration),
+		startPrice,
+		maxBid,
+		weightedAddresses,
+		debt,
+	)
+	if err := auction.Validate(); err != nil {
+		return 0, err
+	}
+
+	// NOTE: for the duration of the auction the auction module account holds the debt and the lot
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(lot))
+	if err != nil {
+		return 0, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(debt))
+	if err != nil {
+		return 0, err
+	}
+
+	auctionID, err := k.StoreNewAuction(ctx, &auction)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionStart,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyAuctionType, auction.GetType()),
+			sdk.NewAttribute(types.AttributeKeyBid, auction.Bid.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, auction.Lot.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxBid, auction.MaxBid.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, auction.StartPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", auction.EndTime.Unix())),
+		),
+	)
+	return auctionID, nil
+}
+
 // PlaceBid places a bid on any auction.
 func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, newAmount sdk.Coin) error {
 	auction, found := k.GetAuction(ctx, auctionID)
@@ -154,10 +214,17 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 		updatedAuction, err = k.PlaceBidDebt(ctx, auctionType, bidder, newAmount)
 	case *types.CollateralAuction:
 		if !auctionType.IsReversePhase() {
-			updatedAuction, err = k.PlaceForwardBidCollateral(ctx, auctionType, bidder, newAmount)
+			// in the forward phase, bids in the lot denom buy part of the lot outright
+			if newAmount.Denom == auctionType.Lot.Denom {
+				updatedAuction, err = k.PlaceFillBidCollateral(ctx, auctionType, bidder, newAmount)
+			} else {
+				updatedAuction, err = k.PlaceForwardBidCollateral(ctx, auctionType, bidder, newAmount)
+			}
 		} else {
 			updatedAuction, err = k.PlaceReverseBidCollateral(ctx, auctionType, bidder, newAmount)
 		}
+	case *types.DutchCollateralAuction:
+		updatedAuction, err = k.PlaceBidDutchCollateral(ctx, auctionType, bidder, newAmount)
 	default:
 		err = errorsmod.Wrap(types.ErrUnrecognizedAuctionType, auction.GetType())
 	}
@@ -167,6 +234,12 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 	}
 
 	k.SetAuction(ctx, updatedAuction)
+	k.RecordBid(ctx, updatedAuction, bidder, newAmount)
+
+	// dutch collateral auctions close as soon as the max bid is raised or the lot is sold
+	if dutchAuction, ok := updatedAuction.(*types.DutchCollateralAuction); ok && dutchAuction.IsComplete() {
+		return k.CloseAuction(ctx, auctionID)
+	}
 
 	return nil
 }
@@ -183,6 +256,8 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction *types.SurplusAuction,
 			sdk.NewDecFromInt(auction.Bid.Amount).Mul(k.GetParams(ctx).IncrementSurplus).RoundInt(),
 		),
 	)
+	// new bids must also be worth at least the min increment value more than the old bid
+	minNewBidAmt = sdk.MaxInt(minNewBidAmt, auction.Bid.Amount.Add(k.minIncrementAmount(ctx, auction.GetType(), auction.Bid.Denom)))
 	if bid.Amount.LT(minNewBidAmt) {
 		return auction, errorsmod.Wrapf(types.ErrBidTooSmall, "%s < %s%s", bid, minNewBidAmt, auction.Bid.Denom)
 	}
@@ -214,11 +289,12 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction *types.SurplusAuction,
 	// Update Auction
 	auction.Bidder = bidder
 	auction.Bid = bid
-	if !auction.HasReceivedBids {
+	firstBid := !auction.HasReceivedBids
+	if firstBid {
 		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
 		auction.HasReceivedBids = true
 	}
-	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).ForwardBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+	auction.EndTime = k.bidEndTime(ctx, auction.GetType(), firstBid, auction.EndTime, auction.MaxEndTime, k.GetParams(ctx).ForwardBidDuration) // increment timeout, up to MaxEndTime
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -248,6 +324,8 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction *types.Collat
 			sdk.NewDecFromInt(auction.Bid.Amount).Mul(k.GetParams(ctx).IncrementCollateral).RoundInt(),
 		),
 	)
+	// new bids must also be worth at least the min increment value more than the old bid
+	minNewBidAmt = sdk.MaxInt(minNewBidAmt, auction.Bid.Amount.Add(k.minIncrementAmount(ctx, auction.GetType(), auction.Bid.Denom)))
 	minNewBidAmt = sdk.MinInt(minNewBidAmt, auction.MaxBid.Amount) // allow new bids to hit MaxBid even though it may be less than the increment %
 	if bid.Amount.LT(minNewBidAmt) {
 		return auction, errorsmod.Wrapf(types.ErrBidTooSmall, "%s < %s%s", bid, minNewBidAmt, auction.Bid.Denom)
@@ -290,16 +368,17 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction *types.Collat
 	// Update Auction
 	auction.Bidder = bidder
 	auction.Bid = bid
-	if !auction.HasReceivedBids {
+	firstBid := !auction.HasReceivedBids
+	if firstBid {
 		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
 		auction.HasReceivedBids = true
 	}
 
 	// If this forward bid converts this to a reverse, increase timeout with ReverseBidDuration
 	if auction.IsReversePhase() {
-		auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).ReverseBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+		auction.EndTime = k.bidEndTime(ctx, auction.GetType(), firstBid, auction.EndTime, auction.MaxEndTime, k.GetParams(ctx).ReverseBidDuration) // increment timeout, up to MaxEndTime
 	} else {
-		auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).ForwardBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+		auction.EndTime = k.bidEndTime(ctx, auction.GetType(), firstBid, auction.EndTime, auction.MaxEndTime, k.GetParams(ctx).ForwardBidDuration) // increment timeout, up to MaxEndTime
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -315,6 +394,97 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction *types.Collat
 	return auction, nil
 }
 
+// PlaceFillBidCollateral buys part of the lot of a collateral auction in forward phase for a proportional share of
+// the max bid, moving coins and returning the updated auction. The remaining lot and debt stay up for auction.
+func (k Keeper) PlaceFillBidCollateral(ctx sdk.Context, auction *types.CollateralAuction, bidder sdk.AccAddress, lot sdk.Coin) (*types.CollateralAuction, error) {
+	// Validate new bid
+	if lot.Denom != auction.Lot.Denom {
+		return auction, errorsmod.Wrapf(types.ErrInvalidLotDenom, "%s ≠ %s", lot.Denom, auction.Lot.Denom)
+	}
+	if auction.IsReversePhase() {
+		panic("cannot place fill bid on auction in reverse phase")
+	}
+	if !lot.IsPositive() {
+		return auction, errorsmod.Wrapf(types.ErrLotTooSmall, "%s ≤ 0%s", lot, auction.Lot.Denom)
+	}
+	if !lot.IsLT(auction.Lot) { // some lot must be left up for auction, to buy the whole lot bid the max bid instead
+		return auction, errorsmod.Wrapf(types.ErrLotTooLarge, "%s ≥ %s", lot, auction.Lot)
+	}
+	remainingLot := auction.Lot.Sub(lot)
+
+	// The payment is rounded up, so the remaining max bid is never raised from less than its share of the lot.
+	paymentAmt := auction.MaxBid.Amount.Mul(lot.Amount).Add(auction.Lot.Amount).SubRaw(1).Quo(auction.Lot.Amount)
+	payment := sdk.NewCoin(auction.MaxBid.Denom, paymentAmt)
+	if !payment.IsLT(auction.MaxBid) {
+		return auction, errorsmod.Wrapf(types.ErrLotTooLarge, "%s leaves no max bid for the remaining lot", lot)
+	}
+	// The current bid is scaled down with the lot, the difference is refunded to the current bidder out of the payment.
+	// The refund is always ≤ the payment as the current bid is less than the max bid.
+	remainingBid := sdk.NewCoin(auction.Bid.Denom, auction.Bid.Amount.Mul(remainingLot.Amount).Quo(auction.Lot.Amount))
+	refund := auction.Bid.Sub(remainingBid)
+
+	// Bidder refunds the current bidder
+	// Catch edge cases of a bidder filling against their own bid, and the amount being zero (sending zero coins produces meaningless send events).
+	if !bidder.Equals(auction.Bidder) && refund.IsPositive() {
+		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(refund))
+		if err != nil {
+			return auction, err
+		}
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.Bidder, sdk.NewCoins(refund))
+		if err != nil {
+			return auction, err
+		}
+	}
+	// Rest of the payment sent to auction initiator
+	proceeds := payment.Sub(refund)
+	if proceeds.IsPositive() {
+		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, auction.Initiator, sdk.NewCoins(proceeds))
+		if err != nil {
+			return auction, err
+		}
+	}
+	// Debt coins are sent to liquidator (until there is no CorrespondingDebt left). Amount sent is equal to proceeds (or whatever is left if < proceeds).
+	if auction.CorrespondingDebt.IsPositive() && proceeds.IsPositive() {
+		debtAmountToReturn := sdk.MinInt(proceeds.Amount, auction.CorrespondingDebt.Amount)
+		debtToReturn := sdk.NewCoin(auction.CorrespondingDebt.Denom, debtAmountToReturn)
+
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(debtToReturn))
+		if err != nil {
+			return auction, err
+		}
+		auction.CorrespondingDebt = auction.CorrespondingDebt.Sub(debtToReturn) // debtToReturn will always be ≤ auction.CorrespondingDebt from the MinInt above
+	}
+	// Filled lot sent to bidder
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(lot))
+	if err != nil {
+		return auction, err
+	}
+
+	// The weights are reset to each address's share of the remaining lot, so later reverse bids and fills
+	// split the lot in the same proportions without compounding rounding errors.
+	auction.LotReturns.Weights = splitIntIntoWeightedBuckets(remainingLot.Amount, auction.LotReturns.Weights)
+
+	// Update Auction
+	auction.Lot = remainingLot
+	auction.MaxBid = auction.MaxBid.Sub(payment)
+	auction.Bid = remainingBid
+	auction.FilledLot = auction.FilledLot.Add(lot)
+	auction.FilledBid = auction.FilledBid.Add(payment)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.ID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyBid, payment.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, lot.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", auction.EndTime.Unix())),
+		),
+	)
+
+	return auction, nil
+}
+
 // PlaceReverseBidCollateral places a reverse bid on a collateral auction, moving coins and returning the updated auction.
 func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction *types.CollateralAuction, bidder sdk.AccAddress, lot sdk.Coin) (*types.CollateralAuction, error) {
 	// Validate new bid
@@ -330,6 +500,8 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction *types.Collat
 			sdk.NewDecFromInt(auction.Lot.Amount).Mul(k.GetParams(ctx).IncrementCollateral).RoundInt(),
 		),
 	)
+	// new lot must also be worth at least the min increment value less than the old lot
+	maxNewLotAmt = sdk.MinInt(maxNewLotAmt, auction.Lot.Amount.Sub(k.minIncrementAmount(ctx, auction.GetType(), auction.Lot.Denom)))
 	if lot.Amount.GT(maxNewLotAmt) {
 		return auction, errorsmod.Wrapf(types.ErrLotTooLarge, "%s > %s%s", lot, maxNewLotAmt, auction.Lot.Denom)
 	}
@@ -370,11 +542,12 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction *types.Collat
 	// Update Auction
 	auction.Bidder = bidder
 	auction.Lot = lot
-	if !auction.HasReceivedBids {
+	firstBid := !auction.HasReceivedBids
+	if firstBid {
 		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
 		auction.HasReceivedBids = true
 	}
-	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).ReverseBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+	auction.EndTime = k.bidEndTime(ctx, auction.GetType(), firstBid, auction.EndTime, auction.MaxEndTime, k.GetParams(ctx).ReverseBidDuration) // increment timeout, up to MaxEndTime
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -389,6 +562,82 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction *types.Collat
 	return auction, nil
 }
 
+// PlaceBidDutchCollateral buys some or all of the lot of a dutch collateral auction at the current price, moving
+// coins and returning the updated auction.
+func (k Keeper) PlaceBidDutchCollateral(ctx sdk.Context, auction *types.DutchCollateralAuction, bidder sdk.AccAddress, lot sdk.Coin) (*types.DutchCollateralAuction, error) {
+	// Validate new bid
+	if lot.Denom != auction.Lot.Denom {
+		return auction, errorsmod.Wrapf(types.ErrInvalidLotDenom, "%s ≠ %s", lot.Denom, auction.Lot.Denom)
+	}
+	if !lot.IsPositive() {
+		return auction, errorsmod.Wrapf(types.ErrLotTooSmall, "%s ≤ 0%s", lot, auction.Lot.Denom)
+	}
+	if auction.Lot.IsLT(lot) {
+		return auction, errorsmod.Wrapf(types.ErrLotTooLarge, "%s > %s", lot, auction.Lot)
+	}
+	price := auction.CurrentPrice(ctx.BlockTime())
+	if !price.IsPositive() {
+		return auction, errorsmod.Wrapf(types.ErrAuctionHasExpired, "%d", auction.ID)
+	}
+
+	// The cost of the lot is rounded up. Purchases that would raise more than the max bid pay only the remaining
+	// max bid, for the part of the lot that it covers rounded down.
+	payment := sdk.NewCoin(auction.Bid.Denom, price.MulInt(lot.Amount).Ceil().TruncateInt())
+	remainingBid := auction.MaxBid.Sub(auction.Bid)
+	if remainingBid.IsLT(payment) {
+		payment = remainingBid
+		lot.Amount = sdk.MinInt(lot.Amount, sdk.NewDecFromInt(payment.Amount).Quo(price).TruncateInt())
+		if !lot.IsPositive() {
+			return auction, errorsmod.Wrapf(types.ErrLotTooSmall, "remaining max bid %s buys no %s", remainingBid, lot.Denom)
+		}
+	}
+
+	// Payment sent to auction initiator
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, auction.Initiator, sdk.NewCoins(payment))
+	if err != nil {
+		return auction, err
+	}
+	// Debt coins are sent to liquidator (until there is no CorrespondingDebt left). Amount sent is equal to payment (or whatever is left if < payment).
+	if auction.CorrespondingDebt.IsPositive() {
+		debtAmountToReturn := sdk.MinInt(payment.Amount, auction.CorrespondingDebt.Amount)
+		debtToReturn := sdk.NewCoin(auction.CorrespondingDebt.Denom, debtAmountToReturn)
+
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(debtToReturn))
+		if err != nil {
+			return auction, err
+		}
+		auction.CorrespondingDebt = auction.CorrespondingDebt.Sub(debtToReturn) // debtToReturn will always be ≤ auction.CorrespondingDebt from the MinInt above
+	}
+	// Purchased lot sent to bidder
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(lot))
+	if err != nil {
+		return auction, err
+	}
+
+	// Update Auction
+	auction.Bidder = bidder
+	auction.Bid = auction.Bid.Add(payment)
+	auction.Lot = auction.Lot.Sub(lot)
+	auction.HasReceivedBids = true
+	if auction.IsComplete() {
+		auction.EndTime = ctx.BlockTime() // the auction is closed immediately, the price decay is unaffected as it uses MaxEndTime
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.ID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, auction.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyBid, payment.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, lot.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", auction.EndTime.Unix())),
+		),
+	)
+
+	return auction, nil
+}
+
 // PlaceBidDebt places a reverse bid on a debt auction, moving coins and returning the updated auction.
 func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction *types.DebtAuction, bidder sdk.AccAddress, lot sdk.Coin) (*types.DebtAuction, error) {
 	// Validate new bid
@@ -401,6 +650,8 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction *types.DebtAuction, bidder
 			sdk.NewDecFromInt(auction.Lot.Amount).Mul(k.GetParams(ctx).IncrementDebt).RoundInt(),
 		),
 	)
+	// new lot must also be worth at least the min increment value less than the old lot
+	maxNewLotAmt = sdk.MinInt(maxNewLotAmt, auction.Lot.Amount.Sub(k.minIncrementAmount(ctx, auction.GetType(), auction.Lot.Denom)))
 	if lot.Amount.GT(maxNewLotAmt) {
 		return auction, errorsmod.Wrapf(types.ErrLotTooLarge, "%s > %s%s", lot, maxNewLotAmt, auction.Lot.Denom)
 	}
@@ -444,11 +695,12 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction *types.DebtAuction, bidder
 	// Update Auction
 	auction.Bidder = bidder
 	auction.Lot = lot
-	if !auction.HasReceivedBids {
+	firstBid := !auction.HasReceivedBids
+	if firstBid {
 		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
 		auction.HasReceivedBids = true
 	}
-	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).ForwardBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+	auction.EndTime = k.bidEndTime(ctx, auction.GetType(), firstBid, auction.EndTime, auction.MaxEndTime, k.GetParams(ctx).ForwardBidDuration) // increment timeout, up to MaxEndTime
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -483,6 +735,8 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 		err = k.PayoutDebtAuction(ctx, auc)
 	case *types.CollateralAuction:
 		err = k.PayoutCollateralAuction(ctx, auc)
+	case *types.DutchCollateralAuction:
+		err = k.PayoutDutchCollateralAuction(ctx, auc)
 	default:
 		err = errorsmod.Wrap(types.ErrUnrecognizedAuctionType, auc.GetType())
 	}
@@ -492,6 +746,7 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 	}
 
 	k.DeleteAuction(ctx, auctionID)
+	k.CloseBidHistory(ctx, auctionID)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -545,6 +800,35 @@ func (k Keeper) PayoutCollateralAuction(ctx sdk.Context, auction *types.Collater
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.CorrespondingDebt))
 }
 
+// PayoutDutchCollateralAuction returns the unsold lot and remaining debt of a dutch collateral auction.
+// Bidders are paid out as they bid.
+func (k Keeper) PayoutDutchCollateralAuction(ctx sdk.Context, auction *types.DutchCollateralAuction) error {
+	// Unsold lot is sent to weighted addresses (normally the CDP depositors)
+	if auction.Lot.IsPositive() {
+		lotPayouts, err := splitCoinIntoWeightedBuckets(auction.Lot, auction.LotReturns.Weights)
+		if err != nil {
+			return err
+		}
+		for i, payout := range lotPayouts {
+			// if the payout amount is 0, don't send 0 coins
+			if !payout.IsPositive() {
+				continue
+			}
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.LotReturns.Addresses[i], sdk.NewCoins(payout))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// if there is remaining debt after the auction, send it back to the initiating module for management
+	if !auction.CorrespondingDebt.IsPositive() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.CorrespondingDebt))
+}
+
 // CloseExpiredAuctions iterates over all the auctions stored by until the current
 // block timestamp and that are past (or at) their ending times and closes them,
 // paying out to the highest bidder.
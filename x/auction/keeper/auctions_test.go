@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"github.com/kava-labs/kava/x/auction/keeper"
 	"github.com/kava-labs/kava/x/auction/testutil"
 	"github.com/kava-labs/kava/x/auction/types"
 )
@@ -194,6 +195,233 @@ func (suite *auctionTestSuite) TestCollateralAuctionDebtRemaining() {
 	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 110), c("debt", 100)))
 }
 
+func (suite *auctionTestSuite) TestCollateralAuctionPartialFill() {
+	// Setup
+	buyer := suite.Addrs[0]
+	filler := suite.Addrs[1]
+	returnAddrs := suite.Addrs[2:]
+	returnWeights := is(30, 20)
+	sellerModName := suite.ModAcc.Name
+	sellerAddr := suite.ModAcc.GetAddress()
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	// Start auction
+	auctionID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	suite.NoError(err)
+
+	// Place a forward bid
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token2", 10)))
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 110), c("debt", 70)))
+
+	// Fill a quarter of the lot for a quarter of the max bid (rounded up), the forward bid is scaled down to the remaining lot
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, filler, c("token1", 5)))
+	// Check filler has paid for and received their part of the lot
+	suite.CheckAccountBalanceEqual(filler, cs(c("token1", 105), c("token2", 87)))
+	// Check the forward bidder has been refunded the part of their bid covering the filled lot
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 100), c("token2", 93)))
+	// Check seller's coins have increased by the rest of the payment
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 120), c("debt", 80)))
+
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.True(found)
+	collateralAuction := auction.(*types.CollateralAuction)
+	suite.Equal(c("token1", 15), collateralAuction.Lot)
+	suite.Equal(c("token2", 37), collateralAuction.MaxBid)
+	suite.Equal(c("token2", 7), collateralAuction.Bid)
+	suite.Equal(buyer, collateralAuction.Bidder)
+	suite.Equal(c("debt", 20), collateralAuction.CorrespondingDebt)
+	suite.Equal(is(9, 6), collateralAuction.LotReturns.Weights)
+	suite.Equal(cs(c("token1", 5)), collateralAuction.FilledLot)
+	suite.Equal(cs(c("token2", 13)), collateralAuction.FilledBid)
+	_, broken := keeper.CollateralFillsInvariant(suite.Keeper)(suite.Ctx)
+	suite.False(broken)
+
+	// Bid the remaining max bid and place a reverse bid, the lot decrease is split by the remaining lot weights
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token2", 37)))
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 150), c("debt", 100)))
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token1", 10)))
+	suite.CheckAccountBalanceEqual(returnAddrs[0], cs(c("token1", 103), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(returnAddrs[1], cs(c("token1", 102), c("token2", 100)))
+	_, broken = keeper.CollateralFillsInvariant(suite.Keeper)(suite.Ctx)
+	suite.False(broken)
+
+	// Close auction at just after auction expiry
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultReverseBidDuration))
+	suite.NoError(suite.Keeper.CloseAuction(ctx, auctionID))
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 110), c("token2", 63)))
+}
+
+func (suite *auctionTestSuite) TestCollateralAuctionPartialFillInvalid() {
+	// Setup
+	buyer := suite.Addrs[0]
+	returnAddrs := suite.Addrs[1:]
+	returnWeights := is(30, 20, 10)
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	auctionID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 1), returnAddrs, returnWeights, c("debt", 1))
+	suite.NoError(err)
+
+	suite.ErrorIs(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token1", 0)), types.ErrLotTooSmall)
+	suite.ErrorIs(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token1", 20)), types.ErrLotTooLarge)
+	// the payment for any fill would use up the whole max bid
+	suite.ErrorIs(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token1", 1)), types.ErrLotTooLarge)
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 100), c("token2", 100)))
+}
+
+func (suite *auctionTestSuite) TestCollateralFillsInvariant() {
+	// Setup
+	returnAddrs := suite.Addrs[1:]
+	returnWeights := is(30, 20, 10)
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	auctionID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	suite.NoError(err)
+	// Unfilled auctions are not checked
+	_, broken := keeper.CollateralFillsInvariant(suite.Keeper)(suite.Ctx)
+	suite.False(broken)
+
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[0], c("token1", 8)))
+	_, broken = keeper.CollateralFillsInvariant(suite.Keeper)(suite.Ctx)
+	suite.False(broken)
+
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.True(found)
+	filled := auction.(*types.CollateralAuction)
+
+	// Lot returns must account for the whole remaining lot
+	unconservedLot := *filled
+	unconservedLot.LotReturns.Weights = is(30, 20, 10)
+	suite.Keeper.SetAuction(suite.Ctx, &unconservedLot)
+	_, broken = keeper.CollateralFillsInvariant(suite.Keeper)(suite.Ctx)
+	suite.True(broken)
+
+	// Remaining debt must be covered by the remaining max bid
+	unconservedDebt := *filled
+	unconservedDebt.LotReturns.Weights = is(6, 4, 2)
+	unconservedDebt.CorrespondingDebt = c("debt", 31)
+	suite.Keeper.SetAuction(suite.Ctx, &unconservedDebt)
+	_, broken = keeper.CollateralFillsInvariant(suite.Keeper)(suite.Ctx)
+	suite.True(broken)
+}
+
+func (suite *auctionTestSuite) TestDutchCollateralAuctionBasic() {
+	// Setup
+	buyer := suite.Addrs[0]
+	returnAddrs := suite.Addrs[1:]
+	returnWeights := is(30, 20, 10)
+	sellerModName := suite.ModAcc.Name
+	sellerAddr := suite.ModAcc.GetAddress()
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	// Start auction, the start price is 50/20 token2 per token1 plus a 20% premium
+	auctionID, err := suite.Keeper.StartDutchCollateralAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	suite.NoError(err)
+	// Check seller's coins have decreased
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 100), c("debt", 60)))
+
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.True(found)
+	dutchAuction := auction.(*types.DutchCollateralAuction)
+	suite.Equal(sdk.MustNewDecFromStr("3.0"), dutchAuction.StartPrice)
+	suite.Equal(suite.Ctx.BlockTime().Add(types.DefaultDutchAuctionDuration), dutchAuction.GetEndTime())
+
+	// Buy half the lot halfway through the auction at half the start price
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultDutchAuctionDuration / 2))
+	suite.NoError(suite.Keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 10)))
+	// Check bidder has paid for and received the lot
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 110), c("token2", 85)))
+	// Check seller's coins have increased
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 115), c("debt", 75)))
+
+	auction, found = suite.Keeper.GetAuction(ctx, auctionID)
+	suite.True(found)
+	suite.Equal(c("token1", 10), auction.GetLot())
+	suite.Equal(c("token2", 15), auction.GetBid())
+
+	// Buy the rest of the lot, the price has fallen further
+	ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultDutchAuctionDuration * 2 / 3))
+	suite.NoError(suite.Keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 10)))
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 120), c("token2", 75)))
+	// Check the auction has closed and the remaining debt returned to the seller
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 125), c("debt", 100)))
+	_, found = suite.Keeper.GetAuction(ctx, auctionID)
+	suite.False(found)
+	// Check return addresses have not received coins
+	for _, ra := range returnAddrs {
+		suite.CheckAccountBalanceEqual(ra, cs(c("token1", 100), c("token2", 100)))
+	}
+}
+
+func (suite *auctionTestSuite) TestDutchCollateralAuctionMaxBidReached() {
+	// Setup
+	buyer := suite.Addrs[0]
+	returnAddrs := suite.Addrs[1:]
+	returnWeights := is(1, 1, 1)
+	sellerModName := suite.ModAcc.Name
+	sellerAddr := suite.ModAcc.GetAddress()
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	auctionID, err := suite.Keeper.StartDutchCollateralAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	suite.NoError(err)
+
+	// Buy part of the lot at the start price, leaving less of the max bid than the price of one token1
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token1", 16)))
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 116), c("token2", 52)))
+
+	// Bids that cannot buy any of the lot with the remaining max bid are rejected
+	suite.ErrorIs(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token1", 4)), types.ErrLotTooSmall)
+
+	// Bid for the rest of the lot at a lower price, only the part of the lot covering the max bid is bought,
+	// rounded down
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultDutchAuctionDuration / 2))
+	suite.NoError(suite.Keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 4)))
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 117), c("token2", 50)))
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 150), c("debt", 100)))
+
+	// Check the auction has closed and the unsold lot returned
+	_, found := suite.Keeper.GetAuction(ctx, auctionID)
+	suite.False(found)
+	for _, ra := range returnAddrs {
+		suite.CheckAccountBalanceEqual(ra, cs(c("token1", 101), c("token2", 100)))
+	}
+}
+
+func (suite *auctionTestSuite) TestDutchCollateralAuctionExpired() {
+	// Setup
+	buyer := suite.Addrs[0]
+	returnAddrs := suite.Addrs[1:]
+	returnWeights := is(1, 1, 2)
+	sellerModName := suite.ModAcc.Name
+	sellerAddr := suite.ModAcc.GetAddress()
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	auctionID, err := suite.Keeper.StartDutchCollateralAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	suite.NoError(err)
+
+	// Invalid bids are rejected
+	suite.ErrorIs(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token2", 10)), types.ErrInvalidLotDenom)
+	suite.ErrorIs(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token1", 0)), types.ErrLotTooSmall)
+	suite.ErrorIs(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token1", 21)), types.ErrLotTooLarge)
+
+	// Bids are rejected once the price has decayed to zero
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultDutchAuctionDuration))
+	suite.ErrorIs(suite.Keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 10)), types.ErrAuctionHasExpired)
+
+	// Close expired auctions
+	suite.NoError(suite.Keeper.CloseExpiredAuctions(ctx))
+	_, found := suite.Keeper.GetAuction(ctx, auctionID)
+	suite.False(found)
+
+	// Check the lot is returned and the debt sent back to the seller
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 100), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 100), c("debt", 100)))
+	suite.CheckAccountBalanceEqual(returnAddrs[0], cs(c("token1", 105), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(returnAddrs[1], cs(c("token1", 105), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(returnAddrs[2], cs(c("token1", 110), c("token2", 100)))
+}
+
 func (suite *auctionTestSuite) TestStartSurplusAuction() {
 	someTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
 	type args struct {
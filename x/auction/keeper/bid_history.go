@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// SetBidHistory puts the bid history into the store, and updates the bidder and close time indexes.
+func (k Keeper) SetBidHistory(ctx sdk.Context, history types.BidHistory) {
+	k.setBidHistory(ctx, history)
+
+	bidderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidderAuctionKeyPrefix)
+	for _, bidder := range history.Bidders {
+		bidderStore.Set(types.GetBidderAuctionKey(bidder, history.AuctionID), types.Uint64ToBytes(history.AuctionID))
+	}
+}
+
+// setBidHistory puts the bid history into the store, and updates the close time index, leaving the bidder index untouched.
+func (k Keeper) setBidHistory(ctx sdk.Context, history types.BidHistory) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidHistoryKeyPrefix)
+	store.Set(types.GetAuctionKey(history.AuctionID), k.cdc.MustMarshal(&history))
+
+	if history.IsClosed() {
+		closeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidHistoryByCloseTimeKeyPrefix)
+		closeStore.Set(types.GetAuctionByTimeKey(history.CloseTime, history.AuctionID), types.Uint64ToBytes(history.AuctionID))
+	}
+}
+
+// GetBidHistory gets the bid history of an auction from the store.
+func (k Keeper) GetBidHistory(ctx sdk.Context, auctionID uint64) (types.BidHistory, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidHistoryKeyPrefix)
+	bz := store.Get(types.GetAuctionKey(auctionID))
+	if bz == nil {
+		return types.BidHistory{}, false
+	}
+
+	var history types.BidHistory
+	k.cdc.MustUnmarshal(bz, &history)
+	return history, true
+}
+
+// DeleteBidHistory removes the bid history of an auction from the store, and any indexes.
+func (k Keeper) DeleteBidHistory(ctx sdk.Context, auctionID uint64) {
+	history, found := k.GetBidHistory(ctx, auctionID)
+	if !found {
+		return
+	}
+
+	bidderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidderAuctionKeyPrefix)
+	for _, bidder := range history.Bidders {
+		bidderStore.Delete(types.GetBidderAuctionKey(bidder, auctionID))
+	}
+
+	if history.IsClosed() {
+		closeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidHistoryByCloseTimeKeyPrefix)
+		closeStore.Delete(types.GetAuctionByTimeKey(history.CloseTime, auctionID))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidHistoryKeyPrefix)
+	store.Delete(types.GetAuctionKey(auctionID))
+}
+
+// IterateBidHistories provides an iterator over all stored bid histories.
+// For each bid history, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateBidHistories(ctx sdk.Context, cb func(history types.BidHistory) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.BidHistoryKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.BidHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &history)
+
+		if cb(history) {
+			break
+		}
+	}
+}
+
+// GetAllBidHistories returns all bid histories from the store
+func (k Keeper) GetAllBidHistories(ctx sdk.Context) (histories []types.BidHistory) {
+	k.IterateBidHistories(ctx, func(history types.BidHistory) bool {
+		histories = append(histories, history)
+		return false
+	})
+	return
+}
+
+// RecordBid adds a bid to the bid history of an auction, keeping up to BidHistoryLength of the most recent bids.
+// Only the index key of a new bidder is written, bidders stay indexed until the bid history is pruned.
+func (k Keeper) RecordBid(ctx sdk.Context, auction types.Auction, bidder sdk.AccAddress, amount sdk.Coin) {
+	params := k.GetParams(ctx)
+	if params.BidHistoryLength == 0 {
+		return
+	}
+
+	history, found := k.GetBidHistory(ctx, auction.GetID())
+	if !found {
+		history = types.NewBidHistory(auction.GetID(), auction.GetType())
+	}
+	newBidder := !history.HasBidder(bidder)
+	history.AddBid(
+		types.NewBidRecord(bidder, amount, auction.GetBid(), auction.GetLot(), ctx.BlockHeight(), ctx.BlockTime()),
+		params.BidHistoryLength,
+	)
+	k.setBidHistory(ctx, history)
+
+	if newBidder {
+		bidderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidderAuctionKeyPrefix)
+		bidderStore.Set(types.GetBidderAuctionKey(bidder, history.AuctionID), types.Uint64ToBytes(history.AuctionID))
+	}
+}
+
+// CloseBidHistory marks the bid history of an auction as closed, starting its retention period.
+func (k Keeper) CloseBidHistory(ctx sdk.Context, auctionID uint64) {
+	history, found := k.GetBidHistory(ctx, auctionID)
+	if !found {
+		return
+	}
+	history.CloseTime = ctx.BlockTime()
+	k.setBidHistory(ctx, history)
+}
+
+// PruneBidHistories deletes the bid histories of auctions that closed more than BidHistoryRetention ago.
+func (k Keeper) PruneBidHistories(ctx sdk.Context) {
+	cutoffTime := ctx.BlockTime().Add(-k.GetParams(ctx).BidHistoryRetention)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidHistoryByCloseTimeKeyPrefix)
+	iterator := store.Iterator(
+		nil, // start at the very start of the prefix store
+		sdk.PrefixEndBytes(sdk.FormatTimeBytes(cutoffTime)), // include any keys with times equal to cutoffTime
+	)
+
+	// collect IDs first as deleting from the store while iterating is not supported
+	var auctionIDs []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		auctionIDs = append(auctionIDs, types.Uint64FromBytes(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, id := range auctionIDs {
+		k.DeleteBidHistory(ctx, id)
+	}
+}
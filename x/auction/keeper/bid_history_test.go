@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/auction/keeper"
+	"github.com/kava-labs/kava/x/auction/testutil"
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+type bidHistoryTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *bidHistoryTestSuite) SetupTest() {
+	suite.Suite.SetupTest(4)
+}
+
+func TestBidHistoryTestSuite(t *testing.T) {
+	suite.Run(t, new(bidHistoryTestSuite))
+}
+
+func (suite *bidHistoryTestSuite) TestBidHistoryRecordedAndPruned() {
+	buyer := suite.Addrs[0]
+	secondBuyer := suite.Addrs[1]
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	auctionID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), suite.Addrs[2:], is(1, 1), c("debt", 40))
+	suite.Require().NoError(err)
+	_, found := suite.Keeper.GetBidHistory(suite.Ctx, auctionID)
+	suite.False(found)
+
+	// Bids are recorded along with the auction state after the bid
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token2", 10)))
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, secondBuyer, c("token2", 50)))
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token1", 15)))
+
+	history, found := suite.Keeper.GetBidHistory(suite.Ctx, auctionID)
+	suite.Require().True(found)
+	suite.Equal(types.CollateralAuctionType, history.AuctionType)
+	suite.Equal([]sdk.AccAddress{buyer, secondBuyer}, history.Bidders)
+	suite.Require().Len(history.Bids, 3)
+	suite.Equal(types.NewBidRecord(secondBuyer, c("token2", 50), c("token2", 50), c("token1", 20), suite.Ctx.BlockHeight(), suite.Ctx.BlockTime()), history.Bids[1])
+	suite.Equal(types.NewBidRecord(buyer, c("token1", 15), c("token2", 50), c("token1", 15), suite.Ctx.BlockHeight(), suite.Ctx.BlockTime()), history.Bids[2])
+	suite.False(history.IsClosed())
+
+	// Bid history is kept after the auction closes
+	closeCtx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultReverseBidDuration))
+	suite.Require().NoError(suite.Keeper.CloseAuction(closeCtx, auctionID))
+	history, found = suite.Keeper.GetBidHistory(closeCtx, auctionID)
+	suite.Require().True(found)
+	suite.Equal(closeCtx.BlockTime(), history.CloseTime)
+
+	qs := keeper.NewQueryServerImpl(suite.Keeper)
+	historyRes, err := qs.BidHistory(sdk.WrapSDKContext(closeCtx), &types.QueryBidHistoryRequest{AuctionId: auctionID})
+	suite.Require().NoError(err)
+	suite.Equal(history, historyRes.BidHistory)
+	bidderRes, err := qs.BidderAuctions(sdk.WrapSDKContext(closeCtx), &types.QueryBidderAuctionsRequest{Bidder: secondBuyer.String()})
+	suite.Require().NoError(err)
+	suite.Equal([]types.BidHistory{history}, bidderRes.BidHistories)
+
+	// Bid history is kept until the retention period has passed
+	pruneCtx := closeCtx.WithBlockTime(closeCtx.BlockTime().Add(types.DefaultBidHistoryRetention - 1))
+	suite.Keeper.PruneBidHistories(pruneCtx)
+	_, found = suite.Keeper.GetBidHistory(pruneCtx, auctionID)
+	suite.True(found)
+
+	pruneCtx = closeCtx.WithBlockTime(closeCtx.BlockTime().Add(types.DefaultBidHistoryRetention))
+	suite.Keeper.PruneBidHistories(pruneCtx)
+	_, found = suite.Keeper.GetBidHistory(pruneCtx, auctionID)
+	suite.False(found)
+
+	_, err = qs.BidHistory(sdk.WrapSDKContext(pruneCtx), &types.QueryBidHistoryRequest{AuctionId: auctionID})
+	suite.Error(err)
+	bidderRes, err = qs.BidderAuctions(sdk.WrapSDKContext(pruneCtx), &types.QueryBidderAuctionsRequest{Bidder: buyer.String()})
+	suite.Require().NoError(err)
+	suite.Empty(bidderRes.BidHistories)
+}
+
+func (suite *bidHistoryTestSuite) TestBidHistoryLength() {
+	buyer := suite.Addrs[0]
+	secondBuyer := suite.Addrs[1]
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100)))
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.BidHistoryLength = 2
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	auctionID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, sellerModName, c("token1", 20), "token2")
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, secondBuyer, c("token2", 10)))
+	for _, bid := range []int64{20, 30} {
+		suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token2", bid)))
+	}
+
+	// only the most recent bids are kept, but all bidders are
+	history, found := suite.Keeper.GetBidHistory(suite.Ctx, auctionID)
+	suite.Require().True(found)
+	suite.Require().Len(history.Bids, 2)
+	suite.Equal(c("token2", 20), history.Bids[0].Amount)
+	suite.Equal(c("token2", 30), history.Bids[1].Amount)
+	suite.Equal([]sdk.AccAddress{secondBuyer, buyer}, history.Bidders)
+
+	// bidders whose bids have been dropped are still indexed
+	qs := keeper.NewQueryServerImpl(suite.Keeper)
+	for _, bidder := range []sdk.AccAddress{secondBuyer, buyer} {
+		bidderRes, err := qs.BidderAuctions(sdk.WrapSDKContext(suite.Ctx), &types.QueryBidderAuctionsRequest{Bidder: bidder.String()})
+		suite.Require().NoError(err)
+		suite.Equal([]types.BidHistory{history}, bidderRes.BidHistories)
+	}
+
+	// no bids are recorded when the bid history is disabled
+	params.BidHistoryLength = 0
+	suite.Keeper.SetParams(suite.Ctx, params)
+	otherID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, sellerModName, c("token1", 20), "token2")
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, otherID, buyer, c("token2", 10)))
+	_, found = suite.Keeper.GetBidHistory(suite.Ctx, otherID)
+	suite.False(found)
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// minIncrementAmount returns the amount of a denom worth the min increment value of an auction type's bid rule,
+// rounded up. It returns zero if the auction type has no min increment value, or the denom can't be valued in USD.
+func (k Keeper) minIncrementAmount(ctx sdk.Context, auctionType, denom string) sdkmath.Int {
+	params := k.GetParams(ctx)
+
+	rule, found := params.BidRules.Get(auctionType)
+	if !found || !rule.MinIncrementValue.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	market, found := params.PriceMarkets.Get(denom)
+	if !found {
+		return sdk.ZeroInt()
+	}
+	// bids are not blocked when the price is unavailable, only the percentage increment applies
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, market.MarketID)
+	if err != nil || !price.Price.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	conversionFactor := sdkmath.NewIntWithDecimal(1, int(market.ConversionFactor.Int64()))
+	return rule.MinIncrementValue.MulInt(conversionFactor).Quo(price.Price).Ceil().TruncateInt()
+}
+
+// bidEndTime returns the end time of an auction after a bid, which is the bid duration after the block time, up to
+// the max end time. If the auction type has an anti-sniping window, bids after the first only extend the end time
+// when placed within the window before it.
+func (k Keeper) bidEndTime(ctx sdk.Context, auctionType string, firstBid bool, endTime, maxEndTime time.Time, bidDuration time.Duration) time.Time {
+	newEndTime := earliestTime(ctx.BlockTime().Add(bidDuration), maxEndTime)
+
+	rule, found := k.GetParams(ctx).BidRules.Get(auctionType)
+	if firstBid || !found || rule.AntiSnipingWindow == 0 {
+		return newEndTime
+	}
+	if endTime.Sub(ctx.BlockTime()) > rule.AntiSnipingWindow {
+		return endTime
+	}
+	// the end time is never brought forward, even if the bid duration is shorter than the window
+	if newEndTime.Before(endTime) {
+		return endTime
+	}
+	return newEndTime
+}
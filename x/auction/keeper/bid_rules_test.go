@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/auction/testutil"
+	"github.com/kava-labs/kava/x/auction/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+type bidRulesTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *bidRulesTestSuite) SetupTest() {
+	suite.Suite.SetupTest(4)
+}
+
+func TestBidRulesTestSuite(t *testing.T) {
+	suite.Run(t, new(bidRulesTestSuite))
+}
+
+// setBidRules sets the auction bid rules, and a token2 price of 0.50 USD
+func (suite *bidRulesTestSuite) setBidRules(bidRules types.BidRules, priceMarkets types.PriceMarkets) {
+	oracle := suite.Addrs[3]
+	pricefeedKeeper := suite.App.GetPriceFeedKeeper()
+	pricefeedKeeper.SetParams(suite.Ctx, pricefeedtypes.NewParams([]pricefeedtypes.Market{
+		pricefeedtypes.NewMarket("token2:usd", "token2", "usd", []sdk.AccAddress{oracle}, true),
+	}, pricefeedtypes.DefaultPriceHistoryLength))
+	_, err := pricefeedKeeper.SetPrice(suite.Ctx, oracle, "token2:usd", sdk.MustNewDecFromStr("0.50"), suite.Ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(suite.Ctx, "token2:usd"))
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.BidRules = bidRules
+	params.PriceMarkets = priceMarkets
+	suite.Keeper.SetParams(suite.Ctx, params)
+}
+
+func (suite *bidRulesTestSuite) TestMinIncrementValueForwardBids() {
+	buyer := suite.Addrs[0]
+	secondBuyer := suite.Addrs[1]
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100)))
+
+	// bids must increase by 10 USD, or 20token2
+	suite.setBidRules(
+		types.BidRules{types.NewBidRule(types.SurplusAuctionType, sdk.MustNewDecFromStr("10"), 0)},
+		types.PriceMarkets{types.NewPriceMarket("token2", "token2:usd", sdkmath.ZeroInt())},
+	)
+
+	auctionID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, sellerModName, c("token1", 20), "token2")
+	suite.Require().NoError(err)
+
+	suite.ErrorIs(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token2", 19)), types.ErrBidTooSmall)
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token2", 20)))
+	suite.ErrorIs(suite.Keeper.PlaceBid(suite.Ctx, auctionID, secondBuyer, c("token2", 39)), types.ErrBidTooSmall)
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, secondBuyer, c("token2", 40)))
+
+	// only the percentage increment applies when the bid denom has no price
+	suite.setBidRules(
+		types.BidRules{types.NewBidRule(types.SurplusAuctionType, sdk.MustNewDecFromStr("10"), 0)},
+		types.PriceMarkets{types.NewPriceMarket("token2", "unknown:usd", sdkmath.ZeroInt())},
+	)
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token2", 42)))
+}
+
+func (suite *bidRulesTestSuite) TestMinIncrementValueReverseBids() {
+	buyer := suite.Addrs[0]
+	secondBuyer := suite.Addrs[1]
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("debt", 100)))
+
+	// lots must decrease by 10 USD, or 20token2
+	suite.setBidRules(
+		types.BidRules{types.NewBidRule(types.DebtAuctionType, sdk.MustNewDecFromStr("10"), 0)},
+		types.PriceMarkets{types.NewPriceMarket("token2", "token2:usd", sdkmath.ZeroInt())},
+	)
+
+	auctionID, err := suite.Keeper.StartDebtAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 100), c("debt", 20))
+	suite.Require().NoError(err)
+
+	suite.ErrorIs(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token2", 81)), types.ErrLotTooLarge)
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token2", 80)))
+	suite.ErrorIs(suite.Keeper.PlaceBid(suite.Ctx, auctionID, secondBuyer, c("token2", 61)), types.ErrLotTooLarge)
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, secondBuyer, c("token2", 60)))
+}
+
+func (suite *bidRulesTestSuite) TestAntiSnipingWindow() {
+	buyer := suite.Addrs[0]
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100)))
+
+	suite.setBidRules(
+		types.BidRules{types.NewBidRule(types.SurplusAuctionType, sdk.ZeroDec(), time.Hour)},
+		types.PriceMarkets{},
+	)
+
+	auctionID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, sellerModName, c("token1", 20), "token2")
+	suite.Require().NoError(err)
+	startTime := suite.Ctx.BlockTime()
+
+	placeBid := func(after time.Duration, amount int64) time.Time {
+		ctx := suite.Ctx.WithBlockTime(startTime.Add(after))
+		suite.Require().NoError(suite.Keeper.PlaceBid(ctx, auctionID, buyer, c("token2", amount)))
+		auction, found := suite.Keeper.GetAuction(ctx, auctionID)
+		suite.Require().True(found)
+		return auction.GetEndTime()
+	}
+
+	// the first bid sets the end time
+	suite.Equal(startTime.Add(types.DefaultForwardBidDuration), placeBid(0, 10))
+	// bids outside the window do not extend the end time
+	suite.Equal(startTime.Add(types.DefaultForwardBidDuration), placeBid(time.Hour, 20))
+	// bids inside the window extend the end time
+	suite.Equal(startTime.Add(types.DefaultForwardBidDuration+23*time.Hour), placeBid(23*time.Hour, 30))
+	// up to the max end time
+	suite.Equal(startTime.Add(types.DefaultMaxAuctionDuration), placeBid(46*time.Hour+30*time.Minute, 40))
+}
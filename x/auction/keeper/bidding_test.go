@@ -462,9 +462,15 @@ func TestAuctionBidding(t *testing.T) {
 				types.DefaultIncrement,
 				types.DefaultIncrement,
 				types.DefaultIncrement,
+				types.DefaultDutchAuctionStartPremium,
+				types.DefaultDutchAuctionDuration,
+				types.DefaultBidHistoryLength,
+				types.DefaultBidHistoryRetention,
+				types.DefaultBidRules,
+				types.DefaultPriceMarkets,
 			)
 
-			auctionGs, err := types.NewGenesisState(types.DefaultNextAuctionID, params, []types.GenesisAuction{})
+			auctionGs, err := types.NewGenesisState(types.DefaultNextAuctionID, params, []types.GenesisAuction{}, []types.BidHistory{})
 			require.NoError(t, err)
 
 			moduleGs := tApp.AppCodec().MustMarshalJSON(auctionGs)
@@ -9,6 +9,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	proto "github.com/cosmos/gogoproto/proto"
@@ -86,12 +87,17 @@ func (s queryServer) Auctions(c context.Context, req *types.QueryAuctionsRequest
 		// True if empty owner, otherwise check if auction contains owner
 		ownerIsMatch := req.Owner == ""
 		if req.Owner != "" {
-			if cAuc, ok := result.(*types.CollateralAuction); ok {
-				for _, addr := range cAuc.GetLotReturns().Addresses {
-					if addr.String() == req.Owner {
-						ownerIsMatch = true
-						break
-					}
+			var lotReturns types.WeightedAddresses
+			switch auc := result.(type) {
+			case *types.CollateralAuction:
+				lotReturns = auc.GetLotReturns()
+			case *types.DutchCollateralAuction:
+				lotReturns = auc.GetLotReturns()
+			}
+			for _, addr := range lotReturns.Addresses {
+				if addr.String() == req.Owner {
+					ownerIsMatch = true
+					break
 				}
 			}
 		}
@@ -143,3 +149,53 @@ func (s queryServer) NextAuctionID(ctx context.Context, req *types.QueryNextAuct
 
 	return &types.QueryNextAuctionIDResponse{Id: nextAuctionID}, nil
 }
+
+// BidHistory implements the Query/BidHistory gRPC method
+func (s queryServer) BidHistory(c context.Context, req *types.QueryBidHistoryRequest) (*types.QueryBidHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	history, found := s.keeper.GetBidHistory(ctx, req.AuctionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no bid history for auction %d", req.AuctionId)
+	}
+
+	return &types.QueryBidHistoryResponse{BidHistory: history}, nil
+}
+
+// BidderAuctions implements the Query/BidderAuctions gRPC method
+func (s queryServer) BidderAuctions(c context.Context, req *types.QueryBidderAuctionsRequest) (*types.QueryBidderAuctionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	bidder, err := sdk.AccAddressFromBech32(req.Bidder)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bidder: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var histories []types.BidHistory
+	bidderStore := prefix.NewStore(ctx.KVStore(s.keeper.storeKey), append(types.BidderAuctionKeyPrefix, address.MustLengthPrefix(bidder)...))
+
+	pageRes, err := query.Paginate(bidderStore, req.Pagination, func(key []byte, value []byte) error {
+		history, found := s.keeper.GetBidHistory(ctx, types.Uint64FromBytes(value))
+		if !found {
+			return status.Errorf(codes.Internal, "bid history %d in bidder index but not in store", types.Uint64FromBytes(value))
+		}
+		histories = append(histories, history)
+		return nil
+	})
+	if err != nil {
+		return &types.QueryBidderAuctionsResponse{}, err
+	}
+
+	return &types.QueryBidderAuctionsResponse{
+		BidHistories: histories,
+		Pagination:   pageRes,
+	}, nil
+}
@@ -18,6 +18,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		ValidAuctionInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "valid-index",
 		ValidIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "collateral-fills",
+		CollateralFillsInvariant(k))
 }
 
 // ModuleAccountInvariants checks that the module account's coins matches those stored in auctions
@@ -130,3 +132,45 @@ func ValidIndexInvariant(k Keeper) sdk.Invariant {
 		return "", false
 	}
 }
+
+// CollateralFillsInvariant checks that partially filled collateral auctions conserve their lot and debt.
+// The remaining lot must be fully owed to the lot returns while in forward phase, and the remaining debt must be
+// covered by what is left to be raised from the max bid.
+func CollateralFillsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var brokenReason string
+		var brokenAuction types.Auction
+		k.IterateAuctions(ctx, func(auction types.Auction) bool {
+			a, ok := auction.(*types.CollateralAuction)
+			if !ok || a.FilledLot.Empty() {
+				return false
+			}
+
+			switch {
+			case len(a.FilledLot) != 1 || a.FilledLot[0].Denom != a.Lot.Denom:
+				brokenReason = fmt.Sprintf("filled lot %s is not in lot denom %s", a.FilledLot, a.Lot.Denom)
+			case len(a.FilledBid) != 1 || a.FilledBid[0].Denom != a.MaxBid.Denom:
+				brokenReason = fmt.Sprintf("filled bid %s is not in bid denom %s", a.FilledBid, a.MaxBid.Denom)
+			case !a.IsReversePhase() && !totalInts(a.LotReturns.Weights...).Equal(a.Lot.Amount):
+				brokenReason = fmt.Sprintf("lot returns weights %s do not sum to remaining lot %s", a.LotReturns.Weights, a.Lot)
+			case a.CorrespondingDebt.Amount.GT(a.MaxBid.Amount.Sub(a.Bid.Amount)):
+				brokenReason = fmt.Sprintf("remaining debt %s exceeds remaining max bid %s", a.CorrespondingDebt, a.MaxBid.Sub(a.Bid))
+			default:
+				return false
+			}
+			brokenAuction = a
+			return true
+		})
+
+		broken := brokenReason != ""
+		invariantMessage := sdk.FormatInvariant(
+			types.ModuleName,
+			"collateral fills",
+			fmt.Sprintf(
+				"\tfound unconserved collateral auction fill, reason: %s\n"+
+					"\tauction:\n\t%s\n",
+				brokenReason, brokenAuction),
+		)
+		return invariantMessage, broken
+	}
+}
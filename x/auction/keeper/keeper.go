@@ -16,27 +16,29 @@ import (
 )
 
 type Keeper struct {
-	storeKey      storetypes.StoreKey
-	cdc           codec.Codec
-	paramSubspace paramtypes.Subspace
-	bankKeeper    types.BankKeeper
-	accountKeeper types.AccountKeeper
+	storeKey        storetypes.StoreKey
+	cdc             codec.Codec
+	paramSubspace   paramtypes.Subspace
+	bankKeeper      types.BankKeeper
+	accountKeeper   types.AccountKeeper
+	pricefeedKeeper types.PricefeedKeeper
 }
 
 // NewKeeper returns a new auction keeper.
 func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, paramstore paramtypes.Subspace,
-	bankKeeper types.BankKeeper, accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper, accountKeeper types.AccountKeeper, pricefeedKeeper types.PricefeedKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
 	}
 
 	return Keeper{
-		storeKey:      storeKey,
-		cdc:           cdc,
-		paramSubspace: paramstore,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
+		storeKey:        storeKey,
+		cdc:             cdc,
+		paramSubspace:   paramstore,
+		accountKeeper:   accountKeeper,
+		bankKeeper:      bankKeeper,
+		pricefeedKeeper: pricefeedKeeper,
 	}
 }
 
@@ -0,0 +1,28 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 2
+// V2 adds the dutch_auction_start_premium, dutch_auction_duration, bid_history_length, bid_history_retention,
+// bid_rules and price_markets params to parameters.
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	migrateParamsStore(ctx, paramstore)
+	return nil
+}
+
+// migrateParamsStore ensures the param key table exists and has the dutch auction, bid history and bid rule properties
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	paramstore.Set(ctx, types.KeyDutchAuctionStartPremium, types.DefaultDutchAuctionStartPremium)
+	paramstore.Set(ctx, types.KeyDutchAuctionDuration, types.DefaultDutchAuctionDuration)
+	paramstore.Set(ctx, types.KeyBidHistoryLength, types.DefaultBidHistoryLength)
+	paramstore.Set(ctx, types.KeyBidHistoryRetention, types.DefaultBidHistoryRetention)
+	paramstore.Set(ctx, types.KeyBidRules, types.DefaultBidRules)
+	paramstore.Set(ctx, types.KeyPriceMarkets, types.DefaultPriceMarkets)
+}
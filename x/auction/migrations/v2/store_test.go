@@ -0,0 +1,114 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v2auction "github.com/kava-labs/kava/x/auction/migrations/v2"
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+func TestStoreMigrationAddsKeyTableIncludingNewParams(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	auctionKey := sdk.NewKVStoreKey(types.ModuleName)
+	tauctionKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(auctionKey, tauctionKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, auctionKey, tauctionKey, types.ModuleName)
+
+	// Check params don't exist before
+	require.False(t, paramstore.Has(ctx, types.KeyDutchAuctionStartPremium))
+	require.False(t, paramstore.Has(ctx, types.KeyDutchAuctionDuration))
+	require.False(t, paramstore.Has(ctx, types.KeyBidHistoryLength))
+	require.False(t, paramstore.Has(ctx, types.KeyBidHistoryRetention))
+	require.False(t, paramstore.Has(ctx, types.KeyBidRules))
+	require.False(t, paramstore.Has(ctx, types.KeyPriceMarkets))
+
+	// Run migrations.
+	err := v2auction.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.KeyDutchAuctionStartPremium))
+	require.True(t, paramstore.Has(ctx, types.KeyDutchAuctionDuration))
+	require.True(t, paramstore.Has(ctx, types.KeyBidHistoryLength))
+	require.True(t, paramstore.Has(ctx, types.KeyBidHistoryRetention))
+	require.True(t, paramstore.Has(ctx, types.KeyBidRules))
+	require.True(t, paramstore.Has(ctx, types.KeyPriceMarkets))
+	// Assert the values are what we expect
+	var premium sdk.Dec
+	paramstore.Get(ctx, types.KeyDutchAuctionStartPremium, &premium)
+	require.Equal(t, types.DefaultDutchAuctionStartPremium, premium)
+	var duration time.Duration
+	paramstore.Get(ctx, types.KeyDutchAuctionDuration, &duration)
+	require.Equal(t, types.DefaultDutchAuctionDuration, duration)
+	var length uint64
+	paramstore.Get(ctx, types.KeyBidHistoryLength, &length)
+	require.Equal(t, types.DefaultBidHistoryLength, length)
+	var retention time.Duration
+	paramstore.Get(ctx, types.KeyBidHistoryRetention, &retention)
+	require.Equal(t, types.DefaultBidHistoryRetention, retention)
+	var bidRules types.BidRules
+	paramstore.Get(ctx, types.KeyBidRules, &bidRules)
+	require.Equal(t, types.DefaultBidRules, bidRules)
+	var priceMarkets types.PriceMarkets
+	paramstore.Get(ctx, types.KeyPriceMarkets, &priceMarkets)
+	require.Equal(t, types.DefaultPriceMarkets, priceMarkets)
+}
+
+func TestStoreMigrationSetsNewParamsOnExistingKeyTable(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	auctionKey := sdk.NewKVStoreKey(types.ModuleName)
+	tauctionKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(auctionKey, tauctionKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, auctionKey, tauctionKey, types.ModuleName)
+	paramstore.WithKeyTable(types.ParamKeyTable())
+
+	// expect it to have key table
+	require.True(t, paramstore.HasKeyTable())
+	// expect it to not have new params
+	require.False(t, paramstore.Has(ctx, types.KeyDutchAuctionStartPremium))
+	require.False(t, paramstore.Has(ctx, types.KeyDutchAuctionDuration))
+	require.False(t, paramstore.Has(ctx, types.KeyBidHistoryLength))
+	require.False(t, paramstore.Has(ctx, types.KeyBidHistoryRetention))
+	require.False(t, paramstore.Has(ctx, types.KeyBidRules))
+	require.False(t, paramstore.Has(ctx, types.KeyPriceMarkets))
+
+	// Run migrations.
+	err := v2auction.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.KeyDutchAuctionStartPremium))
+	require.True(t, paramstore.Has(ctx, types.KeyDutchAuctionDuration))
+	require.True(t, paramstore.Has(ctx, types.KeyBidHistoryLength))
+	require.True(t, paramstore.Has(ctx, types.KeyBidHistoryRetention))
+	require.True(t, paramstore.Has(ctx, types.KeyBidRules))
+	require.True(t, paramstore.Has(ctx, types.KeyPriceMarkets))
+
+	// Assert the values are what we expect
+	var premium sdk.Dec
+	paramstore.Get(ctx, types.KeyDutchAuctionStartPremium, &premium)
+	require.Equal(t, types.DefaultDutchAuctionStartPremium, premium)
+	var duration time.Duration
+	paramstore.Get(ctx, types.KeyDutchAuctionDuration, &duration)
+	require.Equal(t, types.DefaultDutchAuctionDuration, duration)
+	var length uint64
+	paramstore.Get(ctx, types.KeyBidHistoryLength, &length)
+	require.Equal(t, types.DefaultBidHistoryLength, length)
+	var retention time.Duration
+	paramstore.Get(ctx, types.KeyBidHistoryRetention, &retention)
+	require.Equal(t, types.DefaultBidHistoryRetention, retention)
+	var bidRules types.BidRules
+	paramstore.Get(ctx, types.KeyBidRules, &bidRules)
+	require.Equal(t, types.DefaultBidRules, bidRules)
+	var priceMarkets types.PriceMarkets
+	paramstore.Get(ctx, types.KeyPriceMarkets, &priceMarkets)
+	require.Equal(t, types.DefaultPriceMarkets, priceMarkets)
+}
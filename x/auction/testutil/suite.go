@@ -61,9 +61,15 @@ func (suite *Suite) SetupTest(numAddrs int) {
 		types.DefaultIncrement,
 		types.DefaultIncrement,
 		types.DefaultIncrement,
+		types.DefaultDutchAuctionStartPremium,
+		types.DefaultDutchAuctionDuration,
+		types.DefaultBidHistoryLength,
+		types.DefaultBidHistoryRetention,
+		types.DefaultBidRules,
+		types.DefaultPriceMarkets,
 	)
 
-	auctionGs, err := types.NewGenesisState(types.DefaultNextAuctionID, params, []types.GenesisAuction{})
+	auctionGs, err := types.NewGenesisState(types.DefaultNextAuctionID, params, []types.GenesisAuction{}, []types.BidHistory{})
 	suite.Require().NoError(err)
 
 	moduleGs := tApp.AppCodec().MustMarshalJSON(auctionGs)
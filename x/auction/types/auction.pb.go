@@ -159,12 +159,18 @@ var xxx_messageInfo_DebtAuction proto.InternalMessageInfo
 // Initially, in forward auction phase, bids can be placed up to a max bid.
 // Then it switches to a reverse auction phase, where the initial amount up for auction is bid down.
 // Unsold Lot is sent to LotReturns, being divided among the addresses by weight.
+// During the forward phase, part of the lot can be bought outright for a proportional share of the max bid,
+// leaving the remaining lot and debt up for auction.
 // Collateral auctions are normally used to sell off collateral seized from CDPs.
 type CollateralAuction struct {
 	BaseAuction       `protobuf:"bytes,1,opt,name=base_auction,json=baseAuction,proto3,embedded=base_auction" json:"base_auction"`
 	CorrespondingDebt types.Coin        `protobuf:"bytes,2,opt,name=corresponding_debt,json=correspondingDebt,proto3" json:"corresponding_debt"`
 	MaxBid            types.Coin        `protobuf:"bytes,3,opt,name=max_bid,json=maxBid,proto3" json:"max_bid"`
 	LotReturns        WeightedAddresses `protobuf:"bytes,4,opt,name=lot_returns,json=lotReturns,proto3" json:"lot_returns"`
+	// filled_lot is the total amount of the lot sold through partial fills
+	FilledLot github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=filled_lot,json=filledLot,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"filled_lot"`
+	// filled_bid is the total amount paid for partial fills, it is deducted from max bid
+	FilledBid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=filled_bid,json=filledBid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"filled_bid"`
 }
 
 func (m *CollateralAuction) Reset()         { *m = CollateralAuction{} }
@@ -200,6 +206,54 @@ func (m *CollateralAuction) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CollateralAuction proto.InternalMessageInfo
 
+// DutchCollateralAuction is a descending price auction.
+// The price of the lot starts at a premium above the price that raises max bid from the whole lot, and decays
+// linearly to zero at max end time. Any bidder can buy all or part of the remaining lot at the current price.
+// The auction closes once max bid is raised or the lot is sold, with unsold Lot sent to LotReturns.
+// Dutch collateral auctions are an alternative to collateral auctions for selling off collateral seized from CDPs.
+type DutchCollateralAuction struct {
+	BaseAuction       `protobuf:"bytes,1,opt,name=base_auction,json=baseAuction,proto3,embedded=base_auction" json:"base_auction"`
+	CorrespondingDebt types.Coin        `protobuf:"bytes,2,opt,name=corresponding_debt,json=correspondingDebt,proto3" json:"corresponding_debt"`
+	MaxBid            types.Coin        `protobuf:"bytes,3,opt,name=max_bid,json=maxBid,proto3" json:"max_bid"`
+	LotReturns        WeightedAddresses `protobuf:"bytes,4,opt,name=lot_returns,json=lotReturns,proto3" json:"lot_returns"`
+	// start_price is the price of one unit of the lot, in units of the bid denom, at the start of the auction
+	StartPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=start_price,json=startPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"start_price"`
+	StartTime  time.Time                              `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+}
+
+func (m *DutchCollateralAuction) Reset()         { *m = DutchCollateralAuction{} }
+func (m *DutchCollateralAuction) String() string { return proto.CompactTextString(m) }
+func (*DutchCollateralAuction) ProtoMessage()    {}
+func (*DutchCollateralAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9b5dac2c776ef9e, []int{4}
+}
+func (m *DutchCollateralAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DutchCollateralAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DutchCollateralAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DutchCollateralAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutchCollateralAuction.Merge(m, src)
+}
+func (m *DutchCollateralAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DutchCollateralAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutchCollateralAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutchCollateralAuction proto.InternalMessageInfo
+
 // WeightedAddresses is a type for storing some addresses and associated weights.
 type WeightedAddresses struct {
 	Addresses []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,rep,name=addresses,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"addresses,omitempty"`
@@ -210,7 +264,7 @@ func (m *WeightedAddresses) Reset()         { *m = WeightedAddresses{} }
 func (m *WeightedAddresses) String() string { return proto.CompactTextString(m) }
 func (*WeightedAddresses) ProtoMessage()    {}
 func (*WeightedAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b9b5dac2c776ef9e, []int{4}
+	return fileDescriptor_b9b5dac2c776ef9e, []int{5}
 }
 func (m *WeightedAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,12 +293,105 @@ func (m *WeightedAddresses) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WeightedAddresses proto.InternalMessageInfo
 
+// BidRecord is a bid placed on an auction, along with the state of the auction after the bid.
+type BidRecord struct {
+	Bidder github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=bidder,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"bidder,omitempty"`
+	// amount is the amount placed by the bid, in either the bid or lot denom
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	Bid    types.Coin `protobuf:"bytes,3,opt,name=bid,proto3" json:"bid"`
+	Lot    types.Coin `protobuf:"bytes,4,opt,name=lot,proto3" json:"lot"`
+	Height int64      `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time  `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *BidRecord) Reset()         { *m = BidRecord{} }
+func (m *BidRecord) String() string { return proto.CompactTextString(m) }
+func (*BidRecord) ProtoMessage()    {}
+func (*BidRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9b5dac2c776ef9e, []int{6}
+}
+func (m *BidRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BidRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidRecord.Merge(m, src)
+}
+func (m *BidRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidRecord proto.InternalMessageInfo
+
+// BidHistory is the log of the most recent bids placed on an auction.
+// It is kept after the auction closes, until it is pruned after the bid history retention period.
+type BidHistory struct {
+	AuctionID   uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	AuctionType string `protobuf:"bytes,2,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	// bids are the most recent bids, oldest first, up to the bid history length
+	Bids []BidRecord `protobuf:"bytes,3,rep,name=bids,proto3" json:"bids"`
+	// bidders are all the addresses that have bid on the auction
+	Bidders []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=bidders,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"bidders,omitempty"`
+	// close_time is when the auction closed, it is unset while the auction is open
+	CloseTime time.Time `protobuf:"bytes,5,opt,name=close_time,json=closeTime,proto3,stdtime" json:"close_time"`
+}
+
+func (m *BidHistory) Reset()         { *m = BidHistory{} }
+func (m *BidHistory) String() string { return proto.CompactTextString(m) }
+func (*BidHistory) ProtoMessage()    {}
+func (*BidHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9b5dac2c776ef9e, []int{7}
+}
+func (m *BidHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BidHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidHistory.Merge(m, src)
+}
+func (m *BidHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidHistory proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseAuction)(nil), "kava.auction.v1beta1.BaseAuction")
 	proto.RegisterType((*SurplusAuction)(nil), "kava.auction.v1beta1.SurplusAuction")
 	proto.RegisterType((*DebtAuction)(nil), "kava.auction.v1beta1.DebtAuction")
 	proto.RegisterType((*CollateralAuction)(nil), "kava.auction.v1beta1.CollateralAuction")
+	proto.RegisterType((*DutchCollateralAuction)(nil), "kava.auction.v1beta1.DutchCollateralAuction")
 	proto.RegisterType((*WeightedAddresses)(nil), "kava.auction.v1beta1.WeightedAddresses")
+	proto.RegisterType((*BidRecord)(nil), "kava.auction.v1beta1.BidRecord")
+	proto.RegisterType((*BidHistory)(nil), "kava.auction.v1beta1.BidHistory")
 }
 
 func init() {
@@ -252,49 +399,65 @@ func init() {
 }
 
 var fileDescriptor_b9b5dac2c776ef9e = []byte{
-	// 657 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0xce, 0x24, 0xbd, 0x49, 0x3a, 0xae, 0xee, 0x55, 0xe6, 0x56, 0xc8, 0xad, 0x90, 0x1d, 0xba,
-	0x80, 0x80, 0x14, 0x5b, 0x2d, 0x1b, 0xc4, 0x06, 0xd5, 0x2d, 0xd0, 0x6e, 0xba, 0x30, 0x48, 0x48,
-	0x6c, 0xcc, 0xd8, 0x33, 0x75, 0x46, 0xb5, 0x3d, 0x91, 0x67, 0x52, 0xd2, 0xb7, 0xe8, 0xc3, 0x74,
-	0xc5, 0x1e, 0xa9, 0xaa, 0x84, 0x54, 0xb1, 0x42, 0x2c, 0x02, 0xa4, 0x6f, 0xc1, 0x0a, 0x8d, 0x3d,
-	0x6e, 0x1b, 0xd1, 0x45, 0x90, 0x60, 0xc1, 0x2a, 0x3e, 0xdf, 0x9c, 0xf3, 0x7d, 0xe7, 0x37, 0x70,
-	0xed, 0x00, 0x1f, 0x62, 0x17, 0x8f, 0x22, 0xc9, 0x78, 0xe6, 0x1e, 0xae, 0x87, 0x54, 0xe2, 0xf5,
-	0xca, 0x76, 0x86, 0x39, 0x97, 0x1c, 0x2d, 0x2b, 0x1f, 0xa7, 0xc2, 0xb4, 0xcf, 0xaa, 0x15, 0x71,
-	0x91, 0x72, 0xe1, 0x86, 0x58, 0xd0, 0xcb, 0xc0, 0x88, 0x33, 0x1d, 0xb5, 0xba, 0x52, 0xbe, 0x07,
-	0x85, 0xe5, 0x96, 0x86, 0x7e, 0x5a, 0x8e, 0x79, 0xcc, 0x4b, 0x5c, 0x7d, 0x69, 0xd4, 0x8e, 0x39,
-	0x8f, 0x13, 0xea, 0x16, 0x56, 0x38, 0xda, 0x77, 0x25, 0x4b, 0xa9, 0x90, 0x38, 0x1d, 0x96, 0x0e,
-	0x6b, 0x1f, 0x1a, 0xd0, 0xf0, 0xb0, 0xa0, 0x9b, 0x65, 0x26, 0xe8, 0x16, 0xac, 0x33, 0x62, 0x82,
-	0x2e, 0xe8, 0x2d, 0x78, 0xcd, 0xe9, 0xc4, 0xae, 0xef, 0x6e, 0xfb, 0x75, 0x46, 0xd0, 0x6d, 0xb8,
-	0xc8, 0x32, 0x26, 0x19, 0x96, 0x3c, 0x37, 0xeb, 0x5d, 0xd0, 0x5b, 0xf4, 0xaf, 0x00, 0xb4, 0x0e,
-	0x1b, 0x09, 0x97, 0x66, 0xa3, 0x0b, 0x7a, 0xc6, 0xc6, 0x8a, 0xa3, 0x13, 0x53, 0x55, 0x54, 0xa5,
-	0x39, 0x5b, 0x9c, 0x65, 0xde, 0xc2, 0xe9, 0xc4, 0xae, 0xf9, 0xca, 0x17, 0xbd, 0x81, 0xcd, 0x90,
-	0x11, 0x42, 0x73, 0x73, 0xa1, 0x0b, 0x7a, 0x4b, 0xde, 0xce, 0xf7, 0x89, 0xdd, 0x8f, 0x99, 0x1c,
-	0x8c, 0x42, 0x27, 0xe2, 0xa9, 0x2e, 0x4e, 0xff, 0xf4, 0x05, 0x39, 0x70, 0xe5, 0xd1, 0x90, 0x0a,
-	0x67, 0x33, 0x8a, 0x36, 0x09, 0xc9, 0xa9, 0x10, 0x1f, 0x4f, 0xfa, 0xff, 0x6b, 0x25, 0x8d, 0x78,
-	0x47, 0x92, 0x0a, 0x5f, 0xf3, 0xaa, 0xa4, 0x42, 0x46, 0xcc, 0x7f, 0xe6, 0x4c, 0x2a, 0x64, 0x04,
-	0x3d, 0x80, 0x9d, 0x01, 0x16, 0x41, 0x4e, 0x23, 0xca, 0x0e, 0x29, 0x09, 0x42, 0x46, 0x84, 0xd9,
-	0xec, 0x82, 0x5e, 0xdb, 0xff, 0x6f, 0x80, 0x85, 0xaf, 0x71, 0x8f, 0x11, 0x81, 0x9e, 0xc0, 0x36,
-	0xcd, 0x48, 0xa0, 0x1a, 0x6a, 0xb6, 0x0a, 0x8d, 0x55, 0xa7, 0xec, 0xb6, 0x53, 0x75, 0xdb, 0x79,
-	0x59, 0x75, 0xdb, 0x6b, 0x2b, 0x91, 0xe3, 0x2f, 0x36, 0xf0, 0x5b, 0x34, 0x23, 0x0a, 0x47, 0xcf,
-	0xe0, 0x52, 0x8a, 0xc7, 0xc1, 0x25, 0x49, 0xfb, 0x17, 0x48, 0x60, 0x8a, 0xc7, 0x4f, 0x4b, 0x9e,
-	0xc7, 0xc6, 0xd9, 0x49, 0xbf, 0xa5, 0xe7, 0xb7, 0x96, 0xc2, 0x7f, 0x5f, 0x8c, 0xf2, 0x61, 0x32,
-	0x12, 0xd5, 0x44, 0xf7, 0xe0, 0x92, 0xaa, 0x39, 0xd0, 0xbb, 0x56, 0xcc, 0xd6, 0xd8, 0xb8, 0xe3,
-	0xdc, 0xb4, 0x80, 0xce, 0xb5, 0x55, 0x28, 0xd5, 0xce, 0x27, 0x36, 0xf0, 0x8d, 0xf0, 0x0a, 0x9e,
-	0x95, 0x7b, 0x07, 0xa0, 0xb1, 0x4d, 0x43, 0xf9, 0x87, 0xc4, 0xd0, 0x1e, 0x44, 0x11, 0xcf, 0x73,
-	0x2a, 0x86, 0x3c, 0x23, 0x2c, 0x8b, 0x03, 0x42, 0x43, 0x59, 0xec, 0xdf, 0x1c, 0x23, 0xed, 0xcc,
-	0x84, 0xaa, 0x34, 0x67, 0x93, 0x3f, 0xab, 0xc3, 0xce, 0x16, 0x4f, 0x12, 0x2c, 0x69, 0x8e, 0x93,
-	0xbf, 0xa4, 0x04, 0xf4, 0x08, 0xb6, 0xd4, 0xda, 0xa8, 0xd5, 0x9e, 0xf3, 0xde, 0x9a, 0x29, 0x1e,
-	0x7b, 0x8c, 0xa0, 0x3d, 0x68, 0x24, 0x5c, 0x06, 0x39, 0x95, 0xa3, 0x3c, 0x13, 0xc5, 0xdd, 0x19,
-	0x1b, 0xf7, 0x6e, 0x2e, 0xec, 0x15, 0x65, 0xf1, 0x40, 0x52, 0xa2, 0x2f, 0x8b, 0x0a, 0xcd, 0x05,
-	0x13, 0x2e, 0xfd, 0x92, 0x60, 0xb6, 0x99, 0xef, 0x01, 0xec, 0xfc, 0x14, 0x84, 0xf6, 0xe1, 0x22,
-	0xae, 0x0c, 0x13, 0x74, 0x1b, 0xbf, 0xf5, 0xd0, 0xaf, 0xa8, 0xd1, 0x0e, 0x6c, 0xbd, 0x2d, 0xc4,
-	0x85, 0x59, 0x2f, 0x54, 0x1c, 0x95, 0xed, 0xe7, 0x89, 0x7d, 0x77, 0x0e, 0xa5, 0xdd, 0x4c, 0xfa,
-	0x55, 0xb8, 0xf7, 0xfc, 0xf4, 0x9b, 0x55, 0x3b, 0x9d, 0x5a, 0xe0, 0x7c, 0x6a, 0x81, 0xaf, 0x53,
-	0x0b, 0x1c, 0x5f, 0x58, 0xb5, 0xf3, 0x0b, 0xab, 0xf6, 0xe9, 0xc2, 0xaa, 0xbd, 0xbe, 0x7f, 0x8d,
-	0x4e, 0xf5, 0xad, 0x9f, 0xe0, 0x50, 0x14, 0x5f, 0xee, 0xf8, 0xf2, 0x1f, 0xbf, 0x60, 0x0d, 0x9b,
-	0xc5, 0x01, 0x3f, 0xfc, 0x11, 0x00, 0x00, 0xff, 0xff, 0x87, 0x6d, 0x9c, 0x17, 0x0e, 0x06, 0x00,
-	0x00,
+	// 918 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xe3, 0x34, 0x69, 0x9e, 0x03, 0xa8, 0xc3, 0xaa, 0xf2, 0x56, 0x28, 0xce, 0xe6, 0x00,
+	0x01, 0x11, 0x87, 0x96, 0x03, 0x0b, 0x17, 0x54, 0x37, 0x40, 0x2b, 0xa1, 0x82, 0xcc, 0x4a, 0x48,
+	0x5c, 0xcc, 0xd8, 0x33, 0x4d, 0x86, 0x75, 0x3c, 0x91, 0x67, 0x52, 0xda, 0x6f, 0xb1, 0x9f, 0x81,
+	0x23, 0xe7, 0x3d, 0x71, 0xe3, 0x80, 0x54, 0x21, 0x21, 0x55, 0x9c, 0x10, 0x87, 0x2c, 0xa4, 0xe2,
+	0x4b, 0x70, 0x42, 0x33, 0x1e, 0xf7, 0x8f, 0x76, 0x41, 0x09, 0xda, 0x1e, 0x90, 0x38, 0x25, 0xef,
+	0xf9, 0xbd, 0xdf, 0xfb, 0xf7, 0x7b, 0x33, 0x03, 0xdd, 0x87, 0xf8, 0x18, 0x0f, 0xf0, 0x2c, 0x91,
+	0x8c, 0x67, 0x83, 0xe3, 0xed, 0x98, 0x4a, 0xbc, 0x5d, 0xca, 0xfe, 0x34, 0xe7, 0x92, 0xa3, 0x3b,
+	0xca, 0xc6, 0x2f, 0x75, 0xc6, 0x66, 0xab, 0x9d, 0x70, 0x31, 0xe1, 0x62, 0x10, 0x63, 0x41, 0x2f,
+	0x1d, 0x13, 0xce, 0x8c, 0xd7, 0xd6, 0xdd, 0xe2, 0x7b, 0xa4, 0xa5, 0x41, 0x21, 0x98, 0x4f, 0x77,
+	0x46, 0x7c, 0xc4, 0x0b, 0xbd, 0xfa, 0x67, 0xb4, 0xde, 0x88, 0xf3, 0x51, 0x4a, 0x07, 0x5a, 0x8a,
+	0x67, 0x47, 0x03, 0xc9, 0x26, 0x54, 0x48, 0x3c, 0x99, 0x16, 0x06, 0xdd, 0x9f, 0x6c, 0x70, 0x02,
+	0x2c, 0xe8, 0x6e, 0x91, 0x09, 0xda, 0x84, 0x2a, 0x23, 0xae, 0xd5, 0xb1, 0x7a, 0xb5, 0xa0, 0xbe,
+	0x98, 0x7b, 0xd5, 0x83, 0x61, 0x58, 0x65, 0x04, 0xbd, 0x02, 0x4d, 0x96, 0x31, 0xc9, 0xb0, 0xe4,
+	0xb9, 0x5b, 0xed, 0x58, 0xbd, 0x66, 0x78, 0xa5, 0x40, 0xdb, 0x60, 0xa7, 0x5c, 0xba, 0x76, 0xc7,
+	0xea, 0x39, 0x3b, 0x77, 0x7d, 0x93, 0x98, 0xaa, 0xa2, 0x2c, 0xcd, 0xdf, 0xe3, 0x2c, 0x0b, 0x6a,
+	0x67, 0x73, 0xaf, 0x12, 0x2a, 0x5b, 0xf4, 0x25, 0xd4, 0x63, 0x46, 0x08, 0xcd, 0xdd, 0x5a, 0xc7,
+	0xea, 0xb5, 0x82, 0xfd, 0x3f, 0xe7, 0x5e, 0x7f, 0xc4, 0xe4, 0x78, 0x16, 0xfb, 0x09, 0x9f, 0x98,
+	0xe2, 0xcc, 0x4f, 0x5f, 0x90, 0x87, 0x03, 0x79, 0x3a, 0xa5, 0xc2, 0xdf, 0x4d, 0x92, 0x5d, 0x42,
+	0x72, 0x2a, 0xc4, 0xcf, 0x8f, 0xfb, 0x2f, 0x9b, 0x48, 0x46, 0x13, 0x9c, 0x4a, 0x2a, 0x42, 0x83,
+	0xab, 0x92, 0x8a, 0x19, 0x71, 0xd7, 0x96, 0x4c, 0x2a, 0x66, 0x04, 0xbd, 0x01, 0x1b, 0x63, 0x2c,
+	0xa2, 0x9c, 0x26, 0x94, 0x1d, 0x53, 0x12, 0xc5, 0x8c, 0x08, 0xb7, 0xde, 0xb1, 0x7a, 0xeb, 0xe1,
+	0x4b, 0x63, 0x2c, 0x42, 0xa3, 0x0f, 0x18, 0x11, 0xe8, 0x7d, 0x58, 0xa7, 0x19, 0x89, 0x54, 0x43,
+	0xdd, 0x86, 0x8e, 0xb1, 0xe5, 0x17, 0xdd, 0xf6, 0xcb, 0x6e, 0xfb, 0x0f, 0xca, 0x6e, 0x07, 0xeb,
+	0x2a, 0xc8, 0xa3, 0x27, 0x9e, 0x15, 0x36, 0x68, 0x46, 0x94, 0x1e, 0x7d, 0x08, 0xad, 0x09, 0x3e,
+	0x89, 0x2e, 0x41, 0xd6, 0x57, 0x00, 0x81, 0x09, 0x3e, 0xf9, 0xa0, 0xc0, 0x79, 0xcf, 0xf9, 0xf1,
+	0x71, 0xbf, 0x61, 0xe6, 0xd7, 0x9d, 0xc0, 0x8b, 0x9f, 0xcd, 0xf2, 0x69, 0x3a, 0x13, 0xe5, 0x44,
+	0x0f, 0xa1, 0xa5, 0x6a, 0x8e, 0x0c, 0xd7, 0xf4, 0x6c, 0x9d, 0x9d, 0x7b, 0xfe, 0xb3, 0x08, 0xe8,
+	0x5f, 0xa3, 0x42, 0x11, 0xed, 0x7c, 0xee, 0x59, 0xa1, 0x13, 0x5f, 0xa9, 0x6f, 0x86, 0xfb, 0xce,
+	0x02, 0x67, 0x48, 0x63, 0x79, 0x4b, 0xc1, 0xd0, 0x21, 0xa0, 0x84, 0xe7, 0x39, 0x15, 0x53, 0x9e,
+	0x11, 0x96, 0x8d, 0x22, 0x42, 0x63, 0xe9, 0x56, 0x97, 0x1b, 0xe9, 0xc6, 0x0d, 0x57, 0x95, 0xe6,
+	0xcd, 0xe4, 0xbf, 0xa9, 0xc1, 0xc6, 0x1e, 0x4f, 0x53, 0x2c, 0x69, 0x8e, 0xd3, 0xff, 0x48, 0x09,
+	0xe8, 0x3e, 0x34, 0x14, 0x6d, 0x14, 0xb5, 0x97, 0xdc, 0xb7, 0xfa, 0x04, 0x9f, 0x04, 0x8c, 0xa0,
+	0x43, 0x70, 0x52, 0x2e, 0xa3, 0x9c, 0xca, 0x59, 0x9e, 0x09, 0xbd, 0x77, 0xce, 0xce, 0x6b, 0xcf,
+	0x2e, 0xec, 0x73, 0xca, 0x46, 0x63, 0x49, 0x89, 0xd9, 0x2c, 0x2a, 0x0c, 0x16, 0xa4, 0x5c, 0x86,
+	0x05, 0x00, 0xfa, 0x0a, 0xe0, 0x88, 0xa5, 0x29, 0x25, 0x91, 0x5a, 0xfe, 0xb5, 0x8e, 0xfd, 0xcf,
+	0xc9, 0xbc, 0xa5, 0x00, 0xbe, 0x7d, 0xe2, 0xf5, 0x96, 0xd8, 0x72, 0xe5, 0x20, 0xc2, 0x66, 0x01,
+	0xff, 0x31, 0x97, 0xd7, 0x62, 0xa9, 0xc2, 0xeb, 0xb7, 0x16, 0x2b, 0x60, 0xe4, 0x26, 0x49, 0xfe,
+	0xb0, 0x61, 0x73, 0x38, 0x93, 0xc9, 0xf8, 0x7f, 0xa6, 0xfc, 0x7b, 0xa6, 0x7c, 0x02, 0x8e, 0x90,
+	0x38, 0x97, 0xd1, 0x34, 0x67, 0x09, 0xd5, 0x47, 0x72, 0x2b, 0xf0, 0x95, 0xd9, 0xaf, 0x73, 0xef,
+	0xd5, 0x25, 0x66, 0x34, 0xa4, 0x49, 0x08, 0x1a, 0xe2, 0x53, 0x85, 0x80, 0xf6, 0xa0, 0x90, 0x8a,
+	0x93, 0xb3, 0xbe, 0xc2, 0xc9, 0xd9, 0xd4, 0x7e, 0x4f, 0x1f, 0x9c, 0x3f, 0x58, 0xb0, 0xf1, 0x54,
+	0x29, 0xe8, 0x08, 0x9a, 0xb8, 0x14, 0x5c, 0xab, 0x63, 0x3f, 0xd7, 0x8b, 0xea, 0x0a, 0x1a, 0xed,
+	0x43, 0xe3, 0x6b, 0x1d, 0x5c, 0xb8, 0xd5, 0x8e, 0xbd, 0x62, 0x73, 0x0e, 0x32, 0x19, 0x96, 0xee,
+	0xdd, 0x79, 0x15, 0x9a, 0x01, 0x23, 0x21, 0x4d, 0x78, 0x4e, 0xae, 0xdd, 0xb2, 0xd6, 0x2d, 0xdd,
+	0xb2, 0xef, 0x40, 0x1d, 0x4f, 0xf8, 0x2c, 0x5b, 0x9a, 0xa8, 0xc6, 0xbc, 0xbc, 0x9e, 0xed, 0x15,
+	0xae, 0x67, 0xf3, 0xcc, 0xa8, 0xad, 0xf0, 0xcc, 0xd8, 0x84, 0xfa, 0x58, 0x77, 0x46, 0x93, 0xce,
+	0x0e, 0x8d, 0x84, 0xee, 0x43, 0x6d, 0x65, 0xea, 0x68, 0x8f, 0xee, 0xf7, 0x55, 0x80, 0x80, 0x91,
+	0x7d, 0x26, 0x24, 0xcf, 0x4f, 0xd1, 0x9b, 0x00, 0x66, 0x23, 0xa2, 0xcb, 0x87, 0xd3, 0x0b, 0x8b,
+	0xb9, 0xd7, 0x34, 0xc4, 0x3a, 0x18, 0x86, 0x4d, 0x63, 0x70, 0x40, 0xd0, 0x3d, 0x68, 0x95, 0xd6,
+	0xaa, 0xd1, 0xe6, 0x25, 0xe5, 0x18, 0xdd, 0x83, 0xd3, 0x29, 0x45, 0xef, 0x42, 0x4d, 0x3f, 0x3b,
+	0x6c, 0x7d, 0xc6, 0x79, 0x7f, 0x73, 0x9a, 0x94, 0x13, 0x36, 0xb5, 0x6a, 0x17, 0x14, 0x43, 0xa3,
+	0x98, 0x8a, 0x5a, 0xd9, 0xe7, 0xcb, 0xd5, 0x12, 0x58, 0x6d, 0x5e, 0x92, 0x72, 0x41, 0x8b, 0xcd,
+	0x5b, 0x5b, 0x65, 0xf3, 0xb4, 0x9f, 0xfa, 0x12, 0x7c, 0x74, 0xf6, 0x7b, 0xbb, 0x72, 0xb6, 0x68,
+	0x5b, 0xe7, 0x8b, 0xb6, 0xf5, 0xdb, 0xa2, 0x6d, 0x3d, 0xba, 0x68, 0x57, 0xce, 0x2f, 0xda, 0x95,
+	0x5f, 0x2e, 0xda, 0x95, 0x2f, 0x5e, 0xbf, 0x96, 0xb1, 0xaa, 0xbe, 0x9f, 0xe2, 0x58, 0xe8, 0x7f,
+	0x83, 0x93, 0xcb, 0x67, 0xb5, 0x4e, 0x3c, 0xae, 0xeb, 0x88, 0x6f, 0xff, 0x35, 0x00, 0x16, 0xe7,
+	0x1b, 0xd9, 0x73, 0x0b, 0x00, 0x00,
 }
 
 func (m *BaseAuction) Marshal() (dAtA []byte, err error) {
@@ -481,6 +644,115 @@ func (m *CollateralAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FilledBid) > 0 {
+		for iNdEx := len(m.FilledBid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FilledBid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FilledLot) > 0 {
+		for iNdEx := len(m.FilledLot) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FilledLot[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.LotReturns.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MaxBid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CorrespondingDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BaseAuction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DutchCollateralAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DutchCollateralAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DutchCollateralAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintAuction(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.StartPrice.Size()
+		i -= size
+		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size, err := m.LotReturns.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -570,63 +842,202 @@ func (m *WeightedAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAuction(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuction(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BidRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BaseAuction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *BidRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovAuction(uint64(m.ID))
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err17 != nil {
+		return 0, err17
 	}
-	l = len(m.Initiator)
-	if l > 0 {
-		n += 1 + l + sovAuction(uint64(l))
+	i -= n17
+	i = encodeVarintAuction(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x32
+	if m.Height != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = m.Lot.Size()
-	n += 1 + l + sovAuction(uint64(l))
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovAuction(uint64(l))
+	{
+		size, err := m.Lot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
 	}
-	l = m.Bid.Size()
-	n += 1 + l + sovAuction(uint64(l))
-	if m.HasReceivedBids {
-		n += 2
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovAuction(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MaxEndTime)
-	n += 1 + l + sovAuction(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SurplusAuction) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BidHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.BaseAuction.Size()
-	n += 1 + l + sovAuction(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DebtAuction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BidHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CloseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CloseTime):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintAuction(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Bidders) > 0 {
+		for iNdEx := len(m.Bidders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Bidders[iNdEx])
+			copy(dAtA[i:], m.Bidders[iNdEx])
+			i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidders[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AuctionType) > 0 {
+		i -= len(m.AuctionType)
+		copy(dAtA[i:], m.AuctionType)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.AuctionType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionID != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AuctionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BaseAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovAuction(uint64(m.ID))
+	}
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Lot.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Bid.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.HasReceivedBids {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovAuction(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MaxEndTime)
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *SurplusAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseAuction.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *DebtAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = m.BaseAuction.Size()
@@ -650,6 +1061,39 @@ func (m *CollateralAuction) Size() (n int) {
 	n += 1 + l + sovAuction(uint64(l))
 	l = m.LotReturns.Size()
 	n += 1 + l + sovAuction(uint64(l))
+	if len(m.FilledLot) > 0 {
+		for _, e := range m.FilledLot {
+			l = e.Size()
+			n += 1 + l + sovAuction(uint64(l))
+		}
+	}
+	if len(m.FilledBid) > 0 {
+		for _, e := range m.FilledBid {
+			l = e.Size()
+			n += 1 + l + sovAuction(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DutchCollateralAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseAuction.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.CorrespondingDebt.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.MaxBid.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.LotReturns.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.StartPrice.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovAuction(uint64(l))
 	return n
 }
 
@@ -674,6 +1118,60 @@ func (m *WeightedAddresses) Size() (n int) {
 	return n
 }
 
+func (m *BidRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.Bid.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.Lot.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovAuction(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *BidHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionID != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionID))
+	}
+	l = len(m.AuctionType)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovAuction(uint64(l))
+		}
+	}
+	if len(m.Bidders) > 0 {
+		for _, b := range m.Bidders {
+			l = len(b)
+			n += 1 + l + sovAuction(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CloseTime)
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
 func sovAuction(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -952,53 +1450,667 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SurplusAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SurplusAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DebtAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DebtAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DebtAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrespondingDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CorrespondingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollateralAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollateralAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrespondingDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CorrespondingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotReturns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LotReturns.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledLot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilledLot = append(m.FilledLot, types.Coin{})
+			if err := m.FilledLot[len(m.FilledLot)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilledBid = append(m.FilledBid, types.Coin{})
+			if err := m.FilledBid[len(m.FilledBid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutchCollateralAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutchCollateralAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutchCollateralAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrespondingDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CorrespondingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotReturns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LotReturns.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuction
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SurplusAuction: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SurplusAuction: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1025,7 +2137,7 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1050,7 +2162,7 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DebtAuction) Unmarshal(dAtA []byte) error {
+func (m *WeightedAddresses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1073,17 +2185,17 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DebtAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightedAddresses: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DebtAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightedAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1093,30 +2205,29 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
+			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CorrespondingDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1126,22 +2237,24 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CorrespondingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Weights = append(m.Weights, v)
+			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1166,7 +2279,7 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
+func (m *BidRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1189,15 +2302,49 @@ func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CollateralAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: BidRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollateralAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BidRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = append(m.Bidder[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bidder == nil {
+				m.Bidder = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1224,13 +2371,13 @@ func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CorrespondingDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1257,13 +2404,13 @@ func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CorrespondingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1290,13 +2437,32 @@ func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LotReturns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1323,7 +2489,7 @@ func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LotReturns.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1348,7 +2514,7 @@ func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WeightedAddresses) Unmarshal(dAtA []byte) error {
+func (m *BidHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1371,17 +2537,36 @@ func (m *WeightedAddresses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WeightedAddresses: wiretype end group for non-group")
+			return fmt.Errorf("proto: BidHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightedAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BidHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionID", wireType)
+			}
+			m.AuctionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1391,27 +2576,61 @@ func (m *WeightedAddresses) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
-			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			m.AuctionType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, BidRecord{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidders", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1438,9 +2657,39 @@ func (m *WeightedAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.Weights = append(m.Weights, v)
-			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bidders = append(m.Bidders, make([]byte, postIndex-iNdEx))
+			copy(m.Bidders[len(m.Bidders)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CloseTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
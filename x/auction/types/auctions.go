@@ -13,11 +13,13 @@ import (
 )
 
 const (
-	CollateralAuctionType = "collateral"
-	SurplusAuctionType    = "surplus"
-	DebtAuctionType       = "debt"
-	ForwardAuctionPhase   = "forward"
-	ReverseAuctionPhase   = "reverse"
+	CollateralAuctionType      = "collateral"
+	DutchCollateralAuctionType = "dutch_collateral"
+	SurplusAuctionType         = "surplus"
+	DebtAuctionType            = "debt"
+	ForwardAuctionPhase        = "forward"
+	ReverseAuctionPhase        = "reverse"
+	DutchAuctionPhase          = "dutch"
 )
 
 // DistantFuture is a very large time value to use as initial the ending time for auctions.
@@ -32,6 +34,8 @@ var (
 	_ GenesisAuction = &DebtAuction{}
 	_ Auction        = &CollateralAuction{}
 	_ GenesisAuction = &CollateralAuction{}
+	_ Auction        = &DutchCollateralAuction{}
+	_ GenesisAuction = &DutchCollateralAuction{}
 )
 
 // --------------- Shared auction functionality ---------------
@@ -247,6 +251,113 @@ func (a CollateralAuction) Validate() error {
 	if err := a.LotReturns.Validate(); err != nil {
 		return fmt.Errorf("invalid lot returns: %w", err)
 	}
+	if err := a.FilledLot.Validate(); err != nil {
+		return fmt.Errorf("invalid filled lot: %w", err)
+	}
+	if err := a.FilledBid.Validate(); err != nil {
+		return fmt.Errorf("invalid filled bid: %w", err)
+	}
+	return ValidateAuction(&a)
+}
+
+// ValidateCollateralAuctionType checks that an auction type can be used by other modules to sell collateral.
+func ValidateCollateralAuctionType(auctionType string) error {
+	if auctionType != CollateralAuctionType && auctionType != DutchCollateralAuctionType {
+		return fmt.Errorf("invalid collateral auction type: %s", auctionType)
+	}
+	return nil
+}
+
+// --------------- DutchCollateralAuction ---------------
+
+// NewDutchCollateralAuction returns a new dutch collateral auction.
+func NewDutchCollateralAuction(
+	seller string, lot sdk.Coin, startTime, endTime time.Time, startPrice sdk.Dec, maxBid sdk.Coin,
+	lotReturns WeightedAddresses, debt sdk.Coin,
+) DutchCollateralAuction {
+	auction := DutchCollateralAuction{
+		BaseAuction: BaseAuction{
+			// no ID
+			Initiator:       seller,
+			Lot:             lot,
+			Bidder:          nil,
+			Bid:             sdk.NewInt64Coin(maxBid.Denom, 0),
+			HasReceivedBids: false, // new auctions don't have any bids
+			EndTime:         endTime,
+			MaxEndTime:      endTime,
+		},
+		CorrespondingDebt: debt,
+		MaxBid:            maxBid,
+		LotReturns:        lotReturns,
+		StartPrice:        startPrice,
+		StartTime:         startTime,
+	}
+	return auction
+}
+
+func (a DutchCollateralAuction) WithID(id uint64) Auction {
+	a.ID = id
+	return Auction(&a)
+}
+
+// GetType returns the auction type. Used to identify auctions in event attributes.
+func (a DutchCollateralAuction) GetType() string { return DutchCollateralAuctionType }
+
+// GetPhase returns the direction of a dutch collateral auction, which never changes.
+func (a DutchCollateralAuction) GetPhase() string { return DutchAuctionPhase }
+
+// GetLotReturns returns the auction's lot returns as weighted addresses
+func (a DutchCollateralAuction) GetLotReturns() WeightedAddresses { return a.LotReturns }
+
+// CurrentPrice returns the price of one unit of the lot at a time, in units of the bid denom.
+// The price decays linearly from the start price at the start time to zero at the max end time.
+func (a DutchCollateralAuction) CurrentPrice(blockTime time.Time) sdk.Dec {
+	if !blockTime.After(a.StartTime) {
+		return a.StartPrice
+	}
+	if !blockTime.Before(a.MaxEndTime) {
+		return sdk.ZeroDec()
+	}
+	remaining := a.MaxEndTime.Sub(blockTime)
+	duration := a.MaxEndTime.Sub(a.StartTime)
+	return a.StartPrice.MulInt64(int64(remaining)).QuoInt64(int64(duration))
+}
+
+// IsComplete returns whether the max bid has been raised or the whole lot has been sold.
+func (a DutchCollateralAuction) IsComplete() bool {
+	return a.Bid.IsGTE(a.MaxBid) || a.Lot.IsZero()
+}
+
+// GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
+// It is used in genesis initialize the module account correctly.
+func (a DutchCollateralAuction) GetModuleAccountCoins() sdk.Coins {
+	// a.Bid is paid out on bids, so is never stored in the module account
+	return sdk.NewCoins(a.Lot).Add(sdk.NewCoins(a.CorrespondingDebt)...)
+}
+
+// Validate validates the DutchCollateralAuction fields values.
+func (a DutchCollateralAuction) Validate() error {
+	if !a.CorrespondingDebt.IsValid() {
+		return fmt.Errorf("invalid corresponding debt: %s", a.CorrespondingDebt)
+	}
+	if !a.MaxBid.IsValid() {
+		return fmt.Errorf("invalid max bid: %s", a.MaxBid)
+	}
+	if a.MaxBid.IsLT(a.Bid) {
+		return fmt.Errorf("bid is greater than max bid: %s > %s", a.Bid, a.MaxBid)
+	}
+	if err := a.LotReturns.Validate(); err != nil {
+		return fmt.Errorf("invalid lot returns: %w", err)
+	}
+	if a.StartPrice.IsNil() || !a.StartPrice.IsPositive() {
+		return fmt.Errorf("start price must be positive: %s", a.StartPrice)
+	}
+	if a.StartTime.Unix() <= 0 {
+		return errors.New("start time cannot be zero")
+	}
+	if !a.StartTime.Before(a.MaxEndTime) {
+		return fmt.Errorf("MaxEndTime ≤ StartTime (%s ≤ %s)", a.MaxEndTime, a.StartTime)
+	}
 	return ValidateAuction(&a)
 }
 
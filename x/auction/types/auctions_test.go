@@ -354,3 +354,135 @@ func TestNewCollateralAuction(t *testing.T) {
 	require.Equal(t, collateralAuction.LotReturns, weightedAddresses)
 	require.Equal(t, collateralAuction.CorrespondingDebt, c(TestDebtDenom, TestDebtAmount2))
 }
+
+func TestNewDutchCollateralAuction(t *testing.T) {
+	weightedAddresses, err := NewWeightedAddresses(
+		[]sdk.AccAddress{sdk.AccAddress([]byte(testAccAddress1))},
+		[]sdkmath.Int{sdkmath.NewInt(1)},
+	)
+	require.NoError(t, err)
+
+	startTime := time.Now()
+	endTime := startTime.Add(TestExtraEndTime)
+
+	dutchAuction := NewDutchCollateralAuction(
+		TestInitiatorModuleName,
+		c(TestLotDenom, TestLotAmount),
+		startTime,
+		endTime,
+		d("0.24"),
+		c(TestBidDenom, TestBidAmount),
+		weightedAddresses,
+		c(TestDebtDenom, TestDebtAmount2),
+	)
+
+	require.Equal(t, dutchAuction.Initiator, TestInitiatorModuleName)
+	require.Equal(t, dutchAuction.Lot, c(TestLotDenom, TestLotAmount))
+	require.Equal(t, dutchAuction.Bid, c(TestBidDenom, 0))
+	require.Equal(t, dutchAuction.StartTime, startTime)
+	require.Equal(t, dutchAuction.EndTime, endTime)
+	require.Equal(t, dutchAuction.MaxEndTime, endTime)
+	require.Equal(t, dutchAuction.StartPrice, d("0.24"))
+	require.Equal(t, dutchAuction.MaxBid, c(TestBidDenom, TestBidAmount))
+	require.Equal(t, dutchAuction.LotReturns, weightedAddresses)
+	require.Equal(t, dutchAuction.CorrespondingDebt, c(TestDebtDenom, TestDebtAmount2))
+	require.Equal(t, dutchAuction.GetPhase(), DutchAuctionPhase)
+	require.Equal(t, dutchAuction.GetType(), DutchCollateralAuctionType)
+}
+
+func TestDutchCollateralAuctionValidate(t *testing.T) {
+	addr1, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+
+	now := time.Now()
+	validAuction := DutchCollateralAuction{
+		BaseAuction: BaseAuction{
+			ID:              1,
+			Initiator:       testAccAddress1,
+			Lot:             c("usdx", 100),
+			Bidder:          addr1,
+			Bid:             c("kava", 1),
+			EndTime:         now.Add(time.Hour),
+			MaxEndTime:      now.Add(time.Hour),
+			HasReceivedBids: true,
+		},
+		CorrespondingDebt: c("debt", 1),
+		MaxBid:            c("kava", 10),
+		LotReturns: WeightedAddresses{
+			Addresses: []sdk.AccAddress{addr1},
+			Weights:   []sdkmath.Int{sdkmath.NewInt(1)},
+		},
+		StartPrice: d("0.12"),
+		StartTime:  now,
+	}
+
+	tests := []struct {
+		msg     string
+		modify  func(a *DutchCollateralAuction)
+		expPass bool
+	}{
+		{"valid auction", func(a *DutchCollateralAuction) {}, true},
+		{"invalid corresponding debt", func(a *DutchCollateralAuction) {
+			a.CorrespondingDebt = sdk.Coin{Denom: "debt", Amount: sdkmath.NewInt(-1)}
+		}, false},
+		{"invalid max bid", func(a *DutchCollateralAuction) { a.MaxBid = sdk.Coin{Denom: "kava", Amount: sdkmath.NewInt(-1)} }, false},
+		{"bid greater than max bid", func(a *DutchCollateralAuction) { a.Bid = c("kava", 11) }, false},
+		{"invalid lot returns", func(a *DutchCollateralAuction) { a.LotReturns.Addresses = []sdk.AccAddress{nil} }, false},
+		{"zero start price", func(a *DutchCollateralAuction) { a.StartPrice = sdk.ZeroDec() }, false},
+		{"nil start price", func(a *DutchCollateralAuction) { a.StartPrice = sdk.Dec{} }, false},
+		{"zero start time", func(a *DutchCollateralAuction) { a.StartTime = time.Time{} }, false},
+		{"max end time before start time", func(a *DutchCollateralAuction) { a.StartTime = now.Add(2 * time.Hour) }, false},
+	}
+
+	for _, tc := range tests {
+		auction := validAuction
+		auction.LotReturns = WeightedAddresses{
+			Addresses: []sdk.AccAddress{addr1},
+			Weights:   []sdkmath.Int{sdkmath.NewInt(1)},
+		}
+		tc.modify(&auction)
+
+		err := auction.Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
+
+func TestDutchCollateralAuctionCurrentPrice(t *testing.T) {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(10 * time.Hour)
+
+	auction := NewDutchCollateralAuction(
+		TestInitiatorModuleName,
+		c(TestLotDenom, TestLotAmount),
+		startTime,
+		endTime,
+		d("2.0"),
+		c(TestBidDenom, TestBidAmount),
+		WeightedAddresses{},
+		c(TestDebtDenom, TestDebtAmount2),
+	)
+
+	require.Equal(t, d("2.0"), auction.CurrentPrice(startTime.Add(-time.Hour)))
+	require.Equal(t, d("2.0"), auction.CurrentPrice(startTime))
+	require.Equal(t, d("1.8"), auction.CurrentPrice(startTime.Add(time.Hour)))
+	require.Equal(t, d("1.0"), auction.CurrentPrice(startTime.Add(5*time.Hour)))
+	require.Equal(t, d("0.2"), auction.CurrentPrice(endTime.Add(-time.Hour)))
+	require.Equal(t, sdk.ZeroDec(), auction.CurrentPrice(endTime))
+	require.Equal(t, sdk.ZeroDec(), auction.CurrentPrice(endTime.Add(time.Hour)))
+
+	// closing the auction early does not change the price decay
+	auction.EndTime = startTime.Add(time.Hour)
+	require.Equal(t, d("1.0"), auction.CurrentPrice(startTime.Add(5*time.Hour)))
+}
+
+func TestValidateCollateralAuctionType(t *testing.T) {
+	require.NoError(t, ValidateCollateralAuctionType(CollateralAuctionType))
+	require.NoError(t, ValidateCollateralAuctionType(DutchCollateralAuctionType))
+	require.EqualError(t, ValidateCollateralAuctionType(SurplusAuctionType), "invalid collateral auction type: surplus")
+	require.EqualError(t, ValidateCollateralAuctionType(""), "invalid collateral auction type: ")
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewBidRecord returns a new bid record.
+func NewBidRecord(bidder sdk.AccAddress, amount, bid, lot sdk.Coin, height int64, blockTime time.Time) BidRecord {
+	return BidRecord{
+		Bidder: bidder,
+		Amount: amount,
+		Bid:    bid,
+		Lot:    lot,
+		Height: height,
+		Time:   blockTime,
+	}
+}
+
+// Validate validates the BidRecord fields values.
+func (r BidRecord) Validate() error {
+	if r.Bidder.Empty() {
+		return fmt.Errorf("bidder cannot be empty")
+	}
+	if !r.Amount.IsValid() {
+		return fmt.Errorf("invalid amount: %s", r.Amount)
+	}
+	if !r.Bid.IsValid() {
+		return fmt.Errorf("invalid bid: %s", r.Bid)
+	}
+	if !r.Lot.IsValid() {
+		return fmt.Errorf("invalid lot: %s", r.Lot)
+	}
+	if r.Height < 0 {
+		return fmt.Errorf("height cannot be negative: %d", r.Height)
+	}
+	return nil
+}
+
+// NewBidHistory returns a new, empty bid history for an open auction.
+func NewBidHistory(auctionID uint64, auctionType string) BidHistory {
+	return BidHistory{
+		AuctionID:   auctionID,
+		AuctionType: auctionType,
+		Bids:        []BidRecord{},
+		Bidders:     []sdk.AccAddress{},
+	}
+}
+
+// IsClosed returns whether the auction of the bid history has closed.
+func (h BidHistory) IsClosed() bool {
+	return !h.CloseTime.IsZero()
+}
+
+// AddBid appends a bid to the history, dropping the oldest bids to keep at most maxLength bids.
+func (h *BidHistory) AddBid(record BidRecord, maxLength uint64) {
+	h.Bids = append(h.Bids, record)
+	if uint64(len(h.Bids)) > maxLength {
+		h.Bids = h.Bids[uint64(len(h.Bids))-maxLength:]
+	}
+	if !h.HasBidder(record.Bidder) {
+		h.Bidders = append(h.Bidders, record.Bidder)
+	}
+}
+
+// HasBidder returns whether an address has bid on the auction.
+func (h BidHistory) HasBidder(bidder sdk.AccAddress) bool {
+	for _, b := range h.Bidders {
+		if b.Equals(bidder) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate validates the BidHistory fields values.
+func (h BidHistory) Validate() error {
+	if h.AuctionID == 0 {
+		return fmt.Errorf("auction id cannot be zero")
+	}
+	for _, b := range h.Bids {
+		if err := b.Validate(); err != nil {
+			return fmt.Errorf("invalid bid record: %w", err)
+		}
+		if !h.HasBidder(b.Bidder) {
+			return fmt.Errorf("bidder %s missing from bidders", b.Bidder)
+		}
+	}
+	seen := make(map[string]bool, len(h.Bidders))
+	for _, b := range h.Bidders {
+		if b.Empty() {
+			return fmt.Errorf("bidder cannot be empty")
+		}
+		if seen[b.String()] {
+			return fmt.Errorf("duplicate bidder %s", b)
+		}
+		seen[b.String()] = true
+	}
+	return nil
+}
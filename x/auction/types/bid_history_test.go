@@ -0,0 +1,100 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBidHistoryAddBid(t *testing.T) {
+	addr1, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+	addr2, err := sdk.AccAddressFromBech32(testAccAddress2)
+	require.NoError(t, err)
+	now := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	history := NewBidHistory(TestAuctionID, CollateralAuctionType)
+	require.False(t, history.IsClosed())
+
+	history.AddBid(NewBidRecord(addr1, c(TestBidDenom, 10), c(TestBidDenom, 10), c(TestLotDenom, 100), 1, now), 2)
+	history.AddBid(NewBidRecord(addr2, c(TestBidDenom, 11), c(TestBidDenom, 11), c(TestLotDenom, 100), 2, now), 2)
+	history.AddBid(NewBidRecord(addr1, c(TestBidDenom, 12), c(TestBidDenom, 12), c(TestLotDenom, 100), 3, now), 2)
+
+	// only the most recent bids are kept, but all bidders are
+	require.Len(t, history.Bids, 2)
+	require.Equal(t, int64(2), history.Bids[0].Height)
+	require.Equal(t, int64(3), history.Bids[1].Height)
+	require.Equal(t, []sdk.AccAddress{addr1, addr2}, history.Bidders)
+	require.NoError(t, history.Validate())
+
+	// bids are not kept with a zero length
+	history.AddBid(NewBidRecord(addr2, c(TestBidDenom, 13), c(TestBidDenom, 13), c(TestLotDenom, 100), 4, now), 0)
+	require.Empty(t, history.Bids)
+	require.Len(t, history.Bidders, 2)
+
+	history.CloseTime = now
+	require.True(t, history.IsClosed())
+}
+
+func TestBidHistoryValidate(t *testing.T) {
+	addr1, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+	addr2, err := sdk.AccAddressFromBech32(testAccAddress2)
+	require.NoError(t, err)
+	now := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+	record := NewBidRecord(addr1, c(TestBidDenom, 10), c(TestBidDenom, 10), c(TestLotDenom, 100), 1, now)
+
+	tests := []struct {
+		name    string
+		history BidHistory
+		expPass bool
+	}{
+		{
+			"valid",
+			BidHistory{AuctionID: 1, Bids: []BidRecord{record}, Bidders: []sdk.AccAddress{addr1, addr2}},
+			true,
+		},
+		{
+			"valid without bids",
+			BidHistory{AuctionID: 1, Bidders: []sdk.AccAddress{addr1}},
+			true,
+		},
+		{
+			"zero auction id",
+			BidHistory{AuctionID: 0, Bids: []BidRecord{record}, Bidders: []sdk.AccAddress{addr1}},
+			false,
+		},
+		{
+			"bidder missing from bidders",
+			BidHistory{AuctionID: 1, Bids: []BidRecord{record}, Bidders: []sdk.AccAddress{addr2}},
+			false,
+		},
+		{
+			"duplicate bidder",
+			BidHistory{AuctionID: 1, Bids: []BidRecord{record}, Bidders: []sdk.AccAddress{addr1, addr1}},
+			false,
+		},
+		{
+			"invalid bid record",
+			BidHistory{
+				AuctionID: 1,
+				Bids:      []BidRecord{NewBidRecord(addr1, sdk.Coin{Denom: TestBidDenom, Amount: i(-1)}, c(TestBidDenom, 10), c(TestLotDenom, 100), 1, now)},
+				Bidders:   []sdk.AccAddress{addr1},
+			},
+			false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.history.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&SurplusAuction{}, "auction/SurplusAuction", nil)
 	cdc.RegisterConcrete(&DebtAuction{}, "auction/DebtAuction", nil)
 	cdc.RegisterConcrete(&CollateralAuction{}, "auction/CollateralAuction", nil)
+	cdc.RegisterConcrete(&DutchCollateralAuction{}, "auction/DutchCollateralAuction", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -32,6 +33,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&SurplusAuction{},
 		&DebtAuction{},
 		&CollateralAuction{},
+		&DutchCollateralAuction{},
 	)
 
 	registry.RegisterInterface(
@@ -40,6 +42,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&SurplusAuction{},
 		&DebtAuction{},
 		&CollateralAuction{},
+		&DutchCollateralAuction{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -13,6 +13,7 @@ const (
 	AttributeKeyLot         = "lot"
 	AttributeKeyMaxBid      = "max_bid"
 	AttributeKeyBid         = "bid"
+	AttributeKeyPrice       = "price"
 	AttributeKeyEndTime     = "end_time"
 	AttributeKeyCloseBlock  = "close_block"
 )
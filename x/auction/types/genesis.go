@@ -58,7 +58,7 @@ func UnpackGenesisAuctions(genesisAuctionsAny []*types.Any) ([]GenesisAuction, e
 var _ types.UnpackInterfacesMessage = &GenesisState{}
 
 // NewGenesisState returns a new genesis state object for auctions module.
-func NewGenesisState(nextID uint64, ap Params, ga []GenesisAuction) (*GenesisState, error) {
+func NewGenesisState(nextID uint64, ap Params, ga []GenesisAuction, bh []BidHistory) (*GenesisState, error) {
 	packedGA, err := PackGenesisAuctions(ga)
 	if err != nil {
 		return &GenesisState{}, err
@@ -68,6 +68,7 @@ func NewGenesisState(nextID uint64, ap Params, ga []GenesisAuction) (*GenesisSta
 		NextAuctionId: nextID,
 		Params:        ap,
 		Auctions:      packedGA,
+		BidHistories:  bh,
 	}, nil
 }
 
@@ -77,6 +78,7 @@ func DefaultGenesisState() *GenesisState {
 		DefaultNextAuctionID,
 		DefaultParams(),
 		[]GenesisAuction{},
+		[]BidHistory{},
 	)
 	if err != nil {
 		panic(fmt.Sprintf("could not create default genesis state: %v", err))
@@ -111,6 +113,25 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("found auction ID ≥ the nextAuctionID (%d ≥ %d)", a.GetID(), gs.NextAuctionId)
 		}
 	}
+
+	historyIDs := map[uint64]bool{}
+	for _, h := range gs.BidHistories {
+		if err := h.Validate(); err != nil {
+			return fmt.Errorf("found invalid bid history: %w", err)
+		}
+
+		if historyIDs[h.AuctionID] {
+			return fmt.Errorf("found duplicate bid history auction ID (%d)", h.AuctionID)
+		}
+		historyIDs[h.AuctionID] = true
+
+		if h.AuctionID >= gs.NextAuctionId {
+			return fmt.Errorf("found bid history auction ID ≥ the nextAuctionID (%d ≥ %d)", h.AuctionID, gs.NextAuctionId)
+		}
+		if !h.IsClosed() && !ids[h.AuctionID] {
+			return fmt.Errorf("found bid history of open auction (%d) not in auctions", h.AuctionID)
+		}
+	}
 	return nil
 }
 
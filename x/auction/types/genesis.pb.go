@@ -36,6 +36,8 @@ type GenesisState struct {
 	Params        Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// Genesis auctions
 	Auctions []*types.Any `protobuf:"bytes,3,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	// Genesis bid histories, of both open and closed auctions
+	BidHistories []BidHistory `protobuf:"bytes,4,rep,name=bid_histories,json=bidHistories,proto3" json:"bid_histories"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +81,18 @@ type Params struct {
 	IncrementSurplus    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=increment_surplus,json=incrementSurplus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"increment_surplus"`
 	IncrementDebt       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=increment_debt,json=incrementDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"increment_debt"`
 	IncrementCollateral github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=increment_collateral,json=incrementCollateral,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"increment_collateral"`
+	// dutch_auction_start_premium is the fraction above the break even price that dutch collateral auctions start at
+	DutchAuctionStartPremium github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=dutch_auction_start_premium,json=dutchAuctionStartPremium,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dutch_auction_start_premium"`
+	// dutch_auction_duration is how long dutch collateral auctions take to decay to a price of zero
+	DutchAuctionDuration time.Duration `protobuf:"bytes,9,opt,name=dutch_auction_duration,json=dutchAuctionDuration,proto3,stdduration" json:"dutch_auction_duration"`
+	// bid_history_length is the number of most recent bids kept for each auction, zero disables the bid history
+	BidHistoryLength uint64 `protobuf:"varint,10,opt,name=bid_history_length,json=bidHistoryLength,proto3" json:"bid_history_length,omitempty"`
+	// bid_history_retention is how long the bid history of an auction is kept after it closes
+	BidHistoryRetention time.Duration `protobuf:"bytes,11,opt,name=bid_history_retention,json=bidHistoryRetention,proto3,stdduration" json:"bid_history_retention"`
+	// bid_rules are the additional bidding rules of surplus, debt and collateral auctions
+	BidRules BidRules `protobuf:"bytes,12,rep,name=bid_rules,json=bidRules,proto3,castrepeated=BidRules" json:"bid_rules"`
+	// price_markets are the pricefeed markets used to value bids in USD
+	PriceMarkets PriceMarkets `protobuf:"bytes,13,rep,name=price_markets,json=priceMarkets,proto3,castrepeated=PriceMarkets" json:"price_markets"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -114,9 +128,93 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// BidRule defines additional bidding rules for an auction type.
+type BidRule struct {
+	AuctionType string `protobuf:"bytes,1,opt,name=auction_type,json=auctionType,proto3" json:"auction_type,omitempty"`
+	// min_increment_value is the minimum USD value a bid must improve on the previous bid by, zero disables it
+	MinIncrementValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_increment_value,json=minIncrementValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_increment_value"`
+	// anti_sniping_window is how close to its end time a bid must be placed to extend an auction, zero disables it
+	AntiSnipingWindow time.Duration `protobuf:"bytes,3,opt,name=anti_sniping_window,json=antiSnipingWindow,proto3,stdduration" json:"anti_sniping_window"`
+}
+
+func (m *BidRule) Reset()         { *m = BidRule{} }
+func (m *BidRule) String() string { return proto.CompactTextString(m) }
+func (*BidRule) ProtoMessage()    {}
+func (*BidRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0e5cb58293042f7, []int{2}
+}
+func (m *BidRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BidRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidRule.Merge(m, src)
+}
+func (m *BidRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidRule proto.InternalMessageInfo
+
+// PriceMarket defines the pricefeed market used to value a denom in USD.
+type PriceMarket struct {
+	Denom            string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MarketID         string                                 `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	ConversionFactor github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=conversion_factor,json=conversionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"conversion_factor"`
+}
+
+func (m *PriceMarket) Reset()         { *m = PriceMarket{} }
+func (m *PriceMarket) String() string { return proto.CompactTextString(m) }
+func (*PriceMarket) ProtoMessage()    {}
+func (*PriceMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0e5cb58293042f7, []int{3}
+}
+func (m *PriceMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceMarket.Merge(m, src)
+}
+func (m *PriceMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceMarket proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.auction.v1beta1.GenesisState")
 	proto.RegisterType((*Params)(nil), "kava.auction.v1beta1.Params")
+	proto.RegisterType((*BidRule)(nil), "kava.auction.v1beta1.BidRule")
+	proto.RegisterType((*PriceMarket)(nil), "kava.auction.v1beta1.PriceMarket")
 }
 
 func init() {
@@ -124,38 +222,62 @@ func init() {
 }
 
 var fileDescriptor_d0e5cb58293042f7 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0x93, 0x35, 0x94, 0xca, 0xed, 0xc6, 0x30, 0x39, 0xa4, 0x13, 0x4a, 0xab, 0x1e, 0xa6,
-	0x72, 0xa8, 0xa3, 0x95, 0x1b, 0xb7, 0x85, 0x4a, 0x13, 0x9c, 0x50, 0xa6, 0x5d, 0xe0, 0x10, 0x39,
-	0x89, 0x17, 0xa2, 0x25, 0x71, 0x65, 0x3b, 0xa5, 0x7d, 0x0b, 0x8e, 0x3c, 0x08, 0x87, 0x3d, 0x42,
-	0xc5, 0x69, 0x47, 0xc4, 0x61, 0x40, 0xfb, 0x22, 0x28, 0x8e, 0x9b, 0x16, 0xd8, 0x65, 0x3b, 0xd5,
-	0xfe, 0xbe, 0xff, 0xf7, 0xfb, 0xff, 0xed, 0x3a, 0x60, 0x70, 0x85, 0x67, 0xd8, 0xc1, 0x45, 0x28,
-	0x12, 0x9a, 0x3b, 0xb3, 0x93, 0x80, 0x08, 0x7c, 0xe2, 0xc4, 0x24, 0x27, 0x3c, 0xe1, 0x68, 0xca,
-	0xa8, 0xa0, 0xd0, 0x2c, 0x35, 0x48, 0x69, 0x90, 0xd2, 0x1c, 0x75, 0x43, 0xca, 0x33, 0xca, 0x7d,
-	0xa9, 0x71, 0xaa, 0x4d, 0x35, 0x70, 0x64, 0xc6, 0x34, 0xa6, 0x55, 0xbd, 0x5c, 0xa9, 0x6a, 0x37,
-	0xa6, 0x34, 0x4e, 0x89, 0x23, 0x77, 0x41, 0x71, 0xe9, 0xe0, 0x7c, 0xa1, 0x5a, 0xf6, 0xbf, 0xad,
-	0xa8, 0x60, 0x58, 0xba, 0xc9, 0xca, 0xe0, 0x5a, 0x07, 0x9d, 0xb3, 0x2a, 0xd3, 0xb9, 0xc0, 0x82,
-	0xc0, 0x63, 0xf0, 0x24, 0x27, 0x73, 0xe1, 0xab, 0x50, 0x7e, 0x12, 0x59, 0x7a, 0x5f, 0x1f, 0x1a,
-	0xde, 0x7e, 0x59, 0x3e, 0xad, 0xaa, 0x6f, 0x22, 0xf8, 0x0a, 0x34, 0xa7, 0x98, 0xe1, 0x8c, 0x5b,
-	0x7b, 0x7d, 0x7d, 0xd8, 0x1e, 0x3f, 0x47, 0x77, 0x9d, 0x05, 0xbd, 0x93, 0x1a, 0xd7, 0x58, 0xde,
-	0xf6, 0x34, 0x4f, 0x4d, 0xc0, 0x09, 0x68, 0x29, 0x1d, 0xb7, 0x1a, 0xfd, 0xc6, 0xb0, 0x3d, 0x36,
-	0x51, 0x95, 0x13, 0x6d, 0x72, 0xa2, 0xd3, 0x7c, 0xe1, 0xc2, 0x6f, 0x5f, 0x47, 0x07, 0x2a, 0x9d,
-	0x72, 0xf6, 0xea, 0xc9, 0xc1, 0xb5, 0x01, 0x9a, 0x15, 0x1e, 0x5e, 0x00, 0x33, 0xc3, 0xf3, 0x3a,
-	0xf3, 0xe6, 0x8c, 0x32, 0x79, 0x7b, 0xdc, 0xfd, 0x0f, 0x3e, 0x51, 0x02, 0xb7, 0x55, 0xe6, 0xfa,
-	0xf2, 0xb3, 0xa7, 0x7b, 0x30, 0xc3, 0x73, 0xe5, 0xb1, 0xe9, 0x96, 0xd8, 0x4b, 0xca, 0x3e, 0x61,
-	0x16, 0xf9, 0x41, 0x12, 0x6d, 0xb1, 0xcd, 0x7b, 0x60, 0x15, 0xc0, 0x4d, 0xa2, 0x5d, 0x2c, 0x23,
-	0x33, 0xc2, 0x38, 0xf9, 0x1b, 0xfb, 0xf8, 0x1e, 0x58, 0x05, 0xd8, 0xc5, 0x7e, 0x00, 0x4f, 0x93,
-	0x3c, 0x64, 0x24, 0x23, 0xb9, 0xf0, 0x79, 0xc1, 0xa6, 0x69, 0x51, 0x5e, 0xaf, 0x3e, 0xec, 0xb8,
-	0xa8, 0x1c, 0xfc, 0x71, 0xdb, 0x3b, 0x8e, 0x13, 0xf1, 0xb1, 0x08, 0x50, 0x48, 0x33, 0xf5, 0xae,
-	0xd4, 0xcf, 0x88, 0x47, 0x57, 0x8e, 0x58, 0x4c, 0x09, 0x47, 0x13, 0x12, 0x7a, 0x87, 0x35, 0xe8,
-	0xbc, 0xe2, 0xc0, 0x0b, 0x70, 0xb0, 0x85, 0x47, 0x24, 0x10, 0x96, 0xf1, 0x20, 0xf2, 0x7e, 0x4d,
-	0x99, 0x90, 0x40, 0x40, 0x0c, 0xcc, 0x2d, 0x36, 0xa4, 0x69, 0x8a, 0x05, 0x61, 0x38, 0xb5, 0x1e,
-	0x3d, 0x08, 0xfe, 0xac, 0x66, 0xbd, 0xae, 0x51, 0x6f, 0x8d, 0xd6, 0xde, 0x61, 0xc3, 0xeb, 0xec,
-	0xde, 0xb4, 0x7b, 0xb6, 0xfc, 0x6d, 0x6b, 0xcb, 0x95, 0xad, 0xdf, 0xac, 0x6c, 0xfd, 0xd7, 0xca,
-	0xd6, 0x3f, 0xaf, 0x6d, 0xed, 0x66, 0x6d, 0x6b, 0xdf, 0xd7, 0xb6, 0xf6, 0xfe, 0xc5, 0x8e, 0x5d,
-	0xf9, 0xa8, 0x47, 0x29, 0x0e, 0xb8, 0x5c, 0x39, 0xf3, 0xfa, 0x83, 0x96, 0xae, 0x41, 0x53, 0xfe,
-	0x49, 0x2f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x34, 0x3b, 0x9f, 0xed, 0x03, 0x00, 0x00,
+	// 866 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4d, 0x6f, 0xdb, 0x36,
+	0x18, 0xc7, 0xad, 0xc4, 0x4d, 0x65, 0xda, 0xee, 0x1c, 0xc6, 0x1b, 0x94, 0x6e, 0xb3, 0x53, 0x1f,
+	0x8a, 0x14, 0x58, 0x64, 0x34, 0xbb, 0x0d, 0xbb, 0x54, 0x33, 0xd6, 0x79, 0xeb, 0x80, 0x42, 0x5e,
+	0x57, 0xec, 0x05, 0x13, 0x28, 0x89, 0x71, 0x88, 0x48, 0xa4, 0x40, 0x52, 0x49, 0xfc, 0x01, 0x76,
+	0xdf, 0x71, 0x9f, 0x61, 0xe7, 0xee, 0xb0, 0x6f, 0x10, 0xec, 0x54, 0xec, 0x34, 0xec, 0x90, 0xae,
+	0xc9, 0x17, 0x19, 0xf8, 0x62, 0xd9, 0x5d, 0x13, 0x20, 0xc9, 0xc9, 0xe2, 0xc3, 0xff, 0xf3, 0xe3,
+	0x9f, 0xcf, 0x43, 0xd2, 0x60, 0x70, 0x80, 0x0e, 0xd1, 0x10, 0x95, 0x89, 0x24, 0x8c, 0x0e, 0x0f,
+	0x1f, 0xc6, 0x58, 0xa2, 0x87, 0xc3, 0x29, 0xa6, 0x58, 0x10, 0xe1, 0x17, 0x9c, 0x49, 0x06, 0xbb,
+	0x4a, 0xe3, 0x5b, 0x8d, 0x6f, 0x35, 0x77, 0x37, 0x13, 0x26, 0x72, 0x26, 0x22, 0xad, 0x19, 0x9a,
+	0x81, 0x49, 0xb8, 0xdb, 0x9d, 0xb2, 0x29, 0x33, 0x71, 0xf5, 0x65, 0xa3, 0x9b, 0x53, 0xc6, 0xa6,
+	0x19, 0x1e, 0xea, 0x51, 0x5c, 0xee, 0x0d, 0x11, 0x9d, 0xd9, 0xa9, 0xde, 0xff, 0xa7, 0xd2, 0x92,
+	0x23, 0xbd, 0x9a, 0x99, 0xbf, 0xd8, 0xe5, 0xdc, 0x91, 0xd6, 0x0c, 0x7e, 0x5e, 0x01, 0xad, 0xc7,
+	0xc6, 0xf7, 0x44, 0x22, 0x89, 0xe1, 0x7d, 0xf0, 0x0e, 0xc5, 0xc7, 0x32, 0xb2, 0xb2, 0x88, 0xa4,
+	0x9e, 0xb3, 0xe5, 0x6c, 0xd7, 0xc3, 0xb6, 0x0a, 0x3f, 0x32, 0xd1, 0x71, 0x0a, 0x3f, 0x01, 0x6b,
+	0x05, 0xe2, 0x28, 0x17, 0xde, 0xca, 0x96, 0xb3, 0xdd, 0xdc, 0xfd, 0xc0, 0xbf, 0x68, 0xbf, 0xfe,
+	0x53, 0xad, 0x09, 0xea, 0x27, 0xa7, 0xfd, 0x5a, 0x68, 0x33, 0xe0, 0x08, 0xb8, 0x56, 0x27, 0xbc,
+	0xd5, 0xad, 0xd5, 0xed, 0xe6, 0x6e, 0xd7, 0x37, 0x7b, 0xf1, 0xe7, 0x7b, 0xf1, 0x1f, 0xd1, 0x59,
+	0x00, 0xff, 0x7c, 0xb1, 0x73, 0xc7, 0xba, 0xb3, 0x2b, 0x87, 0x55, 0x26, 0xfc, 0x0a, 0xb4, 0x63,
+	0x92, 0x46, 0xfb, 0x44, 0x48, 0xc6, 0x09, 0x16, 0x5e, 0x5d, 0xa3, 0xb6, 0x2e, 0x36, 0x12, 0x90,
+	0xf4, 0x0b, 0xad, 0x9c, 0x59, 0x33, 0xad, 0x78, 0x1e, 0x21, 0x58, 0x0c, 0x7e, 0x77, 0xc1, 0x9a,
+	0xf1, 0x0a, 0x9f, 0x81, 0x6e, 0x8e, 0x8e, 0xab, 0x02, 0xcc, 0x8b, 0xaa, 0xcb, 0xd0, 0xdc, 0xdd,
+	0x7c, 0xcb, 0xe9, 0xc8, 0x0a, 0x02, 0x57, 0x71, 0x7f, 0x7d, 0xd5, 0x77, 0x42, 0x98, 0xa3, 0x63,
+	0x6b, 0x78, 0x3e, 0xab, 0xb0, 0x7b, 0x8c, 0x1f, 0x21, 0x9e, 0x46, 0xca, 0x76, 0x85, 0x5d, 0xbb,
+	0x06, 0xd6, 0x02, 0x02, 0x92, 0x2e, 0x63, 0x39, 0x3e, 0xc4, 0x5c, 0xe0, 0x37, 0xb1, 0xb7, 0xaf,
+	0x81, 0xb5, 0x80, 0x65, 0xec, 0x0f, 0x60, 0x9d, 0xd0, 0x84, 0xe3, 0x1c, 0x53, 0x19, 0x89, 0x92,
+	0x17, 0x59, 0xa9, 0x7a, 0xe5, 0x6c, 0xb7, 0x02, 0x5f, 0x25, 0xfe, 0x73, 0xda, 0xbf, 0x3f, 0x25,
+	0x72, 0xbf, 0x8c, 0xfd, 0x84, 0xe5, 0xf6, 0x20, 0xdb, 0x9f, 0x1d, 0x91, 0x1e, 0x0c, 0xe5, 0xac,
+	0xc0, 0xc2, 0x1f, 0xe1, 0x24, 0xec, 0x54, 0xa0, 0x89, 0xe1, 0xc0, 0x67, 0xe0, 0xce, 0x02, 0x9e,
+	0xe2, 0x58, 0x7a, 0xf5, 0x1b, 0x91, 0xdb, 0x15, 0x65, 0x84, 0x63, 0x09, 0x11, 0xe8, 0x2e, 0xb0,
+	0x09, 0xcb, 0x32, 0x24, 0x31, 0x47, 0x99, 0x77, 0xeb, 0x46, 0xf0, 0x8d, 0x8a, 0xf5, 0x59, 0x85,
+	0x82, 0x39, 0x78, 0x3f, 0x2d, 0x65, 0xb2, 0x5f, 0x9d, 0x0e, 0x21, 0x11, 0x97, 0x51, 0xc1, 0x71,
+	0x4e, 0xca, 0xdc, 0x73, 0x6f, 0xb4, 0x92, 0xa7, 0x91, 0xf6, 0xbc, 0x4c, 0x14, 0xf0, 0xa9, 0xe1,
+	0xc1, 0xef, 0xc0, 0x7b, 0x6f, 0x2e, 0x57, 0xb5, 0xb7, 0x71, 0xf5, 0xf6, 0x76, 0x97, 0xf1, 0x55,
+	0x83, 0x3f, 0x02, 0x70, 0x71, 0x7b, 0x66, 0x51, 0x86, 0xe9, 0x54, 0xee, 0x7b, 0x40, 0x5f, 0xf5,
+	0x4e, 0x75, 0x35, 0x66, 0x4f, 0x74, 0x1c, 0x3e, 0x07, 0xef, 0x2e, 0xab, 0x39, 0x96, 0x98, 0x6a,
+	0x1f, 0xcd, 0xab, 0xfb, 0xd8, 0x58, 0x50, 0xc3, 0x79, 0x3e, 0x7c, 0x02, 0x1a, 0x0a, 0xcc, 0xcb,
+	0x0c, 0x0b, 0xaf, 0xa5, 0x2f, 0xf0, 0x87, 0x97, 0x5e, 0xe0, 0xb0, 0xcc, 0x70, 0xd0, 0x51, 0xc0,
+	0xdf, 0x5e, 0xf5, 0x5d, 0x1b, 0x10, 0xa1, 0x1b, 0xdb, 0x2f, 0xf8, 0x23, 0x68, 0x17, 0x9c, 0x24,
+	0x38, 0xca, 0x11, 0x3f, 0xc0, 0x52, 0x78, 0x6d, 0x4d, 0xbc, 0x77, 0xc9, 0xdb, 0xa4, 0xa4, 0x5f,
+	0x6b, 0x65, 0xd0, 0xb5, 0xd4, 0xd6, 0x52, 0x50, 0x84, 0xad, 0x62, 0x69, 0xf4, 0x65, 0xdd, 0x5d,
+	0xe9, 0xac, 0xea, 0x77, 0xa3, 0xea, 0xc3, 0xe0, 0xb5, 0x03, 0x6e, 0x5b, 0x23, 0xf0, 0x1e, 0x68,
+	0xcd, 0xfb, 0xa4, 0x9a, 0xab, 0x1f, 0x8c, 0x46, 0xd8, 0xb4, 0xb1, 0x6f, 0x66, 0x05, 0x86, 0x3f,
+	0x81, 0x8d, 0x9c, 0xd0, 0x68, 0x71, 0x4c, 0x0f, 0x51, 0x56, 0x62, 0x6f, 0xe5, 0x46, 0xe7, 0x66,
+	0x3d, 0x27, 0x74, 0x3c, 0x27, 0x7d, 0xab, 0x40, 0x70, 0x02, 0x36, 0x10, 0x95, 0x24, 0x12, 0x94,
+	0x14, 0x84, 0x4e, 0xa3, 0x23, 0x42, 0x53, 0x76, 0xe4, 0xad, 0x5e, 0xbd, 0x4b, 0xeb, 0x2a, 0x7f,
+	0x62, 0xd2, 0x9f, 0xeb, 0xec, 0xc1, 0x1f, 0x0e, 0x68, 0x2e, 0x95, 0x05, 0x76, 0xc1, 0xad, 0x14,
+	0x53, 0x96, 0xdb, 0x0d, 0x9a, 0x01, 0x7c, 0x00, 0x1a, 0xa6, 0xea, 0xea, 0x2f, 0x43, 0x6d, 0xa8,
+	0x11, 0xb4, 0xce, 0x4e, 0xfb, 0xae, 0x49, 0x1a, 0x8f, 0x42, 0xd7, 0x4c, 0x8f, 0x53, 0x48, 0xc0,
+	0x7a, 0xc2, 0xa8, 0x7a, 0x73, 0x54, 0xad, 0xf6, 0x50, 0x22, 0x19, 0xd7, 0x1e, 0x1b, 0xc1, 0xa7,
+	0xd7, 0xa8, 0xc1, 0x98, 0xca, 0xbf, 0x5e, 0xec, 0x00, 0x13, 0x57, 0xa3, 0xb0, 0xb3, 0xc0, 0x7e,
+	0xae, 0xa9, 0xc1, 0xe3, 0x93, 0xd7, 0xbd, 0xda, 0xc9, 0x59, 0xcf, 0x79, 0x79, 0xd6, 0x73, 0xfe,
+	0x3d, 0xeb, 0x39, 0xbf, 0x9c, 0xf7, 0x6a, 0x2f, 0xcf, 0x7b, 0xb5, 0xbf, 0xcf, 0x7b, 0xb5, 0xef,
+	0x1f, 0x2c, 0xad, 0xa2, 0x8e, 0xc8, 0x4e, 0x86, 0x62, 0xa1, 0xbf, 0x86, 0xc7, 0xd5, 0x1f, 0xa7,
+	0x5e, 0x2c, 0x5e, 0xd3, 0x45, 0xfb, 0xf8, 0xbf, 0x01, 0x00, 0x04, 0x16, 0x33, 0x71, 0xfb, 0x07,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -178,6 +300,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BidHistories) > 0 {
+		for iNdEx := len(m.BidHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Auctions) > 0 {
 		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -230,21 +366,80 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ReverseBidDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ReverseBidDuration):])
+	if len(m.PriceMarkets) > 0 {
+		for iNdEx := len(m.PriceMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.BidRules) > 0 {
+		for iNdEx := len(m.BidRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.BidHistoryRetention, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BidHistoryRetention):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintGenesis(dAtA, i, uint64(n2))
 	i--
-	dAtA[i] = 0x3a
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ForwardBidDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ForwardBidDuration):])
+	dAtA[i] = 0x5a
+	if m.BidHistoryLength != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BidHistoryLength))
+		i--
+		dAtA[i] = 0x50
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DutchAuctionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DutchAuctionDuration):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintGenesis(dAtA, i, uint64(n3))
 	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.DutchAuctionStartPremium.Size()
+		i -= size
+		if _, err := m.DutchAuctionStartPremium.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ReverseBidDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ReverseBidDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ForwardBidDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ForwardBidDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
 	dAtA[i] = 0x32
 	{
 		size := m.IncrementCollateral.Size()
@@ -276,17 +471,112 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAuctionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAuctionDuration):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAuctionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAuctionDuration):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
+func (m *BidRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AntiSnipingWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AntiSnipingWindow):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintGenesis(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinIncrementValue.Size()
+		i -= size
+		if _, err := m.MinIncrementValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AuctionType) > 0 {
+		i -= len(m.AuctionType)
+		copy(dAtA[i:], m.AuctionType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AuctionType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConversionFactor.Size()
+		i -= size
+		if _, err := m.ConversionFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -315,6 +605,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BidHistories) > 0 {
+		for _, e := range m.BidHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -336,6 +632,63 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ReverseBidDuration)
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DutchAuctionStartPremium.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DutchAuctionDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.BidHistoryLength != 0 {
+		n += 1 + sovGenesis(uint64(m.BidHistoryLength))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BidHistoryRetention)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BidRules) > 0 {
+		for _, e := range m.BidRules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceMarkets) > 0 {
+		for _, e := range m.PriceMarkets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BidRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuctionType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.MinIncrementValue.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AntiSnipingWindow)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PriceMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.ConversionFactor.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -460,6 +813,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidHistories = append(m.BidHistories, BidHistory{})
+			if err := m.BidHistories[len(m.BidHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -708,6 +1095,488 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchAuctionStartPremium", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DutchAuctionStartPremium.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchAuctionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DutchAuctionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidHistoryLength", wireType)
+			}
+			m.BidHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidHistoryRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.BidHistoryRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidRules = append(m.BidRules, BidRule{})
+			if err := m.BidRules[len(m.BidRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceMarkets = append(m.PriceMarkets, PriceMarket{})
+			if err := m.PriceMarkets[len(m.PriceMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BidRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIncrementValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinIncrementValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AntiSnipingWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.AntiSnipingWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -41,6 +41,12 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 	}
 
+	validHistory := NewBidHistory(validAuction.ID, validAuction.GetType())
+	validHistory.AddBid(NewBidRecord(validAuction.Bidder, validAuction.Bid, validAuction.Bid, validAuction.Lot, 1, arbitraryTime), DefaultBidHistoryLength)
+	closedHistory := NewBidHistory(validAuction.ID-1, validAuction.GetType())
+	closedHistory.AddBid(NewBidRecord(validAuction.Bidder, validAuction.Bid, validAuction.Bid, validAuction.Lot, 1, arbitraryTime), DefaultBidHistoryLength)
+	closedHistory.CloseTime = arbitraryTime
+
 	testCases := []struct {
 		name       string
 		genesis    *GenesisState
@@ -61,6 +67,7 @@ func TestGenesisState_Validate(t *testing.T) {
 						validAuction,
 					},
 				),
+				[]BidHistory{},
 			},
 			false,
 		},
@@ -75,6 +82,47 @@ func TestGenesisState_Validate(t *testing.T) {
 						validAuction,
 					},
 				),
+				[]BidHistory{},
+			},
+			false,
+		},
+		{
+			"valid bid histories",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions([]GenesisAuction{validAuction}),
+				[]BidHistory{validHistory, closedHistory},
+			},
+			true,
+		},
+		{
+			"invalid bid histories with repeated ID",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions([]GenesisAuction{validAuction}),
+				[]BidHistory{validHistory, validHistory},
+			},
+			false,
+		},
+		{
+			"invalid bid history of open auction without auction",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions([]GenesisAuction{}),
+				[]BidHistory{validHistory},
+			},
+			false,
+		},
+		{
+			"invalid bid history ID",
+			&GenesisState{
+				closedHistory.AuctionID,
+				DefaultParams(),
+				mustPackGenesisAuctions([]GenesisAuction{}),
+				[]BidHistory{closedHistory},
 			},
 			false,
 		},
@@ -143,6 +191,7 @@ func TestGenesisState_UnmarshalAnys(t *testing.T) {
 		DefaultNextAuctionID,
 		DefaultParams(),
 		auctions,
+		[]BidHistory{},
 	)
 	require.NoError(t, err)
 
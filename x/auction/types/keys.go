@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -27,6 +28,10 @@ var (
 	AuctionByTimeKeyPrefix = []byte{0x01} // prefix for keys that are part of the auctionsByTime index
 
 	NextAuctionIDKey = []byte{0x02} // key for the next auction id
+
+	BidHistoryKeyPrefix            = []byte{0x03} // prefix for keys that store bid histories
+	BidderAuctionKeyPrefix         = []byte{0x04} // prefix for keys that are part of the bidder index of bid histories
+	BidHistoryByCloseTimeKeyPrefix = []byte{0x05} // prefix for keys that are part of the closed bid histories by time index
 )
 
 // GetAuctionKey returns the bytes of an auction key
@@ -39,6 +44,11 @@ func GetAuctionByTimeKey(endTime time.Time, auctionID uint64) []byte {
 	return append(sdk.FormatTimeBytes(endTime), Uint64ToBytes(auctionID)...)
 }
 
+// GetBidderAuctionKey returns the key for the bidder index of bid histories
+func GetBidderAuctionKey(bidder sdk.AccAddress, auctionID uint64) []byte {
+	return append(address.MustLengthPrefix(bidder), Uint64ToBytes(auctionID)...)
+}
+
 // Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
 func Uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
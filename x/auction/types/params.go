@@ -3,8 +3,10 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -19,18 +21,42 @@ const (
 	DefaultForwardBidDuration time.Duration = 24 * time.Hour
 	// DefaultReverseBidDuration how long an auction gets extended when someone bids for a reverse auction
 	DefaultReverseBidDuration time.Duration = 1 * time.Hour
+	// DefaultDutchAuctionDuration how long a dutch auction takes to decay to a price of zero
+	DefaultDutchAuctionDuration time.Duration = 6 * time.Hour
+	// DefaultBidHistoryLength how many of the most recent bids are kept for each auction
+	DefaultBidHistoryLength uint64 = 100
+	// DefaultBidHistoryRetention how long bid histories are kept after their auction closes
+	DefaultBidHistoryRetention time.Duration = 30 * 24 * time.Hour
 )
 
 var (
 	// DefaultIncrement is the smallest percent change a new bid must have from the old one
 	DefaultIncrement sdk.Dec = sdk.MustNewDecFromStr("0.05")
+	// DefaultDutchAuctionStartPremium is the percent above the break even price that dutch auctions start at
+	DefaultDutchAuctionStartPremium sdk.Dec = sdk.MustNewDecFromStr("0.2")
+	// DefaultBidRules are bid rules for each auction type that doesn't add to the increment and duration params
+	DefaultBidRules = BidRules{
+		NewBidRule(SurplusAuctionType, sdk.ZeroDec(), 0),
+		NewBidRule(DebtAuctionType, sdk.ZeroDec(), 0),
+		NewBidRule(CollateralAuctionType, sdk.ZeroDec(), 0),
+	}
+	// DefaultPriceMarkets is empty so no bids are valued in USD
+	DefaultPriceMarkets PriceMarkets
+	// MaxConversionFactor is the largest number of decimals a price market denom can have
+	MaxConversionFactor = sdkmath.NewInt(sdk.Precision)
 	// ParamStoreKeyParams Param store key for auction params
-	KeyForwardBidDuration  = []byte("ForwardBidDuration")
-	KeyReverseBidDuration  = []byte("ReverseBidDuration")
-	KeyMaxAuctionDuration  = []byte("MaxAuctionDuration")
-	KeyIncrementSurplus    = []byte("IncrementSurplus")
-	KeyIncrementDebt       = []byte("IncrementDebt")
-	KeyIncrementCollateral = []byte("IncrementCollateral")
+	KeyForwardBidDuration       = []byte("ForwardBidDuration")
+	KeyReverseBidDuration       = []byte("ReverseBidDuration")
+	KeyMaxAuctionDuration       = []byte("MaxAuctionDuration")
+	KeyIncrementSurplus         = []byte("IncrementSurplus")
+	KeyIncrementDebt            = []byte("IncrementDebt")
+	KeyIncrementCollateral      = []byte("IncrementCollateral")
+	KeyDutchAuctionStartPremium = []byte("DutchAuctionStartPremium")
+	KeyDutchAuctionDuration     = []byte("DutchAuctionDuration")
+	KeyBidHistoryLength         = []byte("BidHistoryLength")
+	KeyBidHistoryRetention      = []byte("BidHistoryRetention")
+	KeyBidRules                 = []byte("BidRules")
+	KeyPriceMarkets             = []byte("PriceMarkets")
 )
 
 // NewParams returns a new Params object.
@@ -38,15 +64,27 @@ func NewParams(
 	maxAuctionDuration, forwardBidDuration, reverseBidDuration time.Duration,
 	incrementSurplus,
 	incrementDebt,
-	incrementCollateral sdk.Dec,
+	incrementCollateral,
+	dutchAuctionStartPremium sdk.Dec,
+	dutchAuctionDuration time.Duration,
+	bidHistoryLength uint64,
+	bidHistoryRetention time.Duration,
+	bidRules BidRules,
+	priceMarkets PriceMarkets,
 ) Params {
 	return Params{
-		MaxAuctionDuration:  maxAuctionDuration,
-		ForwardBidDuration:  forwardBidDuration,
-		ReverseBidDuration:  reverseBidDuration,
-		IncrementSurplus:    incrementSurplus,
-		IncrementDebt:       incrementDebt,
-		IncrementCollateral: incrementCollateral,
+		MaxAuctionDuration:       maxAuctionDuration,
+		ForwardBidDuration:       forwardBidDuration,
+		ReverseBidDuration:       reverseBidDuration,
+		IncrementSurplus:         incrementSurplus,
+		IncrementDebt:            incrementDebt,
+		IncrementCollateral:      incrementCollateral,
+		DutchAuctionStartPremium: dutchAuctionStartPremium,
+		DutchAuctionDuration:     dutchAuctionDuration,
+		BidHistoryLength:         bidHistoryLength,
+		BidHistoryRetention:      bidHistoryRetention,
+		BidRules:                 bidRules,
+		PriceMarkets:             priceMarkets,
 	}
 }
 
@@ -59,6 +97,12 @@ func DefaultParams() Params {
 		DefaultIncrement,
 		DefaultIncrement,
 		DefaultIncrement,
+		DefaultDutchAuctionStartPremium,
+		DefaultDutchAuctionDuration,
+		DefaultBidHistoryLength,
+		DefaultBidHistoryRetention,
+		DefaultBidRules,
+		DefaultPriceMarkets,
 	)
 }
 
@@ -76,6 +120,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyIncrementSurplus, &p.IncrementSurplus, validateIncrementSurplusParam),
 		paramtypes.NewParamSetPair(KeyIncrementDebt, &p.IncrementDebt, validateIncrementDebtParam),
 		paramtypes.NewParamSetPair(KeyIncrementCollateral, &p.IncrementCollateral, validateIncrementCollateralParam),
+		paramtypes.NewParamSetPair(KeyDutchAuctionStartPremium, &p.DutchAuctionStartPremium, validateDutchAuctionStartPremiumParam),
+		paramtypes.NewParamSetPair(KeyDutchAuctionDuration, &p.DutchAuctionDuration, validateDutchAuctionDurationParam),
+		paramtypes.NewParamSetPair(KeyBidHistoryLength, &p.BidHistoryLength, validateBidHistoryLengthParam),
+		paramtypes.NewParamSetPair(KeyBidHistoryRetention, &p.BidHistoryRetention, validateBidHistoryRetentionParam),
+		paramtypes.NewParamSetPair(KeyBidRules, &p.BidRules, validateBidRulesParam),
+		paramtypes.NewParamSetPair(KeyPriceMarkets, &p.PriceMarkets, validatePriceMarketsParam),
 	}
 }
 
@@ -109,7 +159,31 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateIncrementCollateralParam(p.IncrementCollateral)
+	if err := validateIncrementCollateralParam(p.IncrementCollateral); err != nil {
+		return err
+	}
+
+	if err := validateDutchAuctionStartPremiumParam(p.DutchAuctionStartPremium); err != nil {
+		return err
+	}
+
+	if err := validateDutchAuctionDurationParam(p.DutchAuctionDuration); err != nil {
+		return err
+	}
+
+	if err := validateBidHistoryLengthParam(p.BidHistoryLength); err != nil {
+		return err
+	}
+
+	if err := validateBidHistoryRetentionParam(p.BidHistoryRetention); err != nil {
+		return err
+	}
+
+	if err := validateBidRulesParam(p.BidRules); err != nil {
+		return err
+	}
+
+	return validatePriceMarketsParam(p.PriceMarkets)
 }
 
 func validateBidDurationParam(i interface{}) error {
@@ -188,3 +262,191 @@ func validateIncrementCollateralParam(i interface{}) error {
 
 	return nil
 }
+
+func validateDutchAuctionStartPremiumParam(i interface{}) error {
+	startPremium, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if startPremium == emptyDec || startPremium.IsNil() {
+		return errors.New("dutch auction start premium cannot be nil or empty")
+	}
+
+	if startPremium.IsNegative() {
+		return fmt.Errorf("dutch auction start premium cannot be less than zero %s", startPremium)
+	}
+
+	return nil
+}
+
+func validateDutchAuctionDurationParam(i interface{}) error {
+	dutchAuctionDuration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if dutchAuctionDuration <= 0 {
+		return fmt.Errorf("dutch auction duration must be positive %d", dutchAuctionDuration)
+	}
+
+	return nil
+}
+
+func validateBidHistoryLengthParam(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBidHistoryRetentionParam(i interface{}) error {
+	bidHistoryRetention, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if bidHistoryRetention < 0 {
+		return fmt.Errorf("bid history retention cannot be negative %d", bidHistoryRetention)
+	}
+
+	return nil
+}
+
+func validateBidRulesParam(i interface{}) error {
+	bidRules, ok := i.(BidRules)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return bidRules.Validate()
+}
+
+func validatePriceMarketsParam(i interface{}) error {
+	priceMarkets, ok := i.(PriceMarkets)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return priceMarkets.Validate()
+}
+
+// NewBidRule returns a new BidRule.
+func NewBidRule(auctionType string, minIncrementValue sdk.Dec, antiSnipingWindow time.Duration) BidRule {
+	return BidRule{
+		AuctionType:       auctionType,
+		MinIncrementValue: minIncrementValue,
+		AntiSnipingWindow: antiSnipingWindow,
+	}
+}
+
+// Validate checks that the bid rule has valid values.
+func (r BidRule) Validate() error {
+	if r.AuctionType != SurplusAuctionType && r.AuctionType != DebtAuctionType && r.AuctionType != CollateralAuctionType {
+		return fmt.Errorf("bid rules cannot be set for auction type %s", r.AuctionType)
+	}
+
+	if r.MinIncrementValue == emptyDec || r.MinIncrementValue.IsNil() {
+		return fmt.Errorf("%s auction min increment value cannot be nil or empty", r.AuctionType)
+	}
+
+	if r.MinIncrementValue.IsNegative() {
+		return fmt.Errorf("%s auction min increment value cannot be less than zero %s", r.AuctionType, r.MinIncrementValue)
+	}
+
+	if r.AntiSnipingWindow < 0 {
+		return fmt.Errorf("%s auction anti-sniping window cannot be negative %d", r.AuctionType, r.AntiSnipingWindow)
+	}
+
+	return nil
+}
+
+// BidRules is a slice of BidRule
+type BidRules []BidRule
+
+// Validate checks that the bid rules are valid and there is at most one for each auction type.
+func (rs BidRules) Validate() error {
+	auctionTypes := make(map[string]bool)
+	for _, r := range rs {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		if auctionTypes[r.AuctionType] {
+			return fmt.Errorf("duplicate bid rule for auction type %s", r.AuctionType)
+		}
+		auctionTypes[r.AuctionType] = true
+	}
+
+	return nil
+}
+
+// Get returns the bid rule for an auction type.
+func (rs BidRules) Get(auctionType string) (BidRule, bool) {
+	for _, r := range rs {
+		if r.AuctionType == auctionType {
+			return r, true
+		}
+	}
+	return BidRule{}, false
+}
+
+// NewPriceMarket returns a new PriceMarket.
+func NewPriceMarket(denom, marketID string, conversionFactor sdkmath.Int) PriceMarket {
+	return PriceMarket{
+		Denom:            denom,
+		MarketID:         marketID,
+		ConversionFactor: conversionFactor,
+	}
+}
+
+// Validate checks that the price market has valid values.
+func (m PriceMarket) Validate() error {
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return fmt.Errorf("invalid price market denom: %w", err)
+	}
+
+	if strings.TrimSpace(m.MarketID) == "" {
+		return fmt.Errorf("price market id cannot be blank for denom %s", m.Denom)
+	}
+
+	if m.ConversionFactor.IsNil() {
+		return fmt.Errorf("price market conversion factor cannot be nil for denom %s", m.Denom)
+	}
+
+	if m.ConversionFactor.IsNegative() || m.ConversionFactor.GT(MaxConversionFactor) {
+		return fmt.Errorf("price market conversion factor must be between 0 and %s for denom %s: %s", MaxConversionFactor, m.Denom, m.ConversionFactor)
+	}
+
+	return nil
+}
+
+// PriceMarkets is a slice of PriceMarket
+type PriceMarkets []PriceMarket
+
+// Validate checks that the price markets are valid and there is at most one for each denom.
+func (ms PriceMarkets) Validate() error {
+	denoms := make(map[string]bool)
+	for _, m := range ms {
+		if err := m.Validate(); err != nil {
+			return err
+		}
+		if denoms[m.Denom] {
+			return fmt.Errorf("duplicate price market for denom %s", m.Denom)
+		}
+		denoms[m.Denom] = true
+	}
+
+	return nil
+}
+
+// Get returns the price market for a denom.
+func (ms PriceMarkets) Get(denom string) (PriceMarket, bool) {
+	for _, m := range ms {
+		if m.Denom == denom {
+			return m, true
+		}
+	}
+	return PriceMarket{}, false
+}
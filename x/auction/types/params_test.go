@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParams_Validate(t *testing.T) {
@@ -21,96 +23,192 @@ func TestParams_Validate(t *testing.T) {
 		{
 			"negativeForwardBidDuration",
 			Params{
-				MaxAuctionDuration:  24 * time.Hour,
-				ForwardBidDuration:  -1 * time.Hour,
-				ReverseBidDuration:  1 * time.Hour,
-				IncrementSurplus:    d("0.05"),
-				IncrementDebt:       d("0.05"),
-				IncrementCollateral: d("0.05"),
+				MaxAuctionDuration:       24 * time.Hour,
+				ForwardBidDuration:       -1 * time.Hour,
+				ReverseBidDuration:       1 * time.Hour,
+				IncrementSurplus:         d("0.05"),
+				IncrementDebt:            d("0.05"),
+				IncrementCollateral:      d("0.05"),
+				DutchAuctionStartPremium: d("0.2"),
+				DutchAuctionDuration:     6 * time.Hour,
+				BidHistoryLength:         100,
+				BidHistoryRetention:      720 * time.Hour,
 			},
 			true,
 		},
 		{
 			"negativeReverseBidDuration",
 			Params{
-				MaxAuctionDuration:  24 * time.Hour,
-				ForwardBidDuration:  1 * time.Hour,
-				ReverseBidDuration:  -1 * time.Hour,
-				IncrementSurplus:    d("0.05"),
-				IncrementDebt:       d("0.05"),
-				IncrementCollateral: d("0.05"),
+				MaxAuctionDuration:       24 * time.Hour,
+				ForwardBidDuration:       1 * time.Hour,
+				ReverseBidDuration:       -1 * time.Hour,
+				IncrementSurplus:         d("0.05"),
+				IncrementDebt:            d("0.05"),
+				IncrementCollateral:      d("0.05"),
+				DutchAuctionStartPremium: d("0.2"),
+				DutchAuctionDuration:     6 * time.Hour,
+				BidHistoryLength:         100,
+				BidHistoryRetention:      720 * time.Hour,
 			},
 			true,
 		},
 		{
 			"negativeBidDuration",
 			Params{
-				MaxAuctionDuration:  24 * time.Hour,
-				ForwardBidDuration:  -1 * time.Hour,
-				ReverseBidDuration:  -1 * time.Hour,
-				IncrementSurplus:    d("0.05"),
-				IncrementDebt:       d("0.05"),
-				IncrementCollateral: d("0.05"),
+				MaxAuctionDuration:       24 * time.Hour,
+				ForwardBidDuration:       -1 * time.Hour,
+				ReverseBidDuration:       -1 * time.Hour,
+				IncrementSurplus:         d("0.05"),
+				IncrementDebt:            d("0.05"),
+				IncrementCollateral:      d("0.05"),
+				DutchAuctionStartPremium: d("0.2"),
+				DutchAuctionDuration:     6 * time.Hour,
+				BidHistoryLength:         100,
+				BidHistoryRetention:      720 * time.Hour,
 			},
 			true,
 		},
 		{
 			"negativeAuction",
 			Params{
-				MaxAuctionDuration:  -24 * time.Hour,
-				ForwardBidDuration:  1 * time.Hour,
-				ReverseBidDuration:  1 * time.Hour,
-				IncrementSurplus:    d("0.05"),
-				IncrementDebt:       d("0.05"),
-				IncrementCollateral: d("0.05"),
+				MaxAuctionDuration:       -24 * time.Hour,
+				ForwardBidDuration:       1 * time.Hour,
+				ReverseBidDuration:       1 * time.Hour,
+				IncrementSurplus:         d("0.05"),
+				IncrementDebt:            d("0.05"),
+				IncrementCollateral:      d("0.05"),
+				DutchAuctionStartPremium: d("0.2"),
+				DutchAuctionDuration:     6 * time.Hour,
+				BidHistoryLength:         100,
+				BidHistoryRetention:      720 * time.Hour,
 			},
 			true,
 		},
 		{
 			"bid>auction",
 			Params{
-				MaxAuctionDuration:  1 * time.Hour,
-				ForwardBidDuration:  24 * time.Hour,
-				ReverseBidDuration:  1 * time.Hour,
-				IncrementSurplus:    d("0.05"),
-				IncrementDebt:       d("0.05"),
-				IncrementCollateral: d("0.05"),
+				MaxAuctionDuration:       1 * time.Hour,
+				ForwardBidDuration:       24 * time.Hour,
+				ReverseBidDuration:       1 * time.Hour,
+				IncrementSurplus:         d("0.05"),
+				IncrementDebt:            d("0.05"),
+				IncrementCollateral:      d("0.05"),
+				DutchAuctionStartPremium: d("0.2"),
+				DutchAuctionDuration:     6 * time.Hour,
+				BidHistoryLength:         100,
+				BidHistoryRetention:      720 * time.Hour,
 			},
 			true,
 		},
 		{
 			"negative increment surplus",
 			Params{
-				MaxAuctionDuration:  24 * time.Hour,
-				ForwardBidDuration:  1 * time.Hour,
-				ReverseBidDuration:  1 * time.Hour,
-				IncrementSurplus:    d("-0.05"),
-				IncrementDebt:       d("0.05"),
-				IncrementCollateral: d("0.05"),
+				MaxAuctionDuration:       24 * time.Hour,
+				ForwardBidDuration:       1 * time.Hour,
+				ReverseBidDuration:       1 * time.Hour,
+				IncrementSurplus:         d("-0.05"),
+				IncrementDebt:            d("0.05"),
+				IncrementCollateral:      d("0.05"),
+				DutchAuctionStartPremium: d("0.2"),
+				DutchAuctionDuration:     6 * time.Hour,
+				BidHistoryLength:         100,
+				BidHistoryRetention:      720 * time.Hour,
 			},
 			true,
 		},
 		{
 			"negative increment debt",
 			Params{
-				MaxAuctionDuration:  24 * time.Hour,
-				ForwardBidDuration:  1 * time.Hour,
-				ReverseBidDuration:  1 * time.Hour,
-				IncrementSurplus:    d("0.05"),
-				IncrementDebt:       d("-0.05"),
-				IncrementCollateral: d("0.05"),
+				MaxAuctionDuration:       24 * time.Hour,
+				ForwardBidDuration:       1 * time.Hour,
+				ReverseBidDuration:       1 * time.Hour,
+				IncrementSurplus:         d("0.05"),
+				IncrementDebt:            d("-0.05"),
+				IncrementCollateral:      d("0.05"),
+				DutchAuctionStartPremium: d("0.2"),
+				DutchAuctionDuration:     6 * time.Hour,
+				BidHistoryLength:         100,
+				BidHistoryRetention:      720 * time.Hour,
 			},
 			true,
 		},
 		{
 			"negative increment collateral",
 			Params{
-				MaxAuctionDuration:  24 * time.Hour,
-				ForwardBidDuration:  1 * time.Hour,
-				ReverseBidDuration:  1 * time.Hour,
-				IncrementSurplus:    d("0.05"),
-				IncrementDebt:       d("0.05"),
-				IncrementCollateral: d("-0.05"),
+				MaxAuctionDuration:       24 * time.Hour,
+				ForwardBidDuration:       1 * time.Hour,
+				ReverseBidDuration:       1 * time.Hour,
+				IncrementSurplus:         d("0.05"),
+				IncrementDebt:            d("0.05"),
+				IncrementCollateral:      d("-0.05"),
+				DutchAuctionStartPremium: d("0.2"),
+				DutchAuctionDuration:     6 * time.Hour,
+				BidHistoryLength:         100,
+				BidHistoryRetention:      720 * time.Hour,
+			},
+			true,
+		},
+		{
+			"negative dutch auction start premium",
+			Params{
+				MaxAuctionDuration:       24 * time.Hour,
+				ForwardBidDuration:       1 * time.Hour,
+				ReverseBidDuration:       1 * time.Hour,
+				IncrementSurplus:         d("0.05"),
+				IncrementDebt:            d("0.05"),
+				IncrementCollateral:      d("0.05"),
+				DutchAuctionStartPremium: d("-0.2"),
+				DutchAuctionDuration:     6 * time.Hour,
+				BidHistoryLength:         100,
+				BidHistoryRetention:      720 * time.Hour,
+			},
+			true,
+		},
+		{
+			"zero dutch auction duration",
+			Params{
+				MaxAuctionDuration:       24 * time.Hour,
+				ForwardBidDuration:       1 * time.Hour,
+				ReverseBidDuration:       1 * time.Hour,
+				IncrementSurplus:         d("0.05"),
+				IncrementDebt:            d("0.05"),
+				IncrementCollateral:      d("0.05"),
+				DutchAuctionStartPremium: d("0.2"),
+				DutchAuctionDuration:     0,
+				BidHistoryLength:         100,
+				BidHistoryRetention:      720 * time.Hour,
+			},
+			true,
+		},
+		{
+			"zero bid history length",
+			Params{
+				MaxAuctionDuration:       24 * time.Hour,
+				ForwardBidDuration:       1 * time.Hour,
+				ReverseBidDuration:       1 * time.Hour,
+				IncrementSurplus:         d("0.05"),
+				IncrementDebt:            d("0.05"),
+				IncrementCollateral:      d("0.05"),
+				DutchAuctionStartPremium: d("0.2"),
+				DutchAuctionDuration:     6 * time.Hour,
+				BidHistoryLength:         0,
+				BidHistoryRetention:      720 * time.Hour,
+			},
+			false,
+		},
+		{
+			"negative bid history retention",
+			Params{
+				MaxAuctionDuration:       24 * time.Hour,
+				ForwardBidDuration:       1 * time.Hour,
+				ReverseBidDuration:       1 * time.Hour,
+				IncrementSurplus:         d("0.05"),
+				IncrementDebt:            d("0.05"),
+				IncrementCollateral:      d("0.05"),
+				DutchAuctionStartPremium: d("0.2"),
+				DutchAuctionDuration:     6 * time.Hour,
+				BidHistoryLength:         100,
+				BidHistoryRetention:      -1 * time.Hour,
 			},
 			true,
 		},
@@ -131,3 +229,99 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_ValidateBidRules(t *testing.T) {
+	testCases := []struct {
+		name         string
+		bidRules     BidRules
+		priceMarkets PriceMarkets
+		expectErr    bool
+	}{
+		{
+			"valid",
+			BidRules{
+				NewBidRule(SurplusAuctionType, d("10"), time.Hour),
+				NewBidRule(CollateralAuctionType, d("0"), 0),
+			},
+			PriceMarkets{
+				NewPriceMarket("ukava", "kava:usd", i(6)),
+				NewPriceMarket("usdx", "usdx:usd", i(6)),
+			},
+			false,
+		},
+		{
+			"dutch collateral auction bid rule",
+			BidRules{NewBidRule(DutchCollateralAuctionType, d("10"), time.Hour)},
+			PriceMarkets{},
+			true,
+		},
+		{
+			"duplicate bid rule",
+			BidRules{
+				NewBidRule(DebtAuctionType, d("10"), time.Hour),
+				NewBidRule(DebtAuctionType, d("20"), 0),
+			},
+			PriceMarkets{},
+			true,
+		},
+		{
+			"nil min increment value",
+			BidRules{NewBidRule(DebtAuctionType, sdk.Dec{}, time.Hour)},
+			PriceMarkets{},
+			true,
+		},
+		{
+			"negative min increment value",
+			BidRules{NewBidRule(DebtAuctionType, d("-1"), time.Hour)},
+			PriceMarkets{},
+			true,
+		},
+		{
+			"negative anti-sniping window",
+			BidRules{NewBidRule(DebtAuctionType, d("10"), -time.Hour)},
+			PriceMarkets{},
+			true,
+		},
+		{
+			"invalid price market denom",
+			BidRules{},
+			PriceMarkets{NewPriceMarket("", "kava:usd", i(6))},
+			true,
+		},
+		{
+			"blank price market id",
+			BidRules{},
+			PriceMarkets{NewPriceMarket("ukava", " ", i(6))},
+			true,
+		},
+		{
+			"conversion factor too large",
+			BidRules{},
+			PriceMarkets{NewPriceMarket("ukava", "kava:usd", i(19))},
+			true,
+		},
+		{
+			"duplicate price market",
+			BidRules{},
+			PriceMarkets{
+				NewPriceMarket("ukava", "kava:usd", i(6)),
+				NewPriceMarket("ukava", "kava:usd:30", i(6)),
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.BidRules = tc.bidRules
+			params.PriceMarkets = tc.priceMarkets
+
+			err := params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -375,6 +375,190 @@ func (m *QueryNextAuctionIDResponse) GetId() uint64 {
 	return 0
 }
 
+// QueryBidHistoryRequest defines the request type for querying the bid history of an auction.
+type QueryBidHistoryRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (m *QueryBidHistoryRequest) Reset()         { *m = QueryBidHistoryRequest{} }
+func (m *QueryBidHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBidHistoryRequest) ProtoMessage()    {}
+func (*QueryBidHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0afd5f8bae92c6bb, []int{8}
+}
+func (m *QueryBidHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBidHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidHistoryRequest.Merge(m, src)
+}
+func (m *QueryBidHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBidHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryBidHistoryRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+// QueryBidHistoryResponse defines the response type for querying the bid history of an auction.
+type QueryBidHistoryResponse struct {
+	BidHistory BidHistory `protobuf:"bytes,1,opt,name=bid_history,json=bidHistory,proto3" json:"bid_history"`
+}
+
+func (m *QueryBidHistoryResponse) Reset()         { *m = QueryBidHistoryResponse{} }
+func (m *QueryBidHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBidHistoryResponse) ProtoMessage()    {}
+func (*QueryBidHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0afd5f8bae92c6bb, []int{9}
+}
+func (m *QueryBidHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBidHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidHistoryResponse.Merge(m, src)
+}
+func (m *QueryBidHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBidHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryBidHistoryResponse) GetBidHistory() BidHistory {
+	if m != nil {
+		return m.BidHistory
+	}
+	return BidHistory{}
+}
+
+// QueryBidderAuctionsRequest defines the request type for querying the auctions a bidder has bid on.
+type QueryBidderAuctionsRequest struct {
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBidderAuctionsRequest) Reset()         { *m = QueryBidderAuctionsRequest{} }
+func (m *QueryBidderAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBidderAuctionsRequest) ProtoMessage()    {}
+func (*QueryBidderAuctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0afd5f8bae92c6bb, []int{10}
+}
+func (m *QueryBidderAuctionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidderAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidderAuctionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBidderAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidderAuctionsRequest.Merge(m, src)
+}
+func (m *QueryBidderAuctionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidderAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidderAuctionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBidderAuctionsRequest proto.InternalMessageInfo
+
+// QueryBidderAuctionsResponse defines the response type for querying the auctions a bidder has bid on.
+type QueryBidderAuctionsResponse struct {
+	BidHistories []BidHistory `protobuf:"bytes,1,rep,name=bid_histories,json=bidHistories,proto3" json:"bid_histories"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBidderAuctionsResponse) Reset()         { *m = QueryBidderAuctionsResponse{} }
+func (m *QueryBidderAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBidderAuctionsResponse) ProtoMessage()    {}
+func (*QueryBidderAuctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0afd5f8bae92c6bb, []int{11}
+}
+func (m *QueryBidderAuctionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidderAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidderAuctionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBidderAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidderAuctionsResponse.Merge(m, src)
+}
+func (m *QueryBidderAuctionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidderAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidderAuctionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBidderAuctionsResponse proto.InternalMessageInfo
+
+func (m *QueryBidderAuctionsResponse) GetBidHistories() []BidHistory {
+	if m != nil {
+		return m.BidHistories
+	}
+	return nil
+}
+
+func (m *QueryBidderAuctionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "kava.auction.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "kava.auction.v1beta1.QueryParamsResponse")
@@ -384,52 +568,67 @@ func init() {
 	proto.RegisterType((*QueryAuctionsResponse)(nil), "kava.auction.v1beta1.QueryAuctionsResponse")
 	proto.RegisterType((*QueryNextAuctionIDRequest)(nil), "kava.auction.v1beta1.QueryNextAuctionIDRequest")
 	proto.RegisterType((*QueryNextAuctionIDResponse)(nil), "kava.auction.v1beta1.QueryNextAuctionIDResponse")
+	proto.RegisterType((*QueryBidHistoryRequest)(nil), "kava.auction.v1beta1.QueryBidHistoryRequest")
+	proto.RegisterType((*QueryBidHistoryResponse)(nil), "kava.auction.v1beta1.QueryBidHistoryResponse")
+	proto.RegisterType((*QueryBidderAuctionsRequest)(nil), "kava.auction.v1beta1.QueryBidderAuctionsRequest")
+	proto.RegisterType((*QueryBidderAuctionsResponse)(nil), "kava.auction.v1beta1.QueryBidderAuctionsResponse")
 }
 
 func init() { proto.RegisterFile("kava/auction/v1beta1/query.proto", fileDescriptor_0afd5f8bae92c6bb) }
 
 var fileDescriptor_0afd5f8bae92c6bb = []byte{
-	// 630 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0xed, 0x34, 0x4d, 0xd3, 0xfb, 0xe9, 0xc7, 0x70, 0x18, 0x29, 0x35, 0xc1, 0x89, 0x2c,
-	0xe8, 0x5f, 0x72, 0xee, 0x9f, 0xad, 0x03, 0x52, 0x0b, 0x2a, 0xea, 0x82, 0xa8, 0x47, 0x16, 0x74,
-	0x4e, 0x0e, 0xd7, 0xa2, 0xf1, 0xb9, 0x39, 0xa7, 0x24, 0x42, 0x2c, 0xb0, 0x20, 0xb1, 0x20, 0x10,
-	0x7b, 0x79, 0x23, 0xcc, 0x1d, 0x2b, 0x31, 0xc0, 0x84, 0x50, 0xc2, 0xc0, 0xcb, 0x40, 0xbe, 0x7b,
-	0x9c, 0xc4, 0x60, 0x42, 0x36, 0xdf, 0x73, 0xdf, 0xe7, 0xb9, 0xcf, 0x3d, 0xcf, 0xf7, 0x8c, 0xea,
-	0x4f, 0xe9, 0x19, 0x75, 0x68, 0xb7, 0x19, 0x07, 0x3c, 0x74, 0xce, 0xb6, 0x3c, 0x16, 0xd3, 0x2d,
-	0xe7, 0xb4, 0xcb, 0x3a, 0x7d, 0x12, 0x75, 0x78, 0xcc, 0xb1, 0x91, 0x28, 0x08, 0x28, 0x08, 0x28,
-	0xcc, 0xf5, 0x26, 0x17, 0x6d, 0x2e, 0x1c, 0x8f, 0x0a, 0xa6, 0xe4, 0xa3, 0xe4, 0x88, 0xfa, 0x41,
-	0x48, 0xa5, 0x5a, 0x56, 0x30, 0x0d, 0x9f, 0xfb, 0x5c, 0x7e, 0x3a, 0xc9, 0x17, 0x44, 0xab, 0x3e,
-	0xe7, 0xfe, 0x09, 0x73, 0x68, 0x14, 0x38, 0x34, 0x0c, 0x79, 0x2c, 0x53, 0x04, 0xec, 0x2e, 0xc1,
-	0xae, 0x5c, 0x79, 0xdd, 0x27, 0x0e, 0x0d, 0x01, 0xc8, 0xb4, 0x73, 0x91, 0x7d, 0x16, 0x32, 0x11,
-	0x40, 0xba, 0x6d, 0x20, 0x7c, 0x94, 0x40, 0x3d, 0xa4, 0x1d, 0xda, 0x16, 0x2e, 0x3b, 0xed, 0x32,
-	0x11, 0xdb, 0x47, 0xe8, 0x6a, 0x26, 0x2a, 0x22, 0x1e, 0x0a, 0x86, 0x77, 0x51, 0x29, 0x92, 0x91,
-	0x8a, 0x5e, 0xd7, 0x57, 0xff, 0xdb, 0xae, 0x92, 0xbc, 0x2b, 0x13, 0x95, 0xb5, 0x5f, 0xbc, 0xf8,
-	0x56, 0xd3, 0x5c, 0xc8, 0xb0, 0xef, 0x40, 0xc9, 0x3d, 0x25, 0x86, 0x93, 0xf0, 0x0d, 0x84, 0x20,
-	0xfd, 0x71, 0xd0, 0x92, 0x65, 0x8b, 0xee, 0x22, 0x44, 0x0e, 0x5b, 0xbb, 0xe5, 0xd7, 0xe7, 0x35,
-	0xed, 0xe7, 0x79, 0x4d, 0xb3, 0x0f, 0x90, 0x91, 0xcd, 0x07, 0x26, 0x82, 0x16, 0x40, 0x0e, 0x50,
-	0x06, 0x51, 0x1d, 0x21, 0x69, 0x47, 0xc8, 0x5e, 0xd8, 0x77, 0x53, 0x91, 0xfd, 0x49, 0xcf, 0x16,
-	0x4a, 0xef, 0x8c, 0x31, 0x2a, 0xc6, 0xfd, 0x88, 0xc9, 0x2a, 0x8b, 0xae, 0xfc, 0xc6, 0x06, 0x9a,
-	0xe7, 0xcf, 0x42, 0xd6, 0xa9, 0x14, 0x64, 0x50, 0x2d, 0x92, 0x68, 0x8b, 0x85, 0xbc, 0x5d, 0x99,
-	0x53, 0x51, 0xb9, 0x48, 0xa2, 0xd1, 0x31, 0x15, 0xac, 0x52, 0x54, 0x51, 0xb9, 0xc0, 0x07, 0x08,
-	0x8d, 0xc7, 0x5c, 0x99, 0x97, 0x84, 0xcb, 0x44, 0x79, 0x82, 0x24, 0x9e, 0x20, 0xca, 0x42, 0xe3,
-	0xde, 0xf9, 0x0c, 0x88, 0xdc, 0x89, 0xcc, 0x89, 0x46, 0xbc, 0xd3, 0xd1, 0xb5, 0xdf, 0x2e, 0x00,
-	0xad, 0xd8, 0x44, 0x65, 0xb8, 0x65, 0x32, 0xa0, 0xb9, 0xbf, 0xf6, 0x62, 0xa4, 0xc2, 0xf7, 0x33,
-	0x74, 0x05, 0x49, 0xb7, 0xf2, 0x4f, 0x3a, 0x75, 0xdc, 0x24, 0x9e, 0x7d, 0x1d, 0x2d, 0x49, 0xa6,
-	0x07, 0xac, 0x17, 0x03, 0xd7, 0xe1, 0xbd, 0xd4, 0x4d, 0xb7, 0x91, 0x99, 0xb7, 0x09, 0xd4, 0x57,
-	0x50, 0x61, 0x34, 0xf9, 0x42, 0xd0, 0xda, 0xfe, 0x52, 0x44, 0xf3, 0x52, 0x8e, 0x5f, 0xe9, 0xa8,
-	0xa4, 0xbc, 0x84, 0x57, 0xf3, 0x9d, 0xf6, 0xa7, 0x75, 0xcd, 0xb5, 0x19, 0x94, 0xea, 0x64, 0xfb,
-	0xe6, 0xcb, 0xcf, 0x3f, 0xde, 0x17, 0x2c, 0x5c, 0x75, 0x72, 0x1f, 0x8a, 0x32, 0x2e, 0xfe, 0xa0,
-	0xa3, 0x05, 0xa0, 0xc6, 0xd3, 0x8a, 0x67, 0x8d, 0x6d, 0xae, 0xcf, 0x22, 0x05, 0x90, 0x1d, 0x09,
-	0xd2, 0xc0, 0x1b, 0xf9, 0x20, 0xe9, 0xb8, 0x9c, 0xe7, 0xe3, 0xa7, 0xf2, 0x02, 0xbf, 0xd1, 0x51,
-	0x39, 0xb5, 0x00, 0x9e, 0xe1, 0xb4, 0x51, 0x87, 0x36, 0x66, 0xd2, 0x02, 0xda, 0xb2, 0x44, 0xab,
-	0x63, 0x6b, 0x3a, 0x1a, 0xfe, 0xa8, 0xa3, 0xff, 0x33, 0xf3, 0xc5, 0xce, 0x94, 0x63, 0xf2, 0x6c,
-	0x62, 0x6e, 0xce, 0x9e, 0x00, 0x70, 0x0d, 0x09, 0xb7, 0x82, 0x6f, 0xe5, 0xc3, 0x85, 0xac, 0x17,
-	0x37, 0x20, 0xd8, 0x08, 0x5a, 0xfb, 0x77, 0x2f, 0x06, 0x96, 0x7e, 0x39, 0xb0, 0xf4, 0xef, 0x03,
-	0x4b, 0x7f, 0x3b, 0xb4, 0xb4, 0xcb, 0xa1, 0xa5, 0x7d, 0x1d, 0x5a, 0xda, 0xa3, 0x35, 0x3f, 0x88,
-	0x8f, 0xbb, 0x1e, 0x69, 0xf2, 0xb6, 0x2c, 0xd5, 0x38, 0xa1, 0x9e, 0x50, 0x45, 0x7b, 0xa3, 0xb2,
-	0xc9, 0x1f, 0x41, 0x78, 0x25, 0xf9, 0x94, 0x76, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x51, 0x53,
-	0x3d, 0x4a, 0x10, 0x06, 0x00, 0x00,
+	// 802 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4d, 0x4f, 0x13, 0x5d,
+	0x14, 0xc7, 0x3b, 0xa5, 0x14, 0x38, 0x3c, 0xb0, 0xb8, 0x4f, 0xc5, 0x32, 0xe0, 0xd0, 0x4c, 0x94,
+	0xf7, 0xce, 0xb4, 0xb0, 0xc0, 0xb0, 0x30, 0xa1, 0x1a, 0x90, 0x98, 0x18, 0xe9, 0xd2, 0x0d, 0x99,
+	0x61, 0xae, 0xc3, 0x44, 0x3a, 0x53, 0x7a, 0xa7, 0x48, 0x43, 0xd8, 0x68, 0x62, 0x4c, 0xdc, 0x18,
+	0x8d, 0x7b, 0x8c, 0x7b, 0x36, 0xee, 0x5d, 0xb3, 0x24, 0x71, 0xe3, 0xca, 0x18, 0x70, 0xe1, 0xc7,
+	0x30, 0x73, 0xef, 0xe9, 0x94, 0xd2, 0xb1, 0x0e, 0xd1, 0xdd, 0xdc, 0x73, 0xcf, 0xcb, 0xef, 0x9c,
+	0xfb, 0xef, 0x29, 0xe4, 0x9e, 0x1a, 0x7b, 0x86, 0x6e, 0xd4, 0xb7, 0x7c, 0xc7, 0x73, 0xf5, 0xbd,
+	0xa2, 0x49, 0x7d, 0xa3, 0xa8, 0xef, 0xd6, 0x69, 0xad, 0xa1, 0x55, 0x6b, 0x9e, 0xef, 0x91, 0x4c,
+	0xe0, 0xa1, 0xa1, 0x87, 0x86, 0x1e, 0xf2, 0xec, 0x96, 0xc7, 0x2a, 0x1e, 0xd3, 0x4d, 0x83, 0x51,
+	0xe1, 0x1e, 0x06, 0x57, 0x0d, 0xdb, 0x71, 0x0d, 0xee, 0xcd, 0x33, 0xc8, 0x19, 0xdb, 0xb3, 0x3d,
+	0xfe, 0xa9, 0x07, 0x5f, 0x68, 0x1d, 0xb7, 0x3d, 0xcf, 0xde, 0xa1, 0xba, 0x51, 0x75, 0x74, 0xc3,
+	0x75, 0x3d, 0x9f, 0x87, 0x30, 0xbc, 0x1d, 0xc5, 0x5b, 0x7e, 0x32, 0xeb, 0x4f, 0x74, 0xc3, 0x45,
+	0x20, 0x59, 0x8d, 0x44, 0x6e, 0x02, 0x76, 0xf3, 0xb1, 0xa9, 0x4b, 0x99, 0x83, 0x25, 0xd4, 0x0c,
+	0x90, 0x8d, 0x00, 0xfc, 0x91, 0x51, 0x33, 0x2a, 0xac, 0x4c, 0x77, 0xeb, 0x94, 0xf9, 0xea, 0x06,
+	0xfc, 0xdf, 0x66, 0x65, 0x55, 0xcf, 0x65, 0x94, 0x2c, 0x43, 0xba, 0xca, 0x2d, 0x59, 0x29, 0x27,
+	0x4d, 0x0f, 0x2e, 0x8c, 0x6b, 0x51, 0x63, 0xd1, 0x44, 0x54, 0x29, 0x75, 0xf2, 0x6d, 0x22, 0x51,
+	0xc6, 0x08, 0xf5, 0x0e, 0xa6, 0x5c, 0x11, 0xce, 0x58, 0x89, 0xdc, 0x00, 0xc0, 0xf0, 0x4d, 0xc7,
+	0xe2, 0x69, 0x53, 0xe5, 0x01, 0xb4, 0xac, 0x5b, 0xcb, 0xfd, 0xaf, 0x8e, 0x26, 0x12, 0x3f, 0x8f,
+	0x26, 0x12, 0xea, 0x2a, 0x64, 0xda, 0xe3, 0x91, 0x49, 0x83, 0x3e, 0x74, 0x47, 0xa8, 0x8c, 0x26,
+	0xa6, 0xa6, 0x35, 0xa7, 0xa6, 0xad, 0xb8, 0x8d, 0x72, 0xd3, 0x49, 0xfd, 0x2c, 0xb5, 0x27, 0x6a,
+	0xf6, 0x4c, 0x08, 0xa4, 0xfc, 0x46, 0x95, 0xf2, 0x2c, 0x03, 0x65, 0xfe, 0x4d, 0x32, 0xd0, 0xeb,
+	0x3d, 0x73, 0x69, 0x2d, 0x9b, 0xe4, 0x46, 0x71, 0x08, 0xac, 0x16, 0x75, 0xbd, 0x4a, 0xb6, 0x47,
+	0x58, 0xf9, 0x21, 0xb0, 0x56, 0xb7, 0x0d, 0x46, 0xb3, 0x29, 0x61, 0xe5, 0x07, 0xb2, 0x0a, 0xd0,
+	0x92, 0x42, 0xb6, 0x97, 0x13, 0x4e, 0x6a, 0x42, 0x37, 0x5a, 0xa0, 0x1b, 0x4d, 0xc8, 0xac, 0x35,
+	0x3b, 0x9b, 0x22, 0x51, 0xf9, 0x42, 0xe4, 0x85, 0x41, 0xbc, 0x95, 0xe0, 0xda, 0xa5, 0x06, 0x70,
+	0x14, 0x05, 0xe8, 0xc7, 0x2e, 0x83, 0x07, 0xea, 0xf9, 0xed, 0x2c, 0x42, 0x2f, 0xb2, 0xd6, 0x46,
+	0x97, 0xe4, 0x74, 0x53, 0x7f, 0xa4, 0x13, 0xe5, 0x2e, 0xe2, 0xa9, 0x63, 0x30, 0xca, 0x99, 0x1e,
+	0xd2, 0x7d, 0x1f, 0xb9, 0xd6, 0xef, 0x35, 0xd5, 0x34, 0x0f, 0x72, 0xd4, 0x25, 0x52, 0x0f, 0x43,
+	0x32, 0x7c, 0xf9, 0xa4, 0x63, 0xa9, 0x4b, 0x30, 0xc2, 0xbd, 0x4b, 0x8e, 0x75, 0xdf, 0x61, 0xbe,
+	0x57, 0x6b, 0xc4, 0xd3, 0x8a, 0x6a, 0xc2, 0xf5, 0x8e, 0x40, 0xac, 0xb1, 0x06, 0x83, 0xa6, 0x63,
+	0x6d, 0x6e, 0x0b, 0x33, 0x0a, 0x25, 0x17, 0xad, 0xde, 0x56, 0x38, 0x2a, 0x18, 0xcc, 0xd0, 0xa2,
+	0xbe, 0x94, 0xb0, 0x97, 0x92, 0x63, 0x59, 0xb4, 0x76, 0x59, 0x43, 0x23, 0x90, 0x36, 0xf9, 0x05,
+	0xaa, 0x08, 0x4f, 0x64, 0x35, 0x62, 0xce, 0x7f, 0xa7, 0x82, 0x4f, 0x12, 0x8c, 0x45, 0x82, 0x60,
+	0xc7, 0x0f, 0x60, 0xa8, 0xd5, 0xb1, 0x43, 0x9b, 0x82, 0x88, 0xdb, 0xf3, 0x7f, 0x61, 0xcf, 0x0e,
+	0xfd, 0x77, 0x32, 0x59, 0x38, 0xee, 0x83, 0x5e, 0x4e, 0x4d, 0x5e, 0x48, 0x90, 0x16, 0x7b, 0x82,
+	0x4c, 0x47, 0x33, 0x75, 0xae, 0x25, 0x79, 0x26, 0x86, 0xa7, 0xa8, 0xaa, 0xde, 0x7c, 0xfe, 0xe5,
+	0xc7, 0xbb, 0xa4, 0x42, 0xc6, 0xf5, 0xc8, 0x25, 0x28, 0x96, 0x12, 0x79, 0x2f, 0x41, 0x1f, 0x8e,
+	0x8e, 0x74, 0x4b, 0xde, 0xbe, 0xb4, 0xe4, 0xd9, 0x38, 0xae, 0x08, 0xb2, 0xc8, 0x41, 0xf2, 0x64,
+	0x4e, 0xef, 0xb6, 0xb1, 0x99, 0x7e, 0xd0, 0x92, 0xf6, 0x21, 0x79, 0x2d, 0x41, 0x7f, 0xf3, 0x49,
+	0x49, 0x8c, 0x6a, 0xe1, 0x84, 0xe6, 0x62, 0xf9, 0x22, 0xda, 0x24, 0x47, 0xcb, 0x11, 0xa5, 0x3b,
+	0x1a, 0xf9, 0x20, 0xc1, 0x50, 0xdb, 0x6f, 0x97, 0xe8, 0x5d, 0xca, 0x44, 0xad, 0x00, 0xb9, 0x10,
+	0x3f, 0x00, 0xe1, 0xf2, 0x1c, 0x6e, 0x8a, 0xdc, 0x8a, 0x86, 0x73, 0xe9, 0xbe, 0x9f, 0x47, 0x63,
+	0xde, 0xb1, 0xc8, 0x47, 0x09, 0xa0, 0xa5, 0x62, 0x32, 0xdf, 0xa5, 0x5e, 0xc7, 0x62, 0x91, 0xf3,
+	0x31, 0xbd, 0x11, 0xed, 0x36, 0x47, 0x5b, 0x20, 0x85, 0x2b, 0x3c, 0xa9, 0x6e, 0x3a, 0x16, 0x23,
+	0xc7, 0x12, 0x0c, 0xb7, 0xff, 0x60, 0x49, 0xa1, 0x7b, 0xed, 0xce, 0x25, 0x23, 0x17, 0xaf, 0x10,
+	0x81, 0xc4, 0x4b, 0x9c, 0xb8, 0x48, 0xf4, 0x68, 0x62, 0xb1, 0xa5, 0x98, 0x7e, 0x20, 0x3e, 0x0e,
+	0xc3, 0x16, 0x4a, 0x77, 0x4f, 0xce, 0x14, 0xe9, 0xf4, 0x4c, 0x91, 0xbe, 0x9f, 0x29, 0xd2, 0x9b,
+	0x73, 0x25, 0x71, 0x7a, 0xae, 0x24, 0xbe, 0x9e, 0x2b, 0x89, 0xc7, 0x33, 0xb6, 0xe3, 0x6f, 0xd7,
+	0x4d, 0x6d, 0xcb, 0xab, 0xf0, 0xa4, 0xf9, 0x1d, 0xc3, 0x64, 0x22, 0xfd, 0x7e, 0x58, 0x20, 0xf8,
+	0x13, 0x65, 0x66, 0x9a, 0xff, 0xfb, 0x2c, 0xfe, 0x1a, 0x00, 0xa2, 0xf0, 0x15, 0x9a, 0x67, 0x09,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -452,6 +651,10 @@ type QueryClient interface {
 	Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error)
 	// NextAuctionID queries the next auction ID
 	NextAuctionID(ctx context.Context, in *QueryNextAuctionIDRequest, opts ...grpc.CallOption) (*QueryNextAuctionIDResponse, error)
+	// BidHistory queries the bid history of an open or closed auction by auction ID
+	BidHistory(ctx context.Context, in *QueryBidHistoryRequest, opts ...grpc.CallOption) (*QueryBidHistoryResponse, error)
+	// BidderAuctions queries the bid histories of open and closed auctions a bidder has bid on
+	BidderAuctions(ctx context.Context, in *QueryBidderAuctionsRequest, opts ...grpc.CallOption) (*QueryBidderAuctionsResponse, error)
 }
 
 type queryClient struct {
@@ -498,6 +701,24 @@ func (c *queryClient) NextAuctionID(ctx context.Context, in *QueryNextAuctionIDR
 	return out, nil
 }
 
+func (c *queryClient) BidHistory(ctx context.Context, in *QueryBidHistoryRequest, opts ...grpc.CallOption) (*QueryBidHistoryResponse, error) {
+	out := new(QueryBidHistoryResponse)
+	err := c.cc.Invoke(ctx, "/kava.auction.v1beta1.Query/BidHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BidderAuctions(ctx context.Context, in *QueryBidderAuctionsRequest, opts ...grpc.CallOption) (*QueryBidderAuctionsResponse, error) {
+	out := new(QueryBidderAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/kava.auction.v1beta1.Query/BidderAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the auction module.
@@ -508,6 +729,10 @@ type QueryServer interface {
 	Auctions(context.Context, *QueryAuctionsRequest) (*QueryAuctionsResponse, error)
 	// NextAuctionID queries the next auction ID
 	NextAuctionID(context.Context, *QueryNextAuctionIDRequest) (*QueryNextAuctionIDResponse, error)
+	// BidHistory queries the bid history of an open or closed auction by auction ID
+	BidHistory(context.Context, *QueryBidHistoryRequest) (*QueryBidHistoryResponse, error)
+	// BidderAuctions queries the bid histories of open and closed auctions a bidder has bid on
+	BidderAuctions(context.Context, *QueryBidderAuctionsRequest) (*QueryBidderAuctionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -526,6 +751,12 @@ func (*UnimplementedQueryServer) Auctions(ctx context.Context, req *QueryAuction
 func (*UnimplementedQueryServer) NextAuctionID(ctx context.Context, req *QueryNextAuctionIDRequest) (*QueryNextAuctionIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextAuctionID not implemented")
 }
+func (*UnimplementedQueryServer) BidHistory(ctx context.Context, req *QueryBidHistoryRequest) (*QueryBidHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidHistory not implemented")
+}
+func (*UnimplementedQueryServer) BidderAuctions(ctx context.Context, req *QueryBidderAuctionsRequest) (*QueryBidderAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidderAuctions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -603,6 +834,42 @@ func _Query_NextAuctionID_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BidHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BidHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.auction.v1beta1.Query/BidHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BidHistory(ctx, req.(*QueryBidHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BidderAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidderAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BidderAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.auction.v1beta1.Query/BidderAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BidderAuctions(ctx, req.(*QueryBidderAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.auction.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -623,6 +890,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NextAuctionID",
 			Handler:    _Query_NextAuctionID_Handler,
 		},
+		{
+			MethodName: "BidHistory",
+			Handler:    _Query_BidHistory_Handler,
+		},
+		{
+			MethodName: "BidderAuctions",
+			Handler:    _Query_BidderAuctions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/auction/v1beta1/query.proto",
@@ -910,79 +1185,231 @@ func (m *QueryNextAuctionIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBidHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryBidHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAuctionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBidHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBidHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Auction != nil {
-		l = m.Auction.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.BidHistory.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAuctionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBidderAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidderAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidderAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBidderAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidderAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidderAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BidHistories) > 0 {
+		for iNdEx := len(m.BidHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *QueryAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Auction != nil {
+		l = m.Auction.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	l = len(m.Phase)
 	if l > 0 {
@@ -1035,6 +1462,65 @@ func (m *QueryNextAuctionIDResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBidHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *QueryBidHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BidHistory.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBidderAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBidderAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BidHistories) > 0 {
+		for _, e := range m.BidHistories {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1782,6 +2268,396 @@ func (m *QueryNextAuctionIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBidHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBidHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBidHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBidHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBidHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBidHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BidHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBidderAuctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBidderAuctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBidderAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBidderAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBidderAuctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBidderAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidHistories = append(m.BidHistories, BidHistory{})
+			if err := m.BidHistories[len(m.BidHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
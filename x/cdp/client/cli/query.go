@@ -22,6 +22,12 @@ const (
 	flagOwner          = "owner"
 	flagID             = "id"
 	flagRatio          = "ratio" // returns CDPs under the given collateralization ratio threshold
+	flagHorizon        = "horizon"
+	flagDeposit        = "deposit"
+	flagWithdraw       = "withdraw"
+	flagDraw           = "draw"
+	flagRepay          = "repay"
+	flagDenom          = "denom"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -34,10 +40,13 @@ func GetQueryCmd() *cobra.Command {
 
 	cmds := []*cobra.Command{
 		QueryCdpCmd(),
+		QueryCdpHealthCmd(),
 		QueryGetCdpsCmd(),
 		QueryCdpDepositsCmd(),
 		QueryParamsCmd(),
 		QueryGetAccounts(),
+		QueryStabilityFeesCmd(),
+		QueryPegStabilityReservesCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -87,6 +96,81 @@ $ %s query %s cdp kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a
 	}
 }
 
+// QueryCdpHealthCmd returns the command handler for querying the health of a cdp
+func QueryCdpHealthCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "health [owner-addr] [collateral-type]",
+		Short: "get the liquidation price and projected fees of a cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the liquidation price, buffer to liquidation and projected fees of a CDP, optionally
+after hypothetical deposit, withdraw, draw and repay amounts.
+
+Example:
+$ %s query %s health kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a
+$ %s query %s health kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a --horizon 720h --deposit 1000000uatom --draw 500000usdx
+`, version.AppName, types.ModuleName, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			horizon, err := cmd.Flags().GetDuration(flagHorizon)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCdpHealthRequest{
+				Owner:          args[0],
+				CollateralType: args[1],
+				Horizon:        horizon,
+			}
+			for flag, amount := range map[string]**sdk.Coin{
+				flagDeposit:  &req.Deposit,
+				flagWithdraw: &req.Withdraw,
+				flagDraw:     &req.Draw,
+				flagRepay:    &req.Repay,
+			} {
+				coinStr, err := cmd.Flags().GetString(flag)
+				if err != nil {
+					return err
+				}
+				if coinStr == "" {
+					continue
+				}
+				coin, err := sdk.ParseCoinNormalized(coinStr)
+				if err != nil {
+					return fmt.Errorf("invalid %s amount: %w", flag, err)
+				}
+				*amount = &coin
+			}
+
+			res, err := queryClient.CdpHealth(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Duration(flagHorizon, 0, "(optional) time from the current block to project fees over, up to five years")
+	cmd.Flags().String(flagDeposit, "", "(optional) collateral to simulate depositing")
+	cmd.Flags().String(flagWithdraw, "", "(optional) collateral to simulate withdrawing")
+	cmd.Flags().String(flagDraw, "", "(optional) debt to simulate drawing")
+	cmd.Flags().String(flagRepay, "", "(optional) debt to simulate repaying")
+
+	return cmd
+}
+
 // QueryGetCdpsCmd queries the cdps in the store
 func QueryGetCdpsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -270,3 +354,87 @@ func QueryGetAccounts() *cobra.Command {
 		},
 	}
 }
+
+// QueryStabilityFeesCmd returns the command handler for querying the current stability fees of collateral types
+func QueryStabilityFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stability-fees",
+		Short: "get the current stability fees",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the current per second stability fee of each collateral type and the utilization of its debt limit.
+
+Example:
+$ %s query %s stability-fees
+$ %s query %s stability-fees --collateral-type=bnb-a
+`, version.AppName, types.ModuleName, version.AppName, types.ModuleName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateralType, err := cmd.Flags().GetString(flagCollateralType)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StabilityFees(context.Background(), &types.QueryStabilityFeesRequest{
+				CollateralType: collateralType,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagCollateralType, "", "(optional) filter by collateral type")
+
+	return cmd
+}
+
+// QueryPegStabilityReservesCmd returns the command handler for querying the peg stability module reserves
+func QueryPegStabilityReservesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "peg-reserves",
+		Short: "get the peg stability reserves",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the stablecoin reserves held by the peg stability module, the usdx minted against them and their debt limits.
+
+Example:
+$ %s query %s peg-reserves
+$ %s query %s peg-reserves --denom=busd
+`, version.AppName, types.ModuleName, version.AppName, types.ModuleName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PegStabilityReserves(context.Background(), &types.QueryPegStabilityReservesRequest{
+				Denom: denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "(optional) filter by stablecoin denom")
+
+	return cmd
+}
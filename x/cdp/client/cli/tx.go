@@ -29,6 +29,9 @@ func GetTxCmd() *cobra.Command {
 		GetCmdDraw(),
 		GetCmdRepay(),
 		GetCmdLiquidate(),
+		GetCmdMigrate(),
+		GetCmdPegMint(),
+		GetCmdPegRedeem(),
 	}
 
 	for _, cmd := range cmds {
@@ -243,3 +246,101 @@ $ %s tx %s liquidate kava1y70y90wzmnf00e63efk2lycgqwepthdmyzsfzm  btcb-a --from
 		},
 	}
 }
+
+// GetCmdMigrate cli command for moving the debt of a cdp to a cdp of another collateral type.
+func GetCmdMigrate() *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate [collateral-type] [target-collateral-type] [collateral]",
+		Short: "move the debt of a cdp to a cdp of another collateral type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Move the principal and accumulated fees of a cdp to a cdp of another collateral type, depositing some collateral to it.
+The cdp of the target collateral type is created if it doesn't exist, and the original cdp is closed and its collateral returned.
+The collateral can be zero when migrating to an existing cdp.
+
+Example:
+$ %s tx %s migrate bnb-a btcb-a 1000000btcb --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateral, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgMigrateCDP(clientCtx.GetFromAddress(), args[0], args[1], collateral)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdPegMint returns the command handler for minting debt with the peg stability module
+func GetCmdPegMint() *cobra.Command {
+	return &cobra.Command{
+		Use:   "peg-mint [amount]",
+		Short: "mint usdx by depositing a stablecoin to the peg stability reserves",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit a stablecoin to the peg stability module reserves and mint usdx one to one, less the mint fee.
+
+Example:
+$ %s tx %s peg-mint 1000000busd --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgPegMint(clientCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdPegRedeem returns the command handler for redeeming debt with the peg stability module
+func GetCmdPegRedeem() *cobra.Command {
+	return &cobra.Command{
+		Use:   "peg-redeem [amount] [denom]",
+		Short: "redeem usdx for a stablecoin held in the peg stability reserves",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn usdx and receive a stablecoin from the peg stability module reserves one to one, less the redeem fee.
+
+Example:
+$ %s tx %s peg-redeem 1000000usdx busd --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgPegRedeem(clientCtx.GetFromAddress(), amount, args[1])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
@@ -24,6 +24,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, pk types.PricefeedKeeper, ak
 	if liqModuleAcc == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.LiquidatorMacc))
 	}
+	pegStabilityModuleAcc := ak.GetModuleAccount(ctx, types.PegStabilityMacc)
+	if pegStabilityModuleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.PegStabilityMacc))
+	}
 
 	// validate denoms - check that any collaterals in the params are in the pricefeed,
 	// pricefeed MUST call InitGenesis before cdp
@@ -84,6 +88,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, pk types.PricefeedKeeper, ak
 	for _, d := range gs.Deposits {
 		k.SetDeposit(ctx, d)
 	}
+
+	for _, psr := range gs.PegStabilityReserves {
+		k.SetPegStabilityDebt(ctx, psr.Denom, psr.Debt)
+	}
+	if err := k.ValidatePegStabilityReserves(ctx); err != nil {
+		panic(fmt.Sprintf("invalid %s genesis state: %s", types.ModuleName, err))
+	}
 }
 
 // ExportGenesis export genesis state for cdp module
@@ -129,5 +140,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		totalPrincipals = append(totalPrincipals, genTotalPrincipal)
 	}
 
-	return types.NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousAccumTimes, totalPrincipals)
+	var pegStabilityReserves types.PegStabilityReserves
+	k.IteratePegStabilityDebts(ctx, func(reserve types.PegStabilityReserve) bool {
+		pegStabilityReserves = append(pegStabilityReserves, reserve)
+		return false
+	})
+
+	return types.NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousAccumTimes, totalPrincipals, pegStabilityReserves)
 }
@@ -14,6 +14,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/cdp"
@@ -52,6 +53,7 @@ func (suite *GenesisTestSuite) TestInvalidGenState() {
 		govDenom           string
 		genAccumTimes      types.GenesisAccumulationTimes
 		genTotalPrincipals types.GenesisTotalPrincipals
+		pegReserves        types.PegStabilityReserves
 	}
 	type errArgs struct {
 		expectPass bool
@@ -127,11 +129,48 @@ func (suite *GenesisTestSuite) TestInvalidGenState() {
 				contains:   "total principal should be positive",
 			},
 		},
+		{
+			name: "negative peg stability debt",
+			args: args{
+				params:             types.DefaultParams(),
+				cdps:               types.CDPs{},
+				deposits:           types.Deposits{},
+				debtDenom:          types.DefaultDebtDenom,
+				govDenom:           types.DefaultGovDenom,
+				genAccumTimes:      types.DefaultGenesisState().PreviousAccumulationTimes,
+				genTotalPrincipals: types.DefaultGenesisState().TotalPrincipals,
+				pegReserves:        types.PegStabilityReserves{types.NewPegStabilityReserve("busd", sdkmath.NewInt(-1))},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "peg stability debt should not be negative",
+			},
+		},
+		{
+			name: "duplicate peg stability reserve",
+			args: args{
+				params:             types.DefaultParams(),
+				cdps:               types.CDPs{},
+				deposits:           types.Deposits{},
+				debtDenom:          types.DefaultDebtDenom,
+				govDenom:           types.DefaultGovDenom,
+				genAccumTimes:      types.DefaultGenesisState().PreviousAccumulationTimes,
+				genTotalPrincipals: types.DefaultGenesisState().TotalPrincipals,
+				pegReserves: types.PegStabilityReserves{
+					types.NewPegStabilityReserve("busd", sdkmath.NewInt(1)),
+					types.NewPegStabilityReserve("busd", sdkmath.NewInt(2)),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "duplicate peg stability reserve denom busd",
+			},
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			gs := types.NewGenesisState(tc.args.params, tc.args.cdps, tc.args.deposits, tc.args.startingID,
-				tc.args.debtDenom, tc.args.govDenom, tc.args.genAccumTimes, tc.args.genTotalPrincipals)
+				tc.args.debtDenom, tc.args.govDenom, tc.args.genAccumTimes, tc.args.genTotalPrincipals, tc.args.pegReserves)
 			err := gs.Validate()
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err)
@@ -168,6 +207,35 @@ func (suite *GenesisTestSuite) TestValidGenState() {
 	})
 }
 
+func (suite *GenesisTestSuite) TestInitGenesis_PegStabilityReserves() {
+	cdc := suite.app.AppCodec()
+
+	cdpGS := NewCDPGenStateMulti(cdc)
+	gs := types.GenesisState{}
+	cdc.MustUnmarshalJSON(cdpGS["cdp"], &gs)
+	gs.Params.PegStabilityParams = types.PegStabilityParams{
+		types.NewPegStabilityParam("usdc", i(6), c("usdx", 100000000000), d("0.001"), d("0.002")),
+	}
+	gs.PegStabilityReserves = types.PegStabilityReserves{types.NewPegStabilityReserve("usdc", i(10000000))}
+	appGS := app.GenesisState{"cdp": cdc.MustMarshalJSON(&gs)}
+
+	// the module account must hold the reserves backing the imported debt
+	suite.PanicsWithValue("invalid cdp genesis state: 9999999usdc reserves do not cover debt 10000000usdx: insufficient peg stability reserves", func() {
+		authGS := app.NewAuthBankGenesisBuilder().
+			WithSimpleModuleAccount(types.PegStabilityMacc, cs(c("usdc", 9999999)), authtypes.Minter, authtypes.Burner).
+			BuildMarshalled(cdc)
+		suite.app.InitializeFromGenesisStates(authGS, NewPricefeedGenStateMulti(cdc), appGS)
+	})
+
+	suite.NotPanics(func() {
+		suite.SetupTest()
+		authGS := app.NewAuthBankGenesisBuilder().
+			WithSimpleModuleAccount(types.PegStabilityMacc, cs(c("usdc", 10000000)), authtypes.Minter, authtypes.Burner).
+			BuildMarshalled(cdc)
+		suite.app.InitializeFromGenesisStates(authGS, NewPricefeedGenStateMulti(cdc), appGS)
+	})
+}
+
 func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 	cdps := types.CDPs{
 		{
@@ -211,6 +279,7 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 			DebtAuctionThreshold:     types.DefaultDebtThreshold,
 			DebtAuctionLot:           types.DefaultDebtLot,
 			LiquidationBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			CollateralAuctionType:    types.DefaultCollateralAuctionType,
 			CollateralParams: types.CollateralParams{
 				{
 					Denom:                            "xrp",
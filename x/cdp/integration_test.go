@@ -119,6 +119,7 @@ func NewCDPGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 			DebtAuctionThreshold:     types.DefaultDebtThreshold,
 			DebtAuctionLot:           types.DefaultDebtLot,
 			LiquidationBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			CollateralAuctionType:    types.DefaultCollateralAuctionType,
 			CollateralParams: types.CollateralParams{
 				{
 					Denom:                            "xrp",
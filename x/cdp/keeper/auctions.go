@@ -4,6 +4,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 	"github.com/kava-labs/kava/x/cdp/types"
 )
 
@@ -69,7 +70,7 @@ func (k Keeper) CreateAuctionsFromDeposit(
 
 		penalty := k.ApplyLiquidationPenalty(ctx, collateralType, debtAmount)
 
-		_, err := k.auctionKeeper.StartCollateralAuction(
+		_, err := k.startCollateralAuction(
 			ctx, types.LiquidatorMacc, sdk.NewCoin(collateral.Denom, auctionSize),
 			sdk.NewCoin(principalDenom, debtAmount.Add(penalty)), []sdk.AccAddress{returnAddr},
 			[]sdkmath.Int{auctionSize}, sdk.NewCoin(debtDenom, debtAmount),
@@ -94,7 +95,7 @@ func (k Keeper) CreateAuctionsFromDeposit(
 
 	penalty := k.ApplyLiquidationPenalty(ctx, collateralType, lastAuctionDebt)
 
-	_, err := k.auctionKeeper.StartCollateralAuction(
+	_, err := k.startCollateralAuction(
 		ctx, types.LiquidatorMacc, sdk.NewCoin(collateral.Denom, lastAuctionCollateral),
 		sdk.NewCoin(principalDenom, lastAuctionDebt.Add(penalty)), []sdk.AccAddress{returnAddr},
 		[]sdkmath.Int{lastAuctionCollateral}, sdk.NewCoin(debtDenom, lastAuctionDebt),
@@ -103,9 +104,26 @@ func (k Keeper) CreateAuctionsFromDeposit(
 	return err
 }
 
+// startCollateralAuction starts a collateral auction of the type set in the collateral auction type param
+func (k Keeper) startCollateralAuction(
+	ctx sdk.Context, seller string, lot, maxBid sdk.Coin,
+	lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdkmath.Int, debt sdk.Coin,
+) (uint64, error) {
+	if k.GetParams(ctx).CollateralAuctionType == auctiontypes.DutchCollateralAuctionType {
+		return k.auctionKeeper.StartDutchCollateralAuction(ctx, seller, lot, maxBid, lotReturnAddrs, lotReturnWeights, debt)
+	}
+	return k.auctionKeeper.StartCollateralAuction(ctx, seller, lot, maxBid, lotReturnAddrs, lotReturnWeights, debt)
+}
+
 // NetSurplusAndDebt burns surplus and debt coins equal to the minimum of surplus and debt balances held by the liquidator module account
 // for example, if there is 1000 debt and 100 surplus, 100 surplus and 100 debt are burned, netting to 900 debt
+// fees collected by the peg stability module are added to the surplus first
 func (k Keeper) NetSurplusAndDebt(ctx sdk.Context) error {
+	// fees kept in the peg stability reserves are surplus
+	if err := k.realizePegStabilityFees(ctx); err != nil {
+		return err
+	}
+
 	totalSurplus := k.GetTotalSurplus(ctx, types.LiquidatorMacc)
 	debt := k.GetTotalDebt(ctx, types.LiquidatorMacc)
 	netAmount := sdk.MinInt(totalSurplus, debt)
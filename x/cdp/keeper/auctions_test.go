@@ -71,6 +71,26 @@ func (suite *AuctionTestSuite) TestCollateralAuction() {
 	suite.Require().NoError(err)
 }
 
+func (suite *AuctionTestSuite) TestCollateralAuctionType() {
+	bk := suite.app.GetBankKeeper()
+	err := bk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 21000000000), c("bnb", 190000000000)))
+	suite.Require().NoError(err)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CollateralAuctionType = auctiontypes.DutchCollateralAuctionType
+	suite.keeper.SetParams(suite.ctx, params)
+
+	testDeposit := types.NewDeposit(1, suite.addrs[0], c("bnb", 190000000000))
+	err = suite.keeper.AuctionCollateral(suite.ctx, types.Deposits{testDeposit}, "bnb-a", i(21000000000), "usdx")
+	suite.Require().NoError(err)
+
+	auctions := suite.app.GetAuctionKeeper().GetAllAuctions(suite.ctx)
+	suite.Require().NotEmpty(auctions)
+	for _, auction := range auctions {
+		suite.Equal(auctiontypes.DutchCollateralAuctionType, auction.GetType())
+	}
+}
+
 func (suite *AuctionTestSuite) TestSurplusAuction() {
 	bk := suite.app.GetBankKeeper()
 	ak := suite.app.GetAccountKeeper()
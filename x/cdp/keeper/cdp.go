@@ -439,6 +439,20 @@ func (k Keeper) ValidatePrincipalDraw(ctx sdk.Context, principal sdk.Coin, expec
 
 // ValidateDebtLimit validates that the input debt amount does not exceed the global debt limit or the debt limit for that collateral
 func (k Keeper) ValidateDebtLimit(ctx sdk.Context, collateralType string, principal sdk.Coin) error {
+	err := k.validateCollateralDebtLimit(ctx, collateralType, principal)
+	if err != nil {
+		return err
+	}
+	globalDebt := k.getGlobalDebt(ctx, principal.Denom).Add(principal.Amount)
+	globalLimit := k.GetParams(ctx).GlobalDebtLimit.Amount
+	if globalDebt.GT(globalLimit) {
+		return errorsmod.Wrapf(types.ErrExceedsDebtLimit, "debt increase %s > global debt limit  %s", sdk.NewCoin(principal.Denom, globalDebt), sdk.NewCoin(principal.Denom, globalLimit))
+	}
+	return nil
+}
+
+// validateCollateralDebtLimit validates that the input principal doesn't increase the total principal of a collateral type past its debt limit
+func (k Keeper) validateCollateralDebtLimit(ctx sdk.Context, collateralType string, principal sdk.Coin) error {
 	cp, found := k.GetCollateral(ctx, collateralType)
 	if !found {
 		return errorsmod.Wrap(types.ErrCollateralNotSupported, collateralType)
@@ -448,10 +462,6 @@ func (k Keeper) ValidateDebtLimit(ctx sdk.Context, collateralType string, princi
 	if totalPrincipal.GT(collateralLimit) {
 		return errorsmod.Wrapf(types.ErrExceedsDebtLimit, "debt increase %s > collateral debt limit %s", sdk.NewCoins(sdk.NewCoin(principal.Denom, totalPrincipal)), sdk.NewCoins(sdk.NewCoin(principal.Denom, collateralLimit)))
 	}
-	globalLimit := k.GetParams(ctx).GlobalDebtLimit.Amount
-	if totalPrincipal.GT(globalLimit) {
-		return errorsmod.Wrapf(types.ErrExceedsDebtLimit, "debt increase %s > global debt limit  %s", sdk.NewCoin(principal.Denom, totalPrincipal), sdk.NewCoin(principal.Denom, globalLimit))
-	}
 	return nil
 }
 
@@ -16,6 +16,7 @@ import (
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/cdp/keeper"
 	"github.com/kava-labs/kava/x/cdp/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 type CdpTestSuite struct {
@@ -391,6 +392,34 @@ func (suite *CdpTestSuite) TestMarketStatus() {
 	suite.Require().False(status)
 }
 
+func (suite *CdpTestSuite) TestUpdatePricefeedStatus_OracleQuorum() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	pk := suite.app.GetPriceFeedKeeper()
+	suite.Require().True(suite.keeper.UpdatePricefeedStatus(suite.ctx, "xrp:usd"))
+
+	// require two oracles to price xrp, while only one has posted a price
+	params := pk.GetParams(suite.ctx)
+	for i, market := range params.Markets {
+		if market.MarketID == "xrp:usd" {
+			params.Markets[i].Oracles = addrs
+			params.Markets[i].MinOracleCount = 2
+		}
+	}
+	pk.SetParams(suite.ctx, params)
+
+	err := pk.SetCurrentPrices(suite.ctx, "xrp:usd")
+	suite.Require().ErrorIs(err, pricefeedtypes.ErrOracleQuorumNotMet)
+	suite.False(suite.keeper.UpdatePricefeedStatus(suite.ctx, "xrp:usd"))
+	suite.False(suite.keeper.GetMarketStatus(suite.ctx, "xrp:usd"))
+
+	for _, addr := range addrs {
+		_, err = pk.SetPrice(suite.ctx, addr, "xrp:usd", d("0.25"), suite.ctx.BlockTime().Add(time.Hour))
+		suite.Require().NoError(err)
+	}
+	suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, "xrp:usd"))
+	suite.True(suite.keeper.UpdatePricefeedStatus(suite.ctx, "xrp:usd"))
+}
+
 func TestCdpTestSuite(t *testing.T) {
 	suite.Run(t, new(CdpTestSuite))
 }
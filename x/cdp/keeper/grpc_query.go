@@ -238,6 +238,51 @@ func (s QueryServer) Deposits(c context.Context, req *types.QueryDepositsRequest
 	}, nil
 }
 
+// CdpHealth queries the liquidation price and projected fees of a CDP, optionally after hypothetical changes to it.
+func (s QueryServer) CdpHealth(c context.Context, req *types.QueryCdpHealthRequest) (*types.QueryCdpHealthResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address")
+	}
+	if req.Horizon < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "horizon cannot be negative")
+	}
+	if req.Horizon > types.MaxCdpHealthHorizon {
+		return nil, status.Errorf(codes.InvalidArgument, "horizon cannot be longer than %s", types.MaxCdpHealthHorizon)
+	}
+	for _, coin := range []*sdk.Coin{req.Deposit, req.Withdraw, req.Draw, req.Repay} {
+		if coin != nil && (coin.Validate() != nil || !coin.IsPositive()) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid amount %s", coin)
+		}
+	}
+
+	_, valid := s.keeper.GetCollateral(ctx, req.CollateralType)
+	if !valid {
+		return nil, errorsmod.Wrap(types.ErrInvalidCollateral, req.CollateralType)
+	}
+
+	cdp, found := s.keeper.GetCdpByOwnerAndCollateralType(ctx, owner, req.CollateralType)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, denom %s", req.Owner, req.CollateralType)
+	}
+
+	cdp, err = s.keeper.SimulateCdpChanges(ctx, cdp, req.Deposit, req.Withdraw, req.Draw, req.Repay)
+	if err != nil {
+		return nil, err
+	}
+
+	health, err := s.keeper.LoadCdpHealth(ctx, cdp, req.Horizon)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCdpHealthResponse{
+		Health: health,
+	}, nil
+}
+
 // FilterCDPs queries the store for all CDPs that match query req
 func GrpcFilterCDPs(ctx sdk.Context, k Keeper, req types.QueryCdpsRequest) (types.CDPResponses, error) {
 	// TODO: Ideally use query.Paginate() here over existing FilterCDPs. However
@@ -295,3 +340,62 @@ func GrpcFilterCDPs(ctx sdk.Context, k Keeper, req types.QueryCdpsRequest) (type
 
 	return cdpResponses, nil
 }
+
+// StabilityFees queries the current per second stability fee of a given collateral type, or of all collateral types.
+func (s QueryServer) StabilityFees(c context.Context, req *types.QueryStabilityFeesRequest) (*types.QueryStabilityFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var collateralParams types.CollateralParams
+	if req.CollateralType != "" {
+		collateralParam, found := s.keeper.GetCollateral(ctx, req.CollateralType)
+		if !found {
+			return nil, errorsmod.Wrap(types.ErrInvalidCollateral, req.CollateralType)
+		}
+		collateralParams = append(collateralParams, collateralParam)
+	} else {
+		collateralParams = s.keeper.GetParams(ctx).CollateralParams
+	}
+
+	var stabilityFees types.StabilityFeeResponses
+	for _, collateralParam := range collateralParams {
+		stabilityFees = append(stabilityFees, types.NewStabilityFeeResponse(
+			collateralParam.Type,
+			s.keeper.getFeeRate(ctx, collateralParam.Type),
+			s.keeper.getDebtLimitUtilization(ctx, collateralParam),
+		))
+	}
+
+	return &types.QueryStabilityFeesResponse{
+		StabilityFees: stabilityFees,
+	}, nil
+}
+
+// PegStabilityReserves queries the reserves of a given stablecoin held by the peg stability module, or of all stablecoins.
+func (s QueryServer) PegStabilityReserves(c context.Context, req *types.QueryPegStabilityReservesRequest) (*types.QueryPegStabilityReservesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params := s.keeper.GetParams(ctx)
+	var pegStabilityParams types.PegStabilityParams
+	if req.Denom != "" {
+		psp, found := s.keeper.GetPegStabilityParam(ctx, req.Denom)
+		if !found {
+			return nil, errorsmod.Wrap(types.ErrPegStabilityDenomNotFound, req.Denom)
+		}
+		pegStabilityParams = append(pegStabilityParams, psp)
+	} else {
+		pegStabilityParams = params.PegStabilityParams
+	}
+
+	var reserves types.PegStabilityReserveResponses
+	for _, psp := range pegStabilityParams {
+		reserves = append(reserves, types.NewPegStabilityReserveResponse(
+			sdk.NewCoin(psp.Denom, s.keeper.GetPegStabilityReserves(ctx, psp.Denom)),
+			sdk.NewCoin(params.DebtParam.Denom, s.keeper.GetPegStabilityDebt(ctx, psp.Denom)),
+			psp.DebtLimit,
+		))
+	}
+
+	return &types.QueryPegStabilityReservesResponse{
+		Reserves: reserves,
+	}, nil
+}
@@ -219,6 +219,243 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryCdp() {
 	}
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryCdpHealth() {
+	suite.addCdp()
+
+	coin := func(denom string, amount int64) *sdk.Coin {
+		c := c(denom, amount)
+		return &c
+	}
+	tests := []struct {
+		giveName              string
+		giveRequest           types.QueryCdpHealthRequest
+		wantRatio             sdk.Dec
+		wantLiquidationPrice  sdk.Dec
+		wantLiquidationBuffer sdk.Dec
+		wantErr               string
+	}{
+		{
+			"current cdp",
+			types.QueryCdpHealthRequest{CollateralType: "xrp-a", Owner: suite.addrs[0].String()},
+			d("2.5"),
+			d("0.2"),
+			d("0.2"),
+			"",
+		},
+		{
+			"deposit",
+			types.QueryCdpHealthRequest{CollateralType: "xrp-a", Owner: suite.addrs[0].String(), Deposit: coin("xrp", 100000000)},
+			d("5.0"),
+			d("0.1"),
+			d("0.6"),
+			"",
+		},
+		{
+			"draw to liquidation ratio",
+			types.QueryCdpHealthRequest{CollateralType: "xrp-a", Owner: suite.addrs[0].String(), Draw: coin("usdx", 2500000)},
+			d("2.0"),
+			d("0.25"),
+			d("0.0"),
+			"",
+		},
+		{
+			"deposit and withdraw",
+			types.QueryCdpHealthRequest{CollateralType: "xrp-a", Owner: suite.addrs[0].String(), Deposit: coin("xrp", 50000000), Withdraw: coin("xrp", 100000000)},
+			sdk.Dec{},
+			sdk.Dec{},
+			sdk.Dec{},
+			"collateral xrp, collateral ratio 1.250000000000000000, liquidation ratio 2.000000000000000000: proposed collateral ratio is below liquidation ratio",
+		},
+		{
+			"full repayment",
+			types.QueryCdpHealthRequest{CollateralType: "xrp-a", Owner: suite.addrs[0].String(), Repay: coin("usdx", 10000000)},
+			types.MaxSortableDec,
+			d("0.0"),
+			d("1.0"),
+			"",
+		},
+		{
+			"repay below debt floor",
+			types.QueryCdpHealthRequest{CollateralType: "xrp-a", Owner: suite.addrs[0].String(), Repay: coin("usdx", 5000000)},
+			sdk.Dec{},
+			sdk.Dec{},
+			sdk.Dec{},
+			"proposed 5000000usdx < minimum 10000000: proposed cdp debt is below minimum",
+		},
+		{
+			"draw below liquidation ratio",
+			types.QueryCdpHealthRequest{CollateralType: "xrp-a", Owner: suite.addrs[0].String(), Draw: coin("usdx", 3000000)},
+			sdk.Dec{},
+			sdk.Dec{},
+			sdk.Dec{},
+			"collateral xrp, collateral ratio 1.923076923076923077, liquidation ratio 2.000000000000000000: proposed collateral ratio is below liquidation ratio",
+		},
+		{
+			"withdraw more than deposit",
+			types.QueryCdpHealthRequest{CollateralType: "xrp-a", Owner: suite.addrs[0].String(), Withdraw: coin("xrp", 200000000)},
+			sdk.Dec{},
+			sdk.Dec{},
+			sdk.Dec{},
+			"collateral 200000000xrp, deposit 100000000xrp: withdrawal amount exceeds deposit",
+		},
+		{
+			"deposit wrong denom",
+			types.QueryCdpHealthRequest{CollateralType: "xrp-a", Owner: suite.addrs[0].String(), Deposit: coin("btc", 100000000)},
+			sdk.Dec{},
+			sdk.Dec{},
+			sdk.Dec{},
+			"collateral type: xrp-a expected denom: xrp got: btc: invalid collateral for input collateral type",
+		},
+		{
+			"deposit more than balance",
+			types.QueryCdpHealthRequest{CollateralType: "xrp-a", Owner: suite.addrs[0].String(), Deposit: coin("xrp", 300000000)},
+			sdk.Dec{},
+			sdk.Dec{},
+			sdk.Dec{},
+			"100000000xrp < 300000000xrp: insufficient balance",
+		},
+		{
+			"invalid amount",
+			types.QueryCdpHealthRequest{CollateralType: "xrp-a", Owner: suite.addrs[0].String(), Draw: &sdk.Coin{Denom: "usdx", Amount: sdkmath.NewInt(-1)}},
+			sdk.Dec{},
+			sdk.Dec{},
+			sdk.Dec{},
+			"rpc error: code = InvalidArgument desc = invalid amount -1usdx",
+		},
+		{
+			"cdp not found",
+			types.QueryCdpHealthRequest{CollateralType: "btc-a", Owner: suite.addrs[0].String()},
+			sdk.Dec{},
+			sdk.Dec{},
+			sdk.Dec{},
+			"owner " + suite.addrs[0].String() + ", denom btc-a: cdp not found",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.giveName, func() {
+			res, err := suite.queryServer.CdpHealth(sdk.WrapSDKContext(suite.ctx), &tt.giveRequest)
+
+			if tt.wantErr == "" {
+				suite.Require().NoError(err)
+				suite.Equal(tt.wantRatio.String(), res.Health.CollateralizationRatio.String())
+				suite.Equal(tt.wantLiquidationPrice.String(), res.Health.LiquidationPrice.String())
+				suite.Equal(tt.wantLiquidationBuffer.String(), res.Health.LiquidationBuffer.String())
+				suite.Equal(d("0.25").String(), res.Health.CurrentPrice.String())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(tt.wantErr, err.Error())
+			}
+		})
+	}
+
+	// the simulated changes are not applied to the cdp
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().True(found)
+	suite.Equal(c("xrp", 100000000), cdp.Collateral)
+	suite.Equal(c("usdx", 10000000), cdp.Principal)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryCdpHealth_ProjectedFees() {
+	suite.addCdp()
+	err := suite.keeper.AccumulateInterest(suite.ctx, "xrp-a")
+	suite.Require().NoError(err)
+
+	res, err := suite.queryServer.CdpHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryCdpHealthRequest{
+		CollateralType: "xrp-a",
+		Owner:          suite.addrs[0].String(),
+		Horizon:        time.Hour * 24 * 365,
+	})
+	suite.Require().NoError(err)
+
+	// a year of fees at 5% apr
+	suite.Equal(d("1.000000001547125958"), res.Health.StabilityFee)
+	suite.Equal(c("usdx", 0), res.Health.AccumulatedFees)
+	suite.Equal(c("usdx", 500000), res.Health.ProjectedFees)
+	suite.Equal(d("2.5"), res.Health.CollateralizationRatio)
+	suite.Equal(d("25").Quo(d("10.5")), res.Health.ProjectedCollateralizationRatio)
+
+	// fees are projected from the previous accrual time
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour * 24 * 365))
+	res, err = suite.queryServer.CdpHealth(sdk.WrapSDKContext(ctx), &types.QueryCdpHealthRequest{
+		CollateralType: "xrp-a",
+		Owner:          suite.addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Equal(c("usdx", 500000), res.Health.ProjectedFees)
+
+	_, err = suite.queryServer.CdpHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryCdpHealthRequest{
+		CollateralType: "xrp-a",
+		Owner:          suite.addrs[0].String(),
+		Horizon:        -time.Hour,
+	})
+	suite.Require().EqualError(err, "rpc error: code = InvalidArgument desc = horizon cannot be negative")
+
+	_, err = suite.queryServer.CdpHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryCdpHealthRequest{
+		CollateralType: "xrp-a",
+		Owner:          suite.addrs[0].String(),
+		Horizon:        types.MaxCdpHealthHorizon,
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.queryServer.CdpHealth(sdk.WrapSDKContext(suite.ctx), &types.QueryCdpHealthRequest{
+		CollateralType: "xrp-a",
+		Owner:          suite.addrs[0].String(),
+		Horizon:        time.Hour * 24 * 365 * 100,
+	})
+	suite.Require().EqualError(err, "rpc error: code = InvalidArgument desc = horizon cannot be longer than 43800h0m0s")
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryStabilityFees() {
+	suite.addCdp()
+
+	res, err := suite.queryServer.StabilityFees(sdk.WrapSDKContext(suite.ctx), &types.QueryStabilityFeesRequest{})
+	suite.Require().NoError(err)
+	suite.Len(res.StabilityFees, 4, "stability fees should include all collateral params")
+
+	res, err = suite.queryServer.StabilityFees(sdk.WrapSDKContext(suite.ctx), &types.QueryStabilityFeesRequest{
+		CollateralType: "xrp-a",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.StabilityFees, 1)
+	suite.Equal("xrp-a", res.StabilityFees[0].CollateralType)
+	suite.Equal(d("1.000000001547125958").String(), res.StabilityFees[0].StabilityFee.String())
+	suite.Equal(d("0.00002").String(), res.StabilityFees[0].Utilization.String())
+
+	_, err = suite.queryServer.StabilityFees(sdk.WrapSDKContext(suite.ctx), &types.QueryStabilityFeesRequest{
+		CollateralType: "kava-a",
+	})
+	suite.Require().EqualError(err, "kava-a: invalid collateral for input collateral type")
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryPegStabilityReserves() {
+	acc := suite.tApp.GetAccountKeeper().NewAccountWithAddress(suite.ctx, suite.addrs[0])
+	err := suite.tApp.FundAccount(suite.ctx, acc.GetAddress(), cs(c("usdc", 10000000)))
+	suite.Require().NoError(err)
+	_, err = suite.keeper.PegMint(suite.ctx, suite.addrs[0], c("usdc", 10000000))
+	suite.Require().NoError(err)
+
+	res, err := suite.queryServer.PegStabilityReserves(sdk.WrapSDKContext(suite.ctx), &types.QueryPegStabilityReservesRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(
+		types.PegStabilityReserveResponses{
+			types.NewPegStabilityReserveResponse(c("usdc", 10000000), c("usdx", 9990000), c("usdx", 100000000000)),
+		},
+		res.Reserves,
+	)
+
+	res, err = suite.queryServer.PegStabilityReserves(sdk.WrapSDKContext(suite.ctx), &types.QueryPegStabilityReservesRequest{
+		Denom: "usdc",
+	})
+	suite.Require().NoError(err)
+	suite.Len(res.Reserves, 1)
+
+	_, err = suite.queryServer.PegStabilityReserves(sdk.WrapSDKContext(suite.ctx), &types.QueryPegStabilityReservesRequest{
+		Denom: "busd",
+	})
+	suite.Require().EqualError(err, "busd: peg stability denom not found")
+}
+
 func (suite *grpcQueryTestSuite) TestGrpcQueryDeposits() {
 	suite.addCdp()
 
@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"math"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// SimulateCdpChanges returns the cdp, with its latest interest synced, after its owner deposits, withdraws, draws and repays the input
+// amounts. Each change is validated as the corresponding message would validate it, and nil amounts are skipped. The store is not modified.
+func (k Keeper) SimulateCdpChanges(ctx sdk.Context, cdp types.CDP, deposit, withdraw, draw, repay *sdk.Coin) (types.CDP, error) {
+	cdp = k.calculateSyncedCdp(ctx, cdp)
+
+	ownerDeposit, found := k.GetDeposit(ctx, cdp.ID, cdp.Owner)
+	if !found {
+		ownerDeposit = types.NewDeposit(cdp.ID, cdp.Owner, sdk.NewCoin(cdp.Collateral.Denom, sdk.ZeroInt()))
+	}
+
+	if deposit != nil {
+		err := k.ValidateCollateral(ctx, *deposit, cdp.Type)
+		if err != nil {
+			return types.CDP{}, err
+		}
+		err = k.ValidateBalance(ctx, *deposit, cdp.Owner)
+		if err != nil {
+			return types.CDP{}, err
+		}
+		ownerDeposit.Amount = ownerDeposit.Amount.Add(*deposit)
+		cdp.Collateral = cdp.Collateral.Add(*deposit)
+	}
+
+	if repay != nil {
+		err := k.ValidatePaymentCoins(ctx, cdp, *repay)
+		if err != nil {
+			return types.CDP{}, err
+		}
+		err = k.ValidateBalance(ctx, *repay, cdp.Owner)
+		if err != nil {
+			return types.CDP{}, err
+		}
+		feePayment, principalPayment := k.calculatePayment(ctx, cdp.GetTotalPrincipal(), cdp.AccumulatedFees, *repay)
+		err = k.validatePrincipalPayment(ctx, cdp, principalPayment)
+		if err != nil {
+			return types.CDP{}, err
+		}
+		cdp.Principal = cdp.Principal.Sub(principalPayment)
+		cdp.AccumulatedFees = cdp.AccumulatedFees.Sub(feePayment)
+	}
+
+	if withdraw != nil {
+		err := k.ValidateCollateral(ctx, *withdraw, cdp.Type)
+		if err != nil {
+			return types.CDP{}, err
+		}
+		if !found && deposit == nil {
+			return types.CDP{}, errorsmod.Wrapf(types.ErrDepositNotFound, "depositor %s, collateral %s %s", cdp.Owner, withdraw.Denom, cdp.Type)
+		}
+		if withdraw.Amount.GT(ownerDeposit.Amount.Amount) {
+			return types.CDP{}, errorsmod.Wrapf(types.ErrInvalidWithdrawAmount, "collateral %s, deposit %s", withdraw, ownerDeposit.Amount)
+		}
+		cdp.Collateral = cdp.Collateral.Sub(*withdraw)
+	}
+
+	if draw != nil {
+		err := k.ValidatePrincipalDraw(ctx, *draw, cdp.Principal.Denom)
+		if err != nil {
+			return types.CDP{}, err
+		}
+		err = k.ValidateDebtLimit(ctx, cdp.Type, *draw)
+		if err != nil {
+			return types.CDP{}, err
+		}
+		cdp.Principal = cdp.Principal.Add(*draw)
+	}
+
+	// withdrawing collateral and drawing debt must leave the cdp above the liquidation ratio
+	if (withdraw != nil || draw != nil) && cdp.GetTotalPrincipal().IsPositive() {
+		err := k.ValidateCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees)
+		if err != nil {
+			return types.CDP{}, err
+		}
+	}
+	return cdp, nil
+}
+
+// LoadCdpHealth returns the liquidation price of the cdp and its buffer to liquidation at the current liquidation market price,
+// along with its fees projected over the input horizon from the current block time at the current stability fee.
+func (k Keeper) LoadCdpHealth(ctx sdk.Context, cdp types.CDP, horizon time.Duration) (types.CdpHealth, error) {
+	cp, found := k.GetCollateral(ctx, cdp.Type)
+	if !found {
+		return types.CdpHealth{}, errorsmod.Wrap(types.ErrCollateralNotSupported, cdp.Type)
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.LiquidationMarketID)
+	if err != nil {
+		return types.CdpHealth{}, err
+	}
+	cdp = k.calculateSyncedCdp(ctx, cdp)
+	projectedFees := k.calculateProjectedFees(ctx, cdp, horizon)
+
+	collateralBaseUnits := k.convertCollateralToBaseUnits(ctx, cdp.Collateral, cdp.Type)
+	collateralValue := collateralBaseUnits.Mul(price.Price)
+	debtValue := k.convertDebtToBaseUnits(ctx, cdp.GetTotalPrincipal())
+	projectedDebtValue := k.convertDebtToBaseUnits(ctx, cdp.Principal.Add(projectedFees))
+
+	health := types.CdpHealth{
+		ID:                              cdp.ID,
+		Owner:                           cdp.Owner.String(),
+		Type:                            cdp.Type,
+		Collateral:                      cdp.Collateral,
+		Principal:                       cdp.Principal,
+		AccumulatedFees:                 cdp.AccumulatedFees,
+		CollateralizationRatio:          types.MaxSortableDec,
+		LiquidationRatio:                cp.LiquidationRatio,
+		CurrentPrice:                    price.Price,
+		LiquidationPrice:                sdk.ZeroDec(),
+		LiquidationBuffer:               sdk.OneDec(),
+		ProjectedFees:                   projectedFees,
+		ProjectedCollateralizationRatio: types.MaxSortableDec,
+		StabilityFee:                    k.getFeeRate(ctx, cdp.Type),
+	}
+	// a cdp without debt can't be liquidated
+	if !debtValue.IsPositive() {
+		return health, nil
+	}
+	health.CollateralizationRatio = collateralValue.Quo(debtValue)
+	health.ProjectedCollateralizationRatio = collateralValue.Quo(projectedDebtValue)
+
+	// the liquidation price is the price at which the collateral value divided by the debt equals the liquidation ratio
+	if !collateralValue.IsPositive() {
+		health.LiquidationPrice = types.MaxSortableDec
+		health.LiquidationBuffer = sdk.ZeroDec()
+		return health, nil
+	}
+	health.LiquidationPrice = cp.LiquidationRatio.Mul(debtValue).Quo(collateralBaseUnits)
+	health.LiquidationBuffer = sdk.OneDec().Sub(health.LiquidationPrice.Quo(price.Price))
+	return health, nil
+}
+
+// calculateSyncedCdp returns the cdp with its latest interest added to its accumulated fees, without updating the store
+func (k Keeper) calculateSyncedCdp(ctx sdk.Context, cdp types.CDP) types.CDP {
+	interestAccumulated := k.CalculateNewInterest(ctx, cdp)
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Add(interestAccumulated)
+	prevAccrualTime, found := k.GetPreviousAccrualTime(ctx, cdp.Type)
+	if found {
+		cdp.FeesUpdated = prevAccrualTime
+	}
+	globalInterestFactor, found := k.GetInterestFactor(ctx, cdp.Type)
+	if found {
+		cdp.InterestFactor = globalInterestFactor
+	}
+	return cdp
+}
+
+// calculateProjectedFees returns the accumulated fees of the synced cdp after interest accrues until the horizon after the block time
+func (k Keeper) calculateProjectedFees(ctx sdk.Context, cdp types.CDP, horizon time.Duration) sdk.Coin {
+	prevAccrualTime, found := k.GetPreviousAccrualTime(ctx, cdp.Type)
+	if !found {
+		return cdp.AccumulatedFees
+	}
+	interestFactor, found := k.GetInterestFactor(ctx, cdp.Type)
+	if !found {
+		return cdp.AccumulatedFees
+	}
+	secondsElapsed := int64(math.RoundToEven(ctx.BlockTime().Add(horizon).Sub(prevAccrualTime).Seconds()))
+	if secondsElapsed <= 0 {
+		return cdp.AccumulatedFees
+	}
+
+	// accrue interest to the global interest factor in a cached context, as it would be at the horizon
+	cacheCtx, _ := ctx.CacheContext()
+	projectedInterestFactor := interestFactor.Mul(CalculateInterestFactor(k.getFeeRate(ctx, cdp.Type), sdkmath.NewInt(secondsElapsed)))
+	k.SetInterestFactor(cacheCtx, cdp.Type, projectedInterestFactor)
+	return cdp.AccumulatedFees.Add(k.CalculateNewInterest(cacheCtx, cdp))
+}
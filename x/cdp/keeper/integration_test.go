@@ -48,6 +48,7 @@ func NewCDPGenState(cdc codec.JSONCodec, asset string, liquidationRatio sdk.Dec)
 			DebtAuctionThreshold:     types.DefaultDebtThreshold,
 			DebtAuctionLot:           types.DefaultDebtLot,
 			LiquidationBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			CollateralAuctionType:    types.DefaultCollateralAuctionType,
 			CollateralParams: types.CollateralParams{
 				{
 					Denom:                            asset,
@@ -162,6 +163,7 @@ func NewCDPGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 			DebtAuctionThreshold:     types.DefaultDebtThreshold,
 			DebtAuctionLot:           types.DefaultDebtLot,
 			LiquidationBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			CollateralAuctionType:    types.DefaultCollateralAuctionType,
 			CollateralParams: types.CollateralParams{
 				{
 					Denom:                            "xrp",
@@ -226,6 +228,9 @@ func NewCDPGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 				ConversionFactor: i(6),
 				DebtFloor:        i(10000000),
 			},
+			PegStabilityParams: types.PegStabilityParams{
+				types.NewPegStabilityParam("usdc", i(6), sdk.NewInt64Coin("usdx", 100000000000), d("0.001"), d("0.002")),
+			},
 		},
 		StartingCdpID: types.DefaultCdpStartingID,
 		DebtDenom:     types.DefaultDebtDenom,
@@ -256,6 +261,7 @@ func NewCDPGenStateHighDebtLimit(cdc codec.JSONCodec) app.GenesisState {
 			DebtAuctionThreshold:     types.DefaultDebtThreshold,
 			DebtAuctionLot:           types.DefaultDebtLot,
 			LiquidationBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+			CollateralAuctionType:    types.DefaultCollateralAuctionType,
 			CollateralParams: types.CollateralParams{
 				{
 					Denom:                            "xrp",
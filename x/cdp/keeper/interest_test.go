@@ -262,6 +262,69 @@ func (suite *InterestTestSuite) TestAccumulateInterest() {
 }
 
 // TestSynchronizeInterest tests the functionality of synchronizing the accumulated interest for CDPs
+func (suite *InterestTestSuite) TestAccumulateInterestStabilityFeeModel() {
+	oneYearInSeconds := 31536000
+	testCases := []struct {
+		name                   string
+		totalPrincipal         sdkmath.Int
+		expectedStabilityFee   sdk.Dec
+		expectedTotalPrincipal sdkmath.Int
+	}{
+		{
+			"below kink",
+			sdkmath.NewInt(250000000000),
+			sdk.MustNewDecFromStr("1.000000001547125958"), // %5 apr
+			sdkmath.NewInt(262500000000),
+		},
+		{
+			"above kink",
+			sdkmath.NewInt(450000000000),
+			sdk.MustNewDecFromStr("1.000000005248428428"), // %18 apr
+			sdkmath.NewInt(531000000000),
+		},
+		{
+			"above debt limit",
+			sdkmath.NewInt(600000000000),
+			sdk.MustNewDecFromStr("1.000000007827881752"), // %28 apr
+			sdkmath.NewInt(768000000021),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			params := suite.keeper.GetParams(suite.ctx)
+			for i := range params.CollateralParams {
+				if params.CollateralParams[i].Type == "bnb-a" {
+					model := types.NewStabilityFeeModel(
+						sdk.ZeroDec(),
+						sdk.MustNewDecFromStr("0.1"),
+						sdk.MustNewDecFromStr("0.8"),
+						sdk.OneDec(),
+					)
+					params.CollateralParams[i].StabilityFeeModel = &model
+				}
+			}
+			suite.keeper.SetParams(suite.ctx, params)
+
+			suite.keeper.SetTotalPrincipal(suite.ctx, "bnb-a", types.DefaultStableDenom, tc.totalPrincipal)
+			suite.keeper.SetPreviousAccrualTime(suite.ctx, "bnb-a", suite.ctx.BlockTime())
+			suite.keeper.SetInterestFactor(suite.ctx, "bnb-a", sdk.OneDec())
+
+			res, err := keeper.NewQueryServerImpl(suite.keeper).StabilityFees(sdk.WrapSDKContext(suite.ctx), &types.QueryStabilityFeesRequest{CollateralType: "bnb-a"})
+			suite.Require().NoError(err)
+			suite.Require().Len(res.StabilityFees, 1)
+			suite.Require().Equal(tc.expectedStabilityFee.String(), res.StabilityFees[0].StabilityFee.String())
+
+			suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Duration(int(time.Second) * oneYearInSeconds)))
+			err = suite.keeper.AccumulateInterest(suite.ctx, "bnb-a")
+			suite.Require().NoError(err)
+
+			actualTotalPrincipal := suite.keeper.GetTotalPrincipal(suite.ctx, "bnb-a", types.DefaultStableDenom)
+			suite.Require().Equal(tc.expectedTotalPrincipal, actualTotalPrincipal)
+		})
+	}
+}
+
 func (suite *InterestTestSuite) TestSynchronizeInterest() {
 	type args struct {
 		ctype                   string
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// RegisterInvariants registers the cdp module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "peg-stability-debts", PegStabilityDebtsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "peg-stability-reserves", PegStabilityReservesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the cdp module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := PegStabilityDebtsInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		res, stop := PegStabilityReservesInvariant(k)(ctx)
+		return res, stop
+	}
+}
+
+// PegStabilityDebtsInvariant iterates the debt minted against the peg stability reserves and asserts that it is valid
+func PegStabilityDebtsInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "validate peg stability debts broken", "peg stability debt invalid")
+
+	return func(ctx sdk.Context) (string, bool) {
+		broken := false
+		k.IteratePegStabilityDebts(ctx, func(reserve types.PegStabilityReserve) bool {
+			if err := reserve.Validate(); err != nil {
+				broken = true
+				return true
+			}
+			return false
+		})
+
+		return message, broken
+	}
+}
+
+// PegStabilityReservesInvariant iterates the debt minted against the peg stability reserves and ensures that the module
+// account holds enough of each stablecoin to redeem it
+func PegStabilityReservesInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "peg stability solvency broken", "peg stability debt exceeds module account reserves")
+
+	return func(ctx sdk.Context) (string, bool) {
+		broken := k.ValidatePegStabilityReserves(ctx) != nil
+		return message, broken
+	}
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// MigrateCdp moves the principal and accumulated fees of the owner's cdp to a cdp of the target collateral type,
+// depositing the input collateral to it. The target cdp is created if the owner doesn't have one, and the original
+// cdp is closed and its collateral returned to the depositors. It returns the id of the target cdp.
+func (k Keeper) MigrateCdp(ctx sdk.Context, owner sdk.AccAddress, collateralType, targetCollateralType string, collateral sdk.Coin) (uint64, error) {
+	if collateralType == targetCollateralType {
+		return 0, errorsmod.Wrapf(types.ErrInvalidCollateral, "cannot migrate cdp to the same collateral type %s", collateralType)
+	}
+	err := k.ValidateCollateral(ctx, collateral, targetCollateralType)
+	if err != nil {
+		return 0, err
+	}
+	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, collateralType)
+	}
+	err = k.ValidateBalance(ctx, collateral, owner)
+	if err != nil {
+		return 0, err
+	}
+
+	k.hooks.BeforeCDPModified(ctx, cdp)
+	cdp = k.SynchronizeInterest(ctx, cdp)
+
+	targetCdp, targetFound := k.GetCdpByOwnerAndCollateralType(ctx, owner, targetCollateralType)
+	if targetFound {
+		k.hooks.BeforeCDPModified(ctx, targetCdp)
+		targetCdp = k.SynchronizeInterest(ctx, targetCdp)
+	} else {
+		interestFactor, found := k.GetInterestFactor(ctx, targetCollateralType)
+		if !found {
+			interestFactor = sdk.OneDec()
+			k.SetInterestFactor(ctx, targetCollateralType, interestFactor)
+		}
+		zeroDebt := sdk.NewCoin(cdp.Principal.Denom, sdk.ZeroInt())
+		targetCdp = types.NewCDP(k.GetNextCdpID(ctx), owner, sdk.NewCoin(collateral.Denom, sdk.ZeroInt()), targetCollateralType, zeroDebt, ctx.BlockTime(), interestFactor)
+	}
+
+	// validate the target cdp with the migrated debt and the deposited collateral, the debt is already part of the
+	// global debt so only the debt limit of the target collateral type applies
+	debt := cdp.GetTotalPrincipal()
+	err = k.validateCollateralDebtLimit(ctx, targetCollateralType, debt)
+	if err != nil {
+		return 0, err
+	}
+	targetCdp.Collateral = targetCdp.Collateral.Add(collateral)
+	targetCdp.Principal = targetCdp.Principal.Add(cdp.Principal)
+	targetCdp.AccumulatedFees = targetCdp.AccumulatedFees.Add(cdp.AccumulatedFees)
+	err = k.ValidateCollateralizationRatio(ctx, targetCdp.Collateral, targetCollateralType, targetCdp.Principal, targetCdp.AccumulatedFees)
+	if err != nil {
+		return 0, err
+	}
+
+	if collateral.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(collateral))
+		if err != nil {
+			return 0, err
+		}
+		deposit, found := k.GetDeposit(ctx, targetCdp.ID, owner)
+		if found {
+			deposit.Amount = deposit.Amount.Add(collateral)
+		} else {
+			deposit = types.NewDeposit(targetCdp.ID, owner, collateral)
+		}
+		k.SetDeposit(ctx, deposit)
+	}
+
+	// close the original cdp, returning its collateral to the depositors
+	k.ReturnCollateral(ctx, cdp)
+	k.RemoveCdpOwnerIndex(ctx, cdp)
+	err = k.DeleteCdpAndCollateralRatioIndex(ctx, cdp)
+	if err != nil {
+		return 0, err
+	}
+	k.DecrementTotalPrincipal(ctx, cdp.Type, debt)
+
+	// the debt coins minted for the principal are unchanged, only the collateral type it is accounted to changes
+	k.IncrementTotalPrincipal(ctx, targetCollateralType, debt)
+
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, targetCdp.Collateral, targetCdp.Type, targetCdp.GetTotalPrincipal())
+	if targetFound {
+		err = k.UpdateCdpAndCollateralRatioIndex(ctx, targetCdp, collateralToDebtRatio)
+		if err != nil {
+			return 0, err
+		}
+	} else {
+		err = k.SetCdpAndCollateralRatioIndex(ctx, targetCdp, collateralToDebtRatio)
+		if err != nil {
+			return 0, err
+		}
+		k.IndexCdpByOwner(ctx, targetCdp)
+		k.SetNextCdpID(ctx, targetCdp.ID+1)
+
+		k.hooks.AfterCDPCreated(ctx, targetCdp)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCreateCdp,
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", targetCdp.ID)),
+			),
+		)
+	}
+
+	// emit events for the cdp close, deposit, and migration
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpClose,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+		),
+	)
+	if collateral.IsPositive() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpDeposit,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, collateral.String()),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", targetCdp.ID)),
+			),
+		)
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpMigration,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, debt.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyTargetCdpID, fmt.Sprintf("%d", targetCdp.ID)),
+		),
+	)
+
+	return targetCdp.ID, nil
+}
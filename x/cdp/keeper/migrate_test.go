@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+type MigrateTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *MigrateTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	coins := []sdk.Coins{
+		cs(c("xrp", 500000000), c("btc", 500000000)),
+		cs(c("xrp", 200000000)),
+	}
+
+	authGS := app.NewFundedGenStateWithCoins(cdc, coins, addrs)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+	err := suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 400000000), c("usdx", 10000000), "xrp-a")
+	suite.Require().NoError(err)
+}
+
+func (suite *MigrateTestSuite) TestMigrateCdp() {
+	id, err := suite.keeper.MigrateCdp(suite.ctx, suite.addrs[0], "xrp-a", "btc-a", c("btc", 1000000))
+	suite.Require().NoError(err)
+	suite.Equal(uint64(2), id)
+	suite.Equal(uint64(3), suite.keeper.GetNextCdpID(suite.ctx))
+
+	// the original cdp is closed and its collateral returned
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.False(found)
+	_, found = suite.keeper.GetDeposit(suite.ctx, 1, suite.addrs[0])
+	suite.False(found)
+	suite.Empty(suite.keeper.GetAllCdpsByCollateralTypeAndRatio(suite.ctx, "xrp-a", d("100.0")))
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx"))
+
+	// the debt is moved to a new cdp of the target collateral type
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "btc-a", 2)
+	suite.Require().True(found)
+	suite.Equal(c("btc", 1000000), cdp.Collateral)
+	suite.Equal(c("usdx", 10000000), cdp.Principal)
+	suite.Equal(c("usdx", 0), cdp.AccumulatedFees)
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, 2, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Equal(c("btc", 1000000), deposit.Amount)
+	ids, found := suite.keeper.GetCdpIdsByOwner(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Equal([]uint64{2}, ids)
+	suite.Equal(i(10000000), suite.keeper.GetTotalPrincipal(suite.ctx, "btc-a", "usdx"))
+
+	ctd := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdp.Collateral, "btc-a", cdp.GetTotalPrincipal())
+	suite.Equal(d("0.001"), ctd)
+	suite.Empty(suite.keeper.GetAllCdpsByCollateralTypeAndRatio(suite.ctx, "btc-a", d("0.001")))
+	suite.Equal(types.CDPs{cdp}, suite.keeper.GetAllCdpsByCollateralTypeAndRatio(suite.ctx, "btc-a", d("0.001").Add(sdk.SmallestDec())))
+
+	ak := suite.app.GetAccountKeeper()
+	bk := suite.app.GetBankKeeper()
+	suite.Equal(cs(c("btc", 499000000), c("usdx", 10000000), c("xrp", 500000000)), bk.GetAllBalances(suite.ctx, suite.addrs[0]))
+	acc := ak.GetModuleAccount(suite.ctx, types.ModuleName)
+	suite.Equal(cs(c("btc", 1000000), c("debt", 10000000)), bk.GetAllBalances(suite.ctx, acc.GetAddress()))
+}
+
+func (suite *MigrateTestSuite) TestMigrateCdpToExistingCdp() {
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("btc", 1000000), c("usdx", 10000000), "btc-a")
+	suite.Require().NoError(err)
+
+	// the target cdp has enough collateral for the migrated debt, so no collateral is deposited
+	id, err := suite.keeper.MigrateCdp(suite.ctx, suite.addrs[0], "xrp-a", "btc-a", c("btc", 0))
+	suite.Require().NoError(err)
+	suite.Equal(uint64(2), id)
+	suite.Equal(uint64(3), suite.keeper.GetNextCdpID(suite.ctx))
+
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.False(found)
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx"))
+
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "btc-a", 2)
+	suite.Require().True(found)
+	suite.Equal(c("btc", 1000000), cdp.Collateral)
+	suite.Equal(c("usdx", 20000000), cdp.Principal)
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, 2, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Equal(c("btc", 1000000), deposit.Amount)
+	ids, found := suite.keeper.GetCdpIdsByOwner(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Equal([]uint64{2}, ids)
+	suite.Equal(i(20000000), suite.keeper.GetTotalPrincipal(suite.ctx, "btc-a", "usdx"))
+
+	suite.Empty(suite.keeper.GetAllCdpsByCollateralTypeAndRatio(suite.ctx, "btc-a", d("0.0005")))
+	suite.Equal(types.CDPs{cdp}, suite.keeper.GetAllCdpsByCollateralTypeAndRatio(suite.ctx, "btc-a", d("0.0005").Add(sdk.SmallestDec())))
+
+	bk := suite.app.GetBankKeeper()
+	suite.Equal(cs(c("btc", 499000000), c("usdx", 20000000), c("xrp", 500000000)), bk.GetAllBalances(suite.ctx, suite.addrs[0]))
+}
+
+func (suite *MigrateTestSuite) TestMigrateCdpAccumulatedFees() {
+	err := suite.keeper.AccumulateInterest(suite.ctx, "xrp-a")
+	suite.Require().NoError(err)
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour * 24 * 365))
+	err = suite.keeper.AccumulateInterest(ctx, "xrp-a")
+	suite.Require().NoError(err)
+
+	original, found := suite.keeper.GetCDP(ctx, "xrp-a", 1)
+	suite.Require().True(found)
+	fees := suite.keeper.CalculateNewInterest(ctx, original)
+	suite.True(fees.IsPositive())
+	suite.Equal(i(10000000).Add(fees.Amount), suite.keeper.GetTotalPrincipal(ctx, "xrp-a", "usdx"))
+
+	_, err = suite.keeper.MigrateCdp(ctx, suite.addrs[0], "xrp-a", "btc-a", c("btc", 1000000))
+	suite.Require().NoError(err)
+
+	// the accumulated fees are moved along with the principal
+	cdp, found := suite.keeper.GetCDP(ctx, "btc-a", 2)
+	suite.Require().True(found)
+	suite.Equal(c("usdx", 10000000), cdp.Principal)
+	suite.Equal(fees, cdp.AccumulatedFees)
+	interestFactor, found := suite.keeper.GetInterestFactor(ctx, "btc-a")
+	suite.Require().True(found)
+	suite.Equal(interestFactor, cdp.InterestFactor)
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(ctx, "xrp-a", "usdx"))
+	suite.Equal(i(10000000).Add(fees.Amount), suite.keeper.GetTotalPrincipal(ctx, "btc-a", "usdx"))
+}
+
+func (suite *MigrateTestSuite) TestMigrateCdpAtGlobalDebtLimit() {
+	// the migrated debt is already part of the global debt, so a migration is allowed at the global debt limit
+	params := suite.keeper.GetParams(suite.ctx)
+	params.GlobalDebtLimit = c("usdx", 10000000)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	_, err := suite.keeper.MigrateCdp(suite.ctx, suite.addrs[0], "xrp-a", "btc-a", c("btc", 1000000))
+	suite.Require().NoError(err)
+	suite.Equal(i(10000000), suite.keeper.GetTotalPrincipal(suite.ctx, "btc-a", "usdx"))
+}
+
+func (suite *MigrateTestSuite) TestMigrateCdpInvalid() {
+	_, err := suite.keeper.MigrateCdp(suite.ctx, suite.addrs[1], "xrp-a", "btc-a", c("btc", 1000000))
+	suite.ErrorIs(err, types.ErrCdpNotFound)
+
+	_, err = suite.keeper.MigrateCdp(suite.ctx, suite.addrs[0], "xrp-a", "xrp-a", c("xrp", 1000000))
+	suite.ErrorIs(err, types.ErrInvalidCollateral)
+
+	_, err = suite.keeper.MigrateCdp(suite.ctx, suite.addrs[0], "xrp-a", "btc-a", c("xrp", 1000000))
+	suite.ErrorIs(err, types.ErrInvalidCollateral)
+
+	_, err = suite.keeper.MigrateCdp(suite.ctx, suite.addrs[0], "xrp-a", "btc-a", c("btc", 600000000))
+	suite.ErrorIs(err, types.ErrInsufficientBalance)
+
+	_, err = suite.keeper.MigrateCdp(suite.ctx, suite.addrs[0], "xrp-a", "btc-a", c("btc", 0))
+	suite.ErrorIs(err, types.ErrInvalidCollateralRatio)
+
+	_, err = suite.keeper.MigrateCdp(suite.ctx, suite.addrs[0], "xrp-a", "btc-a", c("btc", 100000))
+	suite.ErrorIs(err, types.ErrInvalidCollateralRatio)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	for i, cp := range params.CollateralParams {
+		if cp.Type == "btc-a" {
+			params.CollateralParams[i].DebtLimit = c("usdx", 5000000)
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+	_, err = suite.keeper.MigrateCdp(suite.ctx, suite.addrs[0], "xrp-a", "btc-a", c("btc", 1000000))
+	suite.ErrorIs(err, types.ErrExceedsDebtLimit)
+
+	// the original cdp is left untouched
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().True(found)
+	suite.Equal(c("xrp", 400000000), cdp.Collateral)
+	suite.Equal(c("usdx", 10000000), cdp.Principal)
+	suite.Equal(i(10000000), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx"))
+	_, found = suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "btc-a")
+	suite.False(found)
+}
+
+func TestMigrateTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrateTestSuite))
+}
@@ -173,3 +173,72 @@ func (k msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*t
 	)
 	return &types.MsgLiquidateResponse{}, nil
 }
+
+func (k msgServer) MigrateCDP(goCtx context.Context, msg *types.MsgMigrateCDP) (*types.MsgMigrateCDPResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := k.keeper.MigrateCdp(ctx, sender, msg.CollateralType, msg.TargetCollateralType, msg.Collateral)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgMigrateCDPResponse{CdpID: id}, nil
+}
+
+func (k msgServer) PegMint(goCtx context.Context, msg *types.MsgPegMint) (*types.MsgPegMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	minted, err := k.keeper.PegMint(ctx, sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgPegMintResponse{Minted: minted}, nil
+}
+
+func (k msgServer) PegRedeem(goCtx context.Context, msg *types.MsgPegRedeem) (*types.MsgPegRedeemResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	redeemed, err := k.keeper.PegRedeem(ctx, sender, msg.Amount, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgPegRedeemResponse{Redeemed: redeemed}, nil
+}
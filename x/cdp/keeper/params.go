@@ -32,6 +32,17 @@ func (k Keeper) GetCollateral(ctx sdk.Context, collateralType string) (types.Col
 	return types.CollateralParam{}, false
 }
 
+// GetPegStabilityParam returns the peg stability param with the matching denom
+func (k Keeper) GetPegStabilityParam(ctx sdk.Context, denom string) (types.PegStabilityParam, bool) {
+	params := k.GetParams(ctx)
+	for _, psp := range params.PegStabilityParams {
+		if psp.Denom == denom {
+			return psp, true
+		}
+	}
+	return types.PegStabilityParam{}, false
+}
+
 // GetCollateralTypes returns an array of collateral types
 func (k Keeper) GetCollateralTypes(ctx sdk.Context) []string {
 	params := k.GetParams(ctx)
@@ -91,11 +102,32 @@ func (k Keeper) getAuctionSize(ctx sdk.Context, collateralType string) sdkmath.I
 	return cp.AuctionSize
 }
 
-// GetFeeRate returns the per second fee rate for the input denom
+// GetFeeRate returns the per second fee rate for the input denom, calculated from the utilization of its debt limit
+// if it has a stability fee model
 func (k Keeper) getFeeRate(ctx sdk.Context, collateralType string) (fee sdk.Dec) {
 	collalateralParam, found := k.GetCollateral(ctx, collateralType)
 	if !found {
 		panic(fmt.Sprintf("could not get fee rate for %s, collateral not found", collateralType))
 	}
-	return collalateralParam.StabilityFee
+	if collalateralParam.StabilityFeeModel == nil {
+		return collalateralParam.StabilityFee
+	}
+	utilization := k.getDebtLimitUtilization(ctx, collalateralParam)
+	fee, err := collalateralParam.StabilityFeeModel.CalculateStabilityFee(utilization)
+	if err != nil {
+		panic(fmt.Sprintf("could not get fee rate for %s: %s", collateralType, err))
+	}
+	return fee
+}
+
+// getDebtLimitUtilization returns the ratio of the total principal of the collateral type to its debt limit, at most one
+func (k Keeper) getDebtLimitUtilization(ctx sdk.Context, cp types.CollateralParam) sdk.Dec {
+	totalPrincipal := k.GetTotalPrincipal(ctx, cp.Type, cp.DebtLimit.Denom)
+	if !totalPrincipal.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	if !cp.DebtLimit.Amount.IsPositive() {
+		return sdk.OneDec()
+	}
+	return sdk.MinDec(sdk.OneDec(), sdk.NewDecFromInt(totalPrincipal).QuoInt(cp.DebtLimit.Amount))
 }
@@ -0,0 +1,250 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// PegMint deposits a stablecoin to the peg stability module reserves and mints the debt asset to the sender one to one,
+// less the mint fee. Amounts are converted between the decimals of the stablecoin and the debt asset, truncating the
+// minted amount. The fee is kept in the reserves and realized as surplus when surplus and debt are netted.
+func (k Keeper) PegMint(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	psp, found := k.GetPegStabilityParam(ctx, amount.Denom)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrPegStabilityDenomNotFound, amount.Denom)
+	}
+	dp := k.GetParams(ctx).DebtParam
+
+	fee := sdk.NewDecFromInt(amount.Amount).Mul(psp.MintFee).Ceil().TruncateInt()
+	minted := sdk.NewCoin(dp.Denom, convertDecimals(amount.Amount.Sub(fee), psp.ConversionFactor, dp.ConversionFactor))
+	if !minted.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidPegAmount, "%s is not positive after fee %s", amount, sdk.NewCoin(amount.Denom, fee))
+	}
+	err := k.validatePegStabilityDebtLimit(ctx, psp, minted)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	err = k.ValidateBalance(ctx, amount, sender)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.PegStabilityMacc, sdk.NewCoins(amount))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	err = k.bankKeeper.MintCoins(ctx, types.PegStabilityMacc, sdk.NewCoins(minted))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.PegStabilityMacc, sender, sdk.NewCoins(minted))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	k.SetPegStabilityDebt(ctx, amount.Denom, k.GetPegStabilityDebt(ctx, amount.Denom).Add(minted.Amount))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePegMint,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, sdk.NewCoin(amount.Denom, fee).String()),
+			sdk.NewAttribute(types.AttributeKeyMinted, minted.String()),
+		),
+	)
+	return minted, nil
+}
+
+// PegRedeem burns the debt asset and sends the sender the input stablecoin from the peg stability module reserves one to one,
+// less the redeem fee. Amounts are converted between the decimals of the debt asset and the stablecoin, truncating the
+// redeemed amount. The fee is kept in the reserves and realized as surplus when surplus and debt are netted.
+func (k Keeper) PegRedeem(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin, denom string) (sdk.Coin, error) {
+	psp, found := k.GetPegStabilityParam(ctx, denom)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrPegStabilityDenomNotFound, denom)
+	}
+	dp := k.GetParams(ctx).DebtParam
+	if amount.Denom != dp.Denom {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidPegAmount, "redeemed denom %s does not match debt denom %s", amount.Denom, dp.Denom)
+	}
+
+	fee := sdk.NewDecFromInt(amount.Amount).Mul(psp.RedeemFee).Ceil().TruncateInt()
+	redeemed := sdk.NewCoin(denom, convertDecimals(amount.Amount.Sub(fee), dp.ConversionFactor, psp.ConversionFactor))
+	if !redeemed.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidPegAmount, "%s is not positive after fee %s", amount, sdk.NewCoin(amount.Denom, fee))
+	}
+	debt := k.GetPegStabilityDebt(ctx, denom)
+	if amount.Amount.GT(debt) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInsufficientPegStabilityReserves, "%s > debt minted against %s reserves %s", amount, denom, sdk.NewCoin(dp.Denom, debt))
+	}
+	err := k.ValidateBalance(ctx, amount, sender)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.PegStabilityMacc, sdk.NewCoins(amount))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.PegStabilityMacc, sdk.NewCoins(amount))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.PegStabilityMacc, sender, sdk.NewCoins(redeemed))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	k.SetPegStabilityDebt(ctx, denom, debt.Sub(amount.Amount))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePegRedeem,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, sdk.NewCoin(amount.Denom, fee).String()),
+			sdk.NewAttribute(types.AttributeKeyRedeemed, redeemed.String()),
+		),
+	)
+	return redeemed, nil
+}
+
+// validatePegStabilityDebtLimit validates that minting the input amount against the reserves of a stablecoin doesn't exceed
+// its debt limit or the global debt limit
+func (k Keeper) validatePegStabilityDebtLimit(ctx sdk.Context, psp types.PegStabilityParam, minted sdk.Coin) error {
+	debt := k.GetPegStabilityDebt(ctx, psp.Denom).Add(minted.Amount)
+	if debt.GT(psp.DebtLimit.Amount) {
+		return errorsmod.Wrapf(types.ErrExceedsDebtLimit, "debt increase %s > peg stability debt limit %s", sdk.NewCoin(minted.Denom, debt), psp.DebtLimit)
+	}
+	globalDebt := k.getGlobalDebt(ctx, minted.Denom).Add(minted.Amount)
+	globalLimit := k.GetParams(ctx).GlobalDebtLimit.Amount
+	if globalDebt.GT(globalLimit) {
+		return errorsmod.Wrapf(types.ErrExceedsDebtLimit, "debt increase %s > global debt limit  %s", sdk.NewCoin(minted.Denom, globalDebt), sdk.NewCoin(minted.Denom, globalLimit))
+	}
+	return nil
+}
+
+// getGlobalDebt returns the total principal drawn from cdps of all collateral types plus the debt minted against
+// the peg stability module reserves
+func (k Keeper) getGlobalDebt(ctx sdk.Context, principalDenom string) sdkmath.Int {
+	total := k.GetTotalPegStabilityDebt(ctx)
+	for _, cp := range k.GetParams(ctx).CollateralParams {
+		total = total.Add(k.GetTotalPrincipal(ctx, cp.Type, principalDenom))
+	}
+	return total
+}
+
+// realizePegStabilityFees mints the debt asset to the liquidator module account equal to the peg stability reserves in
+// excess of the debt minted against them, so that fees collected in the reserves can be netted against debt. The realized
+// fees are added to the debt of the reserves, so they are limited to the room left under the peg stability and global
+// debt limits, and the rest is realized once debt is repaid.
+func (k Keeper) realizePegStabilityFees(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	dp := params.DebtParam
+	var reserves types.PegStabilityReserves
+	k.IteratePegStabilityDebts(ctx, func(reserve types.PegStabilityReserve) bool {
+		reserves = append(reserves, reserve)
+		return false
+	})
+
+	for _, reserve := range reserves {
+		psp, found := k.GetPegStabilityParam(ctx, reserve.Denom)
+		if !found {
+			continue
+		}
+		balance := convertDecimals(k.GetPegStabilityReserves(ctx, reserve.Denom), psp.ConversionFactor, dp.ConversionFactor)
+		surplus := sdkmath.MinInt(balance.Sub(reserve.Debt), psp.DebtLimit.Amount.Sub(reserve.Debt))
+		surplus = sdkmath.MinInt(surplus, params.GlobalDebtLimit.Amount.Sub(k.getGlobalDebt(ctx, dp.Denom)))
+		if !surplus.IsPositive() {
+			continue
+		}
+		err := k.bankKeeper.MintCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(dp.Denom, surplus)))
+		if err != nil {
+			return err
+		}
+		k.SetPegStabilityDebt(ctx, reserve.Denom, reserve.Debt.Add(surplus))
+	}
+	return nil
+}
+
+// ValidatePegStabilityReserves validates that the peg stability module account holds enough of each stablecoin to
+// redeem the debt minted against it
+func (k Keeper) ValidatePegStabilityReserves(ctx sdk.Context) error {
+	dp := k.GetParams(ctx).DebtParam
+	var err error
+	k.IteratePegStabilityDebts(ctx, func(reserve types.PegStabilityReserve) bool {
+		psp, found := k.GetPegStabilityParam(ctx, reserve.Denom)
+		if !found {
+			err = errorsmod.Wrapf(types.ErrPegStabilityDenomNotFound, "debt minted against %s reserves", reserve.Denom)
+			return true
+		}
+		balance := k.GetPegStabilityReserves(ctx, reserve.Denom)
+		if convertDecimals(balance, psp.ConversionFactor, dp.ConversionFactor).LT(reserve.Debt) {
+			err = errorsmod.Wrapf(types.ErrInsufficientPegStabilityReserves, "%s reserves do not cover debt %s",
+				sdk.NewCoin(reserve.Denom, balance), sdk.NewCoin(dp.Denom, reserve.Debt))
+			return true
+		}
+		return false
+	})
+	return err
+}
+
+// convertDecimals converts an amount from a denom with the input number of decimals to a denom with the output
+// number of decimals, truncating the result
+func convertDecimals(amount sdkmath.Int, fromDecimals, toDecimals sdkmath.Int) sdkmath.Int {
+	if toDecimals.GTE(fromDecimals) {
+		return amount.Mul(sdkmath.NewIntWithDecimal(1, int(toDecimals.Sub(fromDecimals).Int64())))
+	}
+	return amount.Quo(sdkmath.NewIntWithDecimal(1, int(fromDecimals.Sub(toDecimals).Int64())))
+}
+
+// GetPegStabilityReserves returns the balance of a stablecoin held by the peg stability module account
+func (k Keeper) GetPegStabilityReserves(ctx sdk.Context, denom string) sdkmath.Int {
+	acc := k.accountKeeper.GetModuleAccount(ctx, types.PegStabilityMacc)
+	return k.bankKeeper.GetBalance(ctx, acc.GetAddress(), denom).Amount
+}
+
+// GetPegStabilityDebt returns the amount of the debt asset minted against the reserves of a stablecoin
+func (k Keeper) GetPegStabilityDebt(ctx sdk.Context, denom string) sdkmath.Int {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PegStabilityDebtKeyPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var reserve types.PegStabilityReserve
+	k.cdc.MustUnmarshal(bz, &reserve)
+	return reserve.Debt
+}
+
+// SetPegStabilityDebt sets the amount of the debt asset minted against the reserves of a stablecoin
+func (k Keeper) SetPegStabilityDebt(ctx sdk.Context, denom string, debt sdkmath.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PegStabilityDebtKeyPrefix)
+	reserve := types.NewPegStabilityReserve(denom, debt)
+	store.Set([]byte(denom), k.cdc.MustMarshal(&reserve))
+}
+
+// IteratePegStabilityDebts iterates over the debt minted against the reserves of each stablecoin and performs a callback function
+func (k Keeper) IteratePegStabilityDebts(ctx sdk.Context, cb func(reserve types.PegStabilityReserve) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PegStabilityDebtKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var reserve types.PegStabilityReserve
+		k.cdc.MustUnmarshal(iterator.Value(), &reserve)
+
+		if cb(reserve) {
+			break
+		}
+	}
+}
+
+// GetTotalPegStabilityDebt returns the amount of the debt asset minted against the reserves of all stablecoins
+func (k Keeper) GetTotalPegStabilityDebt(ctx sdk.Context) sdkmath.Int {
+	total := sdk.ZeroInt()
+	k.IteratePegStabilityDebts(ctx, func(reserve types.PegStabilityReserve) bool {
+		total = total.Add(reserve.Debt)
+		return false
+	})
+	return total
+}
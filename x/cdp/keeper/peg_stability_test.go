@@ -0,0 +1,222 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+type PegStabilityTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *PegStabilityTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	coins := []sdk.Coins{
+		cs(c("usdc", 200000000000), c("busd", 100000000)),
+		cs(c("usdc", 100000000)),
+	}
+
+	authGS := app.NewFundedGenStateWithCoins(cdc, coins, addrs)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+}
+
+func (suite *PegStabilityTestSuite) TestPegMint() {
+	minted, err := suite.keeper.PegMint(suite.ctx, suite.addrs[0], c("usdc", 10000000))
+	suite.Require().NoError(err)
+	suite.Equal(c("usdx", 9990000), minted)
+
+	bk := suite.app.GetBankKeeper()
+	suite.Equal(c("usdx", 9990000), bk.GetBalance(suite.ctx, suite.addrs[0], "usdx"))
+	suite.Equal(c("usdc", 199990000000), bk.GetBalance(suite.ctx, suite.addrs[0], "usdc"))
+	suite.Equal(i(10000000), suite.keeper.GetPegStabilityReserves(suite.ctx, "usdc"))
+	suite.Equal(i(9990000), suite.keeper.GetPegStabilityDebt(suite.ctx, "usdc"))
+	suite.Equal(i(9990000), suite.keeper.GetTotalPegStabilityDebt(suite.ctx))
+
+	// fees are rounded up
+	minted, err = suite.keeper.PegMint(suite.ctx, suite.addrs[1], c("usdc", 1001))
+	suite.Require().NoError(err)
+	suite.Equal(c("usdx", 999), minted)
+	suite.Equal(i(9990999), suite.keeper.GetPegStabilityDebt(suite.ctx, "usdc"))
+}
+
+func (suite *PegStabilityTestSuite) TestPegMint_Invalid() {
+	_, err := suite.keeper.PegMint(suite.ctx, suite.addrs[0], c("busd", 10000000))
+	suite.Require().ErrorIs(err, types.ErrPegStabilityDenomNotFound)
+
+	_, err = suite.keeper.PegMint(suite.ctx, suite.addrs[0], c("usdc", 1))
+	suite.Require().ErrorIs(err, types.ErrInvalidPegAmount)
+
+	_, err = suite.keeper.PegMint(suite.ctx, suite.addrs[0], c("usdc", 110000000000))
+	suite.Require().ErrorIs(err, types.ErrExceedsDebtLimit)
+
+	_, err = suite.keeper.PegMint(suite.ctx, suite.addrs[1], c("usdc", 200000000))
+	suite.Require().ErrorIs(err, types.ErrInsufficientBalance)
+	suite.Equal(i(0), suite.keeper.GetPegStabilityDebt(suite.ctx, "usdc"))
+}
+
+func (suite *PegStabilityTestSuite) TestPegMint_GlobalDebtLimit() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.GlobalDebtLimit = c("usdx", 5000000)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	_, err := suite.keeper.PegMint(suite.ctx, suite.addrs[0], c("usdc", 10000000))
+	suite.Require().ErrorIs(err, types.ErrExceedsDebtLimit)
+}
+
+func (suite *PegStabilityTestSuite) TestPegRedeem() {
+	_, err := suite.keeper.PegMint(suite.ctx, suite.addrs[0], c("usdc", 10000000))
+	suite.Require().NoError(err)
+
+	redeemed, err := suite.keeper.PegRedeem(suite.ctx, suite.addrs[0], c("usdx", 5000000), "usdc")
+	suite.Require().NoError(err)
+	suite.Equal(c("usdc", 4990000), redeemed)
+
+	bk := suite.app.GetBankKeeper()
+	suite.Equal(c("usdx", 4990000), bk.GetBalance(suite.ctx, suite.addrs[0], "usdx"))
+	suite.Equal(c("usdc", 199994990000), bk.GetBalance(suite.ctx, suite.addrs[0], "usdc"))
+	suite.Equal(i(5010000), suite.keeper.GetPegStabilityReserves(suite.ctx, "usdc"))
+	suite.Equal(i(4990000), suite.keeper.GetPegStabilityDebt(suite.ctx, "usdc"))
+}
+
+func (suite *PegStabilityTestSuite) TestPegRedeem_Invalid() {
+	_, err := suite.keeper.PegMint(suite.ctx, suite.addrs[0], c("usdc", 10000000))
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.PegRedeem(suite.ctx, suite.addrs[0], c("usdx", 1000000), "busd")
+	suite.Require().ErrorIs(err, types.ErrPegStabilityDenomNotFound)
+
+	_, err = suite.keeper.PegRedeem(suite.ctx, suite.addrs[0], c("busd", 1000000), "usdc")
+	suite.Require().ErrorIs(err, types.ErrInvalidPegAmount)
+
+	_, err = suite.keeper.PegRedeem(suite.ctx, suite.addrs[0], c("usdx", 20000000), "usdc")
+	suite.Require().ErrorIs(err, types.ErrInsufficientPegStabilityReserves)
+
+	_, err = suite.keeper.PegRedeem(suite.ctx, suite.addrs[1], c("usdx", 1000000), "usdc")
+	suite.Require().ErrorIs(err, types.ErrInsufficientBalance)
+	suite.Equal(i(9990000), suite.keeper.GetPegStabilityDebt(suite.ctx, "usdc"))
+}
+
+func (suite *PegStabilityTestSuite) TestNetSurplusAndDebt_PegStabilityFees() {
+	_, err := suite.keeper.PegMint(suite.ctx, suite.addrs[0], c("usdc", 10000000))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.NetSurplusAndDebt(suite.ctx)
+	suite.Require().NoError(err)
+
+	// the fee kept in the reserves is minted as surplus and the reserves are fully backing the debt
+	suite.Equal(i(10000), suite.keeper.GetTotalSurplus(suite.ctx, types.LiquidatorMacc))
+	suite.Equal(i(10000000), suite.keeper.GetPegStabilityDebt(suite.ctx, "usdc"))
+	suite.Equal(i(10000000), suite.keeper.GetPegStabilityReserves(suite.ctx, "usdc"))
+
+	// fees are only realized once
+	err = suite.keeper.NetSurplusAndDebt(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Equal(i(10000), suite.keeper.GetTotalSurplus(suite.ctx, types.LiquidatorMacc))
+}
+
+func (suite *PegStabilityTestSuite) TestPegMintAndRedeem_ConversionFactor() {
+	// busd has 8 decimals and usdx 6
+	params := suite.keeper.GetParams(suite.ctx)
+	params.PegStabilityParams = append(params.PegStabilityParams,
+		types.NewPegStabilityParam("busd", i(8), c("usdx", 100000000000), d("0.001"), d("0.002")),
+	)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	minted, err := suite.keeper.PegMint(suite.ctx, suite.addrs[0], c("busd", 10000000))
+	suite.Require().NoError(err)
+	suite.Equal(c("usdx", 99900), minted)
+	suite.Equal(i(10000000), suite.keeper.GetPegStabilityReserves(suite.ctx, "busd"))
+	suite.Equal(i(99900), suite.keeper.GetPegStabilityDebt(suite.ctx, "busd"))
+
+	redeemed, err := suite.keeper.PegRedeem(suite.ctx, suite.addrs[0], c("usdx", 50000), "busd")
+	suite.Require().NoError(err)
+	suite.Equal(c("busd", 4990000), redeemed)
+	suite.Equal(i(5010000), suite.keeper.GetPegStabilityReserves(suite.ctx, "busd"))
+	suite.Equal(i(49900), suite.keeper.GetPegStabilityDebt(suite.ctx, "busd"))
+
+	// amounts smaller than a unit of the debt asset are truncated
+	_, err = suite.keeper.PegMint(suite.ctx, suite.addrs[0], c("busd", 100))
+	suite.Require().ErrorIs(err, types.ErrInvalidPegAmount)
+
+	_, broken := keeper.PegStabilityReservesInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken)
+}
+
+func (suite *PegStabilityTestSuite) TestNetSurplusAndDebt_PegStabilityFeesDebtLimits() {
+	_, err := suite.keeper.PegMint(suite.ctx, suite.addrs[0], c("usdc", 10000000))
+	suite.Require().NoError(err)
+
+	// realized fees are limited by the peg stability debt limit
+	params := suite.keeper.GetParams(suite.ctx)
+	params.PegStabilityParams[0].DebtLimit = c("usdx", 9994000)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	err = suite.keeper.NetSurplusAndDebt(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Equal(i(4000), suite.keeper.GetTotalSurplus(suite.ctx, types.LiquidatorMacc))
+	suite.Equal(i(9994000), suite.keeper.GetPegStabilityDebt(suite.ctx, "usdc"))
+
+	// and by the global debt limit
+	params.PegStabilityParams[0].DebtLimit = c("usdx", 100000000000)
+	params.GlobalDebtLimit = c("usdx", 9997000)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	err = suite.keeper.NetSurplusAndDebt(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Equal(i(7000), suite.keeper.GetTotalSurplus(suite.ctx, types.LiquidatorMacc))
+	suite.Equal(i(9997000), suite.keeper.GetPegStabilityDebt(suite.ctx, "usdc"))
+
+	// debt at the limits realizes no fees
+	err = suite.keeper.NetSurplusAndDebt(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Equal(i(7000), suite.keeper.GetTotalSurplus(suite.ctx, types.LiquidatorMacc))
+}
+
+func (suite *PegStabilityTestSuite) TestPegStabilityInvariants() {
+	_, err := suite.keeper.PegMint(suite.ctx, suite.addrs[0], c("usdc", 10000000))
+	suite.Require().NoError(err)
+
+	_, broken := keeper.PegStabilityDebtsInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken)
+	_, broken = keeper.PegStabilityReservesInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	// debt in excess of the reserves breaks the invariant
+	suite.keeper.SetPegStabilityDebt(suite.ctx, "usdc", i(10000001))
+	_, broken = keeper.PegStabilityReservesInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+
+	suite.keeper.SetPegStabilityDebt(suite.ctx, "usdc", i(-1))
+	_, broken = keeper.PegStabilityDebtsInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+}
+
+func TestPegStabilityTestSuite(t *testing.T) {
+	suite.Run(t, new(PegStabilityTestSuite))
+}
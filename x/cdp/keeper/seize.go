@@ -12,7 +12,7 @@ import (
 
 // AttemptKeeperLiquidation liquidates the cdp with the input collateral type and owner if it is below the required collateralization ratio
 // if the cdp is liquidated, the keeper that sent the transaction is rewarded a percentage of the collateral according to that collateral types'
-// keeper reward percentage.
+// keeper reward percentage. If the cdp is partially liquidated, the reward is a percentage of the collateral seized.
 func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper, owner sdk.AccAddress, collateralType string) error {
 	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
 	if !found {
@@ -25,7 +25,11 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper, owner sdk.AccA
 	if err != nil {
 		return err
 	}
-	cdp, err = k.payoutKeeperLiquidationReward(ctx, keeper, cdp)
+	rewardBase := cdp.Collateral
+	if seizedCollateral, _, partial := k.calculatePartialLiquidation(ctx, cdp); partial {
+		rewardBase = seizedCollateral
+	}
+	cdp, err = k.payoutKeeperLiquidationReward(ctx, keeper, cdp, rewardBase)
 	if err != nil {
 		return err
 	}
@@ -33,13 +37,19 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper, owner sdk.AccA
 }
 
 // SeizeCollateral liquidates the collateral in the input cdp.
-// the following operations are performed:
+// if the collateral type has a partial liquidation ratio, only the collateral needed to restore the cdp to that ratio is seized,
+// see seizePartialCollateral. Otherwise the following operations are performed:
 // 1. Collateral for all deposits is sent from the cdp module to the liquidator module account
 // 2. The liquidation penalty is applied
 // 3. Debt coins are sent from the cdp module to the liquidator module account
 // 4. The total amount of principal outstanding for that collateral type is decremented
 // (this is the equivalent of saying that fees are no longer accumulated by a cdp once it gets liquidated)
 func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
+	seizedCollateral, seizedDebt, partial := k.calculatePartialLiquidation(ctx, cdp)
+	if partial {
+		return k.seizePartialCollateral(ctx, cdp, seizedCollateral, seizedDebt)
+	}
+
 	// Calculate the previous collateral ratio
 	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
 
@@ -87,6 +97,125 @@ func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
 	return k.DeleteCDP(ctx, cdp)
 }
 
+// seizePartialCollateral liquidates the input amount of collateral and debt from the cdp, leaving the rest with the owner.
+// the following operations are performed:
+// 1. Collateral is taken from each deposit in proportion to its share of the cdp's collateral and sent to the liquidator module account
+// 2. The seized collateral is auctioned to raise the seized debt plus the liquidation penalty
+// 3. Debt coins for the seized debt are sent from the cdp module to the liquidator module account
+// 4. The seized debt is repaid from the cdp's fees first and then its principal, and the total principal is decremented
+// 5. The cdp is updated with its remaining collateral and debt and re-indexed by its new collateral ratio
+func (k Keeper) seizePartialCollateral(ctx sdk.Context, cdp types.CDP, collateral sdk.Coin, debt sdk.Coin) error {
+	deposits := k.GetDeposits(ctx, cdp.ID)
+	totalCollateral := deposits.SumCollateral()
+
+	// liquidate part of each deposit and send collateral from cdp to liquidator
+	var seizedDeposits types.Deposits
+	remaining := collateral.Amount
+	for _, dep := range deposits {
+		if !remaining.IsPositive() {
+			break
+		}
+		// round each share up so the whole amount is seized, the last deposits make up for the rounding
+		amount := dep.Amount.Amount.Mul(collateral.Amount).Add(totalCollateral).Sub(sdk.OneInt()).Quo(totalCollateral)
+		amount = sdk.MinInt(sdk.MinInt(amount, remaining), dep.Amount.Amount)
+		if !amount.IsPositive() {
+			continue
+		}
+		remaining = remaining.Sub(amount)
+		seized := types.NewDeposit(cdp.ID, dep.Depositor, sdk.NewCoin(dep.Amount.Denom, amount))
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(seized.Amount)); err != nil {
+			return err
+		}
+		seizedDeposits = append(seizedDeposits, seized)
+
+		dep.Amount = dep.Amount.Sub(seized.Amount)
+		if dep.Amount.IsZero() {
+			k.DeleteDeposit(ctx, dep.CdpID, dep.Depositor)
+		} else {
+			k.SetDeposit(ctx, dep)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpLiquidation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+				sdk.NewAttribute(types.AttributeKeyDeposit, seized.String()),
+			),
+		)
+	}
+	seizedCollateral := collateral.SubAmount(remaining)
+
+	// Move debt coins for the seized debt from cdp to liquidator account
+	debtAmount := sdk.MinInt(debt.Amount, k.getModAccountDebt(ctx, types.ModuleName))
+	debtCoin := sdk.NewCoin(k.GetDebtDenom(ctx), debtAmount)
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(debtCoin))
+	if err != nil {
+		return err
+	}
+
+	err = k.AuctionCollateral(ctx, seizedDeposits, cdp.Type, debtAmount, cdp.Principal.Denom)
+	if err != nil {
+		return err
+	}
+
+	k.DecrementTotalPrincipal(ctx, cdp.Type, debt)
+
+	// repay fees first, then principal
+	feePayment := sdk.NewCoin(debt.Denom, sdk.MinInt(debt.Amount, cdp.AccumulatedFees.Amount))
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Sub(feePayment)
+	cdp.Principal = cdp.Principal.Sub(debt.Sub(feePayment))
+	cdp.Collateral = cdp.Collateral.Sub(seizedCollateral)
+
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+	return k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+}
+
+// calculatePartialLiquidation returns the collateral and debt to seize from the cdp to restore it to its collateral type's
+// partial liquidation ratio, with the seized collateral covering the seized debt plus the liquidation penalty.
+// It returns false if the collateral type doesn't have a partial liquidation ratio, or if the cdp should be fully liquidated
+// because restoring it would seize all of its collateral or debt, or leave its principal below the debt floor.
+func (k Keeper) calculatePartialLiquidation(ctx sdk.Context, cdp types.CDP) (sdk.Coin, sdk.Coin, bool) {
+	cp, found := k.GetCollateral(ctx, cdp.Type)
+	if !found || cp.PartialLiquidationRatio == nil || !cp.PartialLiquidationRatio.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, false
+	}
+	dp, found := k.GetDebtParam(ctx, cdp.Principal.Denom)
+	if !found {
+		return sdk.Coin{}, sdk.Coin{}, false
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.LiquidationMarketID)
+	if err != nil || !price.Price.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, false
+	}
+
+	// solve (collateralValue - seizedDebt * (1 + penalty)) / (debt - seizedDebt) = partialLiquidationRatio for seizedDebt
+	targetRatio := *cp.PartialLiquidationRatio
+	penaltyMultiplier := sdk.OneDec().Add(cp.LiquidationPenalty)
+	collateralValue := k.convertCollateralToBaseUnits(ctx, cdp.Collateral, cdp.Type).Mul(price.Price)
+	debtValue := k.convertDebtToBaseUnits(ctx, cdp.GetTotalPrincipal())
+	seizedDebtValue := targetRatio.Mul(debtValue).Sub(collateralValue).Quo(targetRatio.Sub(penaltyMultiplier))
+	if !seizedDebtValue.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, false
+	}
+
+	// convert from base units, rounding the debt up and the collateral down so the cdp ends at or above the partial liquidation ratio
+	debtUnit := sdk.NewDecFromIntWithPrec(sdk.OneInt(), dp.ConversionFactor.Int64())
+	collateralUnit := sdk.NewDecFromIntWithPrec(sdk.OneInt(), cp.ConversionFactor.Int64())
+	seizedDebt := sdk.NewCoin(cdp.Principal.Denom, seizedDebtValue.Quo(debtUnit).Ceil().TruncateInt())
+	seizedCollateralValue := k.convertDebtToBaseUnits(ctx, seizedDebt).Mul(penaltyMultiplier)
+	seizedCollateral := sdk.NewCoin(cdp.Collateral.Denom, seizedCollateralValue.Quo(price.Price).Quo(collateralUnit).TruncateInt())
+
+	if seizedDebt.Amount.GTE(cdp.GetTotalPrincipal().Amount) || !seizedCollateral.IsPositive() || seizedCollateral.Amount.GTE(cdp.Collateral.Amount) {
+		return sdk.Coin{}, sdk.Coin{}, false
+	}
+	principalPayment := seizedDebt.Amount.Sub(sdk.MinInt(seizedDebt.Amount, cdp.AccumulatedFees.Amount))
+	if cdp.Principal.Amount.Sub(principalPayment).LT(dp.DebtFloor) {
+		return sdk.Coin{}, sdk.Coin{}, false
+	}
+	return seizedCollateral, seizedDebt, true
+}
+
 // LiquidateCdps seizes collateral from all CDPs below the input liquidation ratio
 func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, collateralType string, liquidationRatio sdk.Dec, count sdkmath.Int) error {
 	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
@@ -136,12 +265,13 @@ func (k Keeper) getModAccountDebt(ctx sdk.Context, accountName string) sdkmath.I
 	return k.bankKeeper.GetBalance(ctx, macc.GetAddress(), k.GetDebtDenom(ctx)).Amount
 }
 
-func (k Keeper) payoutKeeperLiquidationReward(ctx sdk.Context, keeper sdk.AccAddress, cdp types.CDP) (types.CDP, error) {
+// payoutKeeperLiquidationReward pays the keeper the collateral type's keeper reward percentage of the reward base from the cdp's collateral
+func (k Keeper) payoutKeeperLiquidationReward(ctx sdk.Context, keeper sdk.AccAddress, cdp types.CDP, rewardBase sdk.Coin) (types.CDP, error) {
 	collateralParam, found := k.GetCollateral(ctx, cdp.Type)
 	if !found {
 		return types.CDP{}, errorsmod.Wrapf(types.ErrInvalidCollateral, "%s", cdp.Type)
 	}
-	reward := sdk.NewDecFromInt(cdp.Collateral.Amount).Mul(collateralParam.KeeperRewardPercentage).RoundInt()
+	reward := sdk.NewDecFromInt(rewardBase.Amount).Mul(collateralParam.KeeperRewardPercentage).RoundInt()
 	rewardCoin := sdk.NewCoin(cdp.Collateral.Denom, reward)
 	paidReward := false
 	deposits := k.GetDeposits(ctx, cdp.ID)
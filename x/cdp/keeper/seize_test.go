@@ -211,6 +211,140 @@ func (suite *SeizeTestSuite) TestLiquidateCdps() {
 	suite.Equal(10, xrpLiquidations)
 }
 
+func (suite *SeizeTestSuite) setPartialLiquidationRatio(collateralType string, ratio sdk.Dec) {
+	params := suite.keeper.GetParams(suite.ctx)
+	for i, cp := range params.CollateralParams {
+		if cp.Type == collateralType {
+			params.CollateralParams[i].PartialLiquidationRatio = &ratio
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+func (suite *SeizeTestSuite) TestSeizeCollateralPartial() {
+	ak := suite.app.GetAccountKeeper()
+	bk := suite.app.GetBankKeeper()
+	suite.setPartialLiquidationRatio("btc-a", d("2.0"))
+
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("btc", 100000000), c("usdx", 4000000000), "btc-a")
+	suite.Require().NoError(err)
+	suite.setPrice(d("5800.00"), "btc:usd:30")
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "btc-a", 1)
+	suite.Require().True(found)
+
+	err = suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.Require().NoError(err)
+
+	// (5800 - 2256.410257 * 1.025) / (4000 - 2256.410257) = 2.0
+	cdp, found = suite.keeper.GetCDP(suite.ctx, "btc-a", 1)
+	suite.Require().True(found)
+	suite.Equal(c("btc", 60123785), cdp.Collateral)
+	suite.Equal(c("usdx", 1743589743), cdp.Principal)
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, 1, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Equal(c("btc", 60123785), deposit.Amount)
+	suite.Equal(i(1743589743), suite.keeper.GetTotalPrincipal(suite.ctx, "btc-a", "usdx"))
+
+	ratio := sdk.NewDecFromInt(cdp.Collateral.Amount).Mul(d("5800.00")).QuoInt64(100).Quo(sdk.NewDecFromInt(cdp.Principal.Amount))
+	suite.True(ratio.GTE(d("2.0")))
+	ctd := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+	suite.Equal(types.CDPs{cdp}, suite.keeper.GetAllCdpsByCollateralTypeAndRatio(suite.ctx, "btc-a", ctd.Add(sdk.SmallestDec())))
+
+	auctionMacc := ak.GetModuleAccount(suite.ctx, auctiontypes.ModuleName)
+	suite.Equal(cs(c("btc", 39876215), c("debt", 2256410257)), bk.GetAllBalances(suite.ctx, auctionMacc.GetAddress()))
+	_, found = suite.app.GetAuctionKeeper().GetAuction(suite.ctx, auctiontypes.DefaultNextAuctionID)
+	suite.True(found)
+}
+
+func (suite *SeizeTestSuite) TestSeizeCollateralPartialFallback() {
+	ak := suite.app.GetAccountKeeper()
+	bk := suite.app.GetBankKeeper()
+	suite.setPartialLiquidationRatio("btc-a", d("2.0"))
+
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("btc", 100000000), c("usdx", 4000000000), "btc-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.AddCdp(suite.ctx, suite.addrs[1], c("btc", 100000000), c("usdx", 3000000000), "btc-a")
+	suite.Require().NoError(err)
+	suite.setPrice(d("4000.00"), "btc:usd:30")
+
+	// the first cdp can't be restored to the partial liquidation ratio, so all of its collateral is seized
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "btc-a", 1)
+	suite.Require().True(found)
+	err = suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetCDP(suite.ctx, "btc-a", 1)
+	suite.False(found)
+
+	// the second cdp would be left below the debt floor, so it is also fully liquidated
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DebtParam.DebtFloor = i(1000000000)
+	suite.keeper.SetParams(suite.ctx, params)
+	cdp, found = suite.keeper.GetCDP(suite.ctx, "btc-a", 2)
+	suite.Require().True(found)
+	err = suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetCDP(suite.ctx, "btc-a", 2)
+	suite.False(found)
+
+	auctionMacc := ak.GetModuleAccount(suite.ctx, auctiontypes.ModuleName)
+	suite.Equal(cs(c("btc", 200000000), c("debt", 7000000000)), bk.GetAllBalances(suite.ctx, auctionMacc.GetAddress()))
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(suite.ctx, "btc-a", "usdx"))
+}
+
+func (suite *SeizeTestSuite) TestLiquidateCdpsPartial() {
+	suite.setPartialLiquidationRatio("btc-a", d("2.0"))
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("btc", 100000000), c("usdx", 4000000000), "btc-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.AddCdp(suite.ctx, suite.addrs[1], c("btc", 100000000), c("usdx", 2000000000), "btc-a")
+	suite.Require().NoError(err)
+	suite.setPrice(d("5800.00"), "btc:usd:30")
+
+	p, found := suite.keeper.GetCollateral(suite.ctx, "btc-a")
+	suite.Require().True(found)
+	err = suite.keeper.LiquidateCdps(suite.ctx, "btc:usd:30", "btc-a", p.LiquidationRatio, p.CheckCollateralizationIndexCount)
+	suite.Require().NoError(err)
+
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "btc-a", 1)
+	suite.Require().True(found)
+	suite.Equal(c("btc", 60123785), cdp.Collateral)
+	suite.Equal(c("usdx", 1743589743), cdp.Principal)
+	cdp, found = suite.keeper.GetCDP(suite.ctx, "btc-a", 2)
+	suite.Require().True(found)
+	suite.Equal(c("btc", 100000000), cdp.Collateral)
+
+	// the partially liquidated cdp is above the liquidation ratio, so it isn't liquidated again
+	err = suite.keeper.LiquidateCdps(suite.ctx, "btc:usd:30", "btc-a", p.LiquidationRatio, p.CheckCollateralizationIndexCount)
+	suite.Require().NoError(err)
+	cdp, found = suite.keeper.GetCDP(suite.ctx, "btc-a", 1)
+	suite.Require().True(found)
+	suite.Equal(c("btc", 60123785), cdp.Collateral)
+}
+
+func (suite *SeizeTestSuite) TestKeeperLiquidationPartial() {
+	bk := suite.app.GetBankKeeper()
+	suite.setPartialLiquidationRatio("btc-a", d("2.0"))
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("btc", 100000000), c("usdx", 4000000000), "btc-a")
+	suite.Require().NoError(err)
+	suite.setPrice(d("5800.00"), "btc:usd:30")
+
+	keeperBalance := bk.GetBalance(suite.ctx, suite.addrs[1], "btc")
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], "btc-a")
+	suite.Require().NoError(err)
+
+	// the keeper reward is a percentage of the collateral seized, paid before it is seized
+	// 1% of the 39876215 btc that would be seized from the cdp before the reward is paid
+	suite.Equal(keeperBalance.AddAmount(i(398762)), bk.GetBalance(suite.ctx, suite.addrs[1], "btc"))
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "btc-a", 1)
+	suite.Require().True(found)
+	suite.True(cdp.Collateral.Amount.LT(i(99601238)))
+	suite.True(cdp.Principal.Amount.LT(i(4000000000)))
+	ratio := sdk.NewDecFromInt(cdp.Collateral.Amount).Mul(d("5800.00")).QuoInt64(100).Quo(sdk.NewDecFromInt(cdp.Principal.Amount))
+	suite.True(ratio.GTE(d("2.0")))
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, 1, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Equal(cdp.Collateral, deposit.Amount)
+}
+
 func (suite *SeizeTestSuite) TestApplyLiquidationPenalty() {
 	penalty := suite.keeper.ApplyLiquidationPenalty(suite.ctx, "xrp-a", i(1000))
 	suite.Equal(i(50), penalty)
@@ -0,0 +1,22 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 3
+// V3 adds the collateral_auction_type param to parameters.
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	migrateParamsStore(ctx, paramstore)
+	return nil
+}
+
+// migrateParamsStore ensures the param key table exists and has the collateral_auction_type property
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	paramstore.Set(ctx, types.KeyCollateralAuctionType, types.DefaultCollateralAuctionType)
+}
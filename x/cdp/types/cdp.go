@@ -203,3 +203,27 @@ func NewTotalCollateral(collateralType string, amount sdk.Coin) TotalCollateral
 		Amount:         amount,
 	}
 }
+
+// StabilityFeeResponses a collection of StabilityFeeResponse objects
+type StabilityFeeResponses []StabilityFeeResponse
+
+// NewStabilityFeeResponse returns a new StabilityFeeResponse
+func NewStabilityFeeResponse(collateralType string, stabilityFee, utilization sdk.Dec) StabilityFeeResponse {
+	return StabilityFeeResponse{
+		CollateralType: collateralType,
+		StabilityFee:   stabilityFee,
+		Utilization:    utilization,
+	}
+}
+
+// PegStabilityReserveResponses a collection of PegStabilityReserveResponse objects
+type PegStabilityReserveResponses []PegStabilityReserveResponse
+
+// NewPegStabilityReserveResponse returns a new PegStabilityReserveResponse
+func NewPegStabilityReserveResponse(reserves, debt, debtLimit sdk.Coin) PegStabilityReserveResponse {
+	return PegStabilityReserveResponse{
+		Reserves:  reserves,
+		Debt:      debt,
+		DebtLimit: debtLimit,
+	}
+}
@@ -18,6 +18,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDrawDebt{}, "cdp/MsgDrawDebt", nil)
 	cdc.RegisterConcrete(&MsgRepayDebt{}, "cdp/MsgRepayDebt", nil)
 	cdc.RegisterConcrete(&MsgLiquidate{}, "cdp/MsgLiquidate", nil)
+	cdc.RegisterConcrete(&MsgMigrateCDP{}, "cdp/MsgMigrateCDP", nil)
+	cdc.RegisterConcrete(&MsgPegMint{}, "cdp/MsgPegMint", nil)
+	cdc.RegisterConcrete(&MsgPegRedeem{}, "cdp/MsgPegRedeem", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -28,6 +31,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDrawDebt{},
 		&MsgRepayDebt{},
 		&MsgLiquidate{},
+		&MsgMigrateCDP{},
+		&MsgPegMint{},
+		&MsgPegRedeem{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -49,4 +49,10 @@ var (
 	ErrInsufficientBalance = errorsmod.Register(ModuleName, 22, "insufficient balance")
 	// ErrNotLiquidatable error for when an cdp is not liquidatable
 	ErrNotLiquidatable = errorsmod.Register(ModuleName, 23, "cdp collateral ratio not below liquidation ratio")
+	// ErrPegStabilityDenomNotFound error for when a denom is not supported by the peg stability module
+	ErrPegStabilityDenomNotFound = errorsmod.Register(ModuleName, 24, "peg stability denom not found")
+	// ErrInsufficientPegStabilityReserves error for redeeming more than the debt minted against the reserves of a denom
+	ErrInsufficientPegStabilityReserves = errorsmod.Register(ModuleName, 25, "insufficient peg stability reserves")
+	// ErrInvalidPegAmount error for a peg stability swap amount that is invalid or not positive after fees
+	ErrInvalidPegAmount = errorsmod.Register(ModuleName, 26, "invalid peg stability amount")
 )
@@ -9,10 +9,17 @@ const (
 	EventTypeCdpClose          = "cdp_close"
 	EventTypeCdpWithdrawal     = "cdp_withdrawal"
 	EventTypeCdpLiquidation    = "cdp_liquidation"
+	EventTypeCdpMigration      = "cdp_migration"
+	EventTypePegMint           = "peg_mint"
+	EventTypePegRedeem         = "peg_redeem"
 	EventTypeBeginBlockerFatal = "cdp_begin_block_error"
 
-	AttributeKeyCdpID      = "cdp_id"
-	AttributeKeyDeposit    = "deposit"
-	AttributeValueCategory = "cdp"
-	AttributeKeyError      = "error_message"
+	AttributeKeyCdpID       = "cdp_id"
+	AttributeKeyTargetCdpID = "target_cdp_id"
+	AttributeKeyDeposit     = "deposit"
+	AttributeKeyFee         = "fee"
+	AttributeKeyMinted      = "minted"
+	AttributeKeyRedeemed    = "redeemed"
+	AttributeValueCategory  = "cdp"
+	AttributeKeyError       = "error_message"
 )
@@ -12,7 +12,7 @@ import (
 // NewGenesisState returns a new genesis state
 func NewGenesisState(params Params, cdps CDPs, deposits Deposits, startingCdpID uint64,
 	debtDenom, govDenom string, prevAccumTimes GenesisAccumulationTimes,
-	totalPrincipals GenesisTotalPrincipals,
+	totalPrincipals GenesisTotalPrincipals, pegStabilityReserves PegStabilityReserves,
 ) GenesisState {
 	return GenesisState{
 		Params:                    params,
@@ -23,6 +23,7 @@ func NewGenesisState(params Params, cdps CDPs, deposits Deposits, startingCdpID
 		GovDenom:                  govDenom,
 		PreviousAccumulationTimes: prevAccumTimes,
 		TotalPrincipals:           totalPrincipals,
+		PegStabilityReserves:      pegStabilityReserves,
 	}
 }
 
@@ -37,6 +38,7 @@ func DefaultGenesisState() GenesisState {
 		DefaultGovDenom,
 		GenesisAccumulationTimes{},
 		GenesisTotalPrincipals{},
+		PegStabilityReserves{},
 	)
 }
 
@@ -63,6 +65,10 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.PegStabilityReserves.Validate(); err != nil {
+		return err
+	}
+
 	if err := sdk.ValidateDenom(gs.DebtDenom); err != nil {
 		return fmt.Errorf(fmt.Sprintf("debt denom invalid: %v", err))
 	}
@@ -107,6 +113,44 @@ func (gtps GenesisTotalPrincipals) Validate() error {
 	return nil
 }
 
+// NewPegStabilityReserve returns a new PegStabilityReserve
+func NewPegStabilityReserve(denom string, debt sdkmath.Int) PegStabilityReserve {
+	return PegStabilityReserve{
+		Denom: denom,
+		Debt:  debt,
+	}
+}
+
+// Validate performs validation of PegStabilityReserve
+func (psr PegStabilityReserve) Validate() error {
+	if err := sdk.ValidateDenom(psr.Denom); err != nil {
+		return fmt.Errorf("peg stability reserve denom invalid %s", psr.Denom)
+	}
+
+	if psr.Debt.IsNil() || psr.Debt.IsNegative() {
+		return fmt.Errorf("peg stability debt should not be negative, is %s for %s", psr.Debt, psr.Denom)
+	}
+	return nil
+}
+
+// PegStabilityReserves slice of PegStabilityReserve
+type PegStabilityReserves []PegStabilityReserve
+
+// Validate performs validation of PegStabilityReserves
+func (psrs PegStabilityReserves) Validate() error {
+	denomDupMap := make(map[string]bool)
+	for _, psr := range psrs {
+		if err := psr.Validate(); err != nil {
+			return err
+		}
+		if denomDupMap[psr.Denom] {
+			return fmt.Errorf("duplicate peg stability reserve denom %s", psr.Denom)
+		}
+		denomDupMap[psr.Denom] = true
+	}
+	return nil
+}
+
 // NewGenesisAccumulationTime returns a new GenesisAccumulationTime
 func NewGenesisAccumulationTime(ctype string, prevTime time.Time, factor sdk.Dec) GenesisAccumulationTime {
 	return GenesisAccumulationTime{
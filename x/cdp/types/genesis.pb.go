@@ -41,6 +41,7 @@ type GenesisState struct {
 	GovDenom                  string                   `protobuf:"bytes,6,opt,name=gov_denom,json=govDenom,proto3" json:"gov_denom,omitempty"`
 	PreviousAccumulationTimes GenesisAccumulationTimes `protobuf:"bytes,7,rep,name=previous_accumulation_times,json=previousAccumulationTimes,proto3,castrepeated=GenesisAccumulationTimes" json:"previous_accumulation_times"`
 	TotalPrincipals           GenesisTotalPrincipals   `protobuf:"bytes,8,rep,name=total_principals,json=totalPrincipals,proto3,castrepeated=GenesisTotalPrincipals" json:"total_principals"`
+	PegStabilityReserves      PegStabilityReserves     `protobuf:"bytes,9,rep,name=peg_stability_reserves,json=pegStabilityReserves,proto3,castrepeated=PegStabilityReserves" json:"peg_stability_reserves"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -132,6 +133,13 @@ func (m *GenesisState) GetTotalPrincipals() GenesisTotalPrincipals {
 	return nil
 }
 
+func (m *GenesisState) GetPegStabilityReserves() PegStabilityReserves {
+	if m != nil {
+		return m.PegStabilityReserves
+	}
+	return nil
+}
+
 // Params defines the parameters for the cdp module.
 type Params struct {
 	CollateralParams         CollateralParams                       `protobuf:"bytes,1,rep,name=collateral_params,json=collateralParams,proto3,castrepeated=CollateralParams" json:"collateral_params"`
@@ -143,6 +151,12 @@ type Params struct {
 	DebtAuctionLot           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=debt_auction_lot,json=debtAuctionLot,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"debt_auction_lot"`
 	CircuitBreaker           bool                                   `protobuf:"varint,8,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
 	LiquidationBlockInterval int64                                  `protobuf:"varint,9,opt,name=liquidation_block_interval,json=liquidationBlockInterval,proto3" json:"liquidation_block_interval,omitempty"`
+	// collateral_auction_type is the type of auction used to sell seized collateral, either collateral or
+	// dutch_collateral
+	CollateralAuctionType string `protobuf:"bytes,10,opt,name=collateral_auction_type,json=collateralAuctionType,proto3" json:"collateral_auction_type,omitempty"`
+	// peg_stability_params are the stablecoins that can be swapped one to one for the debt asset by the peg
+	// stability module
+	PegStabilityParams PegStabilityParams `protobuf:"bytes,11,rep,name=peg_stability_params,json=pegStabilityParams,proto3,castrepeated=PegStabilityParams" json:"peg_stability_params"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -213,6 +227,20 @@ func (m *Params) GetLiquidationBlockInterval() int64 {
 	return 0
 }
 
+func (m *Params) GetCollateralAuctionType() string {
+	if m != nil {
+		return m.CollateralAuctionType
+	}
+	return ""
+}
+
+func (m *Params) GetPegStabilityParams() PegStabilityParams {
+	if m != nil {
+		return m.PegStabilityParams
+	}
+	return nil
+}
+
 // DebtParam defines governance params for debt assets
 type DebtParam struct {
 	Denom            string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -282,6 +310,12 @@ type CollateralParam struct {
 	KeeperRewardPercentage           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=keeper_reward_percentage,json=keeperRewardPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"keeper_reward_percentage"`
 	CheckCollateralizationIndexCount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=check_collateralization_index_count,json=checkCollateralizationIndexCount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"check_collateralization_index_count"`
 	ConversionFactor                 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=conversion_factor,json=conversionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"conversion_factor"`
+	// partial_liquidation_ratio is the collateralization ratio liquidated cdps are restored to by seizing only part of
+	// their collateral, unset or zero seizes all of the collateral
+	PartialLiquidationRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=partial_liquidation_ratio,json=partialLiquidationRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"partial_liquidation_ratio,omitempty"`
+	// stability_fee_model calculates the stability fee from the utilization of the debt limit, replacing stability_fee
+	// when set
+	StabilityFeeModel *StabilityFeeModel `protobuf:"bytes,14,opt,name=stability_fee_model,json=stabilityFeeModel,proto3" json:"stability_fee_model,omitempty"`
 }
 
 func (m *CollateralParam) Reset()         { *m = CollateralParam{} }
@@ -352,6 +386,163 @@ func (m *CollateralParam) GetLiquidationMarketID() string {
 	return ""
 }
 
+func (m *CollateralParam) GetStabilityFeeModel() *StabilityFeeModel {
+	if m != nil {
+		return m.StabilityFeeModel
+	}
+	return nil
+}
+
+// StabilityFeeModel defines a stability fee that rises with the ratio of a collateral type's total principal to its
+// debt limit, as an annual rate of base_rate_apy + utilization * base_multiplier up to the kink, increasing by
+// jump_multiplier per unit of utilization above it
+type StabilityFeeModel struct {
+	BaseRateAPY    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_rate_apy,json=baseRateApy,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_rate_apy"`
+	BaseMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_multiplier,json=baseMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_multiplier"`
+	Kink           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=kink,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"kink"`
+	JumpMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=jump_multiplier,json=jumpMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"jump_multiplier"`
+}
+
+func (m *StabilityFeeModel) Reset()         { *m = StabilityFeeModel{} }
+func (m *StabilityFeeModel) String() string { return proto.CompactTextString(m) }
+func (*StabilityFeeModel) ProtoMessage()    {}
+func (*StabilityFeeModel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4494a90aaab0034, []int{4}
+}
+func (m *StabilityFeeModel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StabilityFeeModel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StabilityFeeModel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StabilityFeeModel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StabilityFeeModel.Merge(m, src)
+}
+func (m *StabilityFeeModel) XXX_Size() int {
+	return m.Size()
+}
+func (m *StabilityFeeModel) XXX_DiscardUnknown() {
+	xxx_messageInfo_StabilityFeeModel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StabilityFeeModel proto.InternalMessageInfo
+
+// PegStabilityParam defines governance parameters for a stablecoin held in reserve by the peg stability module
+type PegStabilityParam struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// debt_limit is the maximum amount of the debt asset that can be minted against reserves of the denom
+	DebtLimit types.Coin `protobuf:"bytes,2,opt,name=debt_limit,json=debtLimit,proto3" json:"debt_limit"`
+	// mint_fee is the fraction of the deposited stablecoin kept as a fee when minting the debt asset
+	MintFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=mint_fee,json=mintFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mint_fee"`
+	// redeem_fee is the fraction of the repaid debt asset kept as a fee when redeeming the stablecoin
+	RedeemFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=redeem_fee,json=redeemFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redeem_fee"`
+	// conversion_factor is the number of decimals of the stablecoin, its amounts are converted to the debt asset
+	// using the conversion factor of the debt param
+	ConversionFactor github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=conversion_factor,json=conversionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"conversion_factor"`
+}
+
+func (m *PegStabilityParam) Reset()         { *m = PegStabilityParam{} }
+func (m *PegStabilityParam) String() string { return proto.CompactTextString(m) }
+func (*PegStabilityParam) ProtoMessage()    {}
+func (*PegStabilityParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4494a90aaab0034, []int{5}
+}
+func (m *PegStabilityParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PegStabilityParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PegStabilityParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PegStabilityParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PegStabilityParam.Merge(m, src)
+}
+func (m *PegStabilityParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *PegStabilityParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_PegStabilityParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PegStabilityParam proto.InternalMessageInfo
+
+func (m *PegStabilityParam) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PegStabilityParam) GetDebtLimit() types.Coin {
+	if m != nil {
+		return m.DebtLimit
+	}
+	return types.Coin{}
+}
+
+// PegStabilityReserve defines the amount of the debt asset minted against the reserves of a stablecoin
+type PegStabilityReserve struct {
+	Denom string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Debt  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=debt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"debt"`
+}
+
+func (m *PegStabilityReserve) Reset()         { *m = PegStabilityReserve{} }
+func (m *PegStabilityReserve) String() string { return proto.CompactTextString(m) }
+func (*PegStabilityReserve) ProtoMessage()    {}
+func (*PegStabilityReserve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4494a90aaab0034, []int{6}
+}
+func (m *PegStabilityReserve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PegStabilityReserve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PegStabilityReserve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PegStabilityReserve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PegStabilityReserve.Merge(m, src)
+}
+func (m *PegStabilityReserve) XXX_Size() int {
+	return m.Size()
+}
+func (m *PegStabilityReserve) XXX_DiscardUnknown() {
+	xxx_messageInfo_PegStabilityReserve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PegStabilityReserve proto.InternalMessageInfo
+
+func (m *PegStabilityReserve) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // GenesisAccumulationTime defines the previous distribution time and its corresponding denom
 type GenesisAccumulationTime struct {
 	CollateralType           string                                 `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
@@ -363,7 +554,7 @@ func (m *GenesisAccumulationTime) Reset()         { *m = GenesisAccumulationTime
 func (m *GenesisAccumulationTime) String() string { return proto.CompactTextString(m) }
 func (*GenesisAccumulationTime) ProtoMessage()    {}
 func (*GenesisAccumulationTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{4}
+	return fileDescriptor_e4494a90aaab0034, []int{7}
 }
 func (m *GenesisAccumulationTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -416,7 +607,7 @@ func (m *GenesisTotalPrincipal) Reset()         { *m = GenesisTotalPrincipal{} }
 func (m *GenesisTotalPrincipal) String() string { return proto.CompactTextString(m) }
 func (*GenesisTotalPrincipal) ProtoMessage()    {}
 func (*GenesisTotalPrincipal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{5}
+	return fileDescriptor_e4494a90aaab0034, []int{8}
 }
 func (m *GenesisTotalPrincipal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -457,6 +648,9 @@ func init() {
 	proto.RegisterType((*Params)(nil), "kava.cdp.v1beta1.Params")
 	proto.RegisterType((*DebtParam)(nil), "kava.cdp.v1beta1.DebtParam")
 	proto.RegisterType((*CollateralParam)(nil), "kava.cdp.v1beta1.CollateralParam")
+	proto.RegisterType((*StabilityFeeModel)(nil), "kava.cdp.v1beta1.StabilityFeeModel")
+	proto.RegisterType((*PegStabilityParam)(nil), "kava.cdp.v1beta1.PegStabilityParam")
+	proto.RegisterType((*PegStabilityReserve)(nil), "kava.cdp.v1beta1.PegStabilityReserve")
 	proto.RegisterType((*GenesisAccumulationTime)(nil), "kava.cdp.v1beta1.GenesisAccumulationTime")
 	proto.RegisterType((*GenesisTotalPrincipal)(nil), "kava.cdp.v1beta1.GenesisTotalPrincipal")
 }
@@ -464,83 +658,102 @@ func init() {
 func init() { proto.RegisterFile("kava/cdp/v1beta1/genesis.proto", fileDescriptor_e4494a90aaab0034) }
 
 var fileDescriptor_e4494a90aaab0034 = []byte{
-	// 1208 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0x1a, 0xc7,
-	0x17, 0xf7, 0xda, 0xc4, 0x81, 0xb1, 0x63, 0xf0, 0xc4, 0x49, 0xd6, 0x8e, 0xfe, 0xc0, 0xdf, 0x55,
-	0x1b, 0x7a, 0x11, 0x50, 0x52, 0x29, 0x52, 0xa5, 0xa8, 0x69, 0xd6, 0x28, 0x11, 0x4a, 0x2a, 0xa1,
-	0xb5, 0xaf, 0xda, 0x8b, 0xd5, 0xec, 0xec, 0x80, 0x47, 0x2c, 0x3b, 0xdb, 0x99, 0x81, 0x26, 0x79,
-	0x85, 0xaa, 0x6a, 0xd4, 0x97, 0xa8, 0x14, 0xf5, 0xb2, 0x0f, 0x91, 0xde, 0x45, 0xbd, 0xaa, 0x7a,
-	0x41, 0x2a, 0xf2, 0x22, 0xd5, 0x7c, 0x00, 0x6b, 0x30, 0x52, 0x64, 0xd3, 0x1b, 0x76, 0xe7, 0x7c,
-	0xfc, 0xce, 0xc7, 0x9c, 0x73, 0xf6, 0x00, 0xca, 0x3d, 0x34, 0x44, 0x0d, 0x1c, 0xa5, 0x8d, 0xe1,
-	0xbd, 0x90, 0x48, 0x74, 0xaf, 0xd1, 0x25, 0x09, 0x11, 0x54, 0xd4, 0x53, 0xce, 0x24, 0x83, 0x25,
-	0xc5, 0xaf, 0xe3, 0x28, 0xad, 0x5b, 0xfe, 0x41, 0x19, 0x33, 0xd1, 0x67, 0xa2, 0x11, 0x22, 0x41,
-	0xa6, 0x4a, 0x98, 0xd1, 0xc4, 0x68, 0x1c, 0xec, 0x1b, 0x7e, 0xa0, 0x4f, 0x0d, 0x73, 0xb0, 0xac,
-	0xbd, 0x2e, 0xeb, 0x32, 0x43, 0x57, 0x6f, 0x96, 0x5a, 0xe9, 0x32, 0xd6, 0x8d, 0x49, 0x43, 0x9f,
-	0xc2, 0x41, 0xa7, 0x21, 0x69, 0x9f, 0x08, 0x89, 0xfa, 0xa9, 0x15, 0x38, 0x58, 0xf0, 0x51, 0xf9,
-	0xa3, 0x79, 0x87, 0x7f, 0xe4, 0xc0, 0xf6, 0x53, 0xe3, 0xf1, 0xb1, 0x44, 0x92, 0xc0, 0x07, 0x60,
-	0x33, 0x45, 0x1c, 0xf5, 0x85, 0xeb, 0x54, 0x9d, 0xda, 0xd6, 0x7d, 0xb7, 0x3e, 0x1f, 0x41, 0xbd,
-	0xad, 0xf9, 0x5e, 0xee, 0xed, 0xa8, 0xb2, 0xe6, 0x5b, 0x69, 0xf8, 0x08, 0xe4, 0x70, 0x94, 0x0a,
-	0x77, 0xbd, 0xba, 0x51, 0xdb, 0xba, 0x7f, 0x63, 0x51, 0xeb, 0xa8, 0xd9, 0xf6, 0xf6, 0x94, 0xca,
-	0x78, 0x54, 0xc9, 0x1d, 0x35, 0xdb, 0xe2, 0xcd, 0x7b, 0xf3, 0xf4, 0xb5, 0x22, 0x7c, 0x0a, 0xf2,
-	0x11, 0x49, 0x99, 0xa0, 0x52, 0xb8, 0x1b, 0x1a, 0x64, 0x7f, 0x11, 0xa4, 0x69, 0x24, 0xbc, 0x92,
-	0x02, 0x7a, 0xf3, 0xbe, 0x92, 0xb7, 0x04, 0xe1, 0x4f, 0x95, 0xe1, 0x97, 0xa0, 0x28, 0x24, 0xe2,
-	0x92, 0x26, 0xdd, 0x00, 0x47, 0x69, 0x40, 0x23, 0x37, 0x57, 0x75, 0x6a, 0x39, 0x6f, 0x77, 0x3c,
-	0xaa, 0x5c, 0x3b, 0xb6, 0xac, 0xa3, 0x28, 0x6d, 0x35, 0xfd, 0x6b, 0x22, 0x73, 0x8c, 0xe0, 0xff,
-	0x00, 0x88, 0x48, 0x28, 0x83, 0x88, 0x24, 0xac, 0xef, 0x5e, 0xa9, 0x3a, 0xb5, 0x82, 0x5f, 0x50,
-	0x94, 0xa6, 0x22, 0xc0, 0xdb, 0xa0, 0xd0, 0x65, 0x43, 0xcb, 0xdd, 0xd4, 0xdc, 0x7c, 0x97, 0x0d,
-	0x0d, 0xf3, 0x47, 0x07, 0xdc, 0x4e, 0x39, 0x19, 0x52, 0x36, 0x10, 0x01, 0xc2, 0x78, 0xd0, 0x1f,
-	0xc4, 0x48, 0x52, 0x96, 0x04, 0xfa, 0x3e, 0xdc, 0xab, 0x3a, 0xa6, 0xcf, 0x17, 0x63, 0xb2, 0xe9,
-	0x7f, 0x9c, 0x51, 0x39, 0xa1, 0x7d, 0xe2, 0x55, 0x6d, 0x8c, 0xee, 0x12, 0x01, 0xe1, 0xef, 0x4f,
-	0xec, 0x2d, 0xb0, 0x20, 0x07, 0x25, 0xc9, 0x24, 0x8a, 0x83, 0x94, 0xd3, 0x04, 0xd3, 0x14, 0xc5,
-	0xc2, 0xcd, 0x6b, 0x0f, 0xee, 0x2c, 0xf5, 0xe0, 0x44, 0x29, 0xb4, 0x27, 0xf2, 0x5e, 0xd9, 0xda,
-	0xbf, 0x79, 0x2e, 0x5b, 0xf8, 0x45, 0x79, 0x96, 0x70, 0xf8, 0xdb, 0x26, 0xd8, 0x34, 0xb5, 0x01,
-	0x4f, 0xc1, 0x2e, 0x66, 0x71, 0x8c, 0x24, 0xe1, 0xca, 0x87, 0x49, 0x41, 0x29, 0xfb, 0xff, 0x3f,
-	0xa7, 0x34, 0xa6, 0xa2, 0x5a, 0xdd, 0x73, 0xad, 0xe5, 0xd2, 0x1c, 0x43, 0xf8, 0x25, 0x3c, 0x47,
-	0x81, 0x5f, 0xdb, 0x2b, 0xd3, 0x36, 0xdc, 0x75, 0x5d, 0xb3, 0xb7, 0xcf, 0x2b, 0x9c, 0x50, 0x1a,
-	0x70, 0x53, 0xb6, 0xfa, 0x56, 0x35, 0x01, 0x3e, 0x03, 0xbb, 0xdd, 0x98, 0x85, 0x28, 0x0e, 0x34,
-	0x50, 0x4c, 0xfb, 0x54, 0xba, 0x1b, 0x1a, 0x68, 0xbf, 0x6e, 0xfb, 0x4f, 0x35, 0x6b, 0xc6, 0x5d,
-	0x9a, 0x58, 0x98, 0xa2, 0xd1, 0x54, 0xe8, 0xcf, 0x95, 0x1e, 0x7c, 0x01, 0xf6, 0xc5, 0x80, 0xa7,
-	0xb1, 0xaa, 0x81, 0x01, 0x36, 0xd7, 0x7f, 0xca, 0x89, 0x38, 0x65, 0xb1, 0x29, 0xc3, 0x82, 0xf7,
-	0x50, 0x69, 0xfe, 0x3d, 0xaa, 0x7c, 0xd6, 0xa5, 0xf2, 0x74, 0x10, 0xd6, 0x31, 0xeb, 0xdb, 0x36,
-	0xb7, 0x8f, 0xbb, 0x22, 0xea, 0x35, 0xe4, 0xcb, 0x94, 0x88, 0x7a, 0x2b, 0x91, 0x7f, 0xfe, 0x7e,
-	0x17, 0x58, 0x2f, 0x5a, 0x89, 0xf4, 0x6f, 0x59, 0xf8, 0xc7, 0x06, 0xfd, 0x64, 0x02, 0x0e, 0x63,
-	0x70, 0x7d, 0xde, 0x72, 0xcc, 0xa4, 0x29, 0xe2, 0x4b, 0xda, 0xdc, 0x3d, 0x6b, 0xf3, 0x39, 0x93,
-	0x90, 0x83, 0x9b, 0x3a, 0x5b, 0x8b, 0x41, 0x6e, 0xae, 0xc0, 0xe0, 0x9e, 0xc2, 0x5e, 0x88, 0xb0,
-	0x03, 0x4a, 0x67, 0x6c, 0xaa, 0xf0, 0xae, 0xae, 0xc0, 0xda, 0x4e, 0xc6, 0x9a, 0x8a, 0xed, 0x0e,
-	0x28, 0x62, 0xca, 0xf1, 0x80, 0xca, 0x20, 0xe4, 0x04, 0xf5, 0x08, 0x77, 0xf3, 0x55, 0xa7, 0x96,
-	0xf7, 0x77, 0x2c, 0xd9, 0x33, 0x54, 0xf8, 0x10, 0x1c, 0xc4, 0xf4, 0xfb, 0x01, 0x8d, 0x4c, 0x9f,
-	0x87, 0x31, 0xc3, 0xbd, 0x80, 0x26, 0x92, 0xf0, 0x21, 0x8a, 0xdd, 0x42, 0xd5, 0xa9, 0x6d, 0xf8,
-	0x6e, 0x46, 0xc2, 0x53, 0x02, 0x2d, 0xcb, 0x3f, 0xfc, 0x65, 0x1d, 0x14, 0xa6, 0x65, 0x09, 0xf7,
-	0xc0, 0x15, 0x33, 0x57, 0x1c, 0x3d, 0x57, 0xcc, 0x41, 0xb9, 0xc2, 0x49, 0x87, 0x70, 0x92, 0x60,
-	0x12, 0x20, 0x21, 0x88, 0xd4, 0x25, 0x5e, 0xf0, 0x77, 0xa6, 0xe4, 0xc7, 0x8a, 0x0a, 0xa9, 0x6a,
-	0xb8, 0x64, 0x48, 0xb8, 0x50, 0x9e, 0x74, 0x10, 0x96, 0x8c, 0xeb, 0x22, 0xbe, 0x6c, 0x72, 0x4a,
-	0x33, 0xd8, 0x27, 0x1a, 0x15, 0x7e, 0x67, 0x3b, 0xae, 0x13, 0x33, 0xc6, 0x57, 0x52, 0xd3, 0xba,
-	0x19, 0x9f, 0x28, 0xb8, 0xc3, 0x9f, 0xf3, 0xa0, 0x38, 0xd7, 0xf5, 0x4b, 0x52, 0x03, 0x41, 0x4e,
-	0xe1, 0xd9, 0x7c, 0xe8, 0x77, 0x95, 0x85, 0xec, 0x85, 0x70, 0xf5, 0xb8, 0x40, 0x16, 0x9a, 0x04,
-	0x67, 0x3c, 0x6c, 0x12, 0xec, 0x97, 0x32, 0xb0, 0xbe, 0xfa, 0x85, 0x5f, 0xd9, 0x2c, 0x98, 0x71,
-	0x91, 0xfb, 0xb8, 0x71, 0xa1, 0x03, 0x35, 0x83, 0x02, 0x01, 0xf5, 0xed, 0x09, 0x69, 0x4c, 0xe5,
-	0xcb, 0xa0, 0x43, 0xc8, 0x05, 0x1a, 0x75, 0xd1, 0xcd, 0xed, 0x29, 0xe4, 0x13, 0x42, 0x60, 0x00,
-	0xb6, 0x27, 0xad, 0x22, 0xe8, 0x2b, 0xb2, 0x92, 0xce, 0xdc, 0xb2, 0x88, 0xc7, 0xf4, 0x15, 0x81,
-	0x7d, 0x70, 0x3d, 0x9b, 0xee, 0x94, 0x24, 0x28, 0x96, 0x2f, 0x2f, 0xd0, 0x93, 0x8b, 0x91, 0xc0,
-	0x0c, 0x70, 0xdb, 0xe0, 0xc2, 0x07, 0x60, 0x47, 0xa4, 0x4c, 0x06, 0x7d, 0xc4, 0x7b, 0x44, 0xaa,
-	0xef, 0x7a, 0x5e, 0x5b, 0x2a, 0x8d, 0x47, 0x95, 0xed, 0xe3, 0x94, 0xc9, 0x6f, 0x34, 0xa3, 0xd5,
-	0xf4, 0xb7, 0xc5, 0xec, 0x14, 0xc1, 0x67, 0xe0, 0x46, 0xd6, 0xcd, 0x99, 0x7a, 0x41, 0xab, 0xdf,
-	0x1a, 0x8f, 0x2a, 0xd7, 0x9f, 0xcf, 0x04, 0xa6, 0x28, 0xd9, 0xe0, 0xa6, 0x60, 0x43, 0xe0, 0xf6,
-	0x08, 0x49, 0x09, 0x0f, 0x38, 0xf9, 0x01, 0xf1, 0x28, 0x48, 0x09, 0xc7, 0x24, 0x91, 0xa8, 0x4b,
-	0x5c, 0xb0, 0x82, 0xc0, 0x6f, 0x1a, 0x74, 0x5f, 0x83, 0xb7, 0xa7, 0xd8, 0x6a, 0xbd, 0xf8, 0x04,
-	0x9f, 0x12, 0xdc, 0x0b, 0x66, 0x9f, 0x40, 0xfa, 0xca, 0x44, 0x44, 0x93, 0x88, 0xbc, 0x08, 0x30,
-	0x1b, 0x24, 0xd2, 0xdd, 0x5a, 0xc1, 0x25, 0x57, 0xb5, 0xa1, 0xa3, 0x79, 0x3b, 0x2d, 0x65, 0xe6,
-	0x48, 0x59, 0x39, 0x7f, 0xdc, 0x6c, 0xff, 0x17, 0xe3, 0xe6, 0xf0, 0xa7, 0x75, 0x70, 0x6b, 0xc9,
-	0x06, 0xa4, 0x27, 0xf5, 0x6c, 0xcd, 0xd0, 0xe3, 0xc0, 0xcc, 0x88, 0x9d, 0x19, 0xf9, 0x44, 0x0d,
-	0x86, 0x10, 0x1c, 0x2c, 0xdf, 0xcd, 0xec, 0xd6, 0x70, 0x50, 0x37, 0x8b, 0x74, 0x7d, 0xb2, 0x48,
-	0xd7, 0x4f, 0x26, 0x8b, 0xb4, 0x97, 0x57, 0x41, 0xbd, 0x7e, 0x5f, 0x71, 0x7c, 0x77, 0xd9, 0xce,
-	0x05, 0x09, 0x28, 0xea, 0xd9, 0x4f, 0x84, 0xbc, 0xf8, 0x00, 0x5e, 0x2c, 0x88, 0x9d, 0x09, 0xa8,
-	0xcd, 0xc7, 0xaf, 0x0e, 0xb8, 0x71, 0xee, 0x46, 0xf6, 0xf1, 0xd9, 0x20, 0xa0, 0x38, 0xb7, 0x1c,
-	0x9a, 0x29, 0x7a, 0xd9, 0xef, 0xe8, 0xd9, 0x85, 0xd0, 0x7b, 0xf4, 0x76, 0x5c, 0x76, 0xde, 0x8d,
-	0xcb, 0xce, 0x3f, 0xe3, 0xb2, 0xf3, 0xfa, 0x43, 0x79, 0xed, 0xdd, 0x87, 0xf2, 0xda, 0x5f, 0x1f,
-	0xca, 0x6b, 0xdf, 0x7e, 0x9a, 0xc1, 0x57, 0xab, 0xda, 0xdd, 0x18, 0x85, 0x42, 0xbf, 0x35, 0x5e,
-	0xe8, 0x3f, 0x2a, 0xda, 0x44, 0xb8, 0xa9, 0x6f, 0xe2, 0x8b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x5c, 0xdf, 0x27, 0x18, 0x65, 0x0d, 0x00, 0x00,
+	// 1519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5f, 0x6b, 0x1b, 0xc7,
+	0x16, 0xb7, 0x6c, 0xd9, 0x96, 0xc6, 0xb6, 0x2c, 0x8f, 0xff, 0xad, 0x9d, 0x7b, 0x25, 0x5d, 0x85,
+	0xdc, 0xf8, 0x3e, 0x44, 0x22, 0xb9, 0x10, 0x28, 0x84, 0xa6, 0x96, 0x45, 0x82, 0x89, 0x03, 0x62,
+	0x6d, 0x28, 0x6d, 0x1e, 0x96, 0xd1, 0xee, 0xb1, 0x3c, 0xd5, 0xee, 0xce, 0x66, 0x67, 0xa4, 0x5a,
+	0x81, 0x7e, 0x81, 0x96, 0x42, 0xe8, 0x67, 0x28, 0x14, 0xf2, 0xdc, 0x8f, 0xd0, 0x87, 0x3c, 0x86,
+	0xbe, 0xb4, 0xf4, 0x41, 0x29, 0xca, 0x17, 0x29, 0x33, 0x3b, 0x92, 0xd6, 0xfa, 0x53, 0x12, 0x57,
+	0x79, 0x91, 0x76, 0xcf, 0x9f, 0xdf, 0xf9, 0x33, 0xe7, 0x9c, 0x99, 0x59, 0x94, 0x6b, 0x92, 0x36,
+	0x29, 0xdb, 0x4e, 0x50, 0x6e, 0xdf, 0xad, 0x83, 0x20, 0x77, 0xcb, 0x0d, 0xf0, 0x81, 0x53, 0x5e,
+	0x0a, 0x42, 0x26, 0x18, 0xce, 0x4a, 0x7e, 0xc9, 0x76, 0x82, 0x92, 0xe6, 0xef, 0xe7, 0x6c, 0xc6,
+	0x3d, 0xc6, 0xcb, 0x75, 0xc2, 0x61, 0xa0, 0x64, 0x33, 0xea, 0x47, 0x1a, 0xfb, 0x7b, 0x11, 0xdf,
+	0x52, 0x6f, 0xe5, 0xe8, 0x45, 0xb3, 0xb6, 0x1a, 0xac, 0xc1, 0x22, 0xba, 0x7c, 0xd2, 0xd4, 0x7c,
+	0x83, 0xb1, 0x86, 0x0b, 0x65, 0xf5, 0x56, 0x6f, 0x9d, 0x97, 0x05, 0xf5, 0x80, 0x0b, 0xe2, 0x05,
+	0x5a, 0x60, 0x7f, 0xcc, 0x47, 0xdb, 0xd1, 0xbc, 0xe2, 0x2f, 0x8b, 0x68, 0xf5, 0x71, 0xe4, 0xf1,
+	0xa9, 0x20, 0x02, 0xf0, 0x7d, 0xb4, 0x14, 0x90, 0x90, 0x78, 0xdc, 0x48, 0x14, 0x12, 0x07, 0x2b,
+	0xf7, 0x8c, 0xd2, 0x68, 0x04, 0xa5, 0x9a, 0xe2, 0x57, 0x92, 0xaf, 0xbb, 0xf9, 0x39, 0x53, 0x4b,
+	0xe3, 0x87, 0x28, 0x69, 0x3b, 0x01, 0x37, 0xe6, 0x0b, 0x0b, 0x07, 0x2b, 0xf7, 0xb6, 0xc7, 0xb5,
+	0x8e, 0xaa, 0xb5, 0xca, 0x96, 0x54, 0xe9, 0x75, 0xf3, 0xc9, 0xa3, 0x6a, 0x8d, 0xbf, 0x7a, 0x1b,
+	0xfd, 0x9b, 0x4a, 0x11, 0x3f, 0x46, 0x29, 0x07, 0x02, 0xc6, 0xa9, 0xe0, 0xc6, 0x82, 0x02, 0xd9,
+	0x1b, 0x07, 0xa9, 0x46, 0x12, 0x95, 0xac, 0x04, 0x7a, 0xf5, 0x36, 0x9f, 0xd2, 0x04, 0x6e, 0x0e,
+	0x94, 0xf1, 0x27, 0x68, 0x9d, 0x0b, 0x12, 0x0a, 0xea, 0x37, 0x2c, 0xdb, 0x09, 0x2c, 0xea, 0x18,
+	0xc9, 0x42, 0xe2, 0x20, 0x59, 0xd9, 0xe8, 0x75, 0xf3, 0x6b, 0xa7, 0x9a, 0x75, 0xe4, 0x04, 0xc7,
+	0x55, 0x73, 0x8d, 0xc7, 0x5e, 0x1d, 0xfc, 0x6f, 0x84, 0x1c, 0xa8, 0x0b, 0xcb, 0x01, 0x9f, 0x79,
+	0xc6, 0x62, 0x21, 0x71, 0x90, 0x36, 0xd3, 0x92, 0x52, 0x95, 0x04, 0x7c, 0x03, 0xa5, 0x1b, 0xac,
+	0xad, 0xb9, 0x4b, 0x8a, 0x9b, 0x6a, 0xb0, 0x76, 0xc4, 0xfc, 0x2e, 0x81, 0x6e, 0x04, 0x21, 0xb4,
+	0x29, 0x6b, 0x71, 0x8b, 0xd8, 0x76, 0xcb, 0x6b, 0xb9, 0x44, 0x50, 0xe6, 0x5b, 0x6a, 0x3d, 0x8c,
+	0x65, 0x15, 0xd3, 0xff, 0xc6, 0x63, 0xd2, 0xe9, 0x3f, 0x8c, 0xa9, 0x9c, 0x51, 0x0f, 0x2a, 0x05,
+	0x1d, 0xa3, 0x31, 0x45, 0x80, 0x9b, 0x7b, 0x7d, 0x7b, 0x63, 0x2c, 0x1c, 0xa2, 0xac, 0x60, 0x82,
+	0xb8, 0x56, 0x10, 0x52, 0xdf, 0xa6, 0x01, 0x71, 0xb9, 0x91, 0x52, 0x1e, 0xdc, 0x9e, 0xea, 0xc1,
+	0x99, 0x54, 0xa8, 0xf5, 0xe5, 0x2b, 0x39, 0x6d, 0x7f, 0x67, 0x22, 0x9b, 0x9b, 0xeb, 0xe2, 0x2a,
+	0x01, 0x77, 0xd0, 0x4e, 0x00, 0x0d, 0x8b, 0x0b, 0x52, 0xa7, 0x2e, 0x15, 0x1d, 0x2b, 0x04, 0x0e,
+	0x61, 0x1b, 0xb8, 0x91, 0x56, 0x96, 0x6f, 0x4d, 0x28, 0x25, 0x68, 0x9c, 0xf6, 0xc5, 0xcd, 0x48,
+	0xba, 0xf2, 0x2f, 0x6d, 0x77, 0x6b, 0x02, 0x93, 0x9b, 0x5b, 0xc1, 0x04, 0x6a, 0xf1, 0xb7, 0x65,
+	0xb4, 0x14, 0x95, 0x25, 0xbe, 0x40, 0x1b, 0x36, 0x73, 0x5d, 0x22, 0x20, 0x94, 0xe1, 0xf7, 0x6b,
+	0x59, 0x3a, 0xf0, 0x9f, 0x09, 0x55, 0x39, 0x10, 0x55, 0xea, 0x15, 0x43, 0x1b, 0xcf, 0x8e, 0x30,
+	0xb8, 0x99, 0xb5, 0x47, 0x28, 0xf8, 0x33, 0x5d, 0x2d, 0xca, 0x86, 0x31, 0xaf, 0xda, 0xe5, 0xc6,
+	0xa4, 0x9a, 0xad, 0x8b, 0x08, 0x3c, 0xea, 0x98, 0xb4, 0xd3, 0x27, 0xe0, 0x27, 0x68, 0xa3, 0xe1,
+	0xb2, 0x3a, 0x71, 0x2d, 0x05, 0xe4, 0x52, 0x8f, 0x0a, 0x63, 0x41, 0x01, 0xed, 0x95, 0x74, 0xeb,
+	0xcb, 0x39, 0x11, 0x73, 0x97, 0xfa, 0x1a, 0x66, 0x3d, 0xd2, 0x94, 0xe8, 0x27, 0x52, 0x0f, 0x5f,
+	0xa2, 0x3d, 0xde, 0x0a, 0x03, 0x57, 0x96, 0x5f, 0xcb, 0x8e, 0x2a, 0xef, 0x22, 0x04, 0x7e, 0xc1,
+	0xdc, 0xa8, 0x03, 0xd2, 0x95, 0x07, 0x52, 0xf3, 0x8f, 0x6e, 0xfe, 0xbf, 0x0d, 0x2a, 0x2e, 0x5a,
+	0xf5, 0x92, 0xcd, 0x3c, 0x3d, 0x61, 0xf4, 0xdf, 0x1d, 0xee, 0x34, 0xcb, 0xa2, 0x13, 0x00, 0x2f,
+	0x1d, 0xfb, 0xe2, 0xd7, 0x9f, 0xef, 0x20, 0xed, 0xc5, 0xb1, 0x2f, 0xcc, 0x5d, 0x0d, 0x7f, 0x18,
+	0xa1, 0x9f, 0xf5, 0xc1, 0xb1, 0x8b, 0x36, 0x47, 0x2d, 0xbb, 0x4c, 0x18, 0x8b, 0x33, 0xb0, 0xb9,
+	0x71, 0xd5, 0xe6, 0x09, 0x13, 0x38, 0x44, 0x3b, 0x2a, 0x5b, 0xe3, 0x41, 0x2e, 0xcd, 0xc0, 0xe0,
+	0x96, 0xc4, 0x1e, 0x8b, 0xf0, 0x1c, 0x65, 0xaf, 0xd8, 0x94, 0xe1, 0x2d, 0xcf, 0xc0, 0x5a, 0x26,
+	0x66, 0x4d, 0xc6, 0x76, 0x1b, 0xad, 0xdb, 0x34, 0xb4, 0x5b, 0x54, 0x58, 0xf5, 0x10, 0x48, 0x13,
+	0x42, 0x23, 0x55, 0x48, 0x1c, 0xa4, 0xcc, 0x8c, 0x26, 0x57, 0x22, 0x2a, 0x7e, 0x80, 0xf6, 0x5d,
+	0xfa, 0xbc, 0x45, 0x9d, 0x68, 0xc4, 0xd4, 0x5d, 0x66, 0x37, 0x2d, 0xea, 0x0b, 0x08, 0xdb, 0xc4,
+	0x35, 0xd2, 0x85, 0xc4, 0xc1, 0x82, 0x69, 0xc4, 0x24, 0x2a, 0x52, 0xe0, 0x58, 0xf3, 0xf1, 0x7d,
+	0xb4, 0x1b, 0xeb, 0x91, 0x41, 0x22, 0x3b, 0x01, 0x18, 0x48, 0x8d, 0xb5, 0xed, 0x21, 0xbb, 0x9f,
+	0x8b, 0x4e, 0x00, 0xf8, 0x39, 0xda, 0xba, 0xda, 0xe1, 0xba, 0xbd, 0x56, 0x54, 0x7b, 0xdd, 0xfc,
+	0xfb, 0xfe, 0x8e, 0x7a, 0x60, 0x5f, 0x37, 0x18, 0x1e, 0x63, 0x71, 0x13, 0x07, 0x63, 0xb4, 0xe2,
+	0x0f, 0xf3, 0x28, 0x3d, 0xe8, 0x20, 0xbc, 0x85, 0x16, 0xa3, 0xe9, 0x9b, 0x50, 0x6e, 0x46, 0x2f,
+	0x32, 0x6b, 0x21, 0x9c, 0x43, 0x08, 0xbe, 0x0d, 0x16, 0xe1, 0x1c, 0x84, 0xea, 0xc6, 0xb4, 0x99,
+	0x19, 0x90, 0x0f, 0x25, 0x15, 0x53, 0x39, 0x1b, 0xfc, 0x36, 0x84, 0x5c, 0xc6, 0x7b, 0x4e, 0x6c,
+	0xc1, 0x42, 0x63, 0x61, 0x06, 0xeb, 0x98, 0x1d, 0xc2, 0x3e, 0x52, 0xa8, 0xf8, 0x99, 0x1e, 0x0e,
+	0xe7, 0x2e, 0x63, 0xe1, 0x4c, 0xda, 0x4f, 0xcd, 0x8d, 0x47, 0x12, 0xae, 0xd8, 0x4d, 0xa3, 0xf5,
+	0x91, 0x01, 0x35, 0x25, 0x35, 0x18, 0x25, 0xd5, 0xb2, 0x46, 0xf9, 0x50, 0xcf, 0x32, 0x0b, 0xf1,
+	0xda, 0x09, 0xe5, 0xdf, 0x35, 0xb2, 0x50, 0x05, 0x3b, 0xe6, 0x61, 0x15, 0x6c, 0x33, 0x1b, 0x83,
+	0x35, 0xe5, 0x2f, 0xfe, 0x14, 0xa1, 0xd8, 0x64, 0x4b, 0xbe, 0xdf, 0x64, 0x4b, 0x3b, 0x83, 0x99,
+	0x46, 0xd0, 0xda, 0xb0, 0xd8, 0xce, 0x01, 0x8c, 0xc5, 0x19, 0xb8, 0xb9, 0x3a, 0x80, 0x7c, 0x04,
+	0x80, 0x2d, 0xb4, 0xda, 0x6f, 0x00, 0x4e, 0x5f, 0xc0, 0x4c, 0x86, 0xc8, 0x8a, 0x46, 0x3c, 0xa5,
+	0x2f, 0x00, 0x7b, 0x68, 0x33, 0x9e, 0xee, 0x00, 0x7c, 0xe2, 0x8a, 0x8e, 0xb1, 0x3c, 0x83, 0x48,
+	0x70, 0x0c, 0xb8, 0x16, 0xe1, 0xe2, 0xfb, 0x28, 0xc3, 0x03, 0x26, 0x2c, 0x8f, 0x84, 0x4d, 0x10,
+	0xf2, 0xf4, 0x93, 0x52, 0x96, 0xb2, 0xbd, 0x6e, 0x7e, 0xf5, 0x34, 0x60, 0xe2, 0xa9, 0x62, 0x1c,
+	0x57, 0xcd, 0x55, 0x3e, 0x7c, 0x73, 0xf0, 0x13, 0xb4, 0x1d, 0x77, 0x73, 0xa8, 0x9e, 0x56, 0xea,
+	0xbb, 0xbd, 0x6e, 0x7e, 0xf3, 0x64, 0x28, 0x30, 0x40, 0xd9, 0x74, 0xc7, 0x88, 0x0e, 0x6e, 0x23,
+	0xa3, 0x09, 0x10, 0x40, 0x68, 0x85, 0xf0, 0x35, 0x09, 0x1d, 0x2b, 0x80, 0xd0, 0x06, 0x5f, 0x90,
+	0x86, 0x9e, 0x30, 0xff, 0x30, 0xf0, 0x9d, 0x08, 0xdd, 0x54, 0xe0, 0xb5, 0x01, 0xb6, 0x3c, 0x84,
+	0xdd, 0xb4, 0x2f, 0xc0, 0x6e, 0x5a, 0xc3, 0x01, 0x46, 0x5f, 0x44, 0x11, 0x51, 0xdf, 0x81, 0x4b,
+	0xcb, 0x66, 0x2d, 0x5f, 0x18, 0x2b, 0x33, 0x58, 0xe4, 0x82, 0x32, 0x74, 0x34, 0x6a, 0xe7, 0x58,
+	0x9a, 0x39, 0x92, 0x56, 0x26, 0x8f, 0x9b, 0xd5, 0x8f, 0x32, 0x6e, 0x2e, 0xd1, 0x5e, 0x20, 0x4f,
+	0xb2, 0xc4, 0xb5, 0xc6, 0x7b, 0x7b, 0x6d, 0x60, 0x32, 0x71, 0xed, 0x8c, 0xef, 0x6a, 0xf8, 0x93,
+	0xd1, 0x16, 0x3f, 0x45, 0x9b, 0x57, 0x5a, 0xd4, 0xf2, 0x98, 0x03, 0xae, 0x91, 0x29, 0x24, 0x26,
+	0x6f, 0x09, 0xa7, 0xb1, 0xe6, 0x7b, 0x2a, 0x45, 0xcd, 0x0d, 0x3e, 0x4a, 0x2a, 0xfe, 0xb8, 0x80,
+	0x36, 0xc6, 0x04, 0x31, 0x43, 0x6b, 0x72, 0x66, 0xc8, 0xa8, 0xc0, 0x22, 0x41, 0x27, 0x1a, 0x75,
+	0x95, 0x27, 0x1f, 0x56, 0x4a, 0xbd, 0x6e, 0x7e, 0xa5, 0x42, 0x38, 0x98, 0x44, 0xc0, 0x61, 0xed,
+	0x8b, 0x91, 0x38, 0x57, 0xea, 0x7d, 0x56, 0xd0, 0xc1, 0x80, 0xd6, 0x95, 0x41, 0xaf, 0xe5, 0x0a,
+	0x1a, 0xb8, 0x14, 0x42, 0x63, 0x7e, 0x90, 0xcb, 0xeb, 0x57, 0x6f, 0x46, 0x82, 0x3e, 0x1d, 0x60,
+	0xe2, 0x1a, 0x4a, 0x36, 0xa9, 0xdf, 0x9c, 0xc9, 0x0c, 0x56, 0x48, 0xd2, 0xf1, 0xaf, 0x5a, 0x5e,
+	0x10, 0x77, 0x3c, 0x39, 0x0b, 0xc7, 0x25, 0xe8, 0xd0, 0xf1, 0xe2, 0xb7, 0x0b, 0x68, 0x63, 0x6c,
+	0x1f, 0x9f, 0xb2, 0x13, 0x5d, 0xdd, 0x0a, 0xe6, 0x3f, 0x78, 0x2b, 0xf8, 0x1c, 0xa5, 0x3c, 0xea,
+	0x0b, 0xb5, 0x0b, 0xcc, 0x22, 0x51, 0xcb, 0x12, 0x4d, 0x6e, 0x00, 0xcf, 0x10, 0x0a, 0xc1, 0x01,
+	0xf0, 0x14, 0xf4, 0x2c, 0xd2, 0x94, 0x8e, 0xf0, 0x24, 0xf8, 0xc4, 0x11, 0xb0, 0xf8, 0x31, 0x46,
+	0x40, 0xf1, 0x1b, 0xb4, 0x39, 0xe1, 0xc6, 0x34, 0x65, 0x35, 0x6a, 0x28, 0x29, 0x53, 0x6b, 0xcc,
+	0xcf, 0xc0, 0x15, 0x85, 0x54, 0xfc, 0x7e, 0x1e, 0xed, 0x4e, 0xb9, 0xa9, 0xaa, 0x63, 0xed, 0xf0,
+	0xbc, 0xa9, 0x0e, 0x24, 0x91, 0x37, 0x99, 0x21, 0x59, 0x1d, 0x30, 0xeb, 0x68, 0x7f, 0xfa, 0x1d,
+	0x5a, 0x17, 0xcd, 0x7e, 0x29, 0xfa, 0xe0, 0x51, 0xea, 0x7f, 0xf0, 0x28, 0x9d, 0xf5, 0x3f, 0x78,
+	0x54, 0x52, 0x32, 0x90, 0x97, 0x6f, 0xf3, 0x09, 0xd3, 0x98, 0x76, 0x37, 0x96, 0xbd, 0xa1, 0x0e,
+	0xca, 0xc0, 0xc5, 0xf5, 0x8f, 0x80, 0x13, 0x7a, 0xa3, 0x0f, 0xaa, 0x97, 0xe3, 0xa7, 0x04, 0xda,
+	0x9e, 0x78, 0x73, 0x7e, 0xff, 0x6c, 0x00, 0x5a, 0x1f, 0xb9, 0xc4, 0xcf, 0x64, 0xbd, 0x32, 0x57,
+	0x2f, 0xee, 0x95, 0x87, 0xaf, 0x7b, 0xb9, 0xc4, 0x9b, 0x5e, 0x2e, 0xf1, 0x67, 0x2f, 0x97, 0x78,
+	0xf9, 0x2e, 0x37, 0xf7, 0xe6, 0x5d, 0x6e, 0xee, 0xf7, 0x77, 0xb9, 0xb9, 0x2f, 0x6f, 0xc5, 0xf0,
+	0xe5, 0x20, 0xbf, 0xe3, 0x92, 0x3a, 0x57, 0x4f, 0xe5, 0x4b, 0xf5, 0x41, 0x49, 0x99, 0xa8, 0x2f,
+	0xa9, 0x95, 0xf8, 0xff, 0x5f, 0x03, 0x00, 0x7a, 0x13, 0xa9, 0xdc, 0x0d, 0x13, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -563,6 +776,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PegStabilityReserves) > 0 {
+		for iNdEx := len(m.PegStabilityReserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PegStabilityReserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.TotalPrincipals) > 0 {
 		for iNdEx := len(m.TotalPrincipals) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -671,6 +898,27 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PegStabilityParams) > 0 {
+		for iNdEx := len(m.PegStabilityParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PegStabilityParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.CollateralAuctionType) > 0 {
+		i -= len(m.CollateralAuctionType)
+		copy(dAtA[i:], m.CollateralAuctionType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CollateralAuctionType)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.LiquidationBlockInterval != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LiquidationBlockInterval))
 		i--
@@ -840,6 +1088,30 @@ func (m *CollateralParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StabilityFeeModel != nil {
+		{
+			size, err := m.StabilityFeeModel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.PartialLiquidationRatio != nil {
+		{
+			size := m.PartialLiquidationRatio.Size()
+			i -= size
+			if _, err := m.PartialLiquidationRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	{
 		size := m.ConversionFactor.Size()
 		i -= size
@@ -951,7 +1223,7 @@ func (m *CollateralParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisAccumulationTime) Marshal() (dAtA []byte, err error) {
+func (m *StabilityFeeModel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -961,45 +1233,60 @@ func (m *GenesisAccumulationTime) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisAccumulationTime) MarshalTo(dAtA []byte) (int, error) {
+func (m *StabilityFeeModel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisAccumulationTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StabilityFeeModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.InterestFactor.Size()
+		size := m.JumpMultiplier.Size()
 		i -= size
-		if _, err := m.InterestFactor.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.JumpMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Kink.Size()
+		i -= size
+		if _, err := m.Kink.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreviousAccumulationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousAccumulationTime):])
-	if err5 != nil {
-		return 0, err5
+	{
+		size := m.BaseMultiplier.Size()
+		i -= size
+		if _, err := m.BaseMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i -= n5
-	i = encodeVarintGenesis(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x12
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.BaseRateAPY.Size()
+		i -= size
+		if _, err := m.BaseRateAPY.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisTotalPrincipal) Marshal() (dAtA []byte, err error) {
+func (m *PegStabilityParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1009,73 +1296,231 @@ func (m *GenesisTotalPrincipal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisTotalPrincipal) MarshalTo(dAtA []byte) (int, error) {
+func (m *PegStabilityParam) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisTotalPrincipal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PegStabilityParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.TotalPrincipal.Size()
+		size := m.ConversionFactor.Size()
 		i -= size
-		if _, err := m.TotalPrincipal.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ConversionFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RedeemFee.Size()
+		i -= size
+		if _, err := m.RedeemFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MintFee.Size()
+		i -= size
+		if _, err := m.MintFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.DebtLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CollateralType)))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PegStabilityReserve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PegStabilityReserve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PegStabilityReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.CDPs) > 0 {
-		for _, e := range m.CDPs {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Deposits) > 0 {
-		for _, e := range m.Deposits {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	{
+		size := m.Debt.Size()
+		i -= size
+		if _, err := m.Debt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.StartingCdpID != 0 {
-		n += 1 + sovGenesis(uint64(m.StartingCdpID))
-	}
-	l = len(m.DebtDenom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisAccumulationTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisAccumulationTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisAccumulationTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InterestFactor.Size()
+		i -= size
+		if _, err := m.InterestFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreviousAccumulationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousAccumulationTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintGenesis(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisTotalPrincipal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisTotalPrincipal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisTotalPrincipal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalPrincipal.Size()
+		i -= size
+		if _, err := m.TotalPrincipal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CDPs) > 0 {
+		for _, e := range m.CDPs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StartingCdpID != 0 {
+		n += 1 + sovGenesis(uint64(m.StartingCdpID))
+	}
+	l = len(m.DebtDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
 	}
 	l = len(m.GovDenom)
 	if l > 0 {
@@ -1093,6 +1538,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PegStabilityReserves) > 0 {
+		for _, e := range m.PegStabilityReserves {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1126,6 +1577,16 @@ func (m *Params) Size() (n int) {
 	if m.LiquidationBlockInterval != 0 {
 		n += 1 + sovGenesis(uint64(m.LiquidationBlockInterval))
 	}
+	l = len(m.CollateralAuctionType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PegStabilityParams) > 0 {
+		for _, e := range m.PegStabilityParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1188,6 +1649,67 @@ func (m *CollateralParam) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.ConversionFactor.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.PartialLiquidationRatio != nil {
+		l = m.PartialLiquidationRatio.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.StabilityFeeModel != nil {
+		l = m.StabilityFeeModel.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *StabilityFeeModel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseRateAPY.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BaseMultiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Kink.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.JumpMultiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PegStabilityParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.DebtLimit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MintFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.RedeemFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.ConversionFactor.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PegStabilityReserve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Debt.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1510,6 +2032,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PegStabilityReserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PegStabilityReserves = append(m.PegStabilityReserves, PegStabilityReserve{})
+			if err := m.PegStabilityReserves[len(m.PegStabilityReserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1835,22 +2391,88 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAuctionType", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
+			m.CollateralAuctionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PegStabilityParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PegStabilityParams = append(m.PegStabilityParams, PegStabilityParam{})
+			if err := m.PegStabilityParams[len(m.PegStabilityParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -2466,6 +3088,597 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialLiquidationRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.PartialLiquidationRatio = &v
+			if err := m.PartialLiquidationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFeeModel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StabilityFeeModel == nil {
+				m.StabilityFeeModel = &StabilityFeeModel{}
+			}
+			if err := m.StabilityFeeModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StabilityFeeModel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StabilityFeeModel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StabilityFeeModel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRateAPY", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseRateAPY.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kink", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Kink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JumpMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JumpMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PegStabilityParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PegStabilityParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PegStabilityParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedeemFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PegStabilityReserve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PegStabilityReserve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PegStabilityReserve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Debt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -22,6 +22,9 @@ const (
 
 	// LiquidatorMacc module account for liquidator
 	LiquidatorMacc = "liquidator"
+
+	// PegStabilityMacc module account for peg stability reserves
+	PegStabilityMacc = "peg_stability"
 )
 
 var sep = []byte(":")
@@ -42,6 +45,7 @@ var sep = []byte(":")
 // - 0x08:previousDistributionTime
 // - 0x09<marketID>:downTime
 // - 0x10:totalDistributed
+// - 0x14<denom>:pegStabilityDebt
 
 // KVStore key prefixes
 var (
@@ -56,6 +60,7 @@ var (
 	PricefeedStatusKeyPrefix   = []byte{0x10}
 	PreviousAccrualTimePrefix  = []byte{0x12}
 	InterestFactorPrefix       = []byte{0x13}
+	PegStabilityDebtKeyPrefix  = []byte{0x14}
 )
 
 // GetCdpIDBytes returns the byte representation of the cdpID
@@ -18,6 +18,9 @@ var (
 	_ sdk.Msg = &MsgDrawDebt{}
 	_ sdk.Msg = &MsgRepayDebt{}
 	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgMigrateCDP{}
+	_ sdk.Msg = &MsgPegMint{}
+	_ sdk.Msg = &MsgPegRedeem{}
 )
 
 // NewMsgCreateCDP returns a new MsgPlaceBid.
@@ -310,3 +313,148 @@ func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{keeper}
 }
+
+// NewMsgMigrateCDP returns a new MsgMigrateCDP
+func NewMsgMigrateCDP(sender sdk.AccAddress, collateralType, targetCollateralType string, collateral sdk.Coin) MsgMigrateCDP {
+	return MsgMigrateCDP{
+		Sender:               sender.String(),
+		CollateralType:       collateralType,
+		TargetCollateralType: targetCollateralType,
+		Collateral:           collateral,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgMigrateCDP) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgMigrateCDP) Type() string { return "migrate_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgMigrateCDP) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return errorsmod.Wrap(ErrInvalidCollateral, "collateral type cannot be empty")
+	}
+	if strings.TrimSpace(msg.TargetCollateralType) == "" {
+		return errorsmod.Wrap(ErrInvalidCollateral, "target collateral type cannot be empty")
+	}
+	if msg.CollateralType == msg.TargetCollateralType {
+		return errorsmod.Wrapf(ErrInvalidCollateral, "cannot migrate cdp to the same collateral type %s", msg.CollateralType)
+	}
+	// the collateral can be zero when migrating to an existing cdp
+	if !msg.Collateral.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "collateral amount %s", msg.Collateral)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgMigrateCDP) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgMigrateCDP) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgPegMint returns a new MsgPegMint
+func NewMsgPegMint(sender sdk.AccAddress, amount sdk.Coin) MsgPegMint {
+	return MsgPegMint{
+		Sender: sender.String(),
+		Amount: amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPegMint) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPegMint) Type() string { return "peg_mint" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPegMint) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if msg.Amount.IsZero() || !msg.Amount.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "mint amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPegMint) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPegMint) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgPegRedeem returns a new MsgPegRedeem
+func NewMsgPegRedeem(sender sdk.AccAddress, amount sdk.Coin, denom string) MsgPegRedeem {
+	return MsgPegRedeem{
+		Sender: sender.String(),
+		Amount: amount,
+		Denom:  denom,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPegRedeem) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPegRedeem) Type() string { return "peg_redeem" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPegRedeem) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if msg.Amount.IsZero() || !msg.Amount.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "redeem amount %s", msg.Amount)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidPegAmount, err.Error())
+	}
+	if msg.Denom == msg.Amount.Denom {
+		return errorsmod.Wrapf(ErrInvalidPegAmount, "cannot redeem %s for itself", msg.Denom)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPegRedeem) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPegRedeem) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -167,3 +167,91 @@ func TestMsgRepayDebt(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgMigrateCDP(t *testing.T) {
+	tests := []struct {
+		description          string
+		sender               sdk.AccAddress
+		collateralType       string
+		targetCollateralType string
+		collateral           sdk.Coin
+		expectPass           bool
+	}{
+		{"migrate cdp", addrs[0], "bnb-a", "btcb-a", coinsSingle, true},
+		{"migrate cdp no collateral", addrs[0], "bnb-a", "btcb-a", coinsZero, true},
+		{"migrate cdp empty owner", sdk.AccAddress{}, "bnb-a", "btcb-a", coinsSingle, false},
+		{"migrate cdp empty collateral type", addrs[0], "", "btcb-a", coinsSingle, false},
+		{"migrate cdp empty target collateral type", addrs[0], "bnb-a", " ", coinsSingle, false},
+		{"migrate cdp same collateral type", addrs[0], "bnb-a", "bnb-a", coinsSingle, false},
+		{"migrate cdp invalid collateral", addrs[0], "bnb-a", "btcb-a", sdk.Coin{Denom: "BNB!", Amount: sdk.NewInt(1)}, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgMigrateCDP(
+			tc.sender,
+			tc.collateralType,
+			tc.targetCollateralType,
+			tc.collateral,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgPegMint(t *testing.T) {
+	tests := []struct {
+		description string
+		sender      sdk.AccAddress
+		amount      sdk.Coin
+		expectPass  bool
+	}{
+		{"peg mint", addrs[0], coinsSingle, true},
+		{"peg mint zero amount", addrs[0], coinsZero, false},
+		{"peg mint empty sender", sdk.AccAddress{}, coinsSingle, false},
+		{"peg mint invalid amount", addrs[0], sdk.Coin{Denom: "USDC!", Amount: sdk.NewInt(1)}, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgPegMint(
+			tc.sender,
+			tc.amount,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgPegRedeem(t *testing.T) {
+	tests := []struct {
+		description string
+		sender      sdk.AccAddress
+		amount      sdk.Coin
+		denom       string
+		expectPass  bool
+	}{
+		{"peg redeem", addrs[0], coinsSingle, "usdc", true},
+		{"peg redeem zero amount", addrs[0], coinsZero, "usdc", false},
+		{"peg redeem empty sender", sdk.AccAddress{}, coinsSingle, "usdc", false},
+		{"peg redeem empty denom", addrs[0], coinsSingle, "", false},
+		{"peg redeem same denom", addrs[0], coinsSingle, sdk.DefaultBondDenom, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgPegRedeem(
+			tc.sender,
+			tc.amount,
+			tc.denom,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
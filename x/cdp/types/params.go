@@ -9,6 +9,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 )
 
 // Parameter keys
@@ -22,6 +24,8 @@ var (
 	KeySurplusThreshold                   = []byte("SurplusThreshold")
 	KeySurplusLot                         = []byte("SurplusLot")
 	KeyBeginBlockerExecutionBlockInterval = []byte("BeginBlockerExecutionBlockInterval")
+	KeyCollateralAuctionType              = []byte("CollateralAuctionType")
+	KeyPegStabilityParams                 = []byte("PegStabilityParams")
 	DefaultGlobalDebt                     = sdk.NewCoin(DefaultStableDenom, sdk.ZeroInt())
 	DefaultCircuitBreaker                 = false
 	DefaultCollateralParams               = CollateralParams{}
@@ -42,12 +46,21 @@ var (
 	stabilityFeeMax         = sdk.MustNewDecFromStr("1.000000051034942716") // 500% APR
 	// Run every block
 	DefaultBeginBlockerExecutionBlockInterval = int64(1)
+	// Liquidate collateral with two-phase (forward/reverse) auctions
+	DefaultCollateralAuctionType = auctiontypes.CollateralAuctionType
+	DefaultPegStabilityParams    = PegStabilityParams{}
 )
 
+const secondsPerYear = 31536000
+
+// MaxPegStabilityConversionFactor is the largest number of decimals of a peg stability stablecoin
+const MaxPegStabilityConversionFactor = 18
+
 // NewParams returns a new params object
 func NewParams(
 	debtLimit sdk.Coin, collateralParams CollateralParams, debtParam DebtParam, surplusThreshold,
 	surplusLot, debtThreshold, debtLot sdkmath.Int, breaker bool, beginBlockerExecutionBlockInterval int64,
+	collateralAuctionType string, pegStabilityParams PegStabilityParams,
 ) Params {
 	return Params{
 		GlobalDebtLimit:          debtLimit,
@@ -59,6 +72,8 @@ func NewParams(
 		DebtAuctionLot:           debtLot,
 		CircuitBreaker:           breaker,
 		LiquidationBlockInterval: beginBlockerExecutionBlockInterval,
+		CollateralAuctionType:    collateralAuctionType,
+		PegStabilityParams:       pegStabilityParams,
 	}
 }
 
@@ -68,6 +83,7 @@ func DefaultParams() Params {
 		DefaultGlobalDebt, DefaultCollateralParams, DefaultDebtParam, DefaultSurplusThreshold,
 		DefaultSurplusLot, DefaultDebtThreshold, DefaultDebtLot,
 		DefaultCircuitBreaker, DefaultBeginBlockerExecutionBlockInterval,
+		DefaultCollateralAuctionType, DefaultPegStabilityParams,
 	)
 }
 
@@ -95,6 +111,73 @@ func NewCollateralParam(
 // CollateralParams array of CollateralParam
 type CollateralParams []CollateralParam
 
+// NewStabilityFeeModel returns a new StabilityFeeModel
+func NewStabilityFeeModel(baseRateAPY, baseMultiplier, kink, jumpMultiplier sdk.Dec) StabilityFeeModel {
+	return StabilityFeeModel{
+		BaseRateAPY:    baseRateAPY,
+		BaseMultiplier: baseMultiplier,
+		Kink:           kink,
+		JumpMultiplier: jumpMultiplier,
+	}
+}
+
+// Validate StabilityFeeModel param
+func (m StabilityFeeModel) Validate() error {
+	if m.BaseRateAPY.IsNil() || m.BaseMultiplier.IsNil() || m.Kink.IsNil() || m.JumpMultiplier.IsNil() {
+		return fmt.Errorf("stability fee model rates cannot be nil")
+	}
+
+	if m.BaseRateAPY.IsNegative() || m.BaseRateAPY.GT(sdk.OneDec()) {
+		return fmt.Errorf("base rate APY must be in the inclusive range 0.0-1.0")
+	}
+
+	if m.BaseMultiplier.IsNegative() {
+		return fmt.Errorf("base multiplier must not be negative")
+	}
+
+	if m.Kink.IsNegative() || m.Kink.GT(sdk.OneDec()) {
+		return fmt.Errorf("kink must be in the inclusive range 0.0-1.0")
+	}
+
+	if m.JumpMultiplier.IsNegative() {
+		return fmt.Errorf("jump multiplier must not be negative")
+	}
+
+	maxFee, err := m.CalculateStabilityFee(sdk.OneDec())
+	if err != nil {
+		return err
+	}
+	if maxFee.GT(stabilityFeeMax) {
+		return fmt.Errorf("stability fee at full utilization must be ≤ %s, is %s", stabilityFeeMax, maxFee)
+	}
+
+	return nil
+}
+
+// CalculateStabilityFee returns the per second stability fee at the input utilization of the debt limit
+func (m StabilityFeeModel) CalculateStabilityFee(utilization sdk.Dec) (sdk.Dec, error) {
+	apy := utilization.Mul(m.BaseMultiplier).Add(m.BaseRateAPY)
+	if utilization.GT(m.Kink) {
+		// the rate rises by the jump multiplier above the kink
+		apy = m.Kink.Mul(m.BaseMultiplier).Add(m.BaseRateAPY).Add(utilization.Sub(m.Kink).Mul(m.JumpMultiplier))
+	}
+	return sdk.OneDec().Add(apy).ApproxRoot(secondsPerYear)
+}
+
+// NewPegStabilityParam returns a new PegStabilityParam
+func NewPegStabilityParam(denom string, conversionFactor sdkmath.Int, debtLimit sdk.Coin, mintFee, redeemFee sdk.Dec) PegStabilityParam {
+	return PegStabilityParam{
+		Denom:            denom,
+		ConversionFactor: conversionFactor,
+		DebtLimit:        debtLimit,
+		MintFee:          mintFee,
+		RedeemFee:        redeemFee,
+	}
+}
+
+// PegStabilityParams array of PegStabilityParam
+type PegStabilityParams []PegStabilityParam
+
 // NewDebtParam returns a new DebtParam
 func NewDebtParam(denom, refAsset string, conversionFactor, debtFloor sdkmath.Int) DebtParam {
 	return DebtParam{
@@ -127,6 +210,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDebtThreshold, &p.DebtAuctionThreshold, validateDebtAuctionThresholdParam),
 		paramtypes.NewParamSetPair(KeyDebtLot, &p.DebtAuctionLot, validateDebtAuctionLotParam),
 		paramtypes.NewParamSetPair(KeyBeginBlockerExecutionBlockInterval, &p.LiquidationBlockInterval, validateBeginBlockerExecutionBlockIntervalParam),
+		paramtypes.NewParamSetPair(KeyCollateralAuctionType, &p.CollateralAuctionType, validateCollateralAuctionTypeParam),
+		paramtypes.NewParamSetPair(KeyPegStabilityParams, &p.PegStabilityParams, validatePegStabilityParams),
 	}
 }
 
@@ -168,6 +253,27 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateCollateralAuctionTypeParam(p.CollateralAuctionType); err != nil {
+		return err
+	}
+
+	if err := validatePegStabilityParams(p.PegStabilityParams); err != nil {
+		return err
+	}
+
+	for _, psp := range p.PegStabilityParams {
+		if psp.Denom == p.DebtParam.Denom {
+			return fmt.Errorf("peg stability denom cannot be the debt denom %s", psp.Denom)
+		}
+		if psp.DebtLimit.Denom != p.GlobalDebtLimit.Denom {
+			return fmt.Errorf("peg stability debt limit denom %s does not match global debt limit denom %s",
+				psp.DebtLimit.Denom, p.GlobalDebtLimit.Denom)
+		}
+		if psp.DebtLimit.Amount.GT(p.GlobalDebtLimit.Amount) {
+			return fmt.Errorf("peg stability debt limit %s exceeds global debt limit: %s", psp.DebtLimit, p.GlobalDebtLimit)
+		}
+	}
+
 	if len(p.CollateralParams) == 0 { // default value OK
 		return nil
 	}
@@ -272,12 +378,31 @@ func validateCollateralParams(i interface{}) error {
 		if cp.StabilityFee.LT(sdk.OneDec()) || cp.StabilityFee.GT(stabilityFeeMax) {
 			return fmt.Errorf("stability fee must be ≥ 1.0, ≤ %s, is %s for %s", stabilityFeeMax, cp.StabilityFee, cp.Denom)
 		}
+		if cp.StabilityFeeModel != nil {
+			if err := cp.StabilityFeeModel.Validate(); err != nil {
+				return fmt.Errorf("invalid stability fee model for %s: %w", cp.Denom, err)
+			}
+		}
 		if cp.KeeperRewardPercentage.IsNegative() || cp.KeeperRewardPercentage.GT(sdk.OneDec()) {
 			return fmt.Errorf("keeper reward percentage should be between 0 and 1, is %s for %s", cp.KeeperRewardPercentage, cp.Denom)
 		}
 		if cp.CheckCollateralizationIndexCount.IsNegative() {
 			return fmt.Errorf("keeper reward percentage should be positive, is %s for %s", cp.CheckCollateralizationIndexCount, cp.Denom)
 		}
+		if cp.PartialLiquidationRatio != nil {
+			if cp.PartialLiquidationRatio.IsNil() || cp.PartialLiquidationRatio.IsNegative() {
+				return fmt.Errorf("partial liquidation ratio cannot be nil or negative, is %s for %s", cp.PartialLiquidationRatio, cp.Denom)
+			}
+			if cp.PartialLiquidationRatio.IsPositive() {
+				if cp.PartialLiquidationRatio.LTE(cp.LiquidationRatio) {
+					return fmt.Errorf("partial liquidation ratio must be > liquidation ratio %s, is %s for %s", cp.LiquidationRatio, cp.PartialLiquidationRatio, cp.Denom)
+				}
+				minRatio := sdk.OneDec().Add(cp.LiquidationPenalty)
+				if cp.PartialLiquidationRatio.LTE(minRatio) {
+					return fmt.Errorf("partial liquidation ratio must be > 1 + liquidation penalty %s, is %s for %s", minRatio, cp.PartialLiquidationRatio, cp.Denom)
+				}
+			}
+		}
 	}
 
 	return nil
@@ -368,3 +493,45 @@ func validateBeginBlockerExecutionBlockIntervalParam(i interface{}) error {
 
 	return nil
 }
+
+func validateCollateralAuctionTypeParam(i interface{}) error {
+	auctionType, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return auctiontypes.ValidateCollateralAuctionType(auctionType)
+}
+
+func validatePegStabilityParams(i interface{}) error {
+	pegStabilityParams, ok := i.(PegStabilityParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denomDupMap := make(map[string]bool)
+	for _, psp := range pegStabilityParams {
+		if err := sdk.ValidateDenom(psp.Denom); err != nil {
+			return fmt.Errorf("peg stability denom invalid %s", psp.Denom)
+		}
+		if denomDupMap[psp.Denom] {
+			return fmt.Errorf("duplicate peg stability denom %s", psp.Denom)
+		}
+		denomDupMap[psp.Denom] = true
+
+		if !psp.DebtLimit.IsValid() {
+			return fmt.Errorf("peg stability debt limit for %s is invalid: %s", psp.Denom, psp.DebtLimit)
+		}
+		if psp.MintFee.IsNil() || psp.MintFee.IsNegative() || psp.MintFee.GTE(sdk.OneDec()) {
+			return fmt.Errorf("mint fee should be ≥ 0 and < 1, is %s for %s", psp.MintFee, psp.Denom)
+		}
+		if psp.RedeemFee.IsNil() || psp.RedeemFee.IsNegative() || psp.RedeemFee.GTE(sdk.OneDec()) {
+			return fmt.Errorf("redeem fee should be ≥ 0 and < 1, is %s for %s", psp.RedeemFee, psp.Denom)
+		}
+		if psp.ConversionFactor.IsNil() || psp.ConversionFactor.IsNegative() || psp.ConversionFactor.GT(sdk.NewInt(MaxPegStabilityConversionFactor)) {
+			return fmt.Errorf("conversion factor should be ≥ 0 and ≤ %d, is %s for %s", MaxPegStabilityConversionFactor, psp.ConversionFactor, psp.Denom)
+		}
+	}
+
+	return nil
+}
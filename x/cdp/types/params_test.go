@@ -8,6 +8,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 	"github.com/kava-labs/kava/x/cdp/types"
 )
 
@@ -29,6 +30,8 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		debtLot                            sdkmath.Int
 		breaker                            bool
 		beginBlockerExecutionBlockInterval int64
+		collateralAuctionType              string
+		pegStabilityParams                 types.PegStabilityParams
 	}
 	type errArgs struct {
 		expectPass bool
@@ -52,6 +55,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: true,
@@ -90,6 +94,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: true,
@@ -128,6 +133,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -166,6 +172,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -218,6 +225,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: true,
@@ -270,6 +278,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -322,6 +331,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -359,6 +369,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -397,6 +408,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -449,6 +461,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -501,6 +514,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: true,
@@ -539,6 +553,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -577,6 +592,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -615,6 +631,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -653,6 +670,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -686,12 +704,313 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
 				contains:   "liquidation ratio must be > 0",
 			},
 		},
+		{
+			name: "valid collateral params partial liquidation ratio",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1_000_000_000_000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50_000_000_000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+						PartialLiquidationRatio:          decPtr(sdk.MustNewDecFromStr("1.75")),
+					},
+				},
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "valid collateral params zero partial liquidation ratio",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1_000_000_000_000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50_000_000_000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+						PartialLiquidationRatio:          decPtr(sdk.MustNewDecFromStr("0.0")),
+					},
+				},
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "invalid collateral params negative partial liquidation ratio",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1_000_000_000_000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50_000_000_000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+						PartialLiquidationRatio:          decPtr(sdk.MustNewDecFromStr("-1.0")),
+					},
+				},
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "partial liquidation ratio cannot be nil or negative",
+			},
+		},
+		{
+			name: "invalid collateral params partial liquidation ratio below liquidation ratio",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1_000_000_000_000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50_000_000_000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+						PartialLiquidationRatio:          decPtr(sdk.MustNewDecFromStr("1.5")),
+					},
+				},
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "partial liquidation ratio must be > liquidation ratio",
+			},
+		},
+		{
+			name: "valid collateral params stability fee model",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1_000_000_000_000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50_000_000_000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+						StabilityFeeModel: &types.StabilityFeeModel{
+							BaseRateAPY:    sdk.MustNewDecFromStr("0.01"),
+							BaseMultiplier: sdk.MustNewDecFromStr("0.1"),
+							Kink:           sdk.MustNewDecFromStr("0.8"),
+							JumpMultiplier: sdk.MustNewDecFromStr("1.0"),
+						},
+					},
+				},
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "invalid collateral params stability fee model kink above one",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1_000_000_000_000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50_000_000_000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+						StabilityFeeModel: &types.StabilityFeeModel{
+							BaseRateAPY:    sdk.MustNewDecFromStr("0.01"),
+							BaseMultiplier: sdk.MustNewDecFromStr("0.1"),
+							Kink:           sdk.MustNewDecFromStr("1.1"),
+							JumpMultiplier: sdk.MustNewDecFromStr("1.0"),
+						},
+					},
+				},
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "kink must be in the inclusive range 0.0-1.0",
+			},
+		},
+		{
+			name: "invalid collateral params stability fee model negative jump multiplier",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1_000_000_000_000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50_000_000_000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+						StabilityFeeModel: &types.StabilityFeeModel{
+							BaseRateAPY:    sdk.MustNewDecFromStr("0.01"),
+							BaseMultiplier: sdk.MustNewDecFromStr("0.1"),
+							Kink:           sdk.MustNewDecFromStr("0.8"),
+							JumpMultiplier: sdk.MustNewDecFromStr("-1.0"),
+						},
+					},
+				},
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "jump multiplier must not be negative",
+			},
+		},
+		{
+			name: "invalid collateral params stability fee model above max fee",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1_000_000_000_000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdkmath.NewInt(50_000_000_000),
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdkmath.NewInt(8),
+						CheckCollateralizationIndexCount: sdkmath.NewInt(10),
+						StabilityFeeModel: &types.StabilityFeeModel{
+							BaseRateAPY:    sdk.MustNewDecFromStr("0.05"),
+							BaseMultiplier: sdk.MustNewDecFromStr("0.5"),
+							Kink:           sdk.MustNewDecFromStr("0.8"),
+							JumpMultiplier: sdk.MustNewDecFromStr("50.0"),
+						},
+					},
+				},
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "stability fee at full utilization must be ≤",
+			},
+		},
 		{
 			name: "invalid debt param empty denom",
 			args: args{
@@ -724,6 +1043,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -742,6 +1062,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -760,6 +1081,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -778,6 +1100,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -796,6 +1119,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -814,6 +1138,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            sdk.ZeroInt(),
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -832,6 +1157,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: 0,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
@@ -850,16 +1176,233 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				debtLot:                            types.DefaultDebtLot,
 				breaker:                            types.DefaultCircuitBreaker,
 				beginBlockerExecutionBlockInterval: -1,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
 			},
 			errArgs: errArgs{
 				expectPass: false,
 				contains:   "begin blocker execution block interval param should be positive",
 			},
 		},
+		{
+			name: "dutch collateral auction type",
+			args: args{
+				globalDebtLimit:                    types.DefaultGlobalDebt,
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              auctiontypes.DutchCollateralAuctionType,
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "invalid collateral auction type",
+			args: args{
+				globalDebtLimit:                    types.DefaultGlobalDebt,
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              auctiontypes.SurplusAuctionType,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "invalid collateral auction type",
+			},
+		},
+		{
+			name: "valid peg stability params",
+			args: args{
+				globalDebtLimit:                    sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
+				pegStabilityParams: types.PegStabilityParams{
+					types.NewPegStabilityParam("usdc", sdk.NewInt(6), sdk.NewInt64Coin("usdx", 100000000000), sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.002")),
+					types.NewPegStabilityParam("usdt", sdk.NewInt(6), sdk.NewInt64Coin("usdx", 100000000000), sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "invalid peg stability params duplicate denom",
+			args: args{
+				globalDebtLimit:                    sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
+				pegStabilityParams: types.PegStabilityParams{
+					types.NewPegStabilityParam("usdc", sdk.NewInt(6), sdk.NewInt64Coin("usdx", 100000000000), sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.002")),
+					types.NewPegStabilityParam("usdc", sdk.NewInt(6), sdk.NewInt64Coin("usdx", 100000000000), sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "duplicate peg stability denom usdc",
+			},
+		},
+		{
+			name: "invalid peg stability params debt denom",
+			args: args{
+				globalDebtLimit:                    sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
+				pegStabilityParams: types.PegStabilityParams{
+					types.NewPegStabilityParam("usdx", sdk.NewInt(6), sdk.NewInt64Coin("usdx", 100000000000), sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.002")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "peg stability denom cannot be the debt denom usdx",
+			},
+		},
+		{
+			name: "invalid peg stability params mint fee",
+			args: args{
+				globalDebtLimit:                    sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
+				pegStabilityParams: types.PegStabilityParams{
+					types.NewPegStabilityParam("usdc", sdk.NewInt(6), sdk.NewInt64Coin("usdx", 100000000000), sdk.MustNewDecFromStr("1.0"), sdk.MustNewDecFromStr("0.002")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "mint fee should be ≥ 0 and < 1",
+			},
+		},
+		{
+			name: "invalid peg stability params redeem fee",
+			args: args{
+				globalDebtLimit:                    sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
+				pegStabilityParams: types.PegStabilityParams{
+					types.NewPegStabilityParam("usdc", sdk.NewInt(6), sdk.NewInt64Coin("usdx", 100000000000), sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("-0.1")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "redeem fee should be ≥ 0 and < 1",
+			},
+		},
+		{
+			name: "invalid peg stability params conversion factor",
+			args: args{
+				globalDebtLimit:                    sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
+				pegStabilityParams: types.PegStabilityParams{
+					types.NewPegStabilityParam("usdc", sdk.NewInt(19), sdk.NewInt64Coin("usdx", 100000000000), sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.002")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "conversion factor should be ≥ 0 and ≤ 18",
+			},
+		},
+		{
+			name: "invalid peg stability params debt limit denom",
+			args: args{
+				globalDebtLimit:                    sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
+				pegStabilityParams: types.PegStabilityParams{
+					types.NewPegStabilityParam("usdc", sdk.NewInt(6), sdk.NewInt64Coin("busd", 100000000000), sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.002")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "does not match global debt limit denom",
+			},
+		},
+		{
+			name: "invalid peg stability params debt limit above global",
+			args: args{
+				globalDebtLimit:                    sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams:                   types.DefaultCollateralParams,
+				debtParam:                          types.DefaultDebtParam,
+				surplusThreshold:                   types.DefaultSurplusThreshold,
+				surplusLot:                         types.DefaultSurplusLot,
+				debtThreshold:                      types.DefaultDebtThreshold,
+				debtLot:                            types.DefaultDebtLot,
+				breaker:                            types.DefaultCircuitBreaker,
+				beginBlockerExecutionBlockInterval: types.DefaultBeginBlockerExecutionBlockInterval,
+				collateralAuctionType:              types.DefaultCollateralAuctionType,
+				pegStabilityParams: types.PegStabilityParams{
+					types.NewPegStabilityParam("usdc", sdk.NewInt(6), sdk.NewInt64Coin("usdx", 3000000000000), sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.002")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "exceeds global debt limit",
+			},
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.globalDebtLimit, tc.args.collateralParams, tc.args.debtParam, tc.args.surplusThreshold, tc.args.surplusLot, tc.args.debtThreshold, tc.args.debtLot, tc.args.breaker, tc.args.beginBlockerExecutionBlockInterval)
+			params := types.NewParams(tc.args.globalDebtLimit, tc.args.collateralParams, tc.args.debtParam, tc.args.surplusThreshold, tc.args.surplusLot, tc.args.debtThreshold, tc.args.debtLot, tc.args.breaker, tc.args.beginBlockerExecutionBlockInterval, tc.args.collateralAuctionType, tc.args.pegStabilityParams)
 			err := params.Validate()
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err)
@@ -871,6 +1414,43 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 	}
 }
 
+func (suite *ParamsTestSuite) TestStabilityFeeModelCalculateStabilityFee() {
+	model := types.NewStabilityFeeModel(
+		sdk.MustNewDecFromStr("0.05"),
+		sdk.MustNewDecFromStr("0.1"),
+		sdk.MustNewDecFromStr("0.8"),
+		sdk.MustNewDecFromStr("2.0"),
+	)
+
+	testCases := []struct {
+		name        string
+		utilization sdk.Dec
+		expectedAPY sdk.Dec
+	}{
+		{"zero utilization", sdk.ZeroDec(), sdk.MustNewDecFromStr("1.05")},
+		{"below kink", sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("1.1")},
+		{"at kink", sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("1.13")},
+		{"above kink", sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("1.33")},
+		{"full utilization", sdk.OneDec(), sdk.MustNewDecFromStr("1.53")},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			fee, err := model.CalculateStabilityFee(tc.utilization)
+			suite.Require().NoError(err)
+
+			expectedFee, err := tc.expectedAPY.ApproxRoot(31536000)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedFee, fee)
+		})
+	}
+
+	fee, err := model.CalculateStabilityFee(sdk.ZeroDec())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.000000001547125958"), fee)
+}
+
 func TestParamsTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamsTestSuite))
 }
+
+func decPtr(d sdk.Dec) *sdk.Dec { return &d }
@@ -1,9 +1,14 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxCdpHealthHorizon is the longest horizon the cdp health query projects fees over, longer horizons can overflow the interest factor
+const MaxCdpHealthHorizon = 5 * 365 * 24 * time.Hour
+
 // QueryCdpsParams is the params for a filtered CDP query
 type QueryCdpsParams struct {
 	Page           int            `json:"page" yaml:"page"`
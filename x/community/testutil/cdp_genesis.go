@@ -19,6 +19,7 @@ func NewCDPGenState(cdc codec.JSONCodec, denom, asset string, liquidationRatio s
 			DebtAuctionThreshold:     cdptypes.DefaultDebtThreshold,
 			DebtAuctionLot:           cdptypes.DefaultDebtLot,
 			LiquidationBlockInterval: cdptypes.DefaultBeginBlockerExecutionBlockInterval,
+			CollateralAuctionType:    cdptypes.DefaultCollateralAuctionType,
 			CollateralParams: cdptypes.CollateralParams{
 				{
 					Denom:                            denom,
@@ -143,6 +143,7 @@ func (suite *Suite) SetupTest() {
 			),
 		},
 		sdk.NewDec(10),
+		hardtypes.DefaultCollateralAuctionType,
 	),
 		hardtypes.DefaultAccumulationTimes,
 		hardtypes.DefaultDeposits,
@@ -63,6 +63,7 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 			),
 		},
 		sdk.NewDec(10),
+		types.DefaultCollateralAuctionType,
 	)
 
 	deposits := types.Deposits{
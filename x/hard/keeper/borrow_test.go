@@ -540,6 +540,7 @@ func (suite *KeeperTestSuite) TestBorrow() {
 					types.NewMoneyMarket("xyz", types.NewBorrowLimit(false, sdk.NewDec(1), tc.setup.loanToValueBNB), "xyz:usd", sdkmath.NewInt(1), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 				},
 				sdk.NewDec(10),
+				types.DefaultCollateralAuctionType,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
 			)
@@ -693,6 +694,7 @@ func (suite *KeeperTestSuite) TestValidateBorrow() {
 					sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
 			},
 			sdk.NewDec(10),
+			types.DefaultCollateralAuctionType,
 		),
 		types.DefaultAccumulationTimes,
 		types.DefaultDeposits,
@@ -72,6 +72,7 @@ func NewHARDGenState(cdc codec.JSONCodec) app.GenesisState {
 				},
 			},
 			sdk.MustNewDecFromStr("10"),
+			types.DefaultCollateralAuctionType,
 		),
 		PreviousAccumulationTimes: types.GenesisAccumulationTimes{
 			types.NewGenesisAccumulationTime(
@@ -7,6 +7,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 	"github.com/kava-labs/kava/x/hard/types"
 )
 
@@ -194,7 +195,7 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 				}
 
 				// Start auction: bid = full borrow amount, lot = maxLotSize
-				_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+				_, err := k.startCollateralAuction(ctx, lot, bid, returnAddrs, weights, debt)
 				if err != nil {
 					return liquidatedCoins, err
 				}
@@ -245,7 +246,7 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 				}
 
 				// Start auction: bid = maxBid, lot = whole deposit amount
-				_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+				_, err := k.startCollateralAuction(ctx, lot, bid, returnAddrs, weights, debt)
 				if err != nil {
 					return liquidatedCoins, err
 				}
@@ -294,6 +295,14 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 	return liquidatedCoins, nil
 }
 
+// startCollateralAuction starts an auction for seized collateral of the type set in the collateral auction type param
+func (k Keeper) startCollateralAuction(ctx sdk.Context, lot, bid sdk.Coin, returnAddrs []sdk.AccAddress, weights []sdkmath.Int, debt sdk.Coin) (uint64, error) {
+	if k.GetCollateralAuctionType(ctx) == auctiontypes.DutchCollateralAuctionType {
+		return k.auctionKeeper.StartDutchCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+	}
+	return k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+}
+
 // IsWithinValidLtvRange compares a borrow and deposit to see if it's within a valid LTV range at current prices
 func (k Keeper) IsWithinValidLtvRange(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (bool, error) {
 	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
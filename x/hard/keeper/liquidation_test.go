@@ -32,6 +32,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 		expectedKeeperCoins        sdk.Coins              // coins keeper address should have after successfully liquidating position
 		expectedBorrowerCoins      sdk.Coins              // additional coins (if any) the borrower address should have after successfully liquidating position
 		expectedAuctions           []auctiontypes.Auction // the auctions we should expect to find have been started
+		collateralAuctionType      string                 // defaults to the standard collateral auction if empty
 	}
 
 	type errArgs struct {
@@ -59,6 +60,9 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 
 	lotReturns, _ := auctiontypes.NewWeightedAddresses([]sdk.AccAddress{borrower}, []sdkmath.Int{sdkmath.NewInt(100)})
 
+	// Dutch auctions start at liquidation time
+	liquidationTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC).Add(oneMonthDur)
+
 	testCases := []liqTest{
 		{
 			"valid: keeper liquidates borrow",
@@ -99,6 +103,48 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 				contains:   "",
 			},
 		},
+		{
+			"valid: keeper liquidates borrow with a dutch collateral auction",
+			args{
+				borrower:                   borrower,
+				keeper:                     keeper,
+				keeperRewardPercent:        sdk.MustNewDecFromStr("0.05"),
+				initialModuleCoins:         sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF))),
+				initialBorrowerCoins:       sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF))),
+				initialKeeperCoins:         sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100*KAVA_CF))),
+				depositCoins:               sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10*KAVA_CF))),
+				borrowCoins:                sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(8*KAVA_CF))),
+				liquidateAfter:             oneMonthDur,
+				expectedTotalSuppliedCoins: sdk.NewCoins(sdk.NewInt64Coin("ukava", 100004118)),
+				expectedTotalBorrowedCoins: nil,
+				expectedKeeperCoins:        sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100500020))),
+				expectedBorrowerCoins:      sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(98000001))), // initial - deposit + borrow + liquidation leftovers
+				expectedAuctions: []auctiontypes.Auction{
+					&auctiontypes.DutchCollateralAuction{
+						BaseAuction: auctiontypes.BaseAuction{
+							ID:              1,
+							Initiator:       "hard",
+							Lot:             sdk.NewInt64Coin("ukava", 9500390),
+							Bidder:          sdk.AccAddress(nil),
+							Bid:             sdk.NewInt64Coin("ukava", 0),
+							HasReceivedBids: false,
+							EndTime:         liquidationTime.Add(auctiontypes.DefaultDutchAuctionDuration),
+							MaxEndTime:      liquidationTime.Add(auctiontypes.DefaultDutchAuctionDuration),
+						},
+						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
+						MaxBid:            sdk.NewInt64Coin("ukava", 8004766),
+						LotReturns:        lotReturns,
+						StartPrice:        sdk.NewDec(8004766).QuoInt64(9500390).Mul(sdk.OneDec().Add(auctiontypes.DefaultDutchAuctionStartPremium)),
+						StartTime:         liquidationTime,
+					},
+				},
+				collateralAuctionType: auctiontypes.DutchCollateralAuctionType,
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
 		{
 			"valid: 0% keeper rewards",
 			args{
@@ -549,6 +595,11 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 				},
 			)
 
+			collateralAuctionType := types.DefaultCollateralAuctionType
+			if tc.args.collateralAuctionType != "" {
+				collateralAuctionType = tc.args.collateralAuctionType
+			}
+
 			// Hard module genesis state
 			hardGS := types.NewGenesisState(types.NewParams(
 				types.MoneyMarkets{
@@ -603,6 +654,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						tc.args.keeperRewardPercent), // Keeper Reward Percent
 				},
 				sdk.NewDec(10),
+				collateralAuctionType,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
 			)
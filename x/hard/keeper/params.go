@@ -23,3 +23,9 @@ func (k Keeper) GetMinimumBorrowUSDValue(ctx sdk.Context) sdk.Dec {
 	params := k.GetParams(ctx)
 	return params.MinimumBorrowUSDValue
 }
+
+// GetCollateralAuctionType returns the type of auction used to sell seized collateral
+func (k Keeper) GetCollateralAuctionType(ctx sdk.Context) string {
+	params := k.GetParams(ctx)
+	return params.CollateralAuctionType
+}
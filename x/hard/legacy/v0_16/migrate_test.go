@@ -47,8 +47,13 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 	actual := s.cdc.MustMarshalJSON(genstate)
 
 	file = filepath.Join("testdata", "v16-hard.json")
-	expected, err := ioutil.ReadFile(file)
+	data, err = ioutil.ReadFile(file)
 	s.Require().NoError(err)
+
+	// Round trip the expected json to include the defaults of fields added after v16
+	var expectedGenState v016hard.GenesisState
+	s.Require().NoError(s.cdc.UnmarshalJSON(data, &expectedGenState))
+	expected := s.cdc.MustMarshalJSON(&expectedGenState)
 	s.Require().JSONEq(string(expected), string(actual))
 }
 
@@ -0,0 +1,63 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v2hard "github.com/kava-labs/kava/x/hard/migrations/v2"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+func TestStoreMigrationAddsKeyTableIncludingNewParam(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	hardKey := sdk.NewKVStoreKey(types.ModuleName)
+	thardKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(hardKey, thardKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, hardKey, thardKey, types.ModuleName)
+
+	// Check param doesn't exist before
+	require.False(t, paramstore.Has(ctx, types.KeyCollateralAuctionType))
+
+	// Run migrations.
+	err := v2hard.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.KeyCollateralAuctionType))
+	// Assert the value is what we expect
+	result := types.DefaultCollateralAuctionType
+	paramstore.Get(ctx, types.KeyCollateralAuctionType, &result)
+	require.Equal(t, result, types.DefaultCollateralAuctionType)
+}
+
+func TestStoreMigrationSetsNewParamOnExistingKeyTable(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	hardKey := sdk.NewKVStoreKey(types.ModuleName)
+	thardKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(hardKey, thardKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, hardKey, thardKey, types.ModuleName)
+	paramstore.WithKeyTable(types.ParamKeyTable())
+
+	// expect it to have key table
+	require.True(t, paramstore.HasKeyTable())
+	// expect it to not have new param
+	require.False(t, paramstore.Has(ctx, types.KeyCollateralAuctionType))
+
+	// Run migrations.
+	err := v2hard.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.KeyCollateralAuctionType))
+
+	// Assert the value is what we expect
+	result := types.DefaultCollateralAuctionType
+	paramstore.Get(ctx, types.KeyCollateralAuctionType, &result)
+	require.Equal(t, result, types.DefaultCollateralAuctionType)
+}
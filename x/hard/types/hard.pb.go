@@ -30,6 +30,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	MoneyMarkets          MoneyMarkets                           `protobuf:"bytes,1,rep,name=money_markets,json=moneyMarkets,proto3,castrepeated=MoneyMarkets" json:"money_markets"`
 	MinimumBorrowUSDValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=minimum_borrow_usd_value,json=minimumBorrowUsdValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"minimum_borrow_usd_value"`
+	// collateral_auction_type is the type of auction used to sell liquidated deposits, either collateral or
+	// dutch_collateral
+	CollateralAuctionType string `protobuf:"bytes,3,opt,name=collateral_auction_type,json=collateralAuctionType,proto3" json:"collateral_auction_type,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -401,64 +404,66 @@ func init() {
 func init() { proto.RegisterFile("kava/hard/v1beta1/hard.proto", fileDescriptor_23a5de800263a2ff) }
 
 var fileDescriptor_23a5de800263a2ff = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbd, 0x8f, 0x1b, 0x45,
-	0x14, 0xf7, 0x9e, 0x3f, 0x92, 0x8c, 0xed, 0x23, 0xde, 0xdc, 0xa1, 0x4d, 0x04, 0xeb, 0xc8, 0x42,
-	0x70, 0x8d, 0x6d, 0x02, 0x82, 0x8a, 0xe6, 0x16, 0x0b, 0x38, 0x81, 0x25, 0x6b, 0x8f, 0x20, 0x25,
-	0x42, 0x5a, 0xc6, 0xbb, 0x2f, 0x77, 0x83, 0x3d, 0x3b, 0xab, 0x99, 0xb1, 0x63, 0x77, 0xb4, 0x34,
-	0x88, 0x3f, 0x82, 0x8a, 0x0e, 0xe9, 0xfe, 0x88, 0x2b, 0xa3, 0x54, 0x88, 0xc2, 0x80, 0xaf, 0xa3,
-	0xa6, 0xa2, 0x42, 0xf3, 0xe1, 0x8f, 0x5c, 0x1c, 0x29, 0xa7, 0x58, 0x88, 0x6a, 0x77, 0xe6, 0xbd,
-	0xf9, 0xbd, 0xdf, 0xfb, 0xcd, 0x9b, 0x99, 0x87, 0xde, 0x18, 0xe0, 0x31, 0x6e, 0x9f, 0x62, 0x9e,
-	0xb4, 0xc7, 0xf7, 0xfa, 0x20, 0xf1, 0x3d, 0x3d, 0x68, 0x65, 0x9c, 0x49, 0xe6, 0xd6, 0x94, 0xb5,
-	0xa5, 0x27, 0xac, 0xf5, 0x8e, 0x1f, 0x33, 0x41, 0x99, 0x68, 0xf7, 0xb1, 0x80, 0xe5, 0x92, 0x98,
-	0x91, 0xd4, 0x2c, 0xb9, 0x73, 0xdb, 0xd8, 0x23, 0x3d, 0x6a, 0x9b, 0x81, 0x35, 0xed, 0x9d, 0xb0,
-	0x13, 0x66, 0xe6, 0xd5, 0x9f, 0x99, 0x6d, 0xfc, 0xed, 0xa0, 0x52, 0x0f, 0x73, 0x4c, 0x85, 0xfb,
-	0x00, 0x55, 0x29, 0x4b, 0x61, 0x1a, 0x51, 0xcc, 0x07, 0x20, 0x85, 0xe7, 0xdc, 0xcd, 0x1f, 0x94,
-	0xdf, 0xf3, 0x5b, 0xcf, 0xd1, 0x68, 0x75, 0x95, 0x5f, 0x57, 0xbb, 0x05, 0x7b, 0xe7, 0xb3, 0x7a,
-	0xee, 0xe7, 0xdf, 0xeb, 0x95, 0xb5, 0x49, 0x11, 0x56, 0xe8, 0xda, 0xc8, 0xfd, 0xc1, 0x41, 0x1e,
-	0x25, 0x29, 0xa1, 0x23, 0x1a, 0xf5, 0x19, 0xe7, 0xec, 0x71, 0x34, 0x12, 0x49, 0x34, 0xc6, 0xc3,
-	0x11, 0x78, 0x3b, 0x77, 0x9d, 0x83, 0x1b, 0xc1, 0x7d, 0x05, 0xf3, 0xdb, 0xac, 0xfe, 0xf6, 0x09,
-	0x91, 0xa7, 0xa3, 0x7e, 0x2b, 0x66, 0xd4, 0xf2, 0xb7, 0x9f, 0xa6, 0x48, 0x06, 0x6d, 0x39, 0xcd,
-	0x40, 0xb4, 0x3a, 0x10, 0xcf, 0x67, 0xf5, 0xfd, 0xae, 0x41, 0x0c, 0x34, 0xe0, 0xfd, 0xe3, 0xce,
-	0x57, 0x0a, 0xee, 0xe9, 0x59, 0x13, 0xd9, 0xbc, 0x3b, 0x10, 0x87, 0xfb, 0xf4, 0x19, 0x27, 0x91,
-	0x68, 0xa7, 0xc6, 0x79, 0x01, 0x95, 0xd7, 0xf8, 0xba, 0x7b, 0xa8, 0x98, 0x40, 0xca, 0xa8, 0xe7,
-	0x28, 0x32, 0xa1, 0x19, 0xb8, 0x9f, 0xa2, 0x8a, 0x65, 0x3b, 0x24, 0x94, 0x48, 0xcd, 0x74, 0xb3,
-	0x20, 0x06, 0xfe, 0x0b, 0xe5, 0x15, 0x14, 0x54, 0x26, 0x61, 0xb9, 0xbf, 0x9a, 0x72, 0x3f, 0x44,
-	0xbb, 0x22, 0x63, 0xd2, 0x2a, 0x1b, 0x91, 0xc4, 0xcb, 0xeb, 0xa4, 0x6f, 0xce, 0x67, 0xf5, 0xca,
-	0x71, 0xc6, 0xa4, 0xa1, 0x71, 0xd4, 0x09, 0x2b, 0x62, 0x35, 0x4a, 0x5c, 0x82, 0x6a, 0x31, 0x4b,
-	0xc7, 0xc0, 0x05, 0x61, 0x69, 0xf4, 0x08, 0xc7, 0x92, 0x71, 0xaf, 0xa0, 0x97, 0x7e, 0x74, 0x05,
-	0xbd, 0x8e, 0x52, 0xb9, 0x26, 0xcb, 0x51, 0x2a, 0xc3, 0x9b, 0x2b, 0xd8, 0x4f, 0x34, 0xaa, 0xfb,
-	0x10, 0xdd, 0x22, 0xa9, 0x04, 0x0e, 0x42, 0x46, 0x1c, 0x4b, 0x88, 0x28, 0x4b, 0x60, 0xe8, 0x15,
-	0x75, 0xca, 0x6f, 0x6d, 0x48, 0xf9, 0xc8, 0x7a, 0x87, 0x58, 0x42, 0x57, 0xf9, 0xda, 0xc4, 0x6b,
-	0xe4, 0xb2, 0xc1, 0x8d, 0xd1, 0x2e, 0x07, 0x01, 0x7c, 0x0c, 0x8b, 0x1c, 0x4a, 0x57, 0xce, 0xa1,
-	0x03, 0xf1, 0xa5, 0xad, 0xad, 0x5a, 0x4c, 0x9b, 0xc0, 0x18, 0x79, 0x03, 0x80, 0x0c, 0x78, 0xc4,
-	0xe1, 0x31, 0xe6, 0x49, 0x94, 0x01, 0x8f, 0x21, 0x95, 0xf8, 0x04, 0xbc, 0x6b, 0x5b, 0x08, 0xf7,
-	0xba, 0x41, 0x0f, 0x35, 0x78, 0x6f, 0x89, 0xdd, 0xf8, 0x7e, 0x07, 0x95, 0xd7, 0xb6, 0xdf, 0xfd,
-	0x00, 0x55, 0x4f, 0xb1, 0x88, 0x28, 0x9e, 0xd8, 0xaa, 0x51, 0x25, 0x75, 0x3d, 0xa8, 0xfd, 0x35,
-	0xab, 0x3f, 0x6b, 0x08, 0xcb, 0xa7, 0x58, 0x74, 0xf1, 0xc4, 0x2c, 0xc3, 0xa8, 0x4a, 0xf1, 0x44,
-	0x9f, 0x90, 0x55, 0xb1, 0xbd, 0x2a, 0xe7, 0x8a, 0x85, 0x34, 0x21, 0xbe, 0x41, 0xd5, 0x21, 0xc3,
-	0x69, 0x24, 0x99, 0x3d, 0x79, 0xf9, 0x2d, 0x84, 0x28, 0x2b, 0xc8, 0x2f, 0x99, 0x39, 0x56, 0x3f,
-	0xe5, 0x51, 0xed, 0xb9, 0xba, 0x70, 0x19, 0xaa, 0xaa, 0xfb, 0xca, 0x94, 0x15, 0xce, 0xa6, 0xe6,
-	0x90, 0x05, 0x9f, 0x5f, 0xf9, 0xc4, 0x97, 0x03, 0x2c, 0x40, 0xe1, 0x1e, 0xf6, 0x1e, 0x5c, 0xa6,
-	0xd1, 0x5f, 0x98, 0xb2, 0xa9, 0x0b, 0xe8, 0x35, 0x1d, 0x90, 0x8e, 0x86, 0x92, 0x64, 0x43, 0x02,
-	0x7c, 0x2b, 0x6a, 0xee, 0x2a, 0xd0, 0xee, 0x12, 0xd3, 0xed, 0xa1, 0xc2, 0x80, 0xa4, 0x83, 0xad,
-	0xc8, 0xa8, 0x91, 0x14, 0xf1, 0x6f, 0x47, 0x34, 0x5b, 0x27, 0x5e, 0xd8, 0x06, 0x71, 0x05, 0xba,
-	0x22, 0xde, 0x38, 0xdb, 0x41, 0xd7, 0x3a, 0x90, 0x31, 0x41, 0xa4, 0xfb, 0x08, 0xdd, 0x48, 0xcc,
-	0x2f, 0xe3, 0x76, 0x63, 0x3e, 0xfb, 0x67, 0x56, 0x6f, 0xbe, 0x44, 0xa0, 0xc3, 0x38, 0x3e, 0x4c,
-	0x12, 0x0e, 0x42, 0x3c, 0x3d, 0x6b, 0xde, 0xb2, 0xf1, 0xec, 0x4c, 0x30, 0x95, 0x20, 0xc2, 0x15,
-	0xb4, 0x1b, 0xa3, 0x12, 0xa6, 0x6c, 0x94, 0xaa, 0xc2, 0x56, 0xcf, 0xca, 0xed, 0x96, 0x5d, 0xa0,
-	0x44, 0x5d, 0x5e, 0x2a, 0x1f, 0x33, 0x92, 0x06, 0xef, 0xda, 0x17, 0xe5, 0xe0, 0x25, 0x38, 0xa8,
-	0x05, 0x22, 0xb4, 0xd0, 0xee, 0xd7, 0xa8, 0x48, 0xd2, 0x04, 0x26, 0x5e, 0x5e, 0xc7, 0x78, 0x67,
-	0xc3, 0xb5, 0x75, 0x3c, 0xca, 0xb2, 0xe1, 0x74, 0x51, 0xa4, 0xe6, 0xee, 0x08, 0xde, 0xb4, 0x11,
-	0xf7, 0x37, 0x59, 0x45, 0x68, 0x40, 0x1b, 0xbf, 0xec, 0xa0, 0x92, 0x39, 0xe9, 0x6e, 0x82, 0xae,
-	0x9b, 0xfb, 0x1d, 0xb6, 0x2f, 0xda, 0x12, 0xf9, 0x7f, 0xa3, 0x99, 0x49, 0xfa, 0x45, 0x9a, 0x6d,
-	0xb2, 0x2e, 0x35, 0xfb, 0xce, 0x41, 0x7b, 0x9b, 0x44, 0x7d, 0xc1, 0x8b, 0x1b, 0xa2, 0xe2, 0x7a,
-	0x53, 0xf0, 0x6a, 0x65, 0x6f, 0xa0, 0x34, 0x85, 0x4d, 0x1c, 0xff, 0x43, 0x0a, 0x0c, 0x21, 0x2d,
-	0x7a, 0x4f, 0xf7, 0x75, 0x18, 0x15, 0x55, 0xcb, 0xb6, 0x68, 0xb0, 0xb6, 0xba, 0xab, 0x06, 0x39,
-	0xe8, 0x9c, 0xff, 0xe9, 0xe7, 0xce, 0xe7, 0xbe, 0xf3, 0x64, 0xee, 0x3b, 0x7f, 0xcc, 0x7d, 0xe7,
-	0xc7, 0x0b, 0x3f, 0xf7, 0xe4, 0xc2, 0xcf, 0xfd, 0x7a, 0xe1, 0xe7, 0x1e, 0xae, 0xe7, 0xa2, 0x76,
-	0xbb, 0x39, 0xc4, 0x7d, 0xa1, 0xff, 0xda, 0x13, 0xd3, 0x8d, 0x6a, 0xc8, 0x7e, 0x49, 0xf7, 0x88,
-	0xef, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x40, 0xda, 0xa7, 0xd8, 0xa7, 0x0a, 0x00, 0x00,
+	// 938 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0x8f, 0xff, 0xb6, 0x1d, 0xdb, 0xa1, 0x9e, 0x26, 0xb0, 0xad, 0xc0, 0xae, 0x2c, 0x04, 0xb9,
+	0xd8, 0xa6, 0x20, 0x7a, 0xe2, 0x92, 0xc5, 0x02, 0x22, 0xb0, 0x64, 0x6d, 0x5a, 0xa4, 0x56, 0x48,
+	0xcb, 0x78, 0xf7, 0x35, 0x59, 0xbc, 0xb3, 0xb3, 0x9a, 0x99, 0x75, 0xed, 0x1b, 0x57, 0x2e, 0x88,
+	0x0f, 0xc1, 0x09, 0x71, 0x41, 0xca, 0x87, 0xc8, 0xb1, 0xea, 0x09, 0x71, 0x30, 0xe0, 0xdc, 0xf8,
+	0x08, 0x9c, 0xd0, 0xfc, 0x89, 0xed, 0xa6, 0xae, 0xd4, 0xa8, 0x16, 0xea, 0x69, 0x77, 0xe6, 0xbd,
+	0xf7, 0x7b, 0xbf, 0xf7, 0x9b, 0x37, 0x7f, 0xd0, 0xdb, 0x23, 0x32, 0x26, 0xdd, 0x63, 0xc2, 0xc3,
+	0xee, 0xf8, 0xce, 0x10, 0x24, 0xb9, 0xa3, 0x07, 0x9d, 0x94, 0x33, 0xc9, 0x70, 0x5d, 0x59, 0x3b,
+	0x7a, 0xc2, 0x5a, 0x6f, 0x35, 0x02, 0x26, 0x28, 0x13, 0xdd, 0x21, 0x11, 0xb0, 0x08, 0x09, 0x58,
+	0x94, 0x98, 0x90, 0x5b, 0x37, 0x8d, 0xdd, 0xd7, 0xa3, 0xae, 0x19, 0x58, 0xd3, 0xce, 0x11, 0x3b,
+	0x62, 0x66, 0x5e, 0xfd, 0x99, 0xd9, 0xd6, 0xaf, 0x79, 0x54, 0x1e, 0x10, 0x4e, 0xa8, 0xc0, 0x0f,
+	0x50, 0x8d, 0xb2, 0x04, 0xa6, 0x3e, 0x25, 0x7c, 0x04, 0x52, 0x38, 0xb9, 0xdb, 0x85, 0xbd, 0xca,
+	0x87, 0x8d, 0xce, 0x73, 0x34, 0x3a, 0x7d, 0xe5, 0xd7, 0xd7, 0x6e, 0xee, 0xce, 0xe9, 0xac, 0xb9,
+	0xf5, 0xcb, 0x9f, 0xcd, 0xea, 0xca, 0xa4, 0xf0, 0xaa, 0x74, 0x65, 0x84, 0x7f, 0xcc, 0x21, 0x87,
+	0x46, 0x49, 0x44, 0x33, 0xea, 0x0f, 0x19, 0xe7, 0xec, 0xb1, 0x9f, 0x89, 0xd0, 0x1f, 0x93, 0x38,
+	0x03, 0x27, 0x7f, 0x3b, 0xb7, 0x77, 0xcd, 0xbd, 0xaf, 0x60, 0xfe, 0x98, 0x35, 0xdf, 0x3b, 0x8a,
+	0xe4, 0x71, 0x36, 0xec, 0x04, 0x8c, 0x5a, 0xfe, 0xf6, 0xd3, 0x16, 0xe1, 0xa8, 0x2b, 0xa7, 0x29,
+	0x88, 0x4e, 0x0f, 0x82, 0xf9, 0xac, 0xb9, 0xdb, 0x37, 0x88, 0xae, 0x06, 0xbc, 0x7f, 0xd8, 0xfb,
+	0x5a, 0xc1, 0x3d, 0x3d, 0x69, 0x23, 0x5b, 0x77, 0x0f, 0x02, 0x6f, 0x97, 0x3e, 0xe3, 0x24, 0x42,
+	0xed, 0x84, 0xef, 0xa2, 0xb7, 0x02, 0x16, 0xc7, 0x44, 0x02, 0x27, 0xb1, 0x4f, 0xb2, 0x40, 0x46,
+	0x2c, 0xf1, 0x15, 0xba, 0x53, 0x50, 0x74, 0xbc, 0xdd, 0xa5, 0x79, 0xdf, 0x58, 0xef, 0x4d, 0x53,
+	0x68, 0x9d, 0x16, 0x51, 0x65, 0xa5, 0x4e, 0xbc, 0x83, 0x4a, 0x21, 0x24, 0x8c, 0x3a, 0x39, 0x1d,
+	0x65, 0x06, 0xf8, 0x73, 0x54, 0xb5, 0x55, 0xc6, 0x11, 0x8d, 0xa4, 0xae, 0x70, 0xbd, 0x90, 0x86,
+	0xd6, 0x57, 0xca, 0xcb, 0x2d, 0x2a, 0x05, 0xbc, 0xca, 0x70, 0x39, 0x85, 0xef, 0xa2, 0x6d, 0x91,
+	0x32, 0x69, 0x57, 0xc4, 0x8f, 0x42, 0xc3, 0xce, 0xbd, 0x3e, 0x9f, 0x35, 0xab, 0x87, 0x29, 0x93,
+	0x86, 0xc6, 0x41, 0xcf, 0xab, 0x8a, 0xe5, 0x28, 0xc4, 0x11, 0xaa, 0x07, 0x2c, 0x19, 0x03, 0x17,
+	0xaa, 0xac, 0x47, 0x24, 0x90, 0x8c, 0x3b, 0x45, 0x1d, 0xfa, 0xc9, 0x25, 0x74, 0x3e, 0x48, 0xe4,
+	0x8a, 0x9c, 0x07, 0x89, 0xf4, 0xae, 0x2f, 0x61, 0x3f, 0xd3, 0xa8, 0xf8, 0x21, 0xba, 0x11, 0x25,
+	0x12, 0x38, 0x08, 0xe9, 0x73, 0x22, 0xc1, 0xa7, 0x2c, 0x84, 0xd8, 0x29, 0xe9, 0x92, 0xdf, 0x5d,
+	0x53, 0xf2, 0x81, 0xf5, 0xf6, 0x88, 0x84, 0xbe, 0xf2, 0xb5, 0x85, 0xd7, 0xa3, 0x8b, 0x06, 0x1c,
+	0xa0, 0x6d, 0x0e, 0x02, 0xf8, 0x18, 0xce, 0x6b, 0x28, 0x5f, 0xba, 0x86, 0x1e, 0x04, 0x17, 0x5a,
+	0xa2, 0x66, 0x31, 0x6d, 0x01, 0x63, 0xe4, 0x8c, 0x00, 0x52, 0xe0, 0x3e, 0x87, 0xc7, 0x84, 0x87,
+	0x7e, 0x0a, 0x3c, 0x80, 0x44, 0x92, 0x23, 0x70, 0xae, 0x6c, 0x20, 0xdd, 0x9b, 0x06, 0xdd, 0xd3,
+	0xe0, 0x83, 0x05, 0x76, 0xeb, 0x87, 0x3c, 0xaa, 0xac, 0x2c, 0x3f, 0xfe, 0x18, 0xd5, 0x8e, 0x89,
+	0xf0, 0x29, 0x99, 0xd8, 0xae, 0x51, 0x2d, 0x75, 0xd5, 0xad, 0xff, 0x33, 0x6b, 0x3e, 0x6b, 0xf0,
+	0x2a, 0xc7, 0x44, 0xf4, 0xc9, 0xc4, 0x84, 0x11, 0x54, 0xa3, 0x64, 0xa2, 0x77, 0xd6, 0xb2, 0xd9,
+	0x5e, 0x95, 0x73, 0xd5, 0x42, 0x9a, 0x14, 0xdf, 0xa2, 0x5a, 0xcc, 0x48, 0xe2, 0x4b, 0x66, 0x77,
+	0x6c, 0x61, 0x03, 0x29, 0x2a, 0x0a, 0xf2, 0x1e, 0xd3, 0xdb, 0xb1, 0xf5, 0x73, 0x01, 0xd5, 0x9f,
+	0xeb, 0x0b, 0xcc, 0x50, 0x4d, 0x9d, 0x73, 0xa6, 0xad, 0x48, 0x3a, 0x35, 0x9b, 0xcc, 0xfd, 0xf2,
+	0xd2, 0x27, 0x45, 0xc5, 0x25, 0x02, 0x14, 0xee, 0xfe, 0xe0, 0xc1, 0x45, 0x1a, 0xc3, 0x73, 0x53,
+	0x3a, 0xc5, 0x80, 0xde, 0xd0, 0x09, 0x69, 0x16, 0xcb, 0x28, 0x8d, 0x23, 0xe0, 0x1b, 0x51, 0x73,
+	0x5b, 0x81, 0xf6, 0x17, 0x98, 0x78, 0x80, 0x8a, 0xa3, 0x28, 0x19, 0x6d, 0x44, 0x46, 0x8d, 0xa4,
+	0x88, 0x7f, 0x97, 0xd1, 0x74, 0x95, 0x78, 0x71, 0x13, 0xc4, 0x15, 0xe8, 0x92, 0x78, 0xeb, 0x24,
+	0x8f, 0xae, 0xf4, 0x20, 0x65, 0x22, 0x92, 0xf8, 0x11, 0xba, 0x16, 0x9a, 0x5f, 0xc6, 0xed, 0xc2,
+	0x7c, 0xf1, 0xef, 0xac, 0xd9, 0x7e, 0x89, 0x44, 0xfb, 0x41, 0xb0, 0x1f, 0x86, 0x1c, 0x84, 0x78,
+	0x7a, 0xd2, 0xbe, 0x61, 0xf3, 0xd9, 0x19, 0x77, 0x2a, 0x41, 0x78, 0x4b, 0x68, 0x1c, 0xa0, 0x32,
+	0xa1, 0x2c, 0x4b, 0x54, 0x63, 0xab, 0xeb, 0xe8, 0x66, 0xc7, 0x06, 0x28, 0x51, 0x17, 0x87, 0xca,
+	0xa7, 0x2c, 0x4a, 0xdc, 0x0f, 0xec, 0x4d, 0xb4, 0xf7, 0x12, 0x1c, 0x54, 0x80, 0xf0, 0x2c, 0x34,
+	0xfe, 0x06, 0x95, 0xa2, 0x24, 0x84, 0x89, 0x53, 0xd0, 0x39, 0xde, 0x5f, 0x73, 0x6c, 0x1d, 0x66,
+	0x69, 0x1a, 0x4f, 0xcf, 0x9b, 0xd4, 0x9c, 0x1d, 0xee, 0x3b, 0x36, 0xe3, 0xee, 0x3a, 0xab, 0xf0,
+	0x0c, 0x68, 0xeb, 0xb7, 0x3c, 0x2a, 0x9b, 0x9d, 0x8e, 0x43, 0x74, 0xd5, 0x9c, 0xef, 0xb0, 0x79,
+	0xd1, 0x16, 0xc8, 0xaf, 0x8d, 0x66, 0xa6, 0xe8, 0x17, 0x69, 0xb6, 0xce, 0xba, 0xd0, 0xec, 0xfb,
+	0x1c, 0xda, 0x59, 0x27, 0xea, 0x0b, 0x6e, 0x5c, 0x0f, 0x95, 0x56, 0x1f, 0x13, 0xaf, 0xd6, 0xf6,
+	0x06, 0x4a, 0x53, 0x58, 0xc7, 0xf1, 0x7f, 0xa4, 0xc0, 0x10, 0xd2, 0xa2, 0x0f, 0xf4, 0x7b, 0x90,
+	0xa0, 0x92, 0x7a, 0xea, 0x9d, 0x3f, 0xcc, 0x36, 0xba, 0xaa, 0x06, 0xd9, 0xed, 0x9d, 0xfe, 0xdd,
+	0xd8, 0x3a, 0x9d, 0x37, 0x72, 0x4f, 0xe6, 0x8d, 0xdc, 0x5f, 0xf3, 0x46, 0xee, 0xa7, 0xb3, 0xc6,
+	0xd6, 0x93, 0xb3, 0xc6, 0xd6, 0xef, 0x67, 0x8d, 0xad, 0x87, 0xab, 0xb5, 0xa8, 0xd5, 0x6e, 0xc7,
+	0x64, 0x28, 0xf4, 0x5f, 0x77, 0x62, 0x5e, 0xb1, 0x1a, 0x72, 0x58, 0xd6, 0x6f, 0xcb, 0x8f, 0xfe,
+	0x1b, 0x00, 0x83, 0xaa, 0xf5, 0xf1, 0xdf, 0x0a, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -481,6 +486,13 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CollateralAuctionType) > 0 {
+		i -= len(m.CollateralAuctionType)
+		copy(dAtA[i:], m.CollateralAuctionType)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.CollateralAuctionType)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size := m.MinimumBorrowUSDValue.Size()
 		i -= size
@@ -969,6 +981,10 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinimumBorrowUSDValue.Size()
 	n += 1 + l + sovHard(uint64(l))
+	l = len(m.CollateralAuctionType)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
 	return n
 }
 
@@ -1230,6 +1246,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAuctionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralAuctionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHard(dAtA[iNdEx:])
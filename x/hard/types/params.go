@@ -7,14 +7,18 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 )
 
 // Parameter keys and default values
 var (
 	KeyMoneyMarkets              = []byte("MoneyMarkets")
 	KeyMinimumBorrowUSDValue     = []byte("MinimumBorrowUSDValue")
+	KeyCollateralAuctionType     = []byte("CollateralAuctionType")
 	DefaultMoneyMarkets          = MoneyMarkets{}
 	DefaultMinimumBorrowUSDValue = sdk.NewDec(10) // $10 USD minimum borrow value
+	DefaultCollateralAuctionType = auctiontypes.CollateralAuctionType
 	DefaultAccumulationTimes     = GenesisAccumulationTimes{}
 	DefaultTotalSupplied         = sdk.Coins{}
 	DefaultTotalBorrowed         = sdk.Coins{}
@@ -195,16 +199,17 @@ func (irm InterestRateModel) Equal(irmCompareTo InterestRateModel) bool {
 type InterestRateModels []InterestRateModel
 
 // NewParams returns a new params object
-func NewParams(moneyMarkets MoneyMarkets, minimumBorrowUSDValue sdk.Dec) Params {
+func NewParams(moneyMarkets MoneyMarkets, minimumBorrowUSDValue sdk.Dec, collateralAuctionType string) Params {
 	return Params{
 		MoneyMarkets:          moneyMarkets,
 		MinimumBorrowUSDValue: minimumBorrowUSDValue,
+		CollateralAuctionType: collateralAuctionType,
 	}
 }
 
 // DefaultParams returns default params for hard module
 func DefaultParams() Params {
-	return NewParams(DefaultMoneyMarkets, DefaultMinimumBorrowUSDValue)
+	return NewParams(DefaultMoneyMarkets, DefaultMinimumBorrowUSDValue, DefaultCollateralAuctionType)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -217,6 +222,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMoneyMarkets, &p.MoneyMarkets, validateMoneyMarketParams),
 		paramtypes.NewParamSetPair(KeyMinimumBorrowUSDValue, &p.MinimumBorrowUSDValue, validateMinimumBorrowUSDValue),
+		paramtypes.NewParamSetPair(KeyCollateralAuctionType, &p.CollateralAuctionType, validateCollateralAuctionType),
 	}
 }
 
@@ -226,6 +232,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateCollateralAuctionType(p.CollateralAuctionType); err != nil {
+		return err
+	}
+
 	return validateMoneyMarketParams(p.MoneyMarkets)
 }
 
@@ -250,3 +260,12 @@ func validateMoneyMarketParams(i interface{}) error {
 
 	return mm.Validate()
 }
+
+func validateCollateralAuctionType(i interface{}) error {
+	auctionType, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return auctiontypes.ValidateCollateralAuctionType(auctionType)
+}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 	"github.com/kava-labs/kava/x/hard/types"
 )
 
@@ -16,8 +17,9 @@ type ParamTestSuite struct {
 
 func (suite *ParamTestSuite) TestParamValidation() {
 	type args struct {
-		minBorrowVal sdk.Dec
-		mms          types.MoneyMarkets
+		minBorrowVal          sdk.Dec
+		mms                   types.MoneyMarkets
+		collateralAuctionType string
 	}
 	testCases := []struct {
 		name        string
@@ -28,8 +30,9 @@ func (suite *ParamTestSuite) TestParamValidation() {
 		{
 			name: "default",
 			args: args{
-				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
-				mms:          types.DefaultMoneyMarkets,
+				minBorrowVal:          types.DefaultMinimumBorrowUSDValue,
+				collateralAuctionType: types.DefaultCollateralAuctionType,
+				mms:                   types.DefaultMoneyMarkets,
 			},
 			expectPass:  true,
 			expectedErr: "",
@@ -37,7 +40,8 @@ func (suite *ParamTestSuite) TestParamValidation() {
 		{
 			name: "invalid: conversion factor < one",
 			args: args{
-				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				minBorrowVal:          types.DefaultMinimumBorrowUSDValue,
+				collateralAuctionType: types.DefaultCollateralAuctionType,
 				mms: types.MoneyMarkets{
 					{
 						Denom: "btcb",
@@ -57,10 +61,30 @@ func (suite *ParamTestSuite) TestParamValidation() {
 			expectPass:  false,
 			expectedErr: "conversion '0' factor must be ≥ one",
 		},
+		{
+			name: "dutch collateral auction type",
+			args: args{
+				minBorrowVal:          types.DefaultMinimumBorrowUSDValue,
+				mms:                   types.DefaultMoneyMarkets,
+				collateralAuctionType: auctiontypes.DutchCollateralAuctionType,
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: collateral auction type",
+			args: args{
+				minBorrowVal:          types.DefaultMinimumBorrowUSDValue,
+				mms:                   types.DefaultMoneyMarkets,
+				collateralAuctionType: "",
+			},
+			expectPass:  false,
+			expectedErr: "invalid collateral auction type",
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.mms, tc.args.minBorrowVal)
+			params := types.NewParams(tc.args.mms, tc.args.minBorrowVal, tc.args.collateralAuctionType)
 			err := params.Validate()
 			if tc.expectPass {
 				suite.NoError(err)
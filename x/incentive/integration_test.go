@@ -29,6 +29,7 @@ func NewCDPGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 			DebtAuctionThreshold:     cdptypes.DefaultDebtThreshold,
 			DebtAuctionLot:           cdptypes.DefaultDebtLot,
 			LiquidationBlockInterval: cdptypes.DefaultBeginBlockerExecutionBlockInterval,
+			CollateralAuctionType:    cdptypes.DefaultCollateralAuctionType,
 			CollateralParams: cdptypes.CollateralParams{
 				{
 					Denom:               "xrp",
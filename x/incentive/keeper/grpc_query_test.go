@@ -59,6 +59,7 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 				hardtypes.NewMoneyMarket("bnb", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "bnb:usd", sdkmath.NewInt(1000000), hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 			},
 			sdk.NewDec(10),
+			hardtypes.DefaultCollateralAuctionType,
 		),
 		hardtypes.DefaultAccumulationTimes,
 		hardtypes.DefaultDeposits,
@@ -35,6 +35,7 @@ func NewCDPGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 			DebtAuctionThreshold:     cdptypes.DefaultDebtThreshold,
 			DebtAuctionLot:           cdptypes.DefaultDebtLot,
 			LiquidationBlockInterval: cdptypes.DefaultBeginBlockerExecutionBlockInterval,
+			CollateralAuctionType:    cdptypes.DefaultCollateralAuctionType,
 			CollateralParams: cdptypes.CollateralParams{
 				{
 					Denom:               "xrp",
@@ -229,9 +230,13 @@ func NewSwapGenesisState(cdc codec.JSONCodec) app.GenesisState {
 		swaptypes.NewParams(
 			swaptypes.NewAllowedPools(swaptypes.NewAllowedPool("busd", "ukava")),
 			d("0.0"),
+			swaptypes.DefaultProtocolFeeFraction,
+			swaptypes.DefaultProtocolFeeSweepInterval,
 		),
 		swaptypes.DefaultPoolRecords,
 		swaptypes.DefaultShareRecords,
+		swaptypes.DefaultPriceObservations,
+		swaptypes.DefaultProtocolFeeRecords,
 	)
 	return app.GenesisState{
 		swaptypes.ModuleName: cdc.MustMarshalJSON(&genesis),
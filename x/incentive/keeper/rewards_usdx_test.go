@@ -149,6 +149,59 @@ func (suite *USDXIntegrationTests) TestSingleUserAccumulatesRewardsWithoutSyncin
 	suite.BalanceInEpsilon(user, cs(c(cdptypes.DefaultStableDenom, 1e8), c(types.USDXMintingRewardDenom, 3*1e6*1e6)), accuracy)
 }
 
+func (suite *USDXIntegrationTests) TestSingleUserAccumulatesRewardsAfterMigrating() {
+	user := suite.addrs[0]
+
+	authBuilder := app.NewAuthBankGenesisBuilder().
+		WithSimpleModuleAccount(kavadisttypes.ModuleName, cs(c(types.USDXMintingRewardDenom, 1e18))). // Fill kavadist with enough coins to pay out any reward
+		WithSimpleAccount(user, cs(c("bnb", 1e12), c("btc", 1e12)))                                   // give the user some coins
+
+	incentBuilder := testutil.NewIncentiveGenesisBuilder().
+		WithGenesisTime(suite.genesisTime).
+		WithMultipliers(types.MultipliersPerDenoms{{
+			Denom:       types.USDXMintingRewardDenom,
+			Multipliers: types.Multipliers{types.NewMultiplier("large", 12, d("1.0"))}, // keep payout at 1.0 to make maths easier
+		}}).
+		WithSimpleUSDXRewardPeriod("bnb-a", c(types.USDXMintingRewardDenom, 1e6)).
+		WithSimpleUSDXRewardPeriod("btc-a", c(types.USDXMintingRewardDenom, 1e6))
+
+	suite.SetApp()
+	suite.WithGenesisTime(suite.genesisTime)
+	suite.StartChain(
+		authBuilder.BuildMarshalled(suite.App.AppCodec()),
+		NewPricefeedGenStateMultiFromTime(suite.App.AppCodec(), suite.genesisTime),
+		NewCDPGenStateMulti(suite.App.AppCodec()),
+		incentBuilder.BuildMarshalled(suite.App.AppCodec()),
+	)
+
+	suite.NoError(
+		suite.DeliverMsgCreateCDP(user, c("bnb", 1e10), c(cdptypes.DefaultStableDenom, 1e9), "bnb-a"),
+	)
+	suite.NextBlockAfter(1e6 * time.Second) // about 12 days
+
+	// The user moves their debt to a btc cdp, which syncs the bnb rewards and starts the btc rewards.
+	suite.NoError(
+		suite.DeliverCDPMsgMigrate(user, "bnb-a", "btc-a", c("btc", 1e8)),
+	)
+	claim, found := suite.App.GetIncentiveKeeper().GetUSDXMintingClaim(suite.Ctx, user)
+	suite.Require().True(found)
+	globalIndex, found := suite.App.GetIncentiveKeeper().GetUSDXMintingRewardFactor(suite.Ctx, "btc-a")
+	suite.Require().True(found)
+	claimIndex, found := claim.RewardIndexes.Get("btc-a")
+	suite.Require().True(found)
+	suite.Equal(globalIndex, claimIndex)
+
+	suite.NextBlockAfter(1e6 * time.Second)
+
+	msg := types.NewMsgClaimUSDXMintingReward(user.String(), "large")
+	suite.Require().NoError(suite.DeliverIncentiveMsg(&msg))
+
+	// The user has had 100% of the bnb cdp debt, then 100% of the btc cdp debt, so they should receive all rewards of
+	// the bnb type for the first block and of the btc type for the second.
+	accuracy := 1e-18 // using a very high accuracy to flag future small calculation changes
+	suite.BalanceInEpsilon(user, cs(c("bnb", 1e12), c("btc", 1e12-1e8), c(cdptypes.DefaultStableDenom, 1e9), c(types.USDXMintingRewardDenom, 2*1e6*1e6)), accuracy)
+}
+
 func (suite *USDXIntegrationTests) TestReinstatingRewardParamsDoesNotTriggerOverPayments() {
 	userA := suite.addrs[0]
 	userB := suite.addrs[1]
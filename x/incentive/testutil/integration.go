@@ -299,6 +299,14 @@ func (suite *IntegrationTester) DeliverCDPMsgBorrow(owner sdk.AccAddress, collat
 	return err
 }
 
+func (suite *IntegrationTester) DeliverCDPMsgMigrate(owner sdk.AccAddress, collateralType, targetCollateralType string, collateral sdk.Coin) error {
+	msg := cdptypes.NewMsgMigrateCDP(owner, collateralType, targetCollateralType, collateral)
+	msgServer := cdpkeeper.NewMsgServerImpl(suite.App.GetCDPKeeper())
+
+	_, err := msgServer.MigrateCDP(sdk.WrapSDKContext(suite.Ctx), &msg)
+	return err
+}
+
 func (suite *IntegrationTester) DeliverMsgMintDerivative(
 	sender sdk.AccAddress,
 	validator sdk.ValAddress,
@@ -7,6 +7,17 @@ import (
 )
 
 // BeginBlocker publishes metrics at the start of each block.
-func BeginBlocker(ctx sdk.Context, metrics *types.Metrics) {
+func BeginBlocker(ctx sdk.Context, metrics *types.Metrics, pricefeedKeeper types.PricefeedKeeper) {
 	metrics.LatestBlockHeight.Set(float64(ctx.BlockHeight()))
+
+	for _, market := range pricefeedKeeper.GetMarkets(ctx) {
+		staleness := metrics.PricefeedStaleness.With("market_id", market.MarketID)
+		lastPostTime, found := pricefeedKeeper.GetLastPostTime(ctx, market.MarketID)
+		if !market.Active || !found {
+			// reset the staleness of inactive markets, rather than keep publishing the last value from before they were deactivated
+			staleness.Set(0)
+			continue
+		}
+		staleness.Set(ctx.BlockTime().Sub(lastPostTime).Seconds())
+	}
 }
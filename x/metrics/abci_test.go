@@ -2,6 +2,7 @@ package metrics_test
 
 import (
 	"testing"
+	"time"
 
 	kitmetrics "github.com/go-kit/kit/metrics"
 	"github.com/stretchr/testify/require"
@@ -12,6 +13,7 @@ import (
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/metrics"
 	"github.com/kava-labs/kava/x/metrics/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 type MockGauge struct {
@@ -22,10 +24,22 @@ func (mg *MockGauge) With(labelValues ...string) kitmetrics.Gauge { return mg }
 func (mg *MockGauge) Set(value float64)                           { mg.value = value }
 func (*MockGauge) Add(_ float64)                                  {}
 
-func ctxWithHeight(height int64) sdk.Context {
+// MockLabeledGauge records the value set for each label value
+type MockLabeledGauge struct {
+	values map[string]float64
+	label  string
+}
+
+func (mg *MockLabeledGauge) With(labelValues ...string) kitmetrics.Gauge {
+	return &MockLabeledGauge{values: mg.values, label: labelValues[len(labelValues)-1]}
+}
+func (mg *MockLabeledGauge) Set(value float64) { mg.values[mg.label] = value }
+func (*MockLabeledGauge) Add(_ float64)        {}
+
+func ctxWithHeight(height int64) (sdk.Context, app.TestApp) {
 	tApp := app.NewTestApp()
 	tApp.InitializeFromGenesisStates()
-	return tApp.NewContext(false, tmproto.Header{Height: height})
+	return tApp.NewContext(false, tmproto.Header{Height: height}), tApp
 }
 
 func TestBeginBlockEmitsLatestHeight(t *testing.T) {
@@ -34,12 +48,43 @@ func TestBeginBlockEmitsLatestHeight(t *testing.T) {
 		LatestBlockHeight: &gauge,
 	}
 
-	metrics.BeginBlocker(ctxWithHeight(1), myMetrics)
-	require.EqualValues(t, 1, gauge.value)
+	for _, height := range []int64{1, 100, 17e6} {
+		ctx, tApp := ctxWithHeight(height)
+		metrics.BeginBlocker(ctx, myMetrics, tApp.GetPriceFeedKeeper())
+		require.EqualValues(t, height, gauge.value)
+	}
+}
+
+func TestBeginBlockEmitsPricefeedStaleness(t *testing.T) {
+	gauge := MockLabeledGauge{values: make(map[string]float64)}
+	myMetrics := &types.Metrics{
+		LatestBlockHeight:  &MockGauge{},
+		PricefeedStaleness: &gauge,
+	}
+
+	ctx, tApp := ctxWithHeight(1)
+	ctx = ctx.WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	pricefeedKeeper := tApp.GetPriceFeedKeeper()
+	oracle := sdk.AccAddress("oracle")
+	pricefeedKeeper.SetParams(ctx, pricefeedtypes.NewParams([]pricefeedtypes.Market{
+		pricefeedtypes.NewMarket("kava:usd", "kava", "usd", []sdk.AccAddress{oracle}, true),
+		pricefeedtypes.NewMarket("btc:usd", "btc", "usd", []sdk.AccAddress{oracle}, true),
+		pricefeedtypes.NewMarket("xrp:usd", "xrp", "usd", []sdk.AccAddress{oracle}, false),
+	}, pricefeedtypes.DefaultPriceHistoryLength))
+	_, err := pricefeedKeeper.SetPrice(ctx, oracle, "kava:usd", sdk.OneDec(), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	_, err = pricefeedKeeper.SetPrice(ctx, oracle, "xrp:usd", sdk.OneDec(), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+
+	metrics.BeginBlocker(ctx.WithBlockTime(ctx.BlockTime().Add(90*time.Second)), myMetrics, pricefeedKeeper)
 
-	metrics.BeginBlocker(ctxWithHeight(100), myMetrics)
-	require.EqualValues(t, 100, gauge.value)
+	// markets without posted prices and inactive markets have no staleness
+	require.Equal(t, map[string]float64{"kava:usd": 90, "btc:usd": 0, "xrp:usd": 0}, gauge.values)
 
-	metrics.BeginBlocker(ctxWithHeight(17e6), myMetrics)
-	require.EqualValues(t, 17e6, gauge.value)
+	// the staleness of a deactivated market is reset
+	params := pricefeedKeeper.GetParams(ctx)
+	params.Markets[0].Active = false
+	pricefeedKeeper.SetParams(ctx, params)
+	metrics.BeginBlocker(ctx.WithBlockTime(ctx.BlockTime().Add(120*time.Second)), myMetrics, pricefeedKeeper)
+	require.Equal(t, map[string]float64{"kava:usd": 0, "btc:usd": 0, "xrp:usd": 0}, gauge.values)
 }
@@ -65,17 +65,24 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 // AppModule app module type
 type AppModule struct {
 	AppModuleBasic
-	metrics *types.Metrics
+	metrics         *types.Metrics
+	pricefeedKeeper types.PricefeedKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(telemetryOpts types.TelemetryOptions) AppModule {
+func NewAppModule(telemetryOpts types.TelemetryOptions, pricefeedKeeper types.PricefeedKeeper) AppModule {
 	return AppModule{
-		AppModuleBasic: AppModuleBasic{},
-		metrics:        types.NewMetrics(telemetryOpts),
+		AppModuleBasic:  AppModuleBasic{},
+		metrics:         types.NewMetrics(telemetryOpts),
+		pricefeedKeeper: pricefeedKeeper,
 	}
 }
 
+// Metrics returns the metrics exposed by the module
+func (am AppModule) Metrics() *types.Metrics {
+	return am.metrics
+}
+
 // Name module name
 func (am AppModule) Name() string {
 	return am.AppModuleBasic.Name()
@@ -102,7 +109,7 @@ func (am AppModule) ExportGenesis(_ sdk.Context, cdc codec.JSONCodec) json.RawMe
 
 // BeginBlock module begin-block
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
-	BeginBlocker(ctx, am.metrics)
+	BeginBlocker(ctx, am.metrics, am.pricefeedKeeper)
 }
 
 // EndBlock module end-block
@@ -0,0 +1,15 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// PricefeedKeeper defines the expected interface for the pricefeed keeper
+type PricefeedKeeper interface {
+	GetMarkets(ctx sdk.Context) pricefeedtypes.Markets
+	GetLastPostTime(ctx sdk.Context, marketID string) (time.Time, bool)
+}
@@ -3,4 +3,7 @@ package types
 const (
 	// Name of the module
 	ModuleName = "metrics"
+
+	// MetricsNamespace is the prometheus namespace of the metrics defined by kava modules
+	MetricsNamespace = "kava"
 )
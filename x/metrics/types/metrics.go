@@ -55,6 +55,14 @@ type Metrics struct {
 	// It should be removed when kava has been updated to CometBFT v0.38+.
 	// see https://github.com/cometbft/cometbft/blob/v0.38.0-rc3/blocksync/metrics.gen.go
 	LatestBlockHeight metrics.Gauge
+
+	// The number of stateful precompile function calls, labeled by precompile, function and result.
+	PrecompileCalls metrics.Counter
+	// The gas used by stateful precompile function calls, labeled by precompile and function.
+	PrecompileGasUsed metrics.Histogram
+
+	// The seconds since an oracle last posted a price for a pricefeed market, labeled by market.
+	PricefeedStaleness metrics.Gauge
 }
 
 // NewMetrics creates a new Metrics object based on whether or not prometheus instrumentation is enabled.
@@ -71,6 +79,7 @@ func PrometheusMetrics(opts TelemetryOptions) *Metrics {
 	for i := 0; i < len(opts.GlobalLabelsAndValues); i += 2 {
 		labels = append(labels, opts.GlobalLabelsAndValues[i])
 	}
+	precompileLabels := append(append([]string{}, labels...), "precompile", "function")
 	return &Metrics{
 		LatestBlockHeight: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: opts.CometBFTMetricsNamespace,
@@ -78,12 +87,34 @@ func PrometheusMetrics(opts TelemetryOptions) *Metrics {
 			Name:      "latest_block_height",
 			Help:      "The height of the latest block.",
 		}, labels).With(opts.GlobalLabelsAndValues...),
+		PrecompileCalls: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Subsystem: "precompile",
+			Name:      "calls_total",
+			Help:      "The number of stateful precompile function calls.",
+		}, append(precompileLabels, "result")).With(opts.GlobalLabelsAndValues...),
+		PrecompileGasUsed: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Subsystem: "precompile",
+			Name:      "gas_used",
+			Help:      "The gas used by stateful precompile function calls.",
+			Buckets:   stdprometheus.ExponentialBuckets(1_000, 2, 16),
+		}, precompileLabels).With(opts.GlobalLabelsAndValues...),
+		PricefeedStaleness: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Subsystem: "pricefeed",
+			Name:      "market_staleness_seconds",
+			Help:      "The seconds since an oracle last posted a price for a pricefeed market.",
+		}, append(append([]string{}, labels...), "market_id")).With(opts.GlobalLabelsAndValues...),
 	}
 }
 
 // NoopMetrics are a do-nothing placeholder used when prometheus instrumentation is not enabled.
 func NoopMetrics() *Metrics {
 	return &Metrics{
-		LatestBlockHeight: discard.NewGauge(),
+		LatestBlockHeight:  discard.NewGauge(),
+		PrecompileCalls:    discard.NewCounter(),
+		PrecompileGasUsed:  discard.NewHistogram(),
+		PricefeedStaleness: discard.NewGauge(),
 	}
 }
@@ -28,6 +28,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdRawPrices(),
 		GetCmdOracles(),
 		GetCmdMarkets(),
+		GetCmdOracleStats(),
+		GetCmdPriceHistory(),
 		GetCmdQueryParams(),
 	}
 
@@ -178,6 +180,103 @@ func GetCmdMarkets() *cobra.Command {
 	}
 }
 
+// Query oracle stats and price history flags
+const (
+	flagMarket     = "market"
+	flagFromHeight = "from-height"
+	flagToHeight   = "to-height"
+)
+
+// GetCmdOracleStats queries the reliability stats of an oracle
+func GetCmdOracleStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle-stats [oracle-address]",
+		Short: "get the miss and deviation stats of an oracle",
+		Long:  "Get the miss and deviation stats of an oracle for each market, or for a single market with the market flag.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			marketID, err := cmd.Flags().GetString(flagMarket)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryOracleStatsRequest{
+				OracleAddress: args[0],
+				MarketId:      marketID,
+			}
+
+			res, err := queryClient.OracleStats(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagMarket, "", "(optional) filter by market ID")
+
+	return cmd
+}
+
+// GetCmdPriceHistory queries the past current prices of a market
+func GetCmdPriceHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [marketID]",
+		Short: "get the most recent prices of a market",
+		Long:  "Get the most recent current prices of a market, oldest first, optionally between two block heights.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := cmd.Flags().GetInt64(flagFromHeight)
+			if err != nil {
+				return err
+			}
+			toHeight, err := cmd.Flags().GetInt64(flagToHeight)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryPriceHistoryRequest{
+				MarketId:   args[0],
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PriceHistory(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "price history")
+	cmd.Flags().Int64(flagFromHeight, 0, "(optional) first block height to get prices for")
+	cmd.Flags().Int64(flagToHeight, 0, "(optional) last block height to get prices for")
+
+	return cmd
+}
+
 // GetCmdQueryParams queries the pricefeed module parameters
 func GetCmdQueryParams() *cobra.Command {
 	return &cobra.Command{
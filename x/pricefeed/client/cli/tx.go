@@ -30,6 +30,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmds := []*cobra.Command{
 		GetCmdPostPrice(),
+		GetCmdPostPrices(),
 	}
 
 	for _, cmd := range cmds {
@@ -60,19 +61,58 @@ func GetCmdPostPrice() *cobra.Command {
 				return err
 			}
 
-			expiryInt, err := strconv.ParseInt(args[2], 10, 64)
+			expiry, err := parseExpiry(args[2])
 			if err != nil {
-				return fmt.Errorf("invalid expiry %s: %w", args[2], err)
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg := types.NewMsgPostPrice(from.String(), args[0], price, expiry)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
 			}
 
-			if expiryInt > types.MaxExpiry {
-				return fmt.Errorf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+// GetCmdPostPrices cli command for posting prices for several markets in one message.
+func GetCmdPostPrices() *cobra.Command {
+	return &cobra.Command{
+		Use:   "postprices [marketID] [price] [expiry] [[marketID] [price] [expiry]...]",
+		Short: "post the latest prices for several markets, each with a given expiry as a UNIX time",
+		Example: fmt.Sprintf("%s tx %s postprices bnb:usd 25 9999999999 btc:usd 40000 9999999999 --from validator",
+			version.AppName, types.ModuleName),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 || len(args)%3 != 0 {
+				return fmt.Errorf("requires a market id, price and expiry for each market, received %d args", len(args))
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
 			}
 
-			expiry := tmtime.Canonical(time.Unix(expiryInt, 0))
+			var prices types.PostPriceEntries
+			for i := 0; i < len(args); i += 3 {
+				price, err := sdk.NewDecFromStr(args[i+1])
+				if err != nil {
+					return err
+				}
+
+				expiry, err := parseExpiry(args[i+2])
+				if err != nil {
+					return err
+				}
+
+				prices = append(prices, types.NewPostPriceEntry(args[i], price, expiry))
+			}
 
 			from := clientCtx.GetFromAddress()
-			msg := types.NewMsgPostPrice(from.String(), args[0], price, expiry)
+			msg := types.NewMsgPostPrices(from.String(), prices)
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -81,3 +121,17 @@ func GetCmdPostPrice() *cobra.Command {
 		},
 	}
 }
+
+// parseExpiry parses an expiry UNIX time
+func parseExpiry(arg string) (time.Time, error) {
+	expiryInt, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expiry %s: %w", arg, err)
+	}
+
+	if expiryInt > types.MaxExpiry {
+		return time.Time{}, fmt.Errorf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry)
+	}
+
+	return tmtime.Canonical(time.Unix(expiryInt, 0)), nil
+}
@@ -38,6 +38,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 			panic(err)
 		}
 	}
+
+	// Replace the oracle stats recorded while setting the prices above with the genesis stats
+	for _, os := range k.GetAllOracleStats(ctx) {
+		k.DeleteOracleStats(ctx, os.MarketID, os.OracleAddress)
+	}
+	for _, os := range gs.OracleStats {
+		k.SetOracleStats(ctx, os)
+	}
+
+	// Likewise replace the price history recorded while setting the current prices
+	for _, market := range params.Markets {
+		k.DeletePriceHistory(ctx, market.MarketID)
+	}
+	for _, hp := range gs.PriceHistory {
+		k.AddHistoricalPrice(ctx, hp)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -51,5 +67,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		postedPrices = append(postedPrices, pp...)
 	}
 
-	return types.NewGenesisState(params, postedPrices)
+	oracleStats := k.GetAllOracleStats(ctx)
+	priceHistory := k.GetAllHistoricalPrices(ctx)
+
+	return types.NewGenesisState(params, postedPrices, oracleStats, priceHistory)
 }
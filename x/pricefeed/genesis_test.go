@@ -11,6 +11,7 @@ import (
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/pricefeed"
 	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -57,6 +58,23 @@ func (suite *GenesisTestSuite) TestInitExportGenState() {
 	suite.NoError(gs.VerboseEqual(exportedGs), "exported genesis should match init genesis")
 }
 
+func (suite *GenesisTestSuite) TestInitExportGenState_PriceHistory() {
+	gs := NewPricefeedGen()
+	gs.Params.PriceHistoryLength = 2
+	gs.PriceHistory = types.HistoricalPrices{
+		types.NewHistoricalPrice("btc:usd", sdk.MustNewDecFromStr("7900.00"), 1, tmtime.Now()),
+		types.NewHistoricalPrice("btc:usd", sdk.MustNewDecFromStr("8000.00"), 2, tmtime.Now()),
+		types.NewHistoricalPrice("xrp:usd", sdk.MustNewDecFromStr("0.25"), 2, tmtime.Now()),
+	}
+
+	suite.NotPanics(func() {
+		pricefeed.InitGenesis(suite.ctx, suite.keeper, gs)
+	})
+
+	exportedGs := pricefeed.ExportGenesis(suite.ctx, suite.keeper)
+	suite.NoError(gs.VerboseEqual(exportedGs), "exported genesis should match init genesis")
+}
+
 func (suite *GenesisTestSuite) TestParamPricesGenState() {
 	gs := NewPricefeedGen()
 
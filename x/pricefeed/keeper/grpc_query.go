@@ -6,7 +6,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/kava-labs/kava/x/pricefeed/types"
 )
@@ -120,3 +122,78 @@ func (s queryServer) Markets(c context.Context, req *types.QueryMarketsRequest)
 		Markets: markets,
 	}, nil
 }
+
+func (s queryServer) OracleStats(c context.Context, req *types.QueryOracleStatsRequest) (*types.QueryOracleStatsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	oracle, err := sdk.AccAddressFromBech32(req.OracleAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid oracle address")
+	}
+
+	statsList := types.OracleStatsResponses{}
+	if req.MarketId != "" {
+		if _, found := s.keeper.GetMarket(ctx, req.MarketId); !found {
+			return nil, status.Error(codes.NotFound, "invalid market ID")
+		}
+		if stats, found := s.keeper.GetOracleStats(ctx, req.MarketId, oracle); found {
+			statsList = append(statsList, stats.ToOracleStatsResponse(ctx.BlockHeight()))
+		}
+	} else {
+		s.keeper.IterateOracleStats(ctx, func(stats types.OracleStats) bool {
+			if stats.OracleAddress.Equals(oracle) {
+				statsList = append(statsList, stats.ToOracleStatsResponse(ctx.BlockHeight()))
+			}
+			return false
+		})
+	}
+
+	return &types.QueryOracleStatsResponse{
+		OracleStats: statsList,
+	}, nil
+}
+
+func (s queryServer) PriceHistory(c context.Context, req *types.QueryPriceHistoryRequest) (*types.QueryPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := s.keeper.GetMarket(ctx, req.MarketId); !found {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+	if req.ToHeight != 0 && req.ToHeight < req.FromHeight {
+		return nil, status.Error(codes.InvalidArgument, "to height cannot be before from height")
+	}
+
+	prices := types.HistoricalPriceResponses{}
+	historyStore := prefix.NewStore(ctx.KVStore(s.keeper.key), types.PriceHistoryIteratorKey(req.MarketId))
+
+	pageRes, err := query.FilteredPaginate(historyStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		height := int64(sdk.BigEndianToUint64(key))
+		if height < req.FromHeight || (req.ToHeight != 0 && height > req.ToHeight) {
+			return false, nil
+		}
+		if accumulate {
+			var price types.HistoricalPrice
+			if err := s.keeper.cdc.Unmarshal(value, &price); err != nil {
+				return false, err
+			}
+			prices = append(prices, price.ToHistoricalPriceResponse())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return &types.QueryPriceHistoryResponse{}, err
+	}
+
+	return &types.QueryPriceHistoryResponse{
+		PriceHistory: prices,
+		Pagination:   pageRes,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/pricefeed/keeper"
 	"github.com/kava-labs/kava/x/pricefeed/types"
@@ -46,7 +47,7 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 func (suite *grpcQueryTestSuite) setTestParams() {
 	params := types.NewParams([]types.Market{
 		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-	})
+	}, types.DefaultPriceHistoryLength)
 	suite.keeper.SetParams(suite.ctx, params)
 }
 
@@ -59,7 +60,7 @@ func (suite *grpcQueryTestSuite) TestGrpcParams() {
 		{"default params", types.DefaultParams(), true},
 		{"test params", types.NewParams([]types.Market{
 			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-		}), true},
+		}, types.DefaultPriceHistoryLength), true},
 	}
 
 	for _, tt := range tests {
@@ -121,7 +122,7 @@ func (suite *grpcQueryTestSuite) TestGrpcPrices_NoPriceSet() {
 	params := types.NewParams([]types.Market{
 		{MarketID: "tst:usd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
 		{MarketID: "other:usd", BaseAsset: "other", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-	})
+	}, types.DefaultPriceHistoryLength)
 	suite.keeper.SetParams(suite.ctx, params)
 
 	_, err := suite.keeper.SetPrice(
@@ -189,7 +190,7 @@ func (suite *grpcQueryTestSuite) TestGrpcRawPrices_InvalidMarket() {
 func (suite *grpcQueryTestSuite) TestGrpcOracles_Empty() {
 	params := types.NewParams([]types.Market{
 		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-	})
+	}, types.DefaultPriceHistoryLength)
 	suite.keeper.SetParams(suite.ctx, params)
 
 	res, err := suite.queryServer.Oracles(sdk.WrapSDKContext(suite.ctx), &types.QueryOraclesRequest{MarketId: "tstusd"})
@@ -198,7 +199,7 @@ func (suite *grpcQueryTestSuite) TestGrpcOracles_Empty() {
 
 	params = types.NewParams([]types.Market{
 		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: suite.addrs, Active: true},
-	})
+	}, types.DefaultPriceHistoryLength)
 	suite.keeper.SetParams(suite.ctx, params)
 
 	res, err = suite.queryServer.Oracles(sdk.WrapSDKContext(suite.ctx), &types.QueryOraclesRequest{MarketId: "tstusd"})
@@ -212,7 +213,7 @@ func (suite *grpcQueryTestSuite) TestGrpcOracles_Empty() {
 func (suite *grpcQueryTestSuite) TestGrpcOracles() {
 	params := types.NewParams([]types.Market{
 		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: suite.addrs, Active: true},
-	})
+	}, types.DefaultPriceHistoryLength)
 	suite.keeper.SetParams(suite.ctx, params)
 
 	res, err := suite.queryServer.Oracles(sdk.WrapSDKContext(suite.ctx), &types.QueryOraclesRequest{MarketId: "tstusd"})
@@ -231,7 +232,7 @@ func (suite *grpcQueryTestSuite) TestGrpcMarkets() {
 	params := types.NewParams([]types.Market{
 		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
 		{MarketID: "btcusd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-	})
+	}, types.DefaultPriceHistoryLength)
 	suite.keeper.SetParams(suite.ctx, params)
 
 	res, err := suite.queryServer.Markets(sdk.WrapSDKContext(suite.ctx), &types.QueryMarketsRequest{})
@@ -242,6 +243,98 @@ func (suite *grpcQueryTestSuite) TestGrpcMarkets() {
 	suite.NoError(res.Markets[1].VerboseEqual(params.Markets[1].ToMarketResponse()))
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcOracleStats() {
+	params := types.NewParams([]types.Market{
+		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: suite.addrs[:3], Active: true},
+		{MarketID: "btcusd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: suite.addrs[:3], Active: true},
+	}, types.DefaultPriceHistoryLength)
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.setTstPrice()
+
+	res, err := suite.queryServer.OracleStats(sdk.WrapSDKContext(suite.ctx), &types.QueryOracleStatsRequest{
+		OracleAddress: suite.strAddrs[0],
+		MarketId:      "tstusd",
+	})
+	suite.NoError(err)
+	suite.Equal(types.OracleStatsResponses{
+		types.NewOracleStats("tstusd", suite.addrs[0], 0, 0, suite.ctx.BlockTime()).ToOracleStatsResponse(suite.ctx.BlockHeight()),
+	}, res.OracleStats)
+
+	// stats for every market
+	suite.keeper.SetOracleStats(suite.ctx, types.NewOracleStats("btcusd", suite.addrs[0], 3, 1, suite.now))
+	res, err = suite.queryServer.OracleStats(sdk.WrapSDKContext(suite.ctx), &types.QueryOracleStatsRequest{
+		OracleAddress: suite.strAddrs[0],
+	})
+	suite.NoError(err)
+	suite.Len(res.OracleStats, 2)
+
+	// oracles without stats
+	res, err = suite.queryServer.OracleStats(sdk.WrapSDKContext(suite.ctx), &types.QueryOracleStatsRequest{
+		OracleAddress: suite.strAddrs[4],
+	})
+	suite.NoError(err)
+	suite.Empty(res.OracleStats)
+
+	_, err = suite.queryServer.OracleStats(sdk.WrapSDKContext(suite.ctx), &types.QueryOracleStatsRequest{
+		OracleAddress: suite.strAddrs[0],
+		MarketId:      "invalid",
+	})
+	suite.Equal("rpc error: code = NotFound desc = invalid market ID", err.Error())
+
+	_, err = suite.queryServer.OracleStats(sdk.WrapSDKContext(suite.ctx), &types.QueryOracleStatsRequest{
+		OracleAddress: "invalid",
+	})
+	suite.Equal("rpc error: code = InvalidArgument desc = invalid oracle address", err.Error())
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcPriceHistory() {
+	suite.setTestParams()
+	for height := int64(1); height <= 5; height++ {
+		suite.keeper.AddHistoricalPrice(suite.ctx, types.NewHistoricalPrice("tstusd", sdk.NewDec(height), height, suite.now))
+	}
+
+	res, err := suite.queryServer.PriceHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceHistoryRequest{MarketId: "tstusd"})
+	suite.NoError(err)
+	suite.Len(res.PriceHistory, 5)
+	suite.Equal(
+		types.NewHistoricalPrice("tstusd", sdk.NewDec(1), 1, suite.now).ToHistoricalPriceResponse(),
+		res.PriceHistory[0],
+	)
+
+	res, err = suite.queryServer.PriceHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceHistoryRequest{
+		MarketId:   "tstusd",
+		FromHeight: 2,
+		ToHeight:   4,
+	})
+	suite.NoError(err)
+	suite.Equal(types.HistoricalPriceResponses{
+		types.NewHistoricalPrice("tstusd", sdk.NewDec(2), 2, suite.now).ToHistoricalPriceResponse(),
+		types.NewHistoricalPrice("tstusd", sdk.NewDec(3), 3, suite.now).ToHistoricalPriceResponse(),
+		types.NewHistoricalPrice("tstusd", sdk.NewDec(4), 4, suite.now).ToHistoricalPriceResponse(),
+	}, res.PriceHistory)
+
+	res, err = suite.queryServer.PriceHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceHistoryRequest{
+		MarketId:   "tstusd",
+		FromHeight: 4,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.NoError(err)
+	suite.Equal(types.HistoricalPriceResponses{
+		types.NewHistoricalPrice("tstusd", sdk.NewDec(4), 4, suite.now).ToHistoricalPriceResponse(),
+	}, res.PriceHistory)
+	suite.NotNil(res.Pagination.NextKey)
+
+	_, err = suite.queryServer.PriceHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceHistoryRequest{
+		MarketId:   "tstusd",
+		FromHeight: 4,
+		ToHeight:   2,
+	})
+	suite.Equal("rpc error: code = InvalidArgument desc = to height cannot be before from height", err.Error())
+
+	_, err = suite.queryServer.PriceHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryPriceHistoryRequest{MarketId: "invalid"})
+	suite.Equal("rpc error: code = NotFound desc = invalid market ID", err.Error())
+}
+
 func (suite *grpcQueryTestSuite) setTstPrice() {
 	_, err := suite.keeper.SetPrice(
 		suite.ctx, suite.addrs[0], "tstusd",
@@ -76,127 +76,171 @@ func (k Keeper) SetPrice(
 
 	// Sets the raw price for a single oracle instead of an array of all oracle's raw prices
 	store.Set(types.RawPriceKey(marketID, oracle), k.cdc.MustMarshal(&newRawPrice))
+	k.recordPost(ctx, marketID, oracle)
 	return newRawPrice, nil
 }
 
 // SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs
 func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
-	_, ok := k.GetMarket(ctx, marketID)
-	if !ok {
-		return errorsmod.Wrap(types.ErrInvalidMarket, marketID)
+	params := k.GetParams(ctx)
+	for _, market := range params.Markets {
+		if market.MarketID == marketID {
+			if market.IsDerived() {
+				return k.updateDerivedPrice(ctx, market, activeMarketIDs(params.Markets), params.PriceHistoryLength)
+			}
+			return k.updateCurrentPrice(ctx, market, k.GetRawPrices(ctx, marketID), params.PriceHistoryLength)
+		}
 	}
-	// store current price
-	validPrevPrice := true
-	prevPrice, err := k.GetCurrentPrice(ctx, marketID)
-	if err != nil {
-		validPrevPrice = false
+	return errorsmod.Wrap(types.ErrInvalidMarket, marketID)
+}
+
+// SetCurrentPricesForAllMarkets updates the price of an asset to the median of all valid oracle inputs
+func (k Keeper) SetCurrentPricesForAllMarkets(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	orderedMarkets := types.Markets{}
+	marketPricesByID := make(map[string]types.PostedPrices)
+
+	for _, market := range params.Markets {
+		if market.Active && !market.IsDerived() {
+			orderedMarkets = append(orderedMarkets, market)
+			marketPricesByID[market.MarketID] = types.PostedPrices{}
+		}
 	}
 
-	prices := k.GetRawPrices(ctx, marketID)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.RawPriceFeedPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var postedPrice types.PostedPrice
+		k.cdc.MustUnmarshal(iterator.Value(), &postedPrice)
 
-	var notExpiredPrices []types.CurrentPrice
+		prices, found := marketPricesByID[postedPrice.MarketID]
+		if !found {
+			continue
+		}
+		marketPricesByID[postedPrice.MarketID] = append(prices, postedPrice)
+	}
+	iterator.Close()
+
+	for _, market := range orderedMarkets {
+		_ = k.updateCurrentPrice(ctx, market, marketPricesByID[market.MarketID], params.PriceHistoryLength)
+	}
+
+	// derived markets are updated after the medians, in order of their price sources
+	derivedMarkets, err := params.Markets.SortByPriceSources()
+	if err != nil {
+		k.Logger(ctx).Error("failed to sort markets by price sources", "err", err)
+		return
+	}
+	activeMarkets := activeMarketIDs(params.Markets)
+	for _, market := range derivedMarkets {
+		if market.Active && market.IsDerived() {
+			_ = k.updateDerivedPrice(ctx, market, activeMarkets, params.PriceHistoryLength)
+		}
+	}
+}
+
+// updateCurrentPrice sets the current price of a market to the median of its unexpired posted prices, excluding
+// prices outside the market's deviation band, and adds it to the market's price history. It returns an error if
+// there are no valid prices, or fewer than the market's min oracle count.
+func (k Keeper) updateCurrentPrice(ctx sdk.Context, market types.Market, postedPrices types.PostedPrices, priceHistoryLength uint64) error {
+	var notExpiredPrices types.PostedPrices
 	// filter out expired prices
-	for _, v := range prices {
-		if v.Expiry.After(ctx.BlockTime()) {
-			notExpiredPrices = append(notExpiredPrices, types.NewCurrentPrice(v.MarketID, v.Price))
+	for _, pp := range postedPrices {
+		if pp.Expiry.After(ctx.BlockTime()) {
+			notExpiredPrices = append(notExpiredPrices, pp)
 		}
 	}
+	k.recordMisses(ctx, market, notExpiredPrices)
+
+	// filter out prices too far from the previous price
+	notExpiredPrices = k.filterDeviatingPrices(ctx, market, notExpiredPrices)
 
 	if len(notExpiredPrices) == 0 {
 		// NOTE: The current price stored will continue storing the most recent (expired)
 		// price if this is not set.
 		// This zero's out the current price stored value for that market and ensures
 		// that CDP methods that GetCurrentPrice will return error.
-		k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
+		k.setCurrentPrice(ctx, market.MarketID, types.CurrentPrice{})
 		return types.ErrNoValidPrice
 	}
 
-	medianPrice := k.CalculateMedianPrice(notExpiredPrices)
-
-	// check case that market price was not set in genesis
-	if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
-		// only emit event if price has changed
+	// a price from too few oracles is treated the same as no price, so dependent modules pause
+	if uint64(len(notExpiredPrices)) < market.MinOracleCount {
+		k.setCurrentPrice(ctx, market.MarketID, types.CurrentPrice{})
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
-				types.EventTypeMarketPriceUpdated,
-				sdk.NewAttribute(types.AttributeMarketID, marketID),
-				sdk.NewAttribute(types.AttributeMarketPrice, medianPrice.String()),
+				types.EventTypeOracleQuorumNotMet,
+				sdk.NewAttribute(types.AttributeMarketID, market.MarketID),
+				sdk.NewAttribute(types.AttributeOracleCount, fmt.Sprintf("%d", len(notExpiredPrices))),
+				sdk.NewAttribute(types.AttributeMinOracleCount, fmt.Sprintf("%d", market.MinOracleCount)),
 			),
 		)
+		return errorsmod.Wrapf(types.ErrOracleQuorumNotMet, "%s has %d of %d", market.MarketID, len(notExpiredPrices), market.MinOracleCount)
 	}
 
-	currentPrice := types.NewCurrentPrice(marketID, medianPrice)
-	k.setCurrentPrice(ctx, marketID, currentPrice)
+	prices := make([]types.CurrentPrice, len(notExpiredPrices))
+	for i, pp := range notExpiredPrices {
+		prices[i] = types.NewCurrentPrice(pp.MarketID, pp.Price)
+	}
+	medianPrice := k.CalculateMedianPrice(prices)
 
+	k.updateMarketPrice(ctx, market.MarketID, medianPrice, priceHistoryLength)
 	return nil
 }
 
-// SetCurrentPricesForAllMarkets updates the price of an asset to the median of all valid oracle inputs
-func (k Keeper) SetCurrentPricesForAllMarkets(ctx sdk.Context) {
-	orderedMarkets := []string{}
-	marketPricesByID := make(map[string]types.CurrentPrices)
-
-	for _, market := range k.GetMarkets(ctx) {
-		if market.Active {
-			orderedMarkets = append(orderedMarkets, market.MarketID)
-			marketPricesByID[market.MarketID] = types.CurrentPrices{}
+// updateDerivedPrice sets the current price of a derived market to the product of the current prices of its price
+// sources, dividing by inverse sources, and adds it to the market's price history. It returns an error if any of the
+// sources is inactive or has no current price.
+func (k Keeper) updateDerivedPrice(ctx sdk.Context, market types.Market, activeMarkets map[string]bool, priceHistoryLength uint64) error {
+	derivedPrice := sdk.OneDec()
+	for _, source := range market.PriceSources {
+		sourcePrice, err := k.GetCurrentPrice(ctx, source.MarketID)
+		if !activeMarkets[source.MarketID] || err != nil {
+			// a derived price is only as recent as its sources, so it is unavailable while any of them are
+			k.setCurrentPrice(ctx, market.MarketID, types.CurrentPrice{})
+			return errorsmod.Wrapf(types.ErrNoValidPrice, "price source %s of %s", source.MarketID, market.MarketID)
+		}
+		if source.Inverse {
+			derivedPrice = derivedPrice.Quo(sourcePrice.Price)
+		} else {
+			derivedPrice = derivedPrice.Mul(sourcePrice.Price)
 		}
 	}
 
-	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.RawPriceFeedPrefix)
-	for ; iterator.Valid(); iterator.Next() {
-		var postedPrice types.PostedPrice
-		k.cdc.MustUnmarshal(iterator.Value(), &postedPrice)
+	k.updateMarketPrice(ctx, market.MarketID, derivedPrice, priceHistoryLength)
+	return nil
+}
 
-		prices, found := marketPricesByID[postedPrice.MarketID]
-		if !found {
-			continue
-		}
+// updateMarketPrice sets the current price of a market, emitting an event if it changed, and adds it to the market's
+// price history.
+func (k Keeper) updateMarketPrice(ctx sdk.Context, marketID string, price sdk.Dec, priceHistoryLength uint64) {
+	prevPrice, err := k.GetCurrentPrice(ctx, marketID)
 
-		// filter out expired prices
-		if postedPrice.Expiry.After(ctx.BlockTime()) {
-			marketPricesByID[postedPrice.MarketID] = append(prices, types.NewCurrentPrice(postedPrice.MarketID, postedPrice.Price))
-		}
+	// check case that market price was not set in genesis
+	if err == nil && !price.Equal(prevPrice.Price) {
+		// only emit event if price has changed
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMarketPriceUpdated,
+				sdk.NewAttribute(types.AttributeMarketID, marketID),
+				sdk.NewAttribute(types.AttributeMarketPrice, price.String()),
+			),
+		)
 	}
-	iterator.Close()
 
-	for _, marketID := range orderedMarkets {
-		// store current price
-		validPrevPrice := true
-		prevPrice, err := k.GetCurrentPrice(ctx, marketID)
-		if err != nil {
-			validPrevPrice = false
-		}
-
-		notExpiredPrices, _ := marketPricesByID[marketID]
-
-		if len(notExpiredPrices) == 0 {
-			// NOTE: The current price stored will continue storing the most recent (expired)
-			// price if this is not set.
-			// This zero's out the current price stored value for that market and ensures
-			// that CDP methods that GetCurrentPrice will return error.
-			k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
-			continue
-		}
+	currentPrice := types.NewCurrentPrice(marketID, price)
+	k.setCurrentPrice(ctx, marketID, currentPrice)
+	k.recordPrice(ctx, marketID, price, priceHistoryLength)
+}
 
-		medianPrice := k.CalculateMedianPrice(notExpiredPrices)
-
-		// check case that market price was not set in genesis
-		//if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
-		if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
-			// only emit event if price has changed
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					types.EventTypeMarketPriceUpdated,
-					sdk.NewAttribute(types.AttributeMarketID, marketID),
-					sdk.NewAttribute(types.AttributeMarketPrice, medianPrice.String()),
-				),
-			)
+// activeMarketIDs returns the set of the ids of the active markets
+func activeMarketIDs(markets types.Markets) map[string]bool {
+	active := make(map[string]bool, len(markets))
+	for _, market := range markets {
+		if market.Active {
+			active[market.MarketID] = true
 		}
-
-		currentPrice := types.NewCurrentPrice(marketID, medianPrice)
-		k.setCurrentPrice(ctx, marketID, currentPrice)
 	}
+	return active
 }
 
 func (k Keeper) setCurrentPrice(ctx sdk.Context, marketID string, currentPrice types.CurrentPrice) {
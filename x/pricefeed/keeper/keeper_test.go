@@ -240,6 +240,108 @@ func TestKeeper_ExpiredSetCurrentPrices(t *testing.T) {
 	require.ErrorIs(t, types.ErrNoValidPrice, err, "current prices should be invalid")
 }
 
+func TestKeeper_MinOracleCount(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+
+	market := types.NewMarket("tstusd", "tst", "usd", addrs, true)
+	market.MinOracleCount = 2
+	keeper.SetParams(ctx, types.NewParams([]types.Market{market}, types.DefaultPriceHistoryLength))
+
+	_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("0.33"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+
+	// a single oracle is below the quorum
+	err = keeper.SetCurrentPrices(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrOracleQuorumNotMet)
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeOracleQuorumNotMet,
+		sdk.NewAttribute(types.AttributeMarketID, "tstusd"),
+		sdk.NewAttribute(types.AttributeOracleCount, "1"),
+		sdk.NewAttribute(types.AttributeMinOracleCount, "2"),
+	))
+
+	_, err = keeper.SetPrice(ctx, addrs[1], "tstusd", sdk.MustNewDecFromStr("0.35"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.34"), price.Price)
+
+	// the price becomes unavailable again once an oracle's price expires
+	_, err = keeper.SetPrice(ctx, addrs[1], "tstusd", sdk.MustNewDecFromStr("0.35"), ctx.BlockTime().Add(time.Minute))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+}
+
+// TestKeeper_DerivedPrices tests deriving market prices from the current prices of other markets
+func TestKeeper_DerivedPrices(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+
+	hardUSD := types.NewMarket("hard:usd", "hard", "usd", nil, true)
+	hardUSD.PriceSources = types.PriceSources{
+		types.NewPriceSource("hard:kava", false),
+		types.NewPriceSource("kava:usd", false),
+	}
+	usdHard := types.NewMarket("usd:hard", "usd", "hard", nil, true)
+	usdHard.PriceSources = types.PriceSources{types.NewPriceSource("hard:usd", true)}
+	// derived markets are listed before their sources to check they are updated in order
+	keeper.SetParams(ctx, types.NewParams([]types.Market{
+		usdHard,
+		hardUSD,
+		types.NewMarket("hard:kava", "hard", "kava", addrs, true),
+		types.NewMarket("kava:usd", "kava", "usd", addrs, true),
+	}, types.DefaultPriceHistoryLength))
+
+	_, err := keeper.SetPrice(ctx, addrs[0], "hard:kava", sdk.MustNewDecFromStr("0.25"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	_, err = keeper.SetPrice(ctx, addrs[0], "kava:usd", sdk.MustNewDecFromStr("0.80"), ctx.BlockTime().Add(time.Minute))
+	require.NoError(t, err)
+
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+	price, err := keeper.GetCurrentPrice(ctx, "hard:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.20"), price.Price)
+	price, err = keeper.GetCurrentPrice(ctx, "usd:hard")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("5.00"), price.Price)
+	require.Len(t, keeper.GetPriceHistory(ctx, "hard:usd"), 1)
+
+	// derived prices become unavailable when any source is stale
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	keeper.SetCurrentPricesForAllMarkets(ctx)
+	_, err = keeper.GetCurrentPrice(ctx, "hard:usd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+	_, err = keeper.GetCurrentPrice(ctx, "usd:hard")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+	require.ErrorIs(t, keeper.SetCurrentPrices(ctx, "hard:usd"), types.ErrNoValidPrice)
+
+	// and when any source is inactive
+	_, err = keeper.SetPrice(ctx, addrs[0], "kava:usd", sdk.MustNewDecFromStr("0.80"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "kava:usd"))
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "hard:usd"))
+
+	params := keeper.GetParams(ctx)
+	params.Markets[2].Active = false
+	keeper.SetParams(ctx, params)
+	require.ErrorIs(t, keeper.SetCurrentPrices(ctx, "hard:usd"), types.ErrNoValidPrice)
+}
+
 func TestKeeper_SetCurrentPricesForAllMarkets_PriceUpdate(t *testing.T) {
 	testutil.SetCurrentPrices_PriceCalculations(t, func(ctx sdk.Context, keeper keeper.Keeper) {
 		keeper.SetCurrentPricesForAllMarkets(ctx)
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/pricefeed/types"
@@ -48,3 +49,46 @@ func (k msgServer) PostPrice(goCtx context.Context, msg *types.MsgPostPrice) (*t
 
 	return &types.MsgPostPriceResponse{}, nil
 }
+
+func (k msgServer) PostPrices(goCtx context.Context, msg *types.MsgPostPrices) (*types.MsgPostPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	// every price is checked before any are set, so the prices are posted all together or not at all
+	marketsByID := make(map[string]types.Market)
+	for _, market := range k.keeper.GetMarkets(ctx) {
+		marketsByID[market.MarketID] = market
+	}
+	for _, entry := range msg.Prices {
+		market, found := marketsByID[entry.MarketID]
+		if !found {
+			return nil, errorsmod.Wrap(types.ErrInvalidMarket, entry.MarketID)
+		}
+		if !market.HasOracle(from) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidOracle, "%s for market %s", from, entry.MarketID)
+		}
+		if !entry.Expiry.After(ctx.BlockTime()) {
+			return nil, errorsmod.Wrapf(types.ErrExpired, "price for market %s", entry.MarketID)
+		}
+	}
+
+	for _, entry := range msg.Prices {
+		if _, err := k.keeper.SetPrice(ctx, from, entry.MarketID, entry.Price, entry.Expiry); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From),
+		),
+	)
+
+	return &types.MsgPostPricesResponse{}, nil
+}
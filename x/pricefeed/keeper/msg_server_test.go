@@ -61,3 +61,96 @@ func TestKeeper_PostPrice(t *testing.T) {
 		})
 	}
 }
+
+func TestKeeper_PostPrices(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	k := tApp.GetPriceFeedKeeper()
+	msgSrv := keeper.NewMsgServerImpl(k)
+
+	k.SetParams(ctx, types.NewParams([]types.Market{
+		types.NewMarket("tstusd", "tst", "usd", addrs[:2], true),
+		types.NewMarket("tst2usd", "tst2", "usd", addrs[:1], true),
+	}, types.DefaultPriceHistoryLength))
+
+	now := ctx.BlockTime()
+	price := sdk.MustNewDecFromStr("0.5")
+
+	tests := []struct {
+		giveMsg    string
+		giveOracle sdk.AccAddress
+		givePrices types.PostPriceEntries
+		errorKind  error
+	}{
+		{
+			"authorized",
+			addrs[0],
+			types.PostPriceEntries{
+				types.NewPostPriceEntry("tstusd", price, now.Add(time.Hour)),
+				types.NewPostPriceEntry("tst2usd", price, now.Add(time.Hour)),
+			},
+			nil,
+		},
+		{
+			"expired",
+			addrs[0],
+			types.PostPriceEntries{
+				types.NewPostPriceEntry("tstusd", price, now.Add(time.Hour)),
+				types.NewPostPriceEntry("tst2usd", price, now.Add(-time.Hour)),
+			},
+			types.ErrExpired,
+		},
+		{
+			"invalid market",
+			addrs[0],
+			types.PostPriceEntries{
+				types.NewPostPriceEntry("tstusd", price, now.Add(time.Hour)),
+				types.NewPostPriceEntry("invalid", price, now.Add(time.Hour)),
+			},
+			types.ErrInvalidMarket,
+		},
+		{
+			"unauthorized for one market",
+			addrs[1],
+			types.PostPriceEntries{
+				types.NewPostPriceEntry("tstusd", price, now.Add(time.Hour)),
+				types.NewPostPriceEntry("tst2usd", price, now.Add(time.Hour)),
+			},
+			types.ErrInvalidOracle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.giveMsg, func(t *testing.T) {
+			ctx := ctx.WithEventManager(sdk.NewEventManager())
+			cacheCtx, _ := ctx.CacheContext()
+			msg := types.NewMsgPostPrices(tt.giveOracle.String(), tt.givePrices)
+			_, err := msgSrv.PostPrices(sdk.WrapSDKContext(cacheCtx), msg)
+
+			if tt.errorKind != nil {
+				require.ErrorIs(t, err, tt.errorKind)
+				// no prices are set when any of them are rejected
+				require.Empty(t, k.GetRawPrices(cacheCtx, "tstusd"))
+				require.Empty(t, k.GetRawPrices(cacheCtx, "tst2usd"))
+				return
+			}
+
+			require.NoError(t, err)
+			for _, entry := range tt.givePrices {
+				rawPrices := k.GetRawPrices(cacheCtx, entry.MarketID)
+				require.Equal(t, types.PostedPrices{
+					types.NewPostedPrice(entry.MarketID, tt.giveOracle, entry.Price, entry.Expiry),
+				}, rawPrices)
+				require.Contains(t, cacheCtx.EventManager().Events(), sdk.NewEvent(
+					types.EventTypeOracleUpdatedPrice,
+					sdk.NewAttribute(types.AttributeMarketID, entry.MarketID),
+					sdk.NewAttribute(types.AttributeOracle, tt.giveOracle.String()),
+					sdk.NewAttribute(types.AttributeMarketPrice, entry.Price.String()),
+					sdk.NewAttribute(types.AttributeExpiry, entry.Expiry.UTC().String()),
+				))
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// GetOracleStats returns the stats of an oracle for a market, or new empty stats if there are none.
+func (k Keeper) GetOracleStats(ctx sdk.Context, marketID string, oracle sdk.AccAddress) (types.OracleStats, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.OracleStatsKey(marketID, oracle))
+	if bz == nil {
+		return types.NewOracleStats(marketID, oracle, 0, 0, time.Time{}), false
+	}
+	var stats types.OracleStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats, true
+}
+
+// SetOracleStats sets the stats of an oracle for a market.
+func (k Keeper) SetOracleStats(ctx sdk.Context, stats types.OracleStats) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.OracleStatsKey(stats.MarketID, stats.OracleAddress), k.cdc.MustMarshal(&stats))
+}
+
+// DeleteOracleStats deletes the stats of an oracle for a market.
+func (k Keeper) DeleteOracleStats(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.OracleStatsKey(marketID, oracle))
+}
+
+// IterateOracleStats iterates over the oracle stats of all markets and performs a callback function
+func (k Keeper) IterateOracleStats(ctx sdk.Context, cb func(stats types.OracleStats) (stop bool)) {
+	k.iterateOracleStats(ctx, types.OracleStatsPrefix, cb)
+}
+
+// IterateOracleStatsByMarket iterates over the oracle stats of a market and performs a callback function
+func (k Keeper) IterateOracleStatsByMarket(ctx sdk.Context, marketID string, cb func(stats types.OracleStats) (stop bool)) {
+	k.iterateOracleStats(ctx, types.OracleStatsIteratorKey(marketID), cb)
+}
+
+func (k Keeper) iterateOracleStats(ctx sdk.Context, prefix []byte, cb func(stats types.OracleStats) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.OracleStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// GetAllOracleStats returns the oracle stats of all markets
+func (k Keeper) GetAllOracleStats(ctx sdk.Context) types.OracleStatsList {
+	var statsList types.OracleStatsList
+	k.IterateOracleStats(ctx, func(stats types.OracleStats) bool {
+		statsList = append(statsList, stats)
+		return false
+	})
+	return statsList
+}
+
+// GetLastPostTime returns the most recent time an oracle posted a price for a market.
+func (k Keeper) GetLastPostTime(ctx sdk.Context, marketID string) (time.Time, bool) {
+	var lastPostTime time.Time
+	k.IterateOracleStatsByMarket(ctx, marketID, func(stats types.OracleStats) bool {
+		if stats.LastPostTime.After(lastPostTime) {
+			lastPostTime = stats.LastPostTime
+		}
+		return false
+	})
+	return lastPostTime, !lastPostTime.IsZero()
+}
+
+// recordPost sets the last post time of an oracle for a market to the block time.
+func (k Keeper) recordPost(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	stats, _ := k.GetOracleStats(ctx, marketID, oracle)
+	stats.LastPostTime = ctx.BlockTime()
+	k.SetOracleStats(ctx, stats)
+}
+
+// recordMisses updates the miss streaks of the oracles of a market. The stats of an oracle are only written when it
+// starts or ends a miss streak, adding the blocks of the streak to its miss count when it ends.
+func (k Keeper) recordMisses(ctx sdk.Context, market types.Market, validPrices types.PostedPrices) {
+	posted := make(map[string]bool)
+	for _, pp := range validPrices {
+		posted[pp.OracleAddress.String()] = true
+	}
+	for _, oracle := range market.Oracles {
+		stats, _ := k.GetOracleStats(ctx, market.MarketID, oracle)
+		missing := !posted[oracle.String()]
+		switch {
+		case missing && stats.MissingSinceHeight == 0:
+			stats.MissingSinceHeight = ctx.BlockHeight()
+		case !missing && stats.MissingSinceHeight != 0:
+			stats.MissCount = stats.TotalMissCount(ctx.BlockHeight() - 1)
+			stats.MissingSinceHeight = 0
+		default:
+			continue
+		}
+		k.SetOracleStats(ctx, stats)
+	}
+}
+
+// filterDeviatingPrices discards the prices that differ from the previous price of the market by more than the
+// market's max price deviation. Without a previous price, the prices are compared to their median instead.
+func (k Keeper) filterDeviatingPrices(ctx sdk.Context, market types.Market, prices types.PostedPrices) types.PostedPrices {
+	if market.MaxPriceDeviation == nil || len(prices) == 0 {
+		return prices
+	}
+
+	var referencePrice sdk.Dec
+	if prevPrice, err := k.GetCurrentPrice(ctx, market.MarketID); err == nil {
+		referencePrice = prevPrice.Price
+	} else {
+		currentPrices := make([]types.CurrentPrice, len(prices))
+		for i, pp := range prices {
+			currentPrices[i] = types.NewCurrentPrice(pp.MarketID, pp.Price)
+		}
+		referencePrice = k.CalculateMedianPrice(currentPrices)
+	}
+
+	var accepted types.PostedPrices
+	for _, pp := range prices {
+		if market.IsPriceDeviating(pp.Price, referencePrice) {
+			k.recordDeviation(ctx, pp, referencePrice)
+		} else {
+			accepted = append(accepted, pp)
+		}
+	}
+	return accepted
+}
+
+// recordDeviation increments the deviation count of the oracle of a discarded price and emits an event. A posted price
+// is discarded in every block until it expires or is replaced, so it is only counted the first time.
+func (k Keeper) recordDeviation(ctx sdk.Context, pp types.PostedPrice, referencePrice sdk.Dec) {
+	stats, _ := k.GetOracleStats(ctx, pp.MarketID, pp.OracleAddress)
+	if stats.LastDeviationExpiry.Equal(pp.Expiry) {
+		return
+	}
+	stats.DeviationCount++
+	stats.LastDeviationExpiry = pp.Expiry
+	k.SetOracleStats(ctx, stats)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOraclePriceFiltered,
+			sdk.NewAttribute(types.AttributeMarketID, pp.MarketID),
+			sdk.NewAttribute(types.AttributeOracle, pp.OracleAddress.String()),
+			sdk.NewAttribute(types.AttributeMarketPrice, pp.Price.String()),
+			sdk.NewAttribute(types.AttributeReferencePrice, referencePrice.String()),
+		),
+	)
+}
@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_DeviatingPricesFiltered tests that prices outside a market's max price deviation are left out of the median
+func TestKeeper_DeviatingPricesFiltered(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+
+	market := types.NewMarket("tstusd", "tst", "usd", addrs, true)
+	maxDeviation := sdk.MustNewDecFromStr("0.1")
+	market.MaxPriceDeviation = &maxDeviation
+	keeper.SetParams(ctx, types.NewParams([]types.Market{market}, types.DefaultPriceHistoryLength))
+
+	expiry := ctx.BlockTime().Add(time.Hour)
+	postPrices := func(prices ...string) {
+		expiry = expiry.Add(time.Second)
+		for i, price := range prices {
+			_, err := keeper.SetPrice(ctx, addrs[i], "tstusd", sdk.MustNewDecFromStr(price), expiry)
+			require.NoError(t, err)
+		}
+	}
+	requireDeviationCounts := func(counts ...uint64) {
+		for i, count := range counts {
+			stats, _ := keeper.GetOracleStats(ctx, "tstusd", addrs[i])
+			require.Equal(t, count, stats.DeviationCount, "oracle %d", i)
+		}
+	}
+	filteredEvents := func() (events sdk.Events) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeOraclePriceFiltered {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+
+	// without a previous price, prices are compared to their median
+	postPrices("1.00", "1.00", "1.00", "1.50")
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.00"), price.Price)
+	requireDeviationCounts(0, 0, 0, 1)
+	require.Equal(t, sdk.Events{sdk.NewEvent(
+		types.EventTypeOraclePriceFiltered,
+		sdk.NewAttribute(types.AttributeMarketID, "tstusd"),
+		sdk.NewAttribute(types.AttributeOracle, addrs[3].String()),
+		sdk.NewAttribute(types.AttributeMarketPrice, sdk.MustNewDecFromStr("1.50").String()),
+		sdk.NewAttribute(types.AttributeReferencePrice, sdk.MustNewDecFromStr("1.00").String()),
+	)}, filteredEvents())
+
+	// a deviating price is left out in every block until it expires, but only counted once
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	price, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.00"), price.Price)
+	requireDeviationCounts(0, 0, 0, 1)
+	require.Empty(t, filteredEvents())
+
+	// prices are compared to the previous price, so deviating oracles cannot move the reference price
+	postPrices("1.05", "1.08", "1.20", "1.20")
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	price, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.065"), price.Price)
+	requireDeviationCounts(0, 0, 1, 2)
+	require.Len(t, filteredEvents(), 2)
+
+	// when every price deviates, the market has no price until the prices are compared to their median
+	postPrices("2.00", "2.00", "2.04", "2.04")
+	require.ErrorIs(t, keeper.SetCurrentPrices(ctx, "tstusd"), types.ErrNoValidPrice)
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+	requireDeviationCounts(1, 1, 2, 3)
+
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	price, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("2.02"), price.Price)
+	requireDeviationCounts(1, 1, 2, 3)
+}
+
+// TestKeeper_OracleMissesAndPosts tests recording oracle miss streaks and last post times
+func TestKeeper_OracleMissesAndPosts(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp := app.NewTestApp()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, tmprototypes.Header{Height: 1}).WithBlockTime(now)
+	keeper := tApp.GetPriceFeedKeeper()
+
+	keeper.SetParams(ctx, types.NewParams([]types.Market{
+		types.NewMarket("tstusd", "tst", "usd", addrs, true),
+	}, types.DefaultPriceHistoryLength))
+
+	_, found := keeper.GetLastPostTime(ctx, "tstusd")
+	require.False(t, found)
+
+	_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.OneDec(), now.Add(time.Minute))
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+
+	lastPostTime, found := keeper.GetLastPostTime(ctx, "tstusd")
+	require.True(t, found)
+	require.Equal(t, now, lastPostTime)
+
+	stats, _ := keeper.GetOracleStats(ctx, "tstusd", addrs[0])
+	require.Equal(t, uint64(0), stats.TotalMissCount(1))
+	stats, found = keeper.GetOracleStats(ctx, "tstusd", addrs[1])
+	require.True(t, found)
+	require.Equal(t, int64(1), stats.MissingSinceHeight)
+	require.Equal(t, uint64(1), stats.TotalMissCount(1))
+
+	// expired prices count as misses, and a continuing miss streak is not written
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(now.Add(time.Hour))
+	require.ErrorIs(t, keeper.SetCurrentPrices(ctx, "tstusd"), types.ErrNoValidPrice)
+
+	stats, _ = keeper.GetOracleStats(ctx, "tstusd", addrs[0])
+	require.Equal(t, int64(2), stats.MissingSinceHeight)
+	require.Equal(t, uint64(1), stats.TotalMissCount(2))
+	require.Equal(t, now, stats.LastPostTime)
+	stats, _ = keeper.GetOracleStats(ctx, "tstusd", addrs[1])
+	require.Equal(t, int64(1), stats.MissingSinceHeight)
+	require.Equal(t, uint64(0), stats.MissCount)
+	require.Equal(t, uint64(2), stats.TotalMissCount(2))
+
+	// posting a price ends the miss streaks, adding them to the miss counts
+	ctx = ctx.WithBlockHeight(3)
+	for _, addr := range addrs {
+		_, err = keeper.SetPrice(ctx, addr, "tstusd", sdk.OneDec(), ctx.BlockTime().Add(time.Minute))
+		require.NoError(t, err)
+	}
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+
+	stats, _ = keeper.GetOracleStats(ctx, "tstusd", addrs[0])
+	require.Equal(t, types.NewOracleStats("tstusd", addrs[0], 1, 0, ctx.BlockTime()), stats)
+	stats, _ = keeper.GetOracleStats(ctx, "tstusd", addrs[1])
+	require.Equal(t, types.NewOracleStats("tstusd", addrs[1], 2, 0, ctx.BlockTime()), stats)
+	require.Equal(t, uint64(2), stats.TotalMissCount(3))
+}
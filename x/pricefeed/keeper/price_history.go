@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// AddHistoricalPrice adds a price to the price history of its market, deleting the oldest prices to keep up to
+// PriceHistoryLength of the most recent. A price at the same height as an existing price replaces it.
+func (k Keeper) AddHistoricalPrice(ctx sdk.Context, price types.HistoricalPrice) {
+	k.addHistoricalPrice(ctx, price, k.GetParams(ctx).PriceHistoryLength)
+}
+
+func (k Keeper) addHistoricalPrice(ctx sdk.Context, price types.HistoricalPrice, length uint64) {
+	store := ctx.KVStore(k.key)
+	count := k.getPriceHistoryCount(ctx, price.MarketID)
+
+	key := types.PriceHistoryKey(price.MarketID, price.Height)
+	if !store.Has(key) {
+		count++
+	}
+	store.Set(key, k.cdc.MustMarshal(&price))
+
+	// prices are keyed by height, so the oldest are deleted first
+	if count > length {
+		historyStore := prefix.NewStore(store, types.PriceHistoryIteratorKey(price.MarketID))
+		iterator := historyStore.Iterator(nil, nil)
+		var expiredKeys [][]byte
+		for ; iterator.Valid() && count > length; iterator.Next() {
+			expiredKeys = append(expiredKeys, iterator.Key())
+			count--
+		}
+		iterator.Close()
+		for _, key := range expiredKeys {
+			historyStore.Delete(key)
+		}
+	}
+
+	k.setPriceHistoryCount(ctx, price.MarketID, count)
+}
+
+// DeletePriceHistory deletes all the prices in the price history of a market.
+func (k Keeper) DeletePriceHistory(ctx sdk.Context, marketID string) {
+	store := ctx.KVStore(k.key)
+	historyStore := prefix.NewStore(store, types.PriceHistoryIteratorKey(marketID))
+	iterator := historyStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		historyStore.Delete(key)
+	}
+	store.Delete(types.PriceHistoryCountKey(marketID))
+}
+
+// GetPriceHistory returns the price history of a market, oldest first
+func (k Keeper) GetPriceHistory(ctx sdk.Context, marketID string) types.HistoricalPrices {
+	var prices types.HistoricalPrices
+	k.iterateHistoricalPrices(ctx, types.PriceHistoryIteratorKey(marketID), func(price types.HistoricalPrice) bool {
+		prices = append(prices, price)
+		return false
+	})
+	return prices
+}
+
+// IterateHistoricalPrices iterates over the price history of all markets and performs a callback function
+func (k Keeper) IterateHistoricalPrices(ctx sdk.Context, cb func(price types.HistoricalPrice) (stop bool)) {
+	k.iterateHistoricalPrices(ctx, types.PriceHistoryPrefix, cb)
+}
+
+func (k Keeper) iterateHistoricalPrices(ctx sdk.Context, prefix []byte, cb func(price types.HistoricalPrice) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.HistoricalPrice
+		k.cdc.MustUnmarshal(iterator.Value(), &price)
+		if cb(price) {
+			break
+		}
+	}
+}
+
+// GetAllHistoricalPrices returns the price history of all markets
+func (k Keeper) GetAllHistoricalPrices(ctx sdk.Context) types.HistoricalPrices {
+	var prices types.HistoricalPrices
+	k.IterateHistoricalPrices(ctx, func(price types.HistoricalPrice) bool {
+		prices = append(prices, price)
+		return false
+	})
+	return prices
+}
+
+// recordPrice adds a new current price of a market to its price history, keeping up to length of the most recent.
+func (k Keeper) recordPrice(ctx sdk.Context, marketID string, price sdk.Dec, length uint64) {
+	if length == 0 && k.getPriceHistoryCount(ctx, marketID) == 0 {
+		return
+	}
+	k.addHistoricalPrice(ctx, types.NewHistoricalPrice(marketID, price, ctx.BlockHeight(), ctx.BlockTime()), length)
+}
+
+func (k Keeper) getPriceHistoryCount(ctx sdk.Context, marketID string) uint64 {
+	bz := ctx.KVStore(k.key).Get(types.PriceHistoryCountKey(marketID))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setPriceHistoryCount(ctx sdk.Context, marketID string, count uint64) {
+	store := ctx.KVStore(k.key)
+	if count == 0 {
+		store.Delete(types.PriceHistoryCountKey(marketID))
+		return
+	}
+	store.Set(types.PriceHistoryCountKey(marketID), sdk.Uint64ToBigEndian(count))
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_PriceHistory tests keeping the most recent current prices of a market
+func TestKeeper_PriceHistory(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).WithBlockHeight(1).WithBlockTime(startTime)
+	keeper := tApp.GetPriceFeedKeeper()
+
+	keeper.SetParams(ctx, types.NewParams([]types.Market{
+		types.NewMarket("tstusd", "tst", "usd", addrs, true),
+		types.NewMarket("tst2usd", "tst2", "usd", addrs, true),
+	}, 3))
+
+	setPrice := func(height int64, price sdk.Dec) {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(startTime.Add(time.Duration(height) * time.Minute))
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", price, ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		keeper.SetCurrentPricesForAllMarkets(ctx)
+	}
+	historicalPrice := func(height int64, price sdk.Dec) types.HistoricalPrice {
+		return types.NewHistoricalPrice("tstusd", price, height, startTime.Add(time.Duration(height)*time.Minute))
+	}
+
+	for height := int64(1); height <= 5; height++ {
+		setPrice(height, sdk.NewDec(height))
+	}
+	// only the most recent prices are kept, and markets without prices have no history
+	require.Equal(t, types.HistoricalPrices{
+		historicalPrice(3, sdk.NewDec(3)),
+		historicalPrice(4, sdk.NewDec(4)),
+		historicalPrice(5, sdk.NewDec(5)),
+	}, keeper.GetPriceHistory(ctx, "tstusd"))
+	require.Empty(t, keeper.GetPriceHistory(ctx, "tst2usd"))
+
+	// updating the price again in the same block replaces the price
+	setPrice(5, sdk.NewDec(6))
+	require.Equal(t, types.HistoricalPrices{
+		historicalPrice(3, sdk.NewDec(3)),
+		historicalPrice(4, sdk.NewDec(4)),
+		historicalPrice(5, sdk.NewDec(6)),
+	}, keeper.GetPriceHistory(ctx, "tstusd"))
+
+	// reducing the length prunes the oldest prices
+	params := keeper.GetParams(ctx)
+	params.PriceHistoryLength = 1
+	keeper.SetParams(ctx, params)
+	setPrice(6, sdk.NewDec(7))
+	require.Equal(t, types.HistoricalPrices{historicalPrice(6, sdk.NewDec(7))}, keeper.GetPriceHistory(ctx, "tstusd"))
+
+	// a zero length disables price history
+	params.PriceHistoryLength = 0
+	keeper.SetParams(ctx, params)
+	setPrice(7, sdk.NewDec(8))
+	require.Empty(t, keeper.GetPriceHistory(ctx, "tstusd"))
+	setPrice(8, sdk.NewDec(9))
+	require.Empty(t, keeper.GetAllHistoricalPrices(ctx))
+}
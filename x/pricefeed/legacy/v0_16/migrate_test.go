@@ -213,8 +213,13 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 	// v16 pricefeed json should be the same as v15 but with IBC markets added
 	actual := s.cdc.MustMarshalJSON(genstate)
 
+	// Round trip the expected json to include the defaults of fields added after v16
+	var expectedGenState v016pricefeed.GenesisState
+	s.Require().NoError(s.cdc.UnmarshalJSON([]byte(expectedV16Params), &expectedGenState))
+	expected := s.cdc.MustMarshalJSON(&expectedGenState)
+
 	s.Require().NoError(err)
-	s.Require().JSONEq(expectedV16Params, string(actual))
+	s.Require().JSONEq(string(expected), string(actual))
 }
 
 func (s *migrateTestSuite) TestMigrate_Params() {
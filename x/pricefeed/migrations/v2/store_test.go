@@ -0,0 +1,63 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v2pricefeed "github.com/kava-labs/kava/x/pricefeed/migrations/v2"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func TestStoreMigrationAddsKeyTableIncludingNewParam(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	pricefeedKey := sdk.NewKVStoreKey(types.ModuleName)
+	tpricefeedKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(pricefeedKey, tpricefeedKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, pricefeedKey, tpricefeedKey, types.ModuleName)
+
+	// Check param doesn't exist before
+	require.False(t, paramstore.Has(ctx, types.KeyPriceHistoryLength))
+
+	// Run migrations.
+	err := v2pricefeed.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new param is set.
+	require.True(t, paramstore.Has(ctx, types.KeyPriceHistoryLength))
+	// Assert the value is what we expect
+	var length uint64
+	paramstore.Get(ctx, types.KeyPriceHistoryLength, &length)
+	require.Equal(t, types.DefaultPriceHistoryLength, length)
+}
+
+func TestStoreMigrationSetsNewParamOnExistingKeyTable(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	pricefeedKey := sdk.NewKVStoreKey(types.ModuleName)
+	tpricefeedKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(pricefeedKey, tpricefeedKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, pricefeedKey, tpricefeedKey, types.ModuleName)
+	paramstore.WithKeyTable(types.ParamKeyTable())
+
+	// expect it to have key table
+	require.True(t, paramstore.HasKeyTable())
+	// expect it to not have new param
+	require.False(t, paramstore.Has(ctx, types.KeyPriceHistoryLength))
+
+	// Run migrations.
+	err := v2pricefeed.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new param is set.
+	require.True(t, paramstore.Has(ctx, types.KeyPriceHistoryLength))
+
+	// Assert the value is what we expect
+	var length uint64
+	paramstore.Get(ctx, types.KeyPriceHistoryLength, &length)
+	require.Equal(t, types.DefaultPriceHistoryLength, length)
+}
@@ -17,4 +17,6 @@ var (
 	ErrInvalidOracle = errorsmod.Register(ModuleName, 6, "oracle does not exist or not authorized")
 	// ErrAssetNotFound error for not found asset
 	ErrAssetNotFound = errorsmod.Register(ModuleName, 7, "asset not found")
+	// ErrOracleQuorumNotMet error for markets with fewer valid prices than their min oracle count
+	ErrOracleQuorumNotMet = errorsmod.Register(ModuleName, 8, "not enough oracles have posted valid prices")
 )
@@ -2,13 +2,18 @@ package types
 
 // Pricefeed module event types
 const (
-	EventTypeMarketPriceUpdated = "market_price_updated"
-	EventTypeOracleUpdatedPrice = "oracle_updated_price"
-	EventTypeNoValidPrices      = "no_valid_prices"
+	EventTypeMarketPriceUpdated  = "market_price_updated"
+	EventTypeOracleUpdatedPrice  = "oracle_updated_price"
+	EventTypeNoValidPrices       = "no_valid_prices"
+	EventTypeOraclePriceFiltered = "oracle_price_filtered"
+	EventTypeOracleQuorumNotMet  = "oracle_quorum_not_met"
 
-	AttributeValueCategory = ModuleName
-	AttributeMarketID      = "market_id"
-	AttributeMarketPrice   = "market_price"
-	AttributeOracle        = "oracle"
-	AttributeExpiry        = "expiry"
+	AttributeValueCategory  = ModuleName
+	AttributeMarketID       = "market_id"
+	AttributeMarketPrice    = "market_price"
+	AttributeOracle         = "oracle"
+	AttributeExpiry         = "expiry"
+	AttributeReferencePrice = "reference_price"
+	AttributeOracleCount    = "oracle_count"
+	AttributeMinOracleCount = "min_oracle_count"
 )
@@ -1,10 +1,12 @@
 package types
 
 // NewGenesisState creates a new genesis state for the pricefeed module
-func NewGenesisState(p Params, pp []PostedPrice) GenesisState {
+func NewGenesisState(p Params, pp []PostedPrice, os []OracleStats, hp []HistoricalPrice) GenesisState {
 	return GenesisState{
 		Params:       p,
 		PostedPrices: pp,
+		OracleStats:  os,
+		PriceHistory: hp,
 	}
 }
 
@@ -13,6 +15,8 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		[]PostedPrice{},
+		[]OracleStats{},
+		[]HistoricalPrice{},
 	)
 }
 
@@ -23,5 +27,13 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.PostedPrices.Validate()
+	if err := gs.PostedPrices.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.OracleStats.Validate(); err != nil {
+		return err
+	}
+
+	return gs.PriceHistory.Validate()
 }
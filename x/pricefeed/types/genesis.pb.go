@@ -26,8 +26,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the pricefeed module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params       Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PostedPrices PostedPrices `protobuf:"bytes,2,rep,name=posted_prices,json=postedPrices,proto3,castrepeated=PostedPrices" json:"posted_prices"`
+	Params       Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PostedPrices PostedPrices     `protobuf:"bytes,2,rep,name=posted_prices,json=postedPrices,proto3,castrepeated=PostedPrices" json:"posted_prices"`
+	OracleStats  OracleStatsList  `protobuf:"bytes,3,rep,name=oracle_stats,json=oracleStats,proto3,castrepeated=OracleStatsList" json:"oracle_stats"`
+	PriceHistory HistoricalPrices `protobuf:"bytes,4,rep,name=price_history,json=priceHistory,proto3,castrepeated=HistoricalPrices" json:"price_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +79,20 @@ func (m *GenesisState) GetPostedPrices() PostedPrices {
 	return nil
 }
 
+func (m *GenesisState) GetOracleStats() OracleStatsList {
+	if m != nil {
+		return m.OracleStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceHistory() HistoricalPrices {
+	if m != nil {
+		return m.PriceHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.pricefeed.v1beta1.GenesisState")
 }
@@ -86,24 +102,29 @@ func init() {
 }
 
 var fileDescriptor_fffec798191784d2 = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x2f, 0x28, 0xca, 0x4c, 0x4e, 0x4d, 0x4b, 0x4d, 0x4d, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x03, 0xa9, 0xd2, 0x83, 0xab, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x94, 0x70, 0x98, 0x59, 0x5c, 0x92, 0x5f, 0x94,
-	0x0a, 0x51, 0xa3, 0xb4, 0x86, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x47, 0x70, 0x49, 0x62, 0x49, 0xaa,
-	0x90, 0x0d, 0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7,
-	0x91, 0x9c, 0x1e, 0x76, 0x3b, 0xf5, 0x02, 0xc0, 0xaa, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08,
-	0x82, 0xea, 0x11, 0x8a, 0xe3, 0xe2, 0x2d, 0xc8, 0x2f, 0x2e, 0x49, 0x4d, 0x89, 0x07, 0x6b, 0x28,
-	0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc6, 0x69, 0x08, 0x58, 0x71, 0x00, 0x48, 0xdc,
-	0x49, 0x04, 0x64, 0xd2, 0xaa, 0xfb, 0xf2, 0x3c, 0x48, 0x82, 0xc5, 0x41, 0x3c, 0x05, 0x48, 0x3c,
-	0x27, 0xdf, 0x07, 0x0f, 0xe5, 0x18, 0x57, 0x3c, 0x92, 0x63, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
-	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc6, 0x63, 0x39, 0x86, 0x28, 0xed, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c,
-	0x7d, 0x90, 0xa5, 0xba, 0x39, 0x89, 0x49, 0xc5, 0x60, 0x96, 0x7e, 0x05, 0x52, 0x58, 0x94, 0x54,
-	0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x03, 0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xf3,
-	0x1e, 0xef, 0x7e, 0x01, 0x00, 0x00,
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4e, 0xf2, 0x40,
+	0x14, 0x85, 0x3b, 0x40, 0x58, 0x94, 0x92, 0xff, 0x4f, 0x43, 0xb4, 0x61, 0x31, 0x10, 0x34, 0x91,
+	0xc4, 0xd8, 0x06, 0xdc, 0xba, 0xea, 0x46, 0x17, 0x1a, 0x09, 0xee, 0x5c, 0xd8, 0x4c, 0xcb, 0x50,
+	0x26, 0x82, 0x33, 0xe9, 0x1d, 0x89, 0xbc, 0x85, 0x8f, 0x61, 0x7c, 0x12, 0x96, 0x2c, 0x5d, 0x29,
+	0x96, 0x67, 0x70, 0x6f, 0x66, 0xda, 0x68, 0x63, 0xc4, 0xd5, 0xdc, 0x39, 0xf3, 0xdd, 0x73, 0x72,
+	0xe7, 0x9a, 0xfb, 0xb7, 0x64, 0x4e, 0x3c, 0x91, 0xb0, 0x88, 0x8e, 0x29, 0x1d, 0x79, 0xf3, 0x5e,
+	0x48, 0x25, 0xe9, 0x79, 0x31, 0xbd, 0xa3, 0xc0, 0xc0, 0x15, 0x09, 0x97, 0xdc, 0xde, 0x51, 0x94,
+	0xfb, 0x45, 0xb9, 0x39, 0xd5, 0x6c, 0xc4, 0x3c, 0xe6, 0x1a, 0xf1, 0x54, 0x95, 0xd1, 0xcd, 0xce,
+	0x16, 0x4f, 0x90, 0x3c, 0xa1, 0x19, 0xd3, 0xf9, 0x28, 0x99, 0xd6, 0x69, 0x96, 0x71, 0x25, 0x89,
+	0xa4, 0xf6, 0x89, 0x59, 0x15, 0x24, 0x21, 0x33, 0x70, 0x50, 0x1b, 0x75, 0x6b, 0x7d, 0xec, 0xfe,
+	0x9e, 0xe9, 0x0e, 0x34, 0xe5, 0x57, 0x96, 0xaf, 0x2d, 0x63, 0x98, 0xf7, 0xd8, 0x37, 0x66, 0x5d,
+	0x70, 0x90, 0x74, 0x14, 0xe8, 0x06, 0x70, 0x4a, 0xed, 0x72, 0xb7, 0xd6, 0xdf, 0xdb, 0x6a, 0xa2,
+	0xe1, 0x81, 0xd2, 0xfd, 0x86, 0x72, 0x7a, 0x7e, 0x6b, 0x59, 0x05, 0x11, 0x86, 0x96, 0x28, 0xdc,
+	0xec, 0xc0, 0xb4, 0x78, 0x42, 0xa2, 0x29, 0x0d, 0x40, 0x12, 0x09, 0x4e, 0xf9, 0x6f, 0xfb, 0x4b,
+	0xcd, 0xaa, 0xc1, 0xc0, 0xdf, 0xcd, 0xed, 0xff, 0x15, 0xc4, 0x73, 0x06, 0x72, 0x58, 0xe3, 0xdf,
+	0x82, 0x3d, 0x36, 0xeb, 0xda, 0x26, 0x98, 0x30, 0xf5, 0x4d, 0x0b, 0xa7, 0xa2, 0x13, 0x0e, 0xb6,
+	0x25, 0x9c, 0x69, 0x8c, 0x45, 0x64, 0x9a, 0x0d, 0xe1, 0xe4, 0x29, 0xff, 0x7f, 0x3c, 0xa8, 0x41,
+	0xd4, 0x99, 0xc9, 0x0b, 0xff, 0x62, 0xfd, 0x8e, 0xd1, 0x53, 0x8a, 0xd1, 0x32, 0xc5, 0x68, 0x95,
+	0x62, 0xb4, 0x4e, 0x31, 0x7a, 0xdc, 0x60, 0x63, 0xb5, 0xc1, 0xc6, 0xcb, 0x06, 0x1b, 0xd7, 0x87,
+	0x31, 0x93, 0x93, 0xfb, 0xd0, 0x8d, 0xf8, 0xcc, 0x53, 0xe1, 0x47, 0x53, 0x12, 0x82, 0xae, 0xbc,
+	0x87, 0xc2, 0x52, 0xe5, 0x42, 0x50, 0x08, 0xab, 0x7a, 0x9b, 0xc7, 0x9f, 0x03, 0x00, 0x6e, 0xfa,
+	0xcb, 0xdb, 0x47, 0x02, 0x00, 0x00,
 }
 
 func (this *GenesisState) VerboseEqual(that interface{}) error {
@@ -142,6 +163,22 @@ func (this *GenesisState) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("PostedPrices this[%v](%v) Not Equal that[%v](%v)", i, this.PostedPrices[i], i, that1.PostedPrices[i])
 		}
 	}
+	if len(this.OracleStats) != len(that1.OracleStats) {
+		return fmt.Errorf("OracleStats this(%v) Not Equal that(%v)", len(this.OracleStats), len(that1.OracleStats))
+	}
+	for i := range this.OracleStats {
+		if !this.OracleStats[i].Equal(&that1.OracleStats[i]) {
+			return fmt.Errorf("OracleStats this[%v](%v) Not Equal that[%v](%v)", i, this.OracleStats[i], i, that1.OracleStats[i])
+		}
+	}
+	if len(this.PriceHistory) != len(that1.PriceHistory) {
+		return fmt.Errorf("PriceHistory this(%v) Not Equal that(%v)", len(this.PriceHistory), len(that1.PriceHistory))
+	}
+	for i := range this.PriceHistory {
+		if !this.PriceHistory[i].Equal(&that1.PriceHistory[i]) {
+			return fmt.Errorf("PriceHistory this[%v](%v) Not Equal that[%v](%v)", i, this.PriceHistory[i], i, that1.PriceHistory[i])
+		}
+	}
 	return nil
 }
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -174,6 +211,22 @@ func (this *GenesisState) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.OracleStats) != len(that1.OracleStats) {
+		return false
+	}
+	for i := range this.OracleStats {
+		if !this.OracleStats[i].Equal(&that1.OracleStats[i]) {
+			return false
+		}
+	}
+	if len(this.PriceHistory) != len(that1.PriceHistory) {
+		return false
+	}
+	for i := range this.PriceHistory {
+		if !this.PriceHistory[i].Equal(&that1.PriceHistory[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -196,6 +249,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PriceHistory) > 0 {
+		for iNdEx := len(m.PriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.OracleStats) > 0 {
+		for iNdEx := len(m.OracleStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.PostedPrices) > 0 {
 		for iNdEx := len(m.PostedPrices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -248,6 +329,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.OracleStats) > 0 {
+		for _, e := range m.OracleStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceHistory) > 0 {
+		for _, e := range m.PriceHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -353,6 +446,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleStats = append(m.OracleStats, OracleStats{})
+			if err := m.OracleStats[len(m.OracleStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceHistory = append(m.PriceHistory, HistoricalPrice{})
+			if err := m.PriceHistory[len(m.PriceHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -32,9 +32,11 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "valid genesis",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+				}, DefaultPriceHistoryLength),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				[]OracleStats{},
+				[]HistoricalPrice{},
 			),
 			expPass: true,
 		},
@@ -42,9 +44,11 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "invalid param",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					NewMarket("", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+				}, DefaultPriceHistoryLength),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				[]OracleStats{},
+				[]HistoricalPrice{},
 			),
 			expPass: false,
 		},
@@ -52,29 +56,117 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "dup market param",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+				}, DefaultPriceHistoryLength),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				[]OracleStats{},
+				[]HistoricalPrice{},
 			),
 			expPass: false,
 		},
 		{
 			msg: "invalid posted price",
 			genesisState: NewGenesisState(
-				NewParams([]Market{}),
+				NewParams([]Market{}, DefaultPriceHistoryLength),
 				[]PostedPrice{NewPostedPrice("xrp", nil, sdk.OneDec(), now)},
+				[]OracleStats{},
+				[]HistoricalPrice{},
 			),
 			expPass: false,
 		},
 		{
 			msg: "duplicated posted price",
 			genesisState: NewGenesisState(
-				NewParams([]Market{}),
+				NewParams([]Market{}, DefaultPriceHistoryLength),
 				[]PostedPrice{
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 				},
+				[]OracleStats{},
+				[]HistoricalPrice{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid oracle stats",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}, DefaultPriceHistoryLength),
+				[]PostedPrice{},
+				[]OracleStats{
+					NewOracleStats("xrp", addr, 1, 2, now),
+					NewOracleStats("bnb", addr, 0, 0, now),
+				},
+				[]HistoricalPrice{},
+			),
+			expPass: true,
+		},
+		{
+			msg: "invalid oracle stats",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}, DefaultPriceHistoryLength),
+				[]PostedPrice{},
+				[]OracleStats{NewOracleStats("xrp", nil, 1, 2, now)},
+				[]HistoricalPrice{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "negative missing since height",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}, DefaultPriceHistoryLength),
+				[]PostedPrice{},
+				[]OracleStats{{MarketID: "xrp", OracleAddress: addr, MissingSinceHeight: -1}},
+				[]HistoricalPrice{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated oracle stats",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}, DefaultPriceHistoryLength),
+				[]PostedPrice{},
+				[]OracleStats{
+					NewOracleStats("xrp", addr, 1, 2, now),
+					NewOracleStats("xrp", addr, 0, 0, now),
+				},
+				[]HistoricalPrice{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid price history",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}, DefaultPriceHistoryLength),
+				[]PostedPrice{},
+				[]OracleStats{},
+				[]HistoricalPrice{
+					NewHistoricalPrice("xrp", sdk.OneDec(), 1, now),
+					NewHistoricalPrice("xrp", sdk.OneDec(), 2, now),
+				},
+			),
+			expPass: true,
+		},
+		{
+			msg: "invalid price history",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}, DefaultPriceHistoryLength),
+				[]PostedPrice{},
+				[]OracleStats{},
+				[]HistoricalPrice{NewHistoricalPrice("xrp", sdk.ZeroDec(), 1, now)},
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated price history",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}, DefaultPriceHistoryLength),
+				[]PostedPrice{},
+				[]OracleStats{},
+				[]HistoricalPrice{
+					NewHistoricalPrice("xrp", sdk.OneDec(), 1, now),
+					NewHistoricalPrice("xrp", sdk.NewDec(2), 1, now),
+				},
 			),
 			expPass: false,
 		},
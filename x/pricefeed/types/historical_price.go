@@ -0,0 +1,70 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHistoricalPrice returns a new HistoricalPrice
+func NewHistoricalPrice(marketID string, price sdk.Dec, height int64, time time.Time) HistoricalPrice {
+	return HistoricalPrice{
+		MarketID: marketID,
+		Price:    price,
+		Height:   height,
+		Time:     time,
+	}
+}
+
+// Validate performs a basic check of the historical price.
+func (hp HistoricalPrice) Validate() error {
+	if strings.TrimSpace(hp.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if hp.Price.IsNil() || !hp.Price.IsPositive() {
+		return fmt.Errorf("price must be positive: %s", hp.Price)
+	}
+	if hp.Height < 0 {
+		return fmt.Errorf("height cannot be negative: %d", hp.Height)
+	}
+	if hp.Time.IsZero() {
+		return errors.New("time cannot be zero")
+	}
+	return nil
+}
+
+// ToHistoricalPriceResponse returns a new HistoricalPriceResponse from a HistoricalPrice
+func (hp HistoricalPrice) ToHistoricalPriceResponse() HistoricalPriceResponse {
+	return HistoricalPriceResponse{
+		MarketID: hp.MarketID,
+		Price:    hp.Price,
+		Height:   hp.Height,
+		Time:     hp.Time,
+	}
+}
+
+// HistoricalPrices is a slice of HistoricalPrice
+type HistoricalPrices []HistoricalPrice
+
+// Validate checks if all the historical prices are valid and there are no
+// duplicated entries.
+func (hps HistoricalPrices) Validate() error {
+	seenPrices := make(map[string]bool)
+	for _, hp := range hps {
+		if err := hp.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s-%d", hp.MarketID, hp.Height)
+		if seenPrices[key] {
+			return fmt.Errorf("duplicated historical price for market %s at height %d", hp.MarketID, hp.Height)
+		}
+		seenPrices[key] = true
+	}
+	return nil
+}
+
+// HistoricalPriceResponses is a slice of HistoricalPriceResponse
+type HistoricalPriceResponses []HistoricalPriceResponse
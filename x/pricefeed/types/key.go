@@ -22,6 +22,15 @@ var (
 
 	// RawPriceFeedPrefix prefix for the raw pricefeed of an asset
 	RawPriceFeedPrefix = []byte{0x01}
+
+	// OracleStatsPrefix prefix for the stats of an oracle for a market
+	OracleStatsPrefix = []byte{0x02}
+
+	// PriceHistoryPrefix prefix for the past current prices of a market
+	PriceHistoryPrefix = []byte{0x03}
+
+	// PriceHistoryCountPrefix prefix for the number of past current prices kept for a market
+	PriceHistoryCountPrefix = []byte{0x04}
 )
 
 // CurrentPriceKey returns the prefix for the current price
@@ -45,6 +54,43 @@ func RawPriceKey(marketID string, oracleAddr sdk.AccAddress) []byte {
 	)
 }
 
+// OracleStatsIteratorKey returns the prefix for the oracle stats of a single market
+func OracleStatsIteratorKey(marketID string) []byte {
+	return append(
+		OracleStatsPrefix,
+		lengthPrefixWithByte([]byte(marketID))...,
+	)
+}
+
+// OracleStatsKey returns the key for the stats of an oracle for a market
+func OracleStatsKey(marketID string, oracleAddr sdk.AccAddress) []byte {
+	return append(
+		OracleStatsIteratorKey(marketID),
+		lengthPrefixWithByte(oracleAddr)...,
+	)
+}
+
+// PriceHistoryIteratorKey returns the prefix for the price history of a single market
+func PriceHistoryIteratorKey(marketID string) []byte {
+	return append(
+		PriceHistoryPrefix,
+		lengthPrefixWithByte([]byte(marketID))...,
+	)
+}
+
+// PriceHistoryKey returns the key for the price of a market at a block height
+func PriceHistoryKey(marketID string, height int64) []byte {
+	return append(
+		PriceHistoryIteratorKey(marketID),
+		sdk.Uint64ToBigEndian(uint64(height))...,
+	)
+}
+
+// PriceHistoryCountKey returns the key for the number of prices kept for a market
+func PriceHistoryCountKey(marketID string) []byte {
+	return append(PriceHistoryCountPrefix, []byte(marketID)...)
+}
+
 // lengthPrefixWithByte returns the input bytes prefixes with one byte containing its length.
 // It panics if the input is greater than 255 in length.
 func lengthPrefixWithByte(bz []byte) []byte {
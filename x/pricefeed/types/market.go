@@ -41,12 +41,58 @@ func (m Market) Validate() error {
 		}
 		seenOracles[oracle.String()] = true
 	}
+	if m.MaxPriceDeviation != nil && (m.MaxPriceDeviation.IsNil() || m.MaxPriceDeviation.IsNegative()) {
+		return fmt.Errorf("max price deviation cannot be nil or negative %s", m.MaxPriceDeviation)
+	}
+	if m.MinOracleCount > uint64(len(m.Oracles)) {
+		return fmt.Errorf("min oracle count %d cannot be greater than the number of oracles %d", m.MinOracleCount, len(m.Oracles))
+	}
+	if m.IsDerived() {
+		if len(m.Oracles) > 0 {
+			return fmt.Errorf("derived market %s cannot have oracles", m.MarketID)
+		}
+		if m.MaxPriceDeviation != nil {
+			return fmt.Errorf("derived market %s cannot have a max price deviation", m.MarketID)
+		}
+		if err := m.PriceSources.Validate(m.MarketID); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// HasOracle returns true if the address is one of the market's oracles
+func (m Market) HasOracle(address sdk.AccAddress) bool {
+	for _, oracle := range m.Oracles {
+		if oracle.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDerived returns true if the market's price is derived from the prices of other markets instead of posted by
+// oracles.
+func (m Market) IsDerived() bool {
+	return len(m.PriceSources) > 0
+}
+
+// IsPriceDeviating returns true if a price differs from a reference price by more than the max price deviation.
+// An unset or zero max price deviation disables the check.
+func (m Market) IsPriceDeviating(price, referencePrice sdk.Dec) bool {
+	if m.MaxPriceDeviation == nil || !m.MaxPriceDeviation.IsPositive() || !referencePrice.IsPositive() {
+		return false
+	}
+	return price.Sub(referencePrice).Abs().Quo(referencePrice).GT(*m.MaxPriceDeviation)
+}
+
 // ToMarketResponse returns a new MarketResponse from a Market
 func (m Market) ToMarketResponse() MarketResponse {
-	return NewMarketResponse(m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	response := NewMarketResponse(m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	response.MaxPriceDeviation = m.MaxPriceDeviation
+	response.MinOracleCount = m.MinOracleCount
+	response.PriceSources = m.PriceSources
+	return response
 }
 
 // Markets is a slice of Market
@@ -65,6 +111,82 @@ func (ms Markets) Validate() error {
 		}
 		seenMarkets[m.MarketID] = true
 	}
+	_, err := ms.SortByPriceSources()
+	return err
+}
+
+// SortByPriceSources returns the markets ordered so that every derived market comes after the markets it is derived
+// from. It returns an error if a price source is not one of the markets, or if price sources form a cycle.
+func (ms Markets) SortByPriceSources() (Markets, error) {
+	marketsByID := make(map[string]Market, len(ms))
+	for _, m := range ms {
+		marketsByID[m.MarketID] = m
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	states := make(map[string]int, len(ms))
+	sorted := make(Markets, 0, len(ms))
+
+	var visit func(m Market) error
+	visit = func(m Market) error {
+		switch states[m.MarketID] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("price sources of market %s form a cycle", m.MarketID)
+		}
+		states[m.MarketID] = visiting
+		for _, source := range m.PriceSources {
+			sourceMarket, found := marketsByID[source.MarketID]
+			if !found {
+				return fmt.Errorf("price source %s of market %s does not exist", source.MarketID, m.MarketID)
+			}
+			if err := visit(sourceMarket); err != nil {
+				return err
+			}
+		}
+		states[m.MarketID] = visited
+		sorted = append(sorted, m)
+		return nil
+	}
+
+	for _, m := range ms {
+		if err := visit(m); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
+
+// NewPriceSource returns a new PriceSource
+func NewPriceSource(marketID string, inverse bool) PriceSource {
+	return PriceSource{
+		MarketID: marketID,
+		Inverse:  inverse,
+	}
+}
+
+// PriceSources is a slice of PriceSource
+type PriceSources []PriceSource
+
+// Validate checks that the price sources of a market are not blank, duplicated or the market itself.
+func (pss PriceSources) Validate(marketID string) error {
+	seenSources := make(map[string]bool)
+	for _, ps := range pss {
+		if strings.TrimSpace(ps.MarketID) == "" {
+			return fmt.Errorf("price source of market %s cannot be blank", marketID)
+		}
+		if ps.MarketID == marketID {
+			return fmt.Errorf("market %s cannot be its own price source", marketID)
+		}
+		if seenSources[ps.MarketID] {
+			return fmt.Errorf("duplicated price source %s of market %s", ps.MarketID, marketID)
+		}
+		seenSources[ps.MarketID] = true
+	}
 	return nil
 }
 
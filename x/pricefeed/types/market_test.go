@@ -78,6 +78,122 @@ func TestMarketValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid max price deviation",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				Oracles:           []sdk.AccAddress{addr},
+				Active:            true,
+				MaxPriceDeviation: decPtr(sdk.MustNewDecFromStr("0.1")),
+			},
+			true,
+		},
+		{
+			"valid min oracle count",
+			Market{
+				MarketID:       "market",
+				BaseAsset:      "xrp",
+				QuoteAsset:     "bnb",
+				Oracles:        []sdk.AccAddress{addr},
+				Active:         true,
+				MinOracleCount: 1,
+			},
+			true,
+		},
+		{
+			"min oracle count greater than oracles",
+			Market{
+				MarketID:       "market",
+				BaseAsset:      "xrp",
+				QuoteAsset:     "bnb",
+				Oracles:        []sdk.AccAddress{addr},
+				Active:         true,
+				MinOracleCount: 2,
+			},
+			false,
+		},
+		{
+			"negative max price deviation",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				Oracles:           []sdk.AccAddress{addr},
+				Active:            true,
+				MaxPriceDeviation: decPtr(sdk.MustNewDecFromStr("-0.1")),
+			},
+			false,
+		},
+		{
+			"valid derived market",
+			Market{
+				MarketID:     "market",
+				BaseAsset:    "xrp",
+				QuoteAsset:   "bnb",
+				Active:       true,
+				PriceSources: PriceSources{NewPriceSource("xrp:usd", false), NewPriceSource("bnb:usd", true)},
+			},
+			true,
+		},
+		{
+			"derived market with oracles",
+			Market{
+				MarketID:     "market",
+				BaseAsset:    "xrp",
+				QuoteAsset:   "bnb",
+				Oracles:      []sdk.AccAddress{addr},
+				Active:       true,
+				PriceSources: PriceSources{NewPriceSource("xrp:usd", false)},
+			},
+			false,
+		},
+		{
+			"derived market with max price deviation",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				Active:            true,
+				MaxPriceDeviation: decPtr(sdk.MustNewDecFromStr("0.1")),
+				PriceSources:      PriceSources{NewPriceSource("xrp:usd", false)},
+			},
+			false,
+		},
+		{
+			"blank price source",
+			Market{
+				MarketID:     "market",
+				BaseAsset:    "xrp",
+				QuoteAsset:   "bnb",
+				Active:       true,
+				PriceSources: PriceSources{NewPriceSource(" ", false)},
+			},
+			false,
+		},
+		{
+			"market is its own price source",
+			Market{
+				MarketID:     "market",
+				BaseAsset:    "xrp",
+				QuoteAsset:   "bnb",
+				Active:       true,
+				PriceSources: PriceSources{NewPriceSource("market", false)},
+			},
+			false,
+		},
+		{
+			"duplicated price source",
+			Market{
+				MarketID:     "market",
+				BaseAsset:    "xrp",
+				QuoteAsset:   "bnb",
+				Active:       true,
+				PriceSources: PriceSources{NewPriceSource("xrp:usd", false), NewPriceSource("xrp:usd", true)},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -92,6 +208,65 @@ func TestMarketValidate(t *testing.T) {
 	}
 }
 
+func TestMarketsSortByPriceSources(t *testing.T) {
+	derived := func(id string, sources ...string) Market {
+		market := NewMarket(id, "xrp", "bnb", nil, true)
+		for _, source := range sources {
+			market.PriceSources = append(market.PriceSources, NewPriceSource(source, false))
+		}
+		return market
+	}
+
+	testCases := []struct {
+		msg      string
+		markets  Markets
+		expOrder []string
+		expErr   string
+	}{
+		{
+			"no derived markets",
+			Markets{derived("a"), derived("b")},
+			[]string{"a", "b"},
+			"",
+		},
+		{
+			"sources come first",
+			Markets{derived("c", "b", "a"), derived("b", "a"), derived("a")},
+			[]string{"a", "b", "c"},
+			"",
+		},
+		{
+			"missing source",
+			Markets{derived("a", "b")},
+			nil,
+			"price source b of market a does not exist",
+		},
+		{
+			"cycle",
+			Markets{derived("a", "b"), derived("b", "c"), derived("c", "a")},
+			nil,
+			"form a cycle",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			sorted, err := tc.markets.SortByPriceSources()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				require.ErrorContains(t, tc.markets.Validate(), tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			var order []string
+			for _, m := range sorted {
+				order = append(order, m.MarketID)
+			}
+			require.Equal(t, tc.expOrder, order)
+		})
+	}
+}
+
 func TestPostedPriceValidate(t *testing.T) {
 	now := time.Now()
 	mockPrivKey := tmtypes.NewMockPV()
@@ -161,3 +336,20 @@ func TestPostedPriceValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestMarketIsPriceDeviating(t *testing.T) {
+	market := NewMarket("market", "xrp", "bnb", nil, true)
+	require.False(t, market.IsPriceDeviating(sdk.NewDec(2), sdk.OneDec()), "unset max price deviation disables the check")
+
+	market.MaxPriceDeviation = decPtr(sdk.ZeroDec())
+	require.False(t, market.IsPriceDeviating(sdk.NewDec(2), sdk.OneDec()), "zero max price deviation disables the check")
+
+	market.MaxPriceDeviation = decPtr(sdk.MustNewDecFromStr("0.1"))
+	require.False(t, market.IsPriceDeviating(sdk.MustNewDecFromStr("1.1"), sdk.OneDec()))
+	require.False(t, market.IsPriceDeviating(sdk.MustNewDecFromStr("0.9"), sdk.OneDec()))
+	require.True(t, market.IsPriceDeviating(sdk.MustNewDecFromStr("1.11"), sdk.OneDec()))
+	require.True(t, market.IsPriceDeviating(sdk.MustNewDecFromStr("0.89"), sdk.OneDec()))
+	require.False(t, market.IsPriceDeviating(sdk.NewDec(2), sdk.ZeroDec()), "zero reference price disables the check")
+}
+
+func decPtr(d sdk.Dec) *sdk.Dec { return &d }
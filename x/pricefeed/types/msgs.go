@@ -14,13 +14,18 @@ import (
 const (
 	// TypeMsgPostPrice type of PostPrice msg
 	TypeMsgPostPrice = "post_price"
+	// TypeMsgPostPrices type of PostPrices msg
+	TypeMsgPostPrices = "post_prices"
 
 	// MaxExpiry defines the max expiry time defined as UNIX time (9999-12-31 23:59:59 +0000 UTC)
 	MaxExpiry = 253402300799
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPostPrice{}
+var (
+	_ sdk.Msg = &MsgPostPrice{}
+	_ sdk.Msg = &MsgPostPrices{}
+)
 
 // NewMsgPostPrice returns a new MsgPostPrice
 func NewMsgPostPrice(from string, marketID string, price sdk.Dec, expiry time.Time) *MsgPostPrice {
@@ -69,3 +74,84 @@ func (msg MsgPostPrice) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgPostPrices returns a new MsgPostPrices
+func NewMsgPostPrices(from string, prices PostPriceEntries) *MsgPostPrices {
+	return &MsgPostPrices{
+		From:   from,
+		Prices: prices,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgPostPrices) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgPostPrices) Type() string { return TypeMsgPostPrices }
+
+// GetSignBytes Implements Msg.
+func (msg MsgPostPrices) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgPostPrices) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPostPrices) ValidateBasic() error {
+	if len(msg.From) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if len(msg.Prices) == 0 {
+		return errors.New("prices cannot be empty")
+	}
+	return msg.Prices.Validate()
+}
+
+// NewPostPriceEntry returns a new PostPriceEntry
+func NewPostPriceEntry(marketID string, price sdk.Dec, expiry time.Time) PostPriceEntry {
+	return PostPriceEntry{
+		MarketID: marketID,
+		Price:    price,
+		Expiry:   expiry,
+	}
+}
+
+// Validate performs a basic check of a PostPriceEntry
+func (e PostPriceEntry) Validate() error {
+	if strings.TrimSpace(e.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if e.Price.IsNil() || e.Price.IsNegative() {
+		return fmt.Errorf("price cannot be nil or negative: %s", e.Price)
+	}
+	if e.Expiry.Unix() <= 0 {
+		return errors.New("must set an expiration time")
+	}
+	return nil
+}
+
+// PostPriceEntries is a slice of PostPriceEntry
+type PostPriceEntries []PostPriceEntry
+
+// Validate checks that all the entries are valid and there is at most one for each market.
+func (es PostPriceEntries) Validate() error {
+	seenMarkets := make(map[string]bool)
+	for _, e := range es {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+		if seenMarkets[e.MarketID] {
+			return fmt.Errorf("duplicated price for market %s", e.MarketID)
+		}
+		seenMarkets[e.MarketID] = true
+	}
+	return nil
+}
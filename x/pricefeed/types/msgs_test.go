@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -36,3 +37,38 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPostPrices_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	price := sdk.MustNewDecFromStr("0.3005")
+	expiry := tmtime.Now()
+
+	tests := []struct {
+		name       string
+		msg        *MsgPostPrices
+		expectPass bool
+	}{
+		{"normal", NewMsgPostPrices(addr.String(), PostPriceEntries{
+			NewPostPriceEntry("xrp", price, expiry),
+			NewPostPriceEntry("bnb", price, expiry),
+		}), true},
+		{"emptyAddr", NewMsgPostPrices("", PostPriceEntries{NewPostPriceEntry("xrp", price, expiry)}), false},
+		{"emptyPrices", NewMsgPostPrices(addr.String(), PostPriceEntries{}), false},
+		{"emptyAsset", NewMsgPostPrices(addr.String(), PostPriceEntries{NewPostPriceEntry("", price, expiry)}), false},
+		{"negativePrice", NewMsgPostPrices(addr.String(), PostPriceEntries{NewPostPriceEntry("xrp", sdk.NewDec(-1), expiry)}), false},
+		{"zeroExpiry", NewMsgPostPrices(addr.String(), PostPriceEntries{NewPostPriceEntry("xrp", price, time.Time{})}), false},
+		{"duplicatedMarket", NewMsgPostPrices(addr.String(), PostPriceEntries{
+			NewPostPriceEntry("xrp", price, expiry),
+			NewPostPriceEntry("xrp", price, expiry),
+		}), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.NoError(t, tc.msg.ValidateBasic())
+			} else {
+				require.Error(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
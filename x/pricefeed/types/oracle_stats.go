@@ -0,0 +1,78 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewOracleStats returns a new OracleStats
+func NewOracleStats(marketID string, oracle sdk.AccAddress, missCount, deviationCount uint64, lastPostTime time.Time) OracleStats {
+	return OracleStats{
+		MarketID:       marketID,
+		OracleAddress:  oracle,
+		MissCount:      missCount,
+		DeviationCount: deviationCount,
+		LastPostTime:   lastPostTime,
+	}
+}
+
+// Validate performs a basic check of the oracle stats.
+func (os OracleStats) Validate() error {
+	if strings.TrimSpace(os.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if len(os.OracleAddress) == 0 {
+		return errors.New("oracle address cannot be empty")
+	}
+	if os.MissingSinceHeight < 0 {
+		return fmt.Errorf("missing since height cannot be negative: %d", os.MissingSinceHeight)
+	}
+	return nil
+}
+
+// TotalMissCount returns the number of blocks the oracle missed up to and including a height, adding the blocks of
+// its current miss streak to the blocks of its past streaks.
+func (os OracleStats) TotalMissCount(height int64) uint64 {
+	if os.MissingSinceHeight == 0 || height < os.MissingSinceHeight {
+		return os.MissCount
+	}
+	return os.MissCount + uint64(height-os.MissingSinceHeight+1)
+}
+
+// ToOracleStatsResponse returns a new OracleStatsResponse from an OracleStats, counting the misses up to a height
+func (os OracleStats) ToOracleStatsResponse(height int64) OracleStatsResponse {
+	return OracleStatsResponse{
+		MarketID:       os.MarketID,
+		OracleAddress:  os.OracleAddress.String(),
+		MissCount:      os.TotalMissCount(height),
+		DeviationCount: os.DeviationCount,
+		LastPostTime:   os.LastPostTime,
+	}
+}
+
+// OracleStatsList is a slice of OracleStats
+type OracleStatsList []OracleStats
+
+// Validate checks if all the oracle stats are valid and there are no
+// duplicated entries.
+func (osl OracleStatsList) Validate() error {
+	seenStats := make(map[string]bool)
+	for _, os := range osl {
+		if err := os.Validate(); err != nil {
+			return err
+		}
+		key := os.MarketID + "-" + os.OracleAddress.String()
+		if seenStats[key] {
+			return fmt.Errorf("duplicated oracle stats for market %s and oracle %s", os.MarketID, os.OracleAddress)
+		}
+		seenStats[key] = true
+	}
+	return nil
+}
+
+// OracleStatsResponses is a slice of OracleStatsResponse
+type OracleStatsResponses []OracleStatsResponse
@@ -8,20 +8,24 @@ import (
 
 // Parameter keys
 var (
-	KeyMarkets     = []byte("Markets")
-	DefaultMarkets = []Market{}
+	KeyMarkets            = []byte("Markets")
+	KeyPriceHistoryLength = []byte("PriceHistoryLength")
+	DefaultMarkets        = []Market{}
+	// DefaultPriceHistoryLength how many of the most recent current prices are kept for each market
+	DefaultPriceHistoryLength uint64 = 100
 )
 
 // NewParams creates a new AssetParams object
-func NewParams(markets []Market) Params {
+func NewParams(markets []Market, priceHistoryLength uint64) Params {
 	return Params{
-		Markets: markets,
+		Markets:            markets,
+		PriceHistoryLength: priceHistoryLength,
 	}
 }
 
 // DefaultParams default params for pricefeed
 func DefaultParams() Params {
-	return NewParams(DefaultMarkets)
+	return NewParams(DefaultMarkets, DefaultPriceHistoryLength)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -34,12 +38,17 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMarkets, &p.Markets, validateMarketParams),
+		paramtypes.NewParamSetPair(KeyPriceHistoryLength, &p.PriceHistoryLength, validatePriceHistoryLengthParam),
 	}
 }
 
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
-	return validateMarketParams(p.Markets)
+	if err := validateMarketParams(p.Markets); err != nil {
+		return err
+	}
+
+	return validatePriceHistoryLengthParam(p.PriceHistoryLength)
 }
 
 func validateMarketParams(i interface{}) error {
@@ -50,3 +59,12 @@ func validateMarketParams(i interface{}) error {
 
 	return markets.Validate()
 }
+
+func validatePriceHistoryLengthParam(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
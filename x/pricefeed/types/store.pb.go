@@ -33,6 +33,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the pricefeed module.
 type Params struct {
 	Markets Markets `protobuf:"bytes,1,rep,name=markets,proto3,castrepeated=Markets" json:"markets"`
+	// price_history_length is the number of the most recent current prices kept for each market
+	PriceHistoryLength uint64 `protobuf:"varint,2,opt,name=price_history_length,json=priceHistoryLength,proto3" json:"price_history_length,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -75,6 +77,13 @@ func (m *Params) GetMarkets() Markets {
 	return nil
 }
 
+func (m *Params) GetPriceHistoryLength() uint64 {
+	if m != nil {
+		return m.PriceHistoryLength
+	}
+	return 0
+}
+
 // Market defines an asset in the pricefeed.
 type Market struct {
 	MarketID   string                                          `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -82,6 +91,15 @@ type Market struct {
 	QuoteAsset string                                          `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
 	Oracles    []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=oracles,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracles,omitempty"`
 	Active     bool                                            `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	// max_price_deviation is the largest fraction a posted price can differ from the previous price of the market,
+	// or the median of the unexpired prices without one, by to be included in the median, unset or zero disables it
+	MaxPriceDeviation *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_price_deviation,json=maxPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_deviation,omitempty"`
+	// min_oracle_count is the number of oracles that must have a valid price for the market to have a current price,
+	// zero disables it
+	MinOracleCount uint64 `protobuf:"varint,7,opt,name=min_oracle_count,json=minOracleCount,proto3" json:"min_oracle_count,omitempty"`
+	// price_sources are the markets whose current prices are multiplied together, or divided by for inverse sources,
+	// to derive the market's price. A market with price sources is derived and cannot have oracles.
+	PriceSources PriceSources `protobuf:"bytes,8,rep,name=price_sources,json=priceSources,proto3,castrepeated=PriceSources" json:"price_sources,omitempty"`
 }
 
 func (m *Market) Reset()         { *m = Market{} }
@@ -152,6 +170,74 @@ func (m *Market) GetActive() bool {
 	return false
 }
 
+func (m *Market) GetMinOracleCount() uint64 {
+	if m != nil {
+		return m.MinOracleCount
+	}
+	return 0
+}
+
+func (m *Market) GetPriceSources() PriceSources {
+	if m != nil {
+		return m.PriceSources
+	}
+	return nil
+}
+
+// PriceSource defines a market whose current price is used to derive the price of another market.
+type PriceSource struct {
+	MarketID string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// inverse divides by the market's price instead of multiplying by it
+	Inverse bool `protobuf:"varint,2,opt,name=inverse,proto3" json:"inverse,omitempty"`
+}
+
+func (m *PriceSource) Reset()         { *m = PriceSource{} }
+func (m *PriceSource) String() string { return proto.CompactTextString(m) }
+func (*PriceSource) ProtoMessage()    {}
+func (*PriceSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{2}
+}
+func (m *PriceSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceSource.Merge(m, src)
+}
+func (m *PriceSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceSource proto.InternalMessageInfo
+
+func (m *PriceSource) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *PriceSource) GetInverse() bool {
+	if m != nil {
+		return m.Inverse
+	}
+	return false
+}
+
 // PostedPrice defines a price for market posted by a specific oracle.
 type PostedPrice struct {
 	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -164,7 +250,7 @@ func (m *PostedPrice) Reset()         { *m = PostedPrice{} }
 func (m *PostedPrice) String() string { return proto.CompactTextString(m) }
 func (*PostedPrice) ProtoMessage()    {}
 func (*PostedPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40639f5e16f9a, []int{2}
+	return fileDescriptor_9df40639f5e16f9a, []int{3}
 }
 func (m *PostedPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,6 +300,169 @@ func (m *PostedPrice) GetExpiry() time.Time {
 	return time.Time{}
 }
 
+// OracleStats defines the record of an oracle's posted prices for a market.
+type OracleStats struct {
+	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OracleAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle_address,omitempty"`
+	// miss_count is the number of blocks of the oracle's past miss streaks, when the market price was updated
+	// without a valid price from the oracle
+	MissCount uint64 `protobuf:"varint,3,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
+	// deviation_count is the number of times the oracle's price was discarded for being outside the deviation band
+	DeviationCount uint64 `protobuf:"varint,4,opt,name=deviation_count,json=deviationCount,proto3" json:"deviation_count,omitempty"`
+	// last_post_time is when the oracle last posted a price for the market
+	LastPostTime time.Time `protobuf:"bytes,5,opt,name=last_post_time,json=lastPostTime,proto3,stdtime" json:"last_post_time"`
+	// missing_since_height is the height of the first block of the oracle's current miss streak, or zero if the
+	// oracle had a valid price at the last market price update
+	MissingSinceHeight int64 `protobuf:"varint,6,opt,name=missing_since_height,json=missingSinceHeight,proto3" json:"missing_since_height,omitempty"`
+	// last_deviation_expiry is the expiry of the oracle's last price counted as deviating, so that a price discarded in
+	// several blocks is only counted once
+	LastDeviationExpiry time.Time `protobuf:"bytes,7,opt,name=last_deviation_expiry,json=lastDeviationExpiry,proto3,stdtime" json:"last_deviation_expiry"`
+}
+
+func (m *OracleStats) Reset()         { *m = OracleStats{} }
+func (m *OracleStats) String() string { return proto.CompactTextString(m) }
+func (*OracleStats) ProtoMessage()    {}
+func (*OracleStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{4}
+}
+func (m *OracleStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleStats.Merge(m, src)
+}
+func (m *OracleStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleStats proto.InternalMessageInfo
+
+func (m *OracleStats) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *OracleStats) GetOracleAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.OracleAddress
+	}
+	return nil
+}
+
+func (m *OracleStats) GetMissCount() uint64 {
+	if m != nil {
+		return m.MissCount
+	}
+	return 0
+}
+
+func (m *OracleStats) GetDeviationCount() uint64 {
+	if m != nil {
+		return m.DeviationCount
+	}
+	return 0
+}
+
+func (m *OracleStats) GetLastPostTime() time.Time {
+	if m != nil {
+		return m.LastPostTime
+	}
+	return time.Time{}
+}
+
+func (m *OracleStats) GetMissingSinceHeight() int64 {
+	if m != nil {
+		return m.MissingSinceHeight
+	}
+	return 0
+}
+
+func (m *OracleStats) GetLastDeviationExpiry() time.Time {
+	if m != nil {
+		return m.LastDeviationExpiry
+	}
+	return time.Time{}
+}
+
+// HistoricalPrice defines a past current price of a market and the block it was set in.
+type HistoricalPrice struct {
+	MarketID string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Height   int64                                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time     time.Time                              `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *HistoricalPrice) Reset()         { *m = HistoricalPrice{} }
+func (m *HistoricalPrice) String() string { return proto.CompactTextString(m) }
+func (*HistoricalPrice) ProtoMessage()    {}
+func (*HistoricalPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40639f5e16f9a, []int{5}
+}
+func (m *HistoricalPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoricalPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricalPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoricalPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalPrice.Merge(m, src)
+}
+func (m *HistoricalPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoricalPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricalPrice proto.InternalMessageInfo
+
+func (m *HistoricalPrice) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *HistoricalPrice) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *HistoricalPrice) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 // CurrentPrice defines a current price for a particular market in the pricefeed
 // module.
 type CurrentPrice struct {
@@ -225,7 +474,7 @@ func (m *CurrentPrice) Reset()         { *m = CurrentPrice{} }
 func (m *CurrentPrice) String() string { return proto.CompactTextString(m) }
 func (*CurrentPrice) ProtoMessage()    {}
 func (*CurrentPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40639f5e16f9a, []int{3}
+	return fileDescriptor_9df40639f5e16f9a, []int{6}
 }
 func (m *CurrentPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,7 +513,10 @@ func (m *CurrentPrice) GetMarketID() string {
 func init() {
 	proto.RegisterType((*Params)(nil), "kava.pricefeed.v1beta1.Params")
 	proto.RegisterType((*Market)(nil), "kava.pricefeed.v1beta1.Market")
+	proto.RegisterType((*PriceSource)(nil), "kava.pricefeed.v1beta1.PriceSource")
 	proto.RegisterType((*PostedPrice)(nil), "kava.pricefeed.v1beta1.PostedPrice")
+	proto.RegisterType((*OracleStats)(nil), "kava.pricefeed.v1beta1.OracleStats")
+	proto.RegisterType((*HistoricalPrice)(nil), "kava.pricefeed.v1beta1.HistoricalPrice")
 	proto.RegisterType((*CurrentPrice)(nil), "kava.pricefeed.v1beta1.CurrentPrice")
 }
 
@@ -273,39 +525,59 @@ func init() {
 }
 
 var fileDescriptor_9df40639f5e16f9a = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0x25, 0x6d, 0xfe, 0x5c, 0x02, 0x48, 0x06, 0x55, 0x26, 0x12, 0x76, 0x94, 0x01, 0x19,
-	0xa1, 0x9c, 0xd5, 0xb2, 0xb2, 0xc4, 0x64, 0x20, 0x43, 0xa5, 0xc8, 0x30, 0xb1, 0x44, 0x67, 0xfb,
-	0xd5, 0x58, 0x89, 0x39, 0x73, 0x77, 0x89, 0x9a, 0x89, 0xaf, 0xd0, 0x8f, 0x81, 0x90, 0xd8, 0xf8,
-	0x10, 0x1d, 0x2b, 0x26, 0xc4, 0x90, 0x16, 0xe7, 0x03, 0xb0, 0x33, 0x21, 0xdf, 0xd9, 0x55, 0x07,
-	0x06, 0x2a, 0x98, 0xee, 0xde, 0xef, 0xfd, 0xde, 0xbf, 0xdf, 0xbd, 0xc3, 0xc3, 0x05, 0x5d, 0x53,
-	0x37, 0xe3, 0x49, 0x08, 0x27, 0x00, 0x91, 0xbb, 0x3e, 0x0c, 0x40, 0xd2, 0x43, 0x57, 0x48, 0xc6,
-	0x81, 0x64, 0x9c, 0x49, 0x66, 0x1c, 0x14, 0x1c, 0x72, 0xcd, 0x21, 0x25, 0xa7, 0xff, 0x30, 0x64,
-	0x22, 0x65, 0x62, 0xae, 0x58, 0xae, 0x36, 0x74, 0x48, 0xff, 0x41, 0xcc, 0x62, 0xa6, 0xf1, 0xe2,
-	0x56, 0xa2, 0x76, 0xcc, 0x58, 0xbc, 0x04, 0x57, 0x59, 0xc1, 0xea, 0xc4, 0x95, 0x49, 0x0a, 0x42,
-	0xd2, 0x34, 0xd3, 0x84, 0xe1, 0x2b, 0xdc, 0x9c, 0x51, 0x4e, 0x53, 0x61, 0x4c, 0x71, 0x2b, 0xa5,
-	0x7c, 0x01, 0x52, 0x98, 0x68, 0xd0, 0x70, 0xba, 0x47, 0x16, 0xf9, 0x73, 0x17, 0xe4, 0x58, 0xd1,
-	0xbc, 0x7b, 0xe7, 0x5b, 0xbb, 0xf6, 0xe9, 0xd2, 0x6e, 0x69, 0x5b, 0xf8, 0x55, 0xfc, 0xf0, 0x27,
-	0xc2, 0x4d, 0x0d, 0x1a, 0x4f, 0x70, 0x47, 0xa3, 0xf3, 0x24, 0x32, 0xd1, 0x00, 0x39, 0x1d, 0xaf,
-	0x97, 0x6f, 0xed, 0xb6, 0x76, 0x4f, 0x27, 0x7e, 0x5b, 0xbb, 0xa7, 0x91, 0xf1, 0x08, 0xe3, 0x80,
-	0x0a, 0x98, 0x53, 0x21, 0x40, 0x9a, 0xf5, 0x82, 0xeb, 0x77, 0x0a, 0x64, 0x5c, 0x00, 0x86, 0x8d,
-	0xbb, 0xef, 0x57, 0x4c, 0x56, 0xfe, 0x86, 0xf2, 0x63, 0x05, 0x69, 0x42, 0x80, 0x5b, 0x8c, 0xd3,
-	0x70, 0x09, 0xc2, 0xdc, 0x1b, 0x34, 0x9c, 0x9e, 0xf7, 0xf2, 0xd7, 0xd6, 0x1e, 0xc5, 0x89, 0x7c,
-	0xbb, 0x0a, 0x48, 0xc8, 0xd2, 0x52, 0xaf, 0xf2, 0x18, 0x89, 0x68, 0xe1, 0xca, 0x4d, 0x06, 0x82,
-	0x8c, 0xc3, 0x70, 0x1c, 0x45, 0x1c, 0x84, 0xf8, 0xfa, 0x65, 0x74, 0xbf, 0x54, 0xb5, 0x44, 0xbc,
-	0x8d, 0x04, 0xe1, 0x57, 0x89, 0x8d, 0x03, 0xdc, 0xa4, 0xa1, 0x4c, 0xd6, 0x60, 0xee, 0x0f, 0x90,
-	0xd3, 0xf6, 0x4b, 0x6b, 0xf8, 0xb9, 0x8e, 0xbb, 0x33, 0x26, 0x24, 0x44, 0xb3, 0x42, 0xae, 0xdb,
-	0x8c, 0xcd, 0xf0, 0x5d, 0x9d, 0x7d, 0x4e, 0x75, 0x49, 0x35, 0xfa, 0xff, 0xec, 0xfe, 0x8e, 0xce,
-	0x5f, 0x62, 0xc6, 0x04, 0xef, 0xab, 0x37, 0xd5, 0x12, 0x7a, 0xa4, 0x78, 0xc6, 0xef, 0x5b, 0xfb,
-	0xf1, 0x5f, 0xd4, 0x9a, 0x40, 0xe8, 0xeb, 0x60, 0xe3, 0x39, 0x6e, 0xc2, 0x69, 0x96, 0xf0, 0x8d,
-	0xb9, 0x37, 0x40, 0x4e, 0xf7, 0xa8, 0x4f, 0xf4, 0xaa, 0x91, 0x6a, 0xd5, 0xc8, 0xeb, 0x6a, 0xd5,
-	0xbc, 0x76, 0x51, 0xe2, 0xec, 0xd2, 0x46, 0x7e, 0x19, 0x33, 0xfc, 0x80, 0x7b, 0x2f, 0x56, 0x9c,
-	0xc3, 0x3b, 0x79, 0x6b, 0xbd, 0xae, 0xdb, 0xaf, 0xff, 0x43, 0xfb, 0xde, 0xf1, 0xd5, 0x0f, 0x0b,
-	0x7d, 0xcc, 0x2d, 0x74, 0x9e, 0x5b, 0xe8, 0x22, 0xb7, 0xd0, 0x55, 0x6e, 0xa1, 0xb3, 0x9d, 0x55,
-	0xbb, 0xd8, 0x59, 0xb5, 0x6f, 0x3b, 0xab, 0xf6, 0xe6, 0xe9, 0x8d, 0x84, 0xc5, 0x47, 0x18, 0x2d,
-	0x69, 0x20, 0xd4, 0xcd, 0x3d, 0xbd, 0xf1, 0x7d, 0x55, 0xe6, 0xa0, 0xa9, 0xa6, 0x7e, 0xf6, 0x3b,
-	0x00, 0x00, 0xff, 0xff, 0x18, 0xb5, 0x5b, 0xc1, 0xdd, 0x03, 0x00, 0x00,
+	// 821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xbf, 0x6f, 0x23, 0x45,
+	0x14, 0xf6, 0x66, 0x1d, 0xff, 0x18, 0x3b, 0x09, 0x4c, 0x42, 0x58, 0x22, 0x65, 0xd7, 0x32, 0x12,
+	0x18, 0x81, 0x77, 0x49, 0x68, 0x28, 0x68, 0xe2, 0x18, 0x29, 0x41, 0x44, 0x44, 0x1b, 0x0a, 0x44,
+	0xc1, 0x6a, 0xbc, 0x3b, 0x59, 0x8f, 0xe2, 0xdd, 0x59, 0x76, 0xc6, 0x96, 0x5d, 0x51, 0xd1, 0xe7,
+	0xcf, 0x40, 0x91, 0xe8, 0xf8, 0x23, 0x42, 0x17, 0x21, 0x0a, 0x44, 0xe1, 0x04, 0xa7, 0xa3, 0xa6,
+	0xa2, 0x42, 0xf3, 0xc3, 0xc6, 0x27, 0xdd, 0x49, 0x67, 0xdd, 0x49, 0x77, 0x95, 0xf7, 0xbd, 0xef,
+	0xbd, 0x6f, 0xde, 0xbc, 0xf7, 0xbd, 0x31, 0x68, 0x5e, 0xa1, 0x11, 0xf2, 0xb2, 0x9c, 0x84, 0xf8,
+	0x12, 0xe3, 0xc8, 0x1b, 0x1d, 0xf4, 0x30, 0x47, 0x07, 0x1e, 0xe3, 0x34, 0xc7, 0x6e, 0x96, 0x53,
+	0x4e, 0xe1, 0xae, 0x88, 0x71, 0x17, 0x31, 0xae, 0x8e, 0xd9, 0x7b, 0x27, 0xa4, 0x2c, 0xa1, 0x2c,
+	0x90, 0x51, 0x9e, 0x32, 0x54, 0xca, 0xde, 0x4e, 0x4c, 0x63, 0xaa, 0xfc, 0xe2, 0x4b, 0x7b, 0x9d,
+	0x98, 0xd2, 0x78, 0x80, 0x3d, 0x69, 0xf5, 0x86, 0x97, 0x1e, 0x27, 0x09, 0x66, 0x1c, 0x25, 0x99,
+	0x0a, 0x68, 0xfe, 0x68, 0x80, 0xd2, 0x39, 0xca, 0x51, 0xc2, 0xe0, 0x29, 0x28, 0x27, 0x28, 0xbf,
+	0xc2, 0x9c, 0x59, 0x46, 0xc3, 0x6c, 0xd5, 0x0e, 0x6d, 0xf7, 0xe9, 0x65, 0xb8, 0x67, 0x32, 0xac,
+	0xb3, 0x75, 0x3b, 0x75, 0x0a, 0x37, 0xf7, 0x4e, 0x59, 0xd9, 0xcc, 0x9f, 0xe7, 0xc3, 0x8f, 0xc1,
+	0x8e, 0xcc, 0x0a, 0xfa, 0x44, 0x5c, 0x6b, 0x12, 0x0c, 0x70, 0x1a, 0xf3, 0xbe, 0xb5, 0xd6, 0x30,
+	0x5a, 0x45, 0x1f, 0x4a, 0xec, 0x44, 0x41, 0x5f, 0x4a, 0xa4, 0xf9, 0x8f, 0x09, 0x4a, 0x8a, 0x06,
+	0x7e, 0x00, 0xaa, 0x8a, 0x27, 0x20, 0x91, 0x65, 0x34, 0x8c, 0x56, 0xb5, 0x53, 0x9f, 0x4d, 0x9d,
+	0x8a, 0x82, 0x4f, 0xbb, 0x7e, 0x45, 0xc1, 0xa7, 0x11, 0xdc, 0x07, 0xa0, 0x87, 0x18, 0x0e, 0x10,
+	0x63, 0x98, 0x4b, 0xf6, 0xaa, 0x5f, 0x15, 0x9e, 0x23, 0xe1, 0x80, 0x0e, 0xa8, 0x7d, 0x3f, 0xa4,
+	0x7c, 0x8e, 0x9b, 0x12, 0x07, 0xd2, 0xa5, 0x02, 0x7a, 0xa0, 0x4c, 0x73, 0x14, 0x0e, 0x30, 0xb3,
+	0x8a, 0x0d, 0xb3, 0x55, 0xef, 0x9c, 0xfc, 0x3b, 0x75, 0xda, 0x31, 0xe1, 0xfd, 0x61, 0xcf, 0x0d,
+	0x69, 0xa2, 0x5b, 0xac, 0x7f, 0xda, 0x2c, 0xba, 0xf2, 0xf8, 0x24, 0xc3, 0xcc, 0x3d, 0x0a, 0xc3,
+	0xa3, 0x28, 0xca, 0x31, 0x63, 0xbf, 0xfd, 0xd2, 0xde, 0xd6, 0x83, 0xd0, 0x9e, 0xce, 0x84, 0x63,
+	0xe6, 0xcf, 0x89, 0xe1, 0x2e, 0x28, 0xa1, 0x90, 0x93, 0x11, 0xb6, 0xd6, 0x1b, 0x46, 0xab, 0xe2,
+	0x6b, 0x0b, 0x7e, 0x07, 0xb6, 0x13, 0x34, 0x0e, 0x54, 0x9f, 0x22, 0x3c, 0x22, 0x88, 0x13, 0x9a,
+	0x5a, 0x25, 0x79, 0x61, 0xf7, 0x76, 0xea, 0x18, 0x7f, 0x4e, 0x9d, 0xf7, 0x9e, 0xa3, 0x96, 0x2e,
+	0x0e, 0xfd, 0x37, 0x13, 0x34, 0x3e, 0x17, 0x4c, 0xdd, 0x39, 0x11, 0x6c, 0x81, 0x37, 0x12, 0x92,
+	0x06, 0xaa, 0x8c, 0x20, 0xa4, 0xc3, 0x94, 0x5b, 0x65, 0xd9, 0xff, 0xcd, 0x84, 0xa4, 0x5f, 0x49,
+	0xf7, 0xb1, 0xf0, 0xc2, 0x09, 0xd8, 0x50, 0x55, 0x30, 0x3a, 0xcc, 0x43, 0xcc, 0xac, 0x8a, 0x1c,
+	0xff, 0xbb, 0xcf, 0x1a, 0xbf, 0x3c, 0xe8, 0x42, 0xc6, 0x76, 0x3c, 0xa1, 0x81, 0xbf, 0xa7, 0xce,
+	0xdb, 0x4f, 0x30, 0x7c, 0x44, 0x13, 0xc2, 0x71, 0x92, 0xf1, 0xc9, 0xcd, 0xbd, 0x53, 0x5f, 0x8a,
+	0x67, 0x7e, 0x3d, 0x5b, 0xb2, 0x9a, 0x3e, 0xa8, 0x2d, 0xa1, 0xab, 0x8c, 0xde, 0x02, 0x65, 0x92,
+	0x8e, 0x70, 0xce, 0xb0, 0x9c, 0x7b, 0xc5, 0x9f, 0x9b, 0xcd, 0x9f, 0xd7, 0x40, 0xed, 0x9c, 0x32,
+	0x8e, 0x23, 0x49, 0xbd, 0x0a, 0x29, 0x05, 0x9b, 0xba, 0x5f, 0x48, 0xcd, 0x52, 0x72, 0xbf, 0x4c,
+	0x59, 0x6c, 0x28, 0x7e, 0xed, 0x83, 0x5d, 0xb0, 0x2e, 0xfb, 0x61, 0x99, 0x8b, 0xb1, 0x17, 0x56,
+	0x18, 0xbb, 0x4a, 0x86, 0x9f, 0x81, 0x12, 0x1e, 0x67, 0x24, 0x9f, 0x58, 0xc5, 0x86, 0xd1, 0xaa,
+	0x1d, 0xee, 0xb9, 0x6a, 0xed, 0xdd, 0xf9, 0xda, 0xbb, 0x5f, 0xcf, 0xd7, 0xbe, 0x53, 0x11, 0x47,
+	0x5c, 0xdf, 0x3b, 0x86, 0xaf, 0x73, 0x9a, 0xbf, 0x9a, 0xa0, 0xa6, 0xe4, 0x70, 0xc1, 0x11, 0x67,
+	0xaf, 0x75, 0xbf, 0xf6, 0x01, 0x48, 0x08, 0x63, 0x5a, 0xce, 0xa6, 0x94, 0x73, 0x55, 0x78, 0x94,
+	0x92, 0xdf, 0x07, 0x5b, 0x8b, 0x4d, 0xd2, 0x31, 0x45, 0x25, 0xf9, 0x85, 0x5b, 0x05, 0x7e, 0x01,
+	0x36, 0x07, 0x88, 0xf1, 0x20, 0xa3, 0x8c, 0x07, 0xe2, 0x4d, 0xb4, 0xd6, 0x57, 0xe8, 0x5c, 0x5d,
+	0xe4, 0x0a, 0x89, 0x09, 0x50, 0x3c, 0x76, 0xa2, 0x02, 0x92, 0xc6, 0x01, 0x23, 0xa9, 0x78, 0xf4,
+	0x30, 0x89, 0xfb, 0x5c, 0x6e, 0xb2, 0xe9, 0x43, 0x8d, 0x5d, 0x08, 0xe8, 0x44, 0x22, 0xf0, 0x1b,
+	0xf0, 0x96, 0x3c, 0xfd, 0xff, 0x5a, 0xf5, 0xf8, 0xca, 0x2b, 0x14, 0xb1, 0x2d, 0x28, 0x16, 0xeb,
+	0xfe, 0xb9, 0x9a, 0xe5, 0xef, 0x06, 0xd8, 0x52, 0x0f, 0x2b, 0x09, 0xd1, 0x60, 0x65, 0xfd, 0x2f,
+	0xe4, 0xb8, 0xf6, 0x22, 0x72, 0xdc, 0x05, 0x25, 0xdd, 0x02, 0x53, 0xb6, 0x40, 0x5b, 0xf0, 0x53,
+	0x50, 0x94, 0xad, 0x5e, 0x45, 0xa4, 0x32, 0xa3, 0xf9, 0x03, 0xa8, 0x1f, 0x0f, 0xf3, 0x1c, 0xa7,
+	0xfc, 0xd5, 0x5c, 0xa9, 0x73, 0xf6, 0xf0, 0x97, 0x6d, 0xfc, 0x34, 0xb3, 0x8d, 0xdb, 0x99, 0x6d,
+	0xdc, 0xcd, 0x6c, 0xe3, 0x61, 0x66, 0x1b, 0xd7, 0x8f, 0x76, 0xe1, 0xee, 0xd1, 0x2e, 0xfc, 0xf1,
+	0x68, 0x17, 0xbe, 0xfd, 0x70, 0x89, 0x50, 0xbc, 0x9b, 0xed, 0x01, 0xea, 0x31, 0xf9, 0xe5, 0x8d,
+	0x97, 0xfe, 0xed, 0x25, 0x73, 0xaf, 0x24, 0xef, 0xfc, 0xc9, 0x7f, 0x03, 0x00, 0xb7, 0xac, 0x94,
+	0x07, 0x0c, 0x08, 0x00, 0x00,
 }
 
 func (this *Params) VerboseEqual(that interface{}) error {
@@ -341,6 +613,9 @@ func (this *Params) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("Markets this[%v](%v) Not Equal that[%v](%v)", i, this.Markets[i], i, that1.Markets[i])
 		}
 	}
+	if this.PriceHistoryLength != that1.PriceHistoryLength {
+		return fmt.Errorf("PriceHistoryLength this(%v) Not Equal that(%v)", this.PriceHistoryLength, that1.PriceHistoryLength)
+	}
 	return nil
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -370,6 +645,9 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.PriceHistoryLength != that1.PriceHistoryLength {
+		return false
+	}
 	return true
 }
 func (this *Market) VerboseEqual(that interface{}) error {
@@ -417,6 +695,24 @@ func (this *Market) VerboseEqual(that interface{}) error {
 	if this.Active != that1.Active {
 		return fmt.Errorf("Active this(%v) Not Equal that(%v)", this.Active, that1.Active)
 	}
+	if that1.MaxPriceDeviation == nil {
+		if this.MaxPriceDeviation != nil {
+			return fmt.Errorf("this.MaxPriceDeviation != nil && that1.MaxPriceDeviation == nil")
+		}
+	} else if !this.MaxPriceDeviation.Equal(*that1.MaxPriceDeviation) {
+		return fmt.Errorf("MaxPriceDeviation this(%v) Not Equal that(%v)", this.MaxPriceDeviation, that1.MaxPriceDeviation)
+	}
+	if this.MinOracleCount != that1.MinOracleCount {
+		return fmt.Errorf("MinOracleCount this(%v) Not Equal that(%v)", this.MinOracleCount, that1.MinOracleCount)
+	}
+	if len(this.PriceSources) != len(that1.PriceSources) {
+		return fmt.Errorf("PriceSources this(%v) Not Equal that(%v)", len(this.PriceSources), len(that1.PriceSources))
+	}
+	for i := range this.PriceSources {
+		if !this.PriceSources[i].Equal(&that1.PriceSources[i]) {
+			return fmt.Errorf("PriceSources this[%v](%v) Not Equal that[%v](%v)", i, this.PriceSources[i], i, that1.PriceSources[i])
+		}
+	}
 	return nil
 }
 func (this *Market) Equal(that interface{}) bool {
@@ -458,6 +754,84 @@ func (this *Market) Equal(that interface{}) bool {
 	if this.Active != that1.Active {
 		return false
 	}
+	if that1.MaxPriceDeviation == nil {
+		if this.MaxPriceDeviation != nil {
+			return false
+		}
+	} else if !this.MaxPriceDeviation.Equal(*that1.MaxPriceDeviation) {
+		return false
+	}
+	if this.MinOracleCount != that1.MinOracleCount {
+		return false
+	}
+	if len(this.PriceSources) != len(that1.PriceSources) {
+		return false
+	}
+	for i := range this.PriceSources {
+		if !this.PriceSources[i].Equal(&that1.PriceSources[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PriceSource) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PriceSource)
+	if !ok {
+		that2, ok := that.(PriceSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PriceSource")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PriceSource but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PriceSource but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if this.Inverse != that1.Inverse {
+		return fmt.Errorf("Inverse this(%v) Not Equal that(%v)", this.Inverse, that1.Inverse)
+	}
+	return nil
+}
+func (this *PriceSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PriceSource)
+	if !ok {
+		that2, ok := that.(PriceSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if this.Inverse != that1.Inverse {
+		return false
+	}
 	return true
 }
 func (this *PostedPrice) VerboseEqual(that interface{}) error {
@@ -532,7 +906,7 @@ func (this *PostedPrice) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *CurrentPrice) VerboseEqual(that interface{}) error {
+func (this *OracleStats) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -540,39 +914,54 @@ func (this *CurrentPrice) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*CurrentPrice)
+	that1, ok := that.(*OracleStats)
 	if !ok {
-		that2, ok := that.(CurrentPrice)
+		that2, ok := that.(OracleStats)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *CurrentPrice")
+			return fmt.Errorf("that is not of type *OracleStats")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *CurrentPrice but is nil && this != nil")
+		return fmt.Errorf("that is type *OracleStats but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *CurrentPrice but is not nil && this == nil")
+		return fmt.Errorf("that is type *OracleStats but is not nil && this == nil")
 	}
 	if this.MarketID != that1.MarketID {
 		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
 	}
-	if !this.Price.Equal(that1.Price) {
-		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if this.MissCount != that1.MissCount {
+		return fmt.Errorf("MissCount this(%v) Not Equal that(%v)", this.MissCount, that1.MissCount)
+	}
+	if this.DeviationCount != that1.DeviationCount {
+		return fmt.Errorf("DeviationCount this(%v) Not Equal that(%v)", this.DeviationCount, that1.DeviationCount)
+	}
+	if !this.LastPostTime.Equal(that1.LastPostTime) {
+		return fmt.Errorf("LastPostTime this(%v) Not Equal that(%v)", this.LastPostTime, that1.LastPostTime)
+	}
+	if this.MissingSinceHeight != that1.MissingSinceHeight {
+		return fmt.Errorf("MissingSinceHeight this(%v) Not Equal that(%v)", this.MissingSinceHeight, that1.MissingSinceHeight)
+	}
+	if !this.LastDeviationExpiry.Equal(that1.LastDeviationExpiry) {
+		return fmt.Errorf("LastDeviationExpiry this(%v) Not Equal that(%v)", this.LastDeviationExpiry, that1.LastDeviationExpiry)
 	}
 	return nil
 }
-func (this *CurrentPrice) Equal(that interface{}) bool {
+func (this *OracleStats) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CurrentPrice)
+	that1, ok := that.(*OracleStats)
 	if !ok {
-		that2, ok := that.(CurrentPrice)
+		that2, ok := that.(OracleStats)
 		if ok {
 			that1 = &that2
 		} else {
@@ -587,22 +976,169 @@ func (this *CurrentPrice) Equal(that interface{}) bool {
 	if this.MarketID != that1.MarketID {
 		return false
 	}
-	if !this.Price.Equal(that1.Price) {
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return false
+	}
+	if this.MissCount != that1.MissCount {
+		return false
+	}
+	if this.DeviationCount != that1.DeviationCount {
+		return false
+	}
+	if !this.LastPostTime.Equal(that1.LastPostTime) {
+		return false
+	}
+	if this.MissingSinceHeight != that1.MissingSinceHeight {
+		return false
+	}
+	if !this.LastDeviationExpiry.Equal(that1.LastDeviationExpiry) {
 		return false
 	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *HistoricalPrice) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	that1, ok := that.(*HistoricalPrice)
+	if !ok {
+		that2, ok := that.(HistoricalPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *HistoricalPrice")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *HistoricalPrice but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *HistoricalPrice but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	if this.Height != that1.Height {
+		return fmt.Errorf("Height this(%v) Not Equal that(%v)", this.Height, that1.Height)
+	}
+	if !this.Time.Equal(that1.Time) {
+		return fmt.Errorf("Time this(%v) Not Equal that(%v)", this.Time, that1.Time)
+	}
+	return nil
+}
+func (this *HistoricalPrice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HistoricalPrice)
+	if !ok {
+		that2, ok := that.(HistoricalPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	return true
+}
+func (this *CurrentPrice) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CurrentPrice)
+	if !ok {
+		that2, ok := that.(CurrentPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CurrentPrice")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CurrentPrice but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CurrentPrice but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	return nil
+}
+func (this *CurrentPrice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CurrentPrice)
+	if !ok {
+		that2, ok := that.(CurrentPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -612,6 +1148,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PriceHistoryLength != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.PriceHistoryLength))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Markets) > 0 {
 		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -649,6 +1190,37 @@ func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PriceSources) > 0 {
+		for iNdEx := len(m.PriceSources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceSources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MinOracleCount != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MinOracleCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxPriceDeviation != nil {
+		{
+			size := m.MaxPriceDeviation.Size()
+			i -= size
+			if _, err := m.MaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintStore(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Active {
 		i--
 		if m.Active {
@@ -692,6 +1264,46 @@ func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PriceSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Inverse {
+		i--
+		if m.Inverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PostedPrice) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -747,7 +1359,7 @@ func (m *PostedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CurrentPrice) Marshal() (dAtA []byte, err error) {
+func (m *OracleStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -757,16 +1369,97 @@ func (m *CurrentPrice) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CurrentPrice) MarshalTo(dAtA []byte) (int, error) {
+func (m *OracleStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CurrentPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OracleStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastDeviationExpiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastDeviationExpiry):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintStore(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if m.MissingSinceHeight != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MissingSinceHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastPostTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastPostTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintStore(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if m.DeviationCount != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.DeviationCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MissCount != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MissCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoricalPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintStore(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.Price.Size()
 		i -= size
@@ -787,29 +1480,72 @@ func (m *CurrentPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStore(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CurrentPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *CurrentPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrentPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStore(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
 			l = e.Size()
 			n += 1 + l + sovStore(uint64(l))
 		}
 	}
+	if m.PriceHistoryLength != 0 {
+		n += 1 + sovStore(uint64(m.PriceHistoryLength))
+	}
 	return n
 }
 
@@ -840,6 +1576,35 @@ func (m *Market) Size() (n int) {
 	if m.Active {
 		n += 2
 	}
+	if m.MaxPriceDeviation != nil {
+		l = m.MaxPriceDeviation.Size()
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.MinOracleCount != 0 {
+		n += 1 + sovStore(uint64(m.MinOracleCount))
+	}
+	if len(m.PriceSources) > 0 {
+		for _, e := range m.PriceSources {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.Inverse {
+		n += 2
+	}
 	return n
 }
 
@@ -864,6 +1629,56 @@ func (m *PostedPrice) Size() (n int) {
 	return n
 }
 
+func (m *OracleStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.MissCount != 0 {
+		n += 1 + sovStore(uint64(m.MissCount))
+	}
+	if m.DeviationCount != 0 {
+		n += 1 + sovStore(uint64(m.DeviationCount))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastPostTime)
+	n += 1 + l + sovStore(uint64(l))
+	if m.MissingSinceHeight != 0 {
+		n += 1 + sovStore(uint64(m.MissingSinceHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastDeviationExpiry)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *HistoricalPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovStore(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
 func (m *CurrentPrice) Size() (n int) {
 	if m == nil {
 		return 0
@@ -885,7 +1700,499 @@ func sovStore(x uint64) (n int) {
 func sozStore(x uint64) (n int) {
 	return sovStore(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryLength", wireType)
+			}
+			m.PriceHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Market) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracles = append(m.Oracles, make([]byte, postIndex-iNdEx))
+			copy(m.Oracles[len(m.Oracles)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxPriceDeviation = &v
+			if err := m.MaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOracleCount", wireType)
+			}
+			m.MinOracleCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOracleCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSources = append(m.PriceSources, PriceSource{})
+			if err := m.PriceSources[len(m.PriceSources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Inverse = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -908,15 +2215,115 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PostedPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PostedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OracleAddress == nil {
+				m.OracleAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -943,8 +2350,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, Market{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -969,7 +2375,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Market) Unmarshal(dAtA []byte) error {
+func (m *OracleStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -992,10 +2398,10 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1032,9 +2438,9 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1044,29 +2450,31 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OracleAddress == nil {
+				m.OracleAddress = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
 			}
-			var stringLen uint64
+			m.MissCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1076,29 +2484,35 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MissCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStore
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStore
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationCount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DeviationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPostTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1108,29 +2522,30 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Oracles = append(m.Oracles, make([]byte, postIndex-iNdEx))
-			copy(m.Oracles[len(m.Oracles)-1], dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastPostTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingSinceHeight", wireType)
 			}
-			var v int
+			m.MissingSinceHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1140,12 +2555,44 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MissingSinceHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDeviationExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastDeviationExpiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
@@ -1167,7 +2614,7 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostedPrice) Unmarshal(dAtA []byte) error {
+func (m *HistoricalPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1190,10 +2637,10 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostedPrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoricalPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoricalPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1230,9 +2677,9 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1242,31 +2689,31 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.OracleAddress == nil {
-				m.OracleAddress = []byte{}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1276,29 +2723,14 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStore
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStore
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1325,7 +2757,7 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	earntypes "github.com/kava-labs/kava/x/earn/types"
+)
+
+// DelegateMintDeposit delegates tokens to a validator, then converts them into staking derivatives,
+// then deposits to an earn vault. It returns the staking derivatives deposited.
+func (k Keeper) DelegateMintDeposit(ctx sdk.Context, depositor sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (sdk.Coin, error) {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, stakingtypes.ErrNoValidatorFound
+	}
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	if amount.Denom != bondDenom {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", amount.Denom, bondDenom,
+		)
+	}
+	newShares, err := k.stakingKeeper.Delegate(ctx, depositor, amount.Amount, stakingtypes.Unbonded, validator, true)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	derivativeMinted, err := k.liquidKeeper.MintDerivative(ctx, depositor, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	err = k.earnKeeper.Deposit(ctx, depositor, derivativeMinted, earntypes.STRATEGY_TYPE_SAVINGS)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			stakingtypes.EventTypeDelegate,
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyNewShares, newShares.String()),
+		),
+	)
+
+	return derivativeMinted, nil
+}
+
+// WithdrawBurnUndelegate removes staking derivatives from an earn vault, converts them to a staking delegation,
+// then undelegates them from their validator. It returns the delegation shares undelegated and the time the
+// unbonding completes.
+func (k Keeper) WithdrawBurnUndelegate(ctx sdk.Context, from sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (sdk.Dec, time.Time, error) {
+	tokenAmount, err := k.liquidKeeper.DerivativeFromTokens(ctx, valAddr, amount)
+	if err != nil {
+		return sdk.Dec{}, time.Time{}, err
+	}
+
+	withdrawnAmount, err := k.earnKeeper.Withdraw(ctx, from, tokenAmount, earntypes.STRATEGY_TYPE_SAVINGS)
+	if err != nil {
+		return sdk.Dec{}, time.Time{}, err
+	}
+
+	sharesReturned, err := k.liquidKeeper.BurnDerivative(ctx, from, valAddr, withdrawnAmount)
+	if err != nil {
+		return sdk.Dec{}, time.Time{}, err
+	}
+
+	completionTime, err := k.stakingKeeper.Undelegate(ctx, from, valAddr, sharesReturned)
+	if err != nil {
+		return sdk.Dec{}, time.Time{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			stakingtypes.EventTypeUnbond,
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	)
+
+	return sharesReturned, completionTime, nil
+}
@@ -0,0 +1,24 @@
+package swap
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// EndBlocker sweeps the accrued protocol fees to the community pool every protocol fee sweep interval
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if ctx.BlockHeight()%k.GetProtocolFeeSweepInterval(ctx) != 0 {
+		return
+	}
+
+	if err := k.SweepProtocolFees(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,47 @@
+package swap_test
+
+import (
+	"testing"
+
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+	"github.com/kava-labs/kava/x/swap"
+	"github.com/kava-labs/kava/x/swap/testutil"
+	"github.com/kava-labs/kava/x/swap/types"
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type abciTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *abciTestSuite) TestEndBlocker_SweepsProtocolFeesOnInterval() {
+	communityAddr := suite.AccountKeeper.GetModuleAddress(communitytypes.ModuleAccountName)
+	communityBalance := suite.BankKeeper.GetAllBalances(suite.Ctx, communityAddr)
+
+	params := types.DefaultParams()
+	params.ProtocolFeeSweepInterval = 100
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	fees := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e3)))
+	suite.AddCoinsToProtocolFeeAccount(fees)
+	suite.Keeper.SetProtocolFeeRecord(suite.Ctx, types.NewProtocolFeeRecord("ukava:usdx", fees))
+
+	// fees are not swept between intervals
+	suite.Ctx = suite.Ctx.WithBlockHeight(99)
+	swap.EndBlocker(suite.Ctx, suite.Keeper)
+	suite.ProtocolFeeAccountBalanceEqual(fees)
+	suite.Equal(types.ProtocolFeeRecords{types.NewProtocolFeeRecord("ukava:usdx", fees)}, suite.Keeper.GetAllProtocolFeeRecords(suite.Ctx))
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(100)
+	swap.EndBlocker(suite.Ctx, suite.Keeper)
+	suite.ProtocolFeeAccountBalanceEqual(sdk.NewCoins())
+	suite.Equal(communityBalance.Add(fees...), suite.BankKeeper.GetAllBalances(suite.Ctx, communityAddr))
+	suite.Empty(suite.Keeper.GetAllProtocolFeeRecords(suite.Ctx))
+}
+
+func TestABCITestSuite(t *testing.T) {
+	suite.Run(t, new(abciTestSuite))
+}
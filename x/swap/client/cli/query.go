@@ -2,12 +2,15 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/swap/types"
 )
@@ -32,6 +35,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		queryParamsCmd(queryRoute),
 		queryDepositsCmd(queryRoute),
 		queryPoolsCmd(queryRoute),
+		queryEstimateSwapExactForTokensCmd(queryRoute),
+		queryEstimateSwapForExactTokensCmd(queryRoute),
+		queryEstimateDepositCmd(queryRoute),
+		queryEstimateWithdrawCmd(queryRoute),
+		queryTWAPCmd(queryRoute),
+		queryProtocolFeesCmd(queryRoute),
 	}
 
 	for _, cmd := range cmds {
@@ -152,3 +161,230 @@ func queryPoolsCmd(queryRoute string) *cobra.Command {
 	}
 	return cmd
 }
+
+func queryEstimateSwapExactForTokensCmd(queryRoute string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "estimate-swap-exact-for-tokens [exactCoinA] [denomB]",
+		Short: "estimate a swap of an exact amount of token a for token b",
+		Long: strings.TrimSpace(`estimate the output, fee, price impact and resulting pool reserves of a swap with an exact input:
+ 		Example:
+ 		$ kava q swap estimate-swap-exact-for-tokens 1000000ukava usdx`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			exactTokenA, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateSwapExactForTokens(context.Background(), &types.QueryEstimateSwapExactForTokensRequest{
+				ExactTokenA: exactTokenA,
+				TokenBDenom: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryEstimateSwapForExactTokensCmd(queryRoute string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "estimate-swap-for-exact-tokens [denomA] [exactCoinB]",
+		Short: "estimate a swap of token a for an exact amount of token b",
+		Long: strings.TrimSpace(`estimate the input, fee, price impact and resulting pool reserves of a swap with an exact output:
+ 		Example:
+ 		$ kava q swap estimate-swap-for-exact-tokens ukava 5000000usdx`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			exactTokenB, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateSwapForExactTokens(context.Background(), &types.QueryEstimateSwapForExactTokensRequest{
+				TokenADenom: args[0],
+				ExactTokenB: exactTokenB,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryEstimateDepositCmd(queryRoute string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "estimate-deposit [tokenA] [tokenB]",
+		Short: "estimate a deposit of liquidity into a pool",
+		Long: strings.TrimSpace(`estimate the deposited amount, shares and resulting pool reserves of a deposit:
+ 		Example:
+ 		$ kava q swap estimate-deposit 10000000ukava 50000000usdx`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenA, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			tokenB, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateDeposit(context.Background(), &types.QueryEstimateDepositRequest{
+				TokenA: tokenA,
+				TokenB: tokenB,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryEstimateWithdrawCmd(queryRoute string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "estimate-withdraw [poolID] [shares]",
+		Short: "estimate a withdraw of liquidity from a pool",
+		Long: strings.TrimSpace(`estimate the withdrawn amount and resulting pool reserves of a withdraw:
+ 		Example:
+ 		$ kava q swap estimate-withdraw ukava:usdx 1000000`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			shares, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("shares '%s' not a valid int", args[1])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateWithdraw(context.Background(), &types.QueryEstimateWithdrawRequest{
+				PoolId: args[0],
+				Shares: shares,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryTWAPCmd(queryRoute string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "twap [poolID] [window]",
+		Short: "get the time-weighted average prices of a pool",
+		Long: strings.TrimSpace(`get the time-weighted average prices of a pool over a window ending at the current block:
+ 		Example:
+ 		$ kava q swap twap ukava:usdx 1h`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			window, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("window '%s' not a valid duration: %w", args[1], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TWAP(context.Background(), &types.QueryTWAPRequest{
+				PoolId: args[0],
+				Window: window,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryProtocolFeesCmd(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protocol-fees",
+		Short: "get protocol fees accrued by pools",
+		Long: strings.TrimSpace(`get the protocol fees accrued by pools that have not yet been swept to the community pool:
+ 		Example:
+ 		$ kava q swap protocol-fees
+ 		$ kava q swap protocol-fees --pool ukava:usdx`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pool, err := cmd.Flags().GetString(flagPool)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProtocolFees(context.Background(), &types.QueryProtocolFeesRequest{
+				PoolId:     pool,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "protocol-fees")
+
+	cmd.Flags().String(flagPool, "", "pool name")
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -31,6 +32,7 @@ func GetTxCmd() *cobra.Command {
 		getCmdWithdraw(),
 		getCmdSwapExactForTokens(),
 		getCmdSwapForExactTokens(),
+		getCmdSwapExactForTokensRoute(),
 	}
 
 	for _, cmd := range cmds {
@@ -226,3 +228,51 @@ func getCmdSwapForExactTokens() *cobra.Command {
 		},
 	}
 }
+
+func getCmdSwapExactForTokensRoute() *cobra.Command {
+	return &cobra.Command{
+		Use:   "swap-exact-for-tokens-route [exactCoinA] [coinB] [path] [slippage] [deadline]",
+		Short: "swap an exact amount of token a for token b through a comma separated path of denoms",
+		Example: fmt.Sprintf(
+			`%s tx %s swap-exact-for-tokens-route 1000000hard 2500000usdx hard,ukava,usdx 0.01 1624224736 --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			exactTokenA, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			tokenB, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			path := strings.Split(args[2], ",")
+
+			slippage, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			deadline, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgSwapExactForTokensRoute(fromAddr.String(), exactTokenA, tokenB, path, slippage, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
@@ -22,6 +22,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, sh := range gs.ShareRecords {
 		k.SetDepositorShares(ctx, sh)
 	}
+	for _, o := range gs.PriceObservations {
+		k.SetPriceObservation(ctx, o)
+	}
+	for _, r := range gs.ProtocolFeeRecords {
+		k.SetProtocolFeeRecord(ctx, r)
+	}
 }
 
 // ExportGenesis exports the genesis state
@@ -29,6 +35,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	pools := k.GetAllPools(ctx)
 	shares := k.GetAllDepositorShares(ctx)
+	observations := k.GetAllPriceObservations(ctx)
+	protocolFees := k.GetAllProtocolFeeRecords(ctx)
 
-	return types.NewGenesisState(params, pools, shares)
+	return types.NewGenesisState(params, pools, shares, observations, protocolFees)
 }
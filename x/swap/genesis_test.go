@@ -2,6 +2,7 @@ package swap_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/swap"
@@ -24,6 +25,8 @@ func (suite *genesisTestSuite) Test_InitGenesis_ValidationPanic() {
 		},
 		types.PoolRecords{},
 		types.ShareRecords{},
+		types.PriceObservations{},
+		types.ProtocolFeeRecords{},
 	)
 
 	suite.Panics(func() {
@@ -37,35 +40,53 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 	depositor_2, err := sdk.AccAddressFromBech32("kava1esagqd83rhqdtpy5sxhklaxgn58k2m3s3mnpea")
 	suite.Require().NoError(err)
 
+	poolRecord1 := types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), sdkmath.NewInt(1e6))
+	poolRecord1.PriceCumulativeA = sdk.NewDec(7200)
+	poolRecord1.PriceCumulativeB = sdk.NewDec(1800)
+	poolRecord1.PriceCumulativeUpdatedAt = time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC)
+
 	// slices are sorted by key as stored in the data store, so init and export can be compared with equal
 	state := types.NewGenesisState(
 		types.Params{
-			AllowedPools: types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
-			SwapFee:      sdk.MustNewDecFromStr("0.00255"),
+			AllowedPools:             types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
+			SwapFee:                  sdk.MustNewDecFromStr("0.00255"),
+			ProtocolFeeFraction:      sdk.MustNewDecFromStr("0.1"),
+			ProtocolFeeSweepInterval: types.DefaultProtocolFeeSweepInterval,
 		},
 		types.PoolRecords{
-			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), sdkmath.NewInt(1e6)),
+			poolRecord1,
 			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5e6))), sdkmath.NewInt(3e6)),
 		},
 		types.ShareRecords{
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), sdkmath.NewInt(1e6)),
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), sdkmath.NewInt(3e6)),
 		},
+		types.PriceObservations{
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.ZeroDec(), sdk.ZeroDec()),
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC), sdk.NewDec(7200), sdk.NewDec(1800)),
+		},
+		types.ProtocolFeeRecords{
+			types.NewProtocolFeeRecord(types.PoolID("hard", "usdx"), sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(300)))),
+			types.NewProtocolFeeRecord(types.PoolID("ukava", "usdx"), sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100)), sdk.NewCoin("usdx", sdkmath.NewInt(500)))),
+		},
 	)
 
 	swap.InitGenesis(suite.Ctx, suite.Keeper, state)
 	suite.Equal(state.Params, suite.Keeper.GetParams(suite.Ctx))
 
-	poolRecord1, _ := suite.Keeper.GetPool(suite.Ctx, types.PoolID("hard", "usdx"))
-	suite.Equal(state.PoolRecords[0], poolRecord1)
-	poolRecord2, _ := suite.Keeper.GetPool(suite.Ctx, types.PoolID("ukava", "usdx"))
-	suite.Equal(state.PoolRecords[1], poolRecord2)
+	storedPoolRecord1, _ := suite.Keeper.GetPool(suite.Ctx, types.PoolID("hard", "usdx"))
+	suite.Equal(state.PoolRecords[0], storedPoolRecord1)
+	storedPoolRecord2, _ := suite.Keeper.GetPool(suite.Ctx, types.PoolID("ukava", "usdx"))
+	suite.Equal(state.PoolRecords[1], storedPoolRecord2)
 
 	shareRecord1, _ := suite.Keeper.GetDepositorShares(suite.Ctx, depositor_2, types.PoolID("hard", "usdx"))
 	suite.Equal(state.ShareRecords[0], shareRecord1)
 	shareRecord2, _ := suite.Keeper.GetDepositorShares(suite.Ctx, depositor_1, types.PoolID("ukava", "usdx"))
 	suite.Equal(state.ShareRecords[1], shareRecord2)
 
+	suite.Equal(state.PriceObservations, suite.Keeper.GetAllPriceObservations(suite.Ctx))
+	suite.Equal(state.ProtocolFeeRecords, suite.Keeper.GetAllProtocolFeeRecords(suite.Ctx))
+
 	exportedState := swap.ExportGenesis(suite.Ctx, suite.Keeper)
 	suite.Equal(state, exportedState)
 }
@@ -76,20 +97,35 @@ func (suite *genesisTestSuite) Test_Marshall() {
 	depositor_2, err := sdk.AccAddressFromBech32("kava1esagqd83rhqdtpy5sxhklaxgn58k2m3s3mnpea")
 	suite.Require().NoError(err)
 
+	poolRecord1 := types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), sdkmath.NewInt(1e6))
+	poolRecord1.PriceCumulativeA = sdk.NewDec(7200)
+	poolRecord1.PriceCumulativeB = sdk.NewDec(1800)
+	poolRecord1.PriceCumulativeUpdatedAt = time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC)
+
 	// slices are sorted by key as stored in the data store, so init and export can be compared with equal
 	state := types.NewGenesisState(
 		types.Params{
-			AllowedPools: types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
-			SwapFee:      sdk.MustNewDecFromStr("0.00255"),
+			AllowedPools:             types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
+			SwapFee:                  sdk.MustNewDecFromStr("0.00255"),
+			ProtocolFeeFraction:      sdk.MustNewDecFromStr("0.1"),
+			ProtocolFeeSweepInterval: types.DefaultProtocolFeeSweepInterval,
 		},
 		types.PoolRecords{
-			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), sdkmath.NewInt(1e6)),
+			poolRecord1,
 			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5e6))), sdkmath.NewInt(3e6)),
 		},
 		types.ShareRecords{
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), sdkmath.NewInt(1e6)),
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), sdkmath.NewInt(3e6)),
 		},
+		types.PriceObservations{
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.ZeroDec(), sdk.ZeroDec()),
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC), sdk.NewDec(7200), sdk.NewDec(1800)),
+		},
+		types.ProtocolFeeRecords{
+			types.NewProtocolFeeRecord(types.PoolID("hard", "usdx"), sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(300)))),
+			types.NewProtocolFeeRecord(types.PoolID("ukava", "usdx"), sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100)), sdk.NewCoin("usdx", sdkmath.NewInt(500)))),
+		},
 	)
 
 	encodingCfg := app.MakeEncodingConfig()
@@ -111,20 +147,40 @@ func (suite *genesisTestSuite) Test_LegacyJSONConversion() {
 	depositor_2, err := sdk.AccAddressFromBech32("kava1esagqd83rhqdtpy5sxhklaxgn58k2m3s3mnpea")
 	suite.Require().NoError(err)
 
+	// amino json omits the zero values of omitempty fields, so they are set to compare with proto json
+	poolRecord1 := types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), sdkmath.NewInt(1e6))
+	poolRecord1.AmplificationCoefficient = 100
+	poolRecord1.PriceCumulativeA = sdk.NewDec(7200)
+	poolRecord1.PriceCumulativeB = sdk.NewDec(1800)
+	poolRecord1.PriceCumulativeUpdatedAt = time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC)
+	poolRecord2 := types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5e6))), sdkmath.NewInt(3e6))
+	poolRecord2.AmplificationCoefficient = 100
+	poolRecord2.PriceCumulativeUpdatedAt = time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC)
+
 	// slices are sorted by key as stored in the data store, so init and export can be compared with equal
 	state := types.NewGenesisState(
 		types.Params{
-			AllowedPools: types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
-			SwapFee:      sdk.MustNewDecFromStr("0.00255"),
+			AllowedPools:             types.AllowedPools{types.NewAllowedStablePool("ukava", "usdx", 100)},
+			SwapFee:                  sdk.MustNewDecFromStr("0.00255"),
+			ProtocolFeeFraction:      sdk.MustNewDecFromStr("0.1"),
+			ProtocolFeeSweepInterval: types.DefaultProtocolFeeSweepInterval,
 		},
 		types.PoolRecords{
-			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(2e6))), sdkmath.NewInt(1e6)),
-			types.NewPoolRecord(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5e6))), sdkmath.NewInt(3e6)),
+			poolRecord1,
+			poolRecord2,
 		},
 		types.ShareRecords{
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), sdkmath.NewInt(1e6)),
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), sdkmath.NewInt(3e6)),
 		},
+		types.PriceObservations{
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.ZeroDec(), sdk.ZeroDec()),
+			types.NewPriceObservation(types.PoolID("hard", "usdx"), time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC), sdk.NewDec(7200), sdk.NewDec(1800)),
+		},
+		types.ProtocolFeeRecords{
+			types.NewProtocolFeeRecord(types.PoolID("hard", "usdx"), sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(300)))),
+			types.NewProtocolFeeRecord(types.PoolID("ukava", "usdx"), sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(100)), sdk.NewCoin("usdx", sdkmath.NewInt(500)))),
+		},
 	)
 
 	encodingCfg := app.MakeEncodingConfig()
@@ -104,22 +104,34 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coinA sdk.Coi
 	return nil
 }
 
-func (k Keeper) depositAllowed(ctx sdk.Context, poolID string) bool {
+// allowedPool returns the allowed pool parameter for a pool id, returning false if pool creation is not allowed
+func (k Keeper) allowedPool(ctx sdk.Context, poolID string) (types.AllowedPool, bool) {
 	params := k.GetParams(ctx)
 	for _, p := range params.AllowedPools {
 		if poolID == types.PoolID(p.TokenA, p.TokenB) {
-			return true
+			return p, true
 		}
 	}
-	return false
+	return types.AllowedPool{}, false
 }
 
+// initializePool creates a pool of the type set by its allowed pool parameter, which is a stable swap pool
+// when an amplification coefficient is set and a constant-product pool otherwise
 func (k Keeper) initializePool(ctx sdk.Context, poolID string, depositor sdk.AccAddress, reserves sdk.Coins) (*types.DenominatedPool, sdk.Coins, sdkmath.Int, error) {
-	if allowed := k.depositAllowed(ctx, poolID); !allowed {
+	allowedPool, allowed := k.allowedPool(ctx, poolID)
+	if !allowed {
 		return nil, sdk.Coins{}, sdk.ZeroInt(), errorsmod.Wrap(types.ErrNotAllowed, fmt.Sprintf("can not create pool '%s'", poolID))
 	}
 
-	pool, err := types.NewDenominatedPool(reserves)
+	var (
+		pool *types.DenominatedPool
+		err  error
+	)
+	if allowedPool.AmplificationCoefficient > 0 {
+		pool, err = types.NewStableDenominatedPool(reserves, allowedPool.AmplificationCoefficient)
+	} else {
+		pool, err = types.NewDenominatedPool(reserves)
+	}
 	if err != nil {
 		return nil, sdk.Coins{}, sdk.ZeroInt(), err
 	}
@@ -128,7 +140,7 @@ func (k Keeper) initializePool(ctx sdk.Context, poolID string, depositor sdk.Acc
 }
 
 func (k Keeper) addLiquidityToPool(ctx sdk.Context, record types.PoolRecord, depositor sdk.AccAddress, desiredAmount sdk.Coins) (*types.DenominatedPool, sdk.Coins, sdkmath.Int, error) {
-	pool, err := types.NewDenominatedPoolWithExistingShares(record.Reserves(), record.TotalShares)
+	pool, err := types.NewDenominatedPoolFromRecord(record)
 	if err != nil {
 		return nil, sdk.Coins{}, sdk.ZeroInt(), err
 	}
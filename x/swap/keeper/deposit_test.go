@@ -59,7 +59,7 @@ func (suite *keeperTestSuite) TestDeposit_InsufficientFunds() {
 
 			pool := types.NewAllowedPool(tc.depositA.Denom, tc.depositB.Denom)
 			suite.Require().NoError(pool.Validate())
-			suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+			suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee, types.DefaultProtocolFeeFraction, types.DefaultProtocolFeeSweepInterval))
 
 			balance := sdk.NewCoins(tc.balanceA, tc.balanceB)
 			depositor := suite.CreateAccount(balance)
@@ -123,7 +123,7 @@ func (suite *keeperTestSuite) TestDeposit_InsufficientFunds_Vesting() {
 
 			pool := types.NewAllowedPool(tc.depositA.Denom, tc.depositB.Denom)
 			suite.Require().NoError(pool.Validate())
-			suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+			suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee, types.DefaultProtocolFeeFraction, types.DefaultProtocolFeeSweepInterval))
 
 			balance := sdk.NewCoins(tc.balanceA, tc.balanceB)
 			vesting := sdk.NewCoins(tc.vestingA, tc.vestingB)
@@ -147,7 +147,7 @@ func (suite *keeperTestSuite) TestDeposit_InsufficientFunds_Vesting() {
 func (suite *keeperTestSuite) TestDeposit_CreatePool() {
 	pool := types.NewAllowedPool("ukava", "usdx")
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee, types.DefaultProtocolFeeFraction, types.DefaultProtocolFeeSweepInterval))
 
 	amountA := sdk.NewCoin(pool.TokenA, sdkmath.NewInt(11e6))
 	amountB := sdk.NewCoin(pool.TokenB, sdkmath.NewInt(51e6))
@@ -323,10 +323,12 @@ func (suite *keeperTestSuite) TestDeposit_InsufficientLiquidity() {
 			suite.SetupTest()
 
 			record := types.PoolRecord{
-				PoolID:      types.PoolID("ukava", "usdx"),
-				ReservesA:   tc.poolA,
-				ReservesB:   tc.poolB,
-				TotalShares: tc.poolShares,
+				PoolID:           types.PoolID("ukava", "usdx"),
+				ReservesA:        tc.poolA,
+				ReservesB:        tc.poolB,
+				TotalShares:      tc.poolShares,
+				PriceCumulativeA: sdk.ZeroDec(),
+				PriceCumulativeB: sdk.ZeroDec(),
 			}
 
 			suite.Keeper.SetPool(suite.Ctx, record)
@@ -339,3 +341,52 @@ func (suite *keeperTestSuite) TestDeposit_InsufficientLiquidity() {
 		})
 	}
 }
+
+func (suite *keeperTestSuite) TestDeposit_CreateStablePool() {
+	pool := types.NewAllowedStablePool("usdc", "usdx", 100)
+	suite.Require().NoError(pool.Validate())
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), types.DefaultSwapFee, types.DefaultProtocolFeeFraction, types.DefaultProtocolFeeSweepInterval))
+
+	depositA := sdk.NewCoin(pool.TokenA, sdkmath.NewInt(10e6))
+	depositB := sdk.NewCoin(pool.TokenB, sdkmath.NewInt(10e6))
+	deposit := sdk.NewCoins(depositA, depositB)
+	depositor := suite.CreateAccount(deposit)
+
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), depositA, depositB, sdk.MustNewDecFromStr("0"))
+	suite.Require().NoError(err)
+	suite.AccountBalanceEqual(depositor.GetAddress(), sdk.Coins{})
+	suite.ModuleAccountBalanceEqual(deposit)
+	suite.PoolLiquidityEqual(deposit)
+	suite.PoolShareValueEqual(depositor, pool, deposit)
+
+	record, found := suite.Keeper.GetPool(suite.Ctx, pool.Name())
+	suite.Require().True(found)
+	suite.True(record.IsStableSwap())
+	suite.Equal(uint64(100), record.AmplificationCoefficient)
+
+	// the shares of a balanced stable swap pool are the sum of its reserves
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapDeposit,
+		sdk.NewAttribute(types.AttributeKeyPoolID, pool.Name()),
+		sdk.NewAttribute(types.AttributeKeyDepositor, depositor.GetAddress().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, deposit.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, "20000000"),
+	))
+
+	// changing the allowed pool parameter does not change the coefficient of an existing pool
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(
+		types.NewAllowedPools(types.NewAllowedStablePool("usdc", "usdx", 500)),
+		types.DefaultSwapFee,
+		types.DefaultProtocolFeeFraction,
+		types.DefaultProtocolFeeSweepInterval,
+	))
+
+	secondDepositor := suite.NewAccountFromAddr(sdk.AccAddress("second depositor----"), deposit)
+	err = suite.Keeper.Deposit(suite.Ctx, secondDepositor.GetAddress(), depositA, depositB, sdk.MustNewDecFromStr("0"))
+	suite.Require().NoError(err)
+	suite.PoolShareValueEqual(secondDepositor, pool, deposit)
+
+	record, found = suite.Keeper.GetPool(suite.Ctx, pool.Name())
+	suite.Require().True(found)
+	suite.Equal(uint64(100), record.AmplificationCoefficient)
+}
@@ -7,6 +7,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -59,15 +61,16 @@ func (s queryServer) Pools(c context.Context, req *types.QueryPoolsRequest) (*ty
 		}
 
 		if shouldAccumulate {
-			denominatedPool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+			denominatedPool, err := types.NewDenominatedPoolFromRecord(poolRecord)
 			if err != nil {
 				return true, types.ErrInvalidPool
 			}
 			totalCoins := denominatedPool.ShareValue(denominatedPool.TotalShares())
 			queryResult := types.PoolResponse{
-				Name:        poolRecord.PoolID,
-				Coins:       totalCoins,
-				TotalShares: denominatedPool.TotalShares(),
+				Name:                     poolRecord.PoolID,
+				Coins:                    totalCoins,
+				TotalShares:              denominatedPool.TotalShares(),
+				AmplificationCoefficient: denominatedPool.AmplificationCoefficient(),
 			}
 			queryResults = append(queryResults, queryResult)
 		}
@@ -148,3 +151,218 @@ func (s queryServer) Deposits(c context.Context, req *types.QueryDepositsRequest
 		Pagination: pageRes,
 	}, nil
 }
+
+// EstimateSwapExactForTokens implements the Query/EstimateSwapExactForTokens gRPC method
+func (s queryServer) EstimateSwapExactForTokens(c context.Context, req *types.QueryEstimateSwapExactForTokensRequest) (*types.QueryEstimateSwapExactForTokensResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := validateSwapDenoms(req.ExactTokenA, req.TokenBDenom); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	_, pool, err := s.keeper.loadPool(ctx, req.ExactTokenA.Denom, req.TokenBDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	spotPrice := pool.SpotPrice(req.ExactTokenA.Denom)
+	swapOutput, feePaid := pool.SwapWithExactInput(req.ExactTokenA, s.keeper.GetSwapFee(ctx))
+	if swapOutput.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientLiquidity, "swap output rounds to zero, increase input amount")
+	}
+	pool, _ = s.keeper.removeProtocolFee(ctx, pool, feePaid)
+
+	return &types.QueryEstimateSwapExactForTokensResponse{
+		TokenB:       swapOutput,
+		FeePaid:      feePaid,
+		PriceImpact:  priceImpact(req.ExactTokenA.Sub(feePaid), swapOutput, spotPrice),
+		PoolReserves: pool.Reserves(),
+	}, nil
+}
+
+// EstimateSwapForExactTokens implements the Query/EstimateSwapForExactTokens gRPC method
+func (s queryServer) EstimateSwapForExactTokens(c context.Context, req *types.QueryEstimateSwapForExactTokensRequest) (*types.QueryEstimateSwapForExactTokensResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := validateSwapDenoms(req.ExactTokenB, req.TokenADenom); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	_, pool, err := s.keeper.loadPool(ctx, req.TokenADenom, req.ExactTokenB.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ExactTokenB.Amount.GTE(pool.Reserves().AmountOf(req.ExactTokenB.Denom)) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInsufficientLiquidity,
+			"output %s >= pool reserves %s", req.ExactTokenB.Amount.String(), pool.Reserves().AmountOf(req.ExactTokenB.Denom).String(),
+		)
+	}
+
+	spotPrice := pool.SpotPrice(req.TokenADenom)
+	swapInput, feePaid := pool.SwapWithExactOutput(req.ExactTokenB, s.keeper.GetSwapFee(ctx))
+	pool, _ = s.keeper.removeProtocolFee(ctx, pool, feePaid)
+
+	return &types.QueryEstimateSwapForExactTokensResponse{
+		TokenA:       swapInput,
+		FeePaid:      feePaid,
+		PriceImpact:  priceImpact(swapInput.Sub(feePaid), req.ExactTokenB, spotPrice),
+		PoolReserves: pool.Reserves(),
+	}, nil
+}
+
+// EstimateDeposit implements the Query/EstimateDeposit gRPC method
+func (s queryServer) EstimateDeposit(c context.Context, req *types.QueryEstimateDepositRequest) (*types.QueryEstimateDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := validateSwapDenoms(req.TokenA, req.TokenB.Denom); err != nil {
+		return nil, err
+	}
+	if err := req.TokenB.Validate(); err != nil || !req.TokenB.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token b: %s", req.TokenB)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	desiredAmount := sdk.NewCoins(req.TokenA, req.TokenB)
+	poolID := types.PoolIDFromCoins(desiredAmount)
+
+	var (
+		pool          *types.DenominatedPool
+		depositAmount sdk.Coins
+		shares        sdkmath.Int
+		err           error
+	)
+	if poolRecord, found := s.keeper.GetPool(ctx, poolID); found {
+		pool, depositAmount, shares, err = s.keeper.addLiquidityToPool(ctx, poolRecord, nil, desiredAmount)
+	} else {
+		pool, depositAmount, shares, err = s.keeper.initializePool(ctx, poolID, nil, desiredAmount)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if depositAmount.AmountOf(req.TokenA.Denom).IsZero() || depositAmount.AmountOf(req.TokenB.Denom).IsZero() || shares.IsZero() {
+		return nil, errorsmod.Wrap(types.ErrInsufficientLiquidity, "deposit must be increased")
+	}
+
+	return &types.QueryEstimateDepositResponse{
+		Deposit:      depositAmount,
+		Shares:       shares,
+		PoolReserves: pool.Reserves(),
+		TotalShares:  pool.TotalShares(),
+	}, nil
+}
+
+// EstimateWithdraw implements the Query/EstimateWithdraw gRPC method
+func (s queryServer) EstimateWithdraw(c context.Context, req *types.QueryEstimateWithdrawRequest) (*types.QueryEstimateWithdrawResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Shares.IsNil() || !req.Shares.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "shares must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pool, err := s.keeper.loadDenominatedPool(ctx, req.PoolId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "pool %s not found", req.PoolId)
+	}
+
+	if req.Shares.GT(pool.TotalShares()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidShares, "withdraw of %s shares greater than %s total shares", req.Shares, pool.TotalShares())
+	}
+
+	withdrawnAmount := pool.RemoveLiquidity(req.Shares)
+	if len(withdrawnAmount) != 2 {
+		return nil, errorsmod.Wrap(types.ErrInsufficientLiquidity, "shares must be increased")
+	}
+
+	return &types.QueryEstimateWithdrawResponse{
+		Amount:       withdrawnAmount,
+		PoolReserves: pool.Reserves(),
+		TotalShares:  pool.TotalShares(),
+	}, nil
+}
+
+// TWAP implements the Query/TWAP gRPC method
+func (s queryServer) TWAP(c context.Context, req *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	priceA, priceB, err := s.keeper.TWAP(ctx, req.PoolId, req.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTWAPResponse{
+		PriceA: priceA,
+		PriceB: priceB,
+	}, nil
+}
+
+// ProtocolFees implements the Query/ProtocolFees gRPC method
+func (s queryServer) ProtocolFees(c context.Context, req *types.QueryProtocolFeesRequest) (*types.QueryProtocolFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(s.keeper.key), types.ProtocolFeeKeyPrefix)
+
+	var queryResults []types.ProtocolFeeRecord
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, shouldAccumulate bool) (bool, error) {
+		var record types.ProtocolFeeRecord
+		if err := s.keeper.cdc.Unmarshal(value, &record); err != nil {
+			return false, err
+		}
+
+		if len(req.PoolId) > 0 && record.PoolID != req.PoolId {
+			return false, nil
+		}
+
+		if shouldAccumulate {
+			queryResults = append(queryResults, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryProtocolFeesResponse{
+		ProtocolFees: queryResults,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// validateSwapDenoms returns an invalid argument error if the token is not positive or the other
+// denom is invalid or the same as the token denom
+func validateSwapDenoms(token sdk.Coin, otherDenom string) error {
+	if err := token.Validate(); err != nil || !token.IsPositive() {
+		return status.Errorf(codes.InvalidArgument, "invalid token: %s", token)
+	}
+	if err := sdk.ValidateDenom(otherDenom); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if token.Denom == otherDenom {
+		return status.Error(codes.InvalidArgument, "denominations can not be equal")
+	}
+
+	return nil
+}
+
+// priceImpact returns the decimal percentage that the execution price of a swap, excluding fees,
+// is below the spot price of the pool before the swap
+func priceImpact(input, output sdk.Coin, spotPrice sdk.Dec) sdk.Dec {
+	executionPrice := sdk.NewDecFromInt(output.Amount).Quo(sdk.NewDecFromInt(input.Amount))
+
+	return sdk.OneDec().Sub(executionPrice.Quo(spotPrice))
+}
@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/testutil"
+	"github.com/kava-labs/kava/x/swap/types"
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type grpcQueryTestSuite struct {
+	testutil.Suite
+	queryServer types.QueryServer
+}
+
+func (suite *grpcQueryTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.queryServer = keeper.NewQueryServerImpl(suite.Keeper)
+}
+
+func TestGrpcQueryTestSuite(t *testing.T) {
+	suite.Run(t, new(grpcQueryTestSuite))
+}
+
+func (suite *grpcQueryTestSuite) TestEstimateSwapExactForTokens() {
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(50e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	res, err := suite.queryServer.EstimateSwapExactForTokens(sdk.WrapSDKContext(suite.Ctx), &types.QueryEstimateSwapExactForTokensRequest{
+		ExactTokenA: sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		TokenBDenom: "usdx",
+	})
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoin("usdx", sdkmath.NewInt(4533054)), res.TokenB)
+	suite.Equal(sdk.NewCoin("ukava", sdkmath.NewInt(3000)), res.FeePaid)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(11e6)), sdk.NewCoin("usdx", sdkmath.NewInt(45466946))), res.PoolReserves)
+
+	expectedImpact := sdk.OneDec().Sub(sdk.NewDec(4533054).QuoInt64(997000).QuoInt64(5))
+	suite.Equal(expectedImpact, res.PriceImpact)
+
+	// the estimate does not modify the pool
+	suite.PoolReservesEqual(types.PoolIDFromCoins(reserves), reserves)
+
+	// the estimate matches the executed swap
+	requester := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6))))
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), res.TokenB, sdk.ZeroDec())
+	suite.Require().NoError(err)
+	suite.AccountBalanceEqual(requester.GetAddress(), sdk.NewCoins(res.TokenB))
+	suite.PoolReservesEqual(types.PoolIDFromCoins(reserves), res.PoolReserves)
+}
+
+func (suite *grpcQueryTestSuite) TestEstimateSwapForExactTokens() {
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(50e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	res, err := suite.queryServer.EstimateSwapForExactTokens(sdk.WrapSDKContext(suite.Ctx), &types.QueryEstimateSwapForExactTokensRequest{
+		TokenADenom: "usdx",
+		ExactTokenB: sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+	})
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoin("usdx", sdkmath.NewInt(5572273)), res.TokenA)
+	suite.Equal(sdk.NewCoin("usdx", sdkmath.NewInt(16717)), res.FeePaid)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(9e6)), sdk.NewCoin("usdx", sdkmath.NewInt(55572273))), res.PoolReserves)
+	suite.True(res.PriceImpact.GT(sdk.ZeroDec()))
+	suite.True(res.PriceImpact.LT(sdk.MustNewDecFromStr("0.11")))
+
+	suite.PoolReservesEqual(types.PoolIDFromCoins(reserves), reserves)
+
+	requester := suite.CreateAccount(sdk.NewCoins(res.TokenA))
+	err = suite.Keeper.SwapForExactTokens(suite.Ctx, requester.GetAddress(), res.TokenA, sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.ZeroDec())
+	suite.Require().NoError(err)
+	suite.AccountBalanceEqual(requester.GetAddress(), sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6))))
+	suite.PoolReservesEqual(types.PoolIDFromCoins(reserves), res.PoolReserves)
+
+	_, err = suite.queryServer.EstimateSwapForExactTokens(sdk.WrapSDKContext(suite.Ctx), &types.QueryEstimateSwapForExactTokensRequest{
+		TokenADenom: "usdx",
+		ExactTokenB: sdk.NewCoin("ukava", sdkmath.NewInt(100e6)),
+	})
+	suite.ErrorIs(err, types.ErrInsufficientLiquidity)
+}
+
+func (suite *grpcQueryTestSuite) TestEstimateSwap_Errors() {
+	suite.Require().NoError(suite.CreatePool(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(50e6)))))
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+
+	_, err := suite.queryServer.EstimateSwapExactForTokens(ctx, nil)
+	suite.EqualError(err, "rpc error: code = InvalidArgument desc = empty request")
+
+	_, err = suite.queryServer.EstimateSwapExactForTokens(ctx, &types.QueryEstimateSwapExactForTokensRequest{
+		ExactTokenA: sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		TokenBDenom: "ukava",
+	})
+	suite.EqualError(err, "rpc error: code = InvalidArgument desc = denominations can not be equal")
+
+	_, err = suite.queryServer.EstimateSwapExactForTokens(ctx, &types.QueryEstimateSwapExactForTokensRequest{
+		ExactTokenA: sdk.NewCoin("ukava", sdkmath.ZeroInt()),
+		TokenBDenom: "usdx",
+	})
+	suite.EqualError(err, "rpc error: code = InvalidArgument desc = invalid token: 0ukava")
+
+	_, err = suite.queryServer.EstimateSwapExactForTokens(ctx, &types.QueryEstimateSwapExactForTokensRequest{
+		ExactTokenA: sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		TokenBDenom: "hard",
+	})
+	suite.EqualError(err, "pool hard:ukava not found: invalid pool")
+
+	_, err = suite.queryServer.EstimateSwapExactForTokens(ctx, &types.QueryEstimateSwapExactForTokensRequest{
+		ExactTokenA: sdk.NewCoin("ukava", sdkmath.NewInt(1)),
+		TokenBDenom: "usdx",
+	})
+	suite.ErrorIs(err, types.ErrInsufficientLiquidity)
+}
+
+func (suite *grpcQueryTestSuite) TestEstimateDeposit() {
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(50e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+	poolID := types.PoolIDFromCoins(reserves)
+	totalShares, found := suite.Keeper.GetPoolShares(suite.Ctx, poolID)
+	suite.Require().True(found)
+
+	res, err := suite.queryServer.EstimateDeposit(sdk.WrapSDKContext(suite.Ctx), &types.QueryEstimateDepositRequest{
+		TokenA: sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+		TokenB: sdk.NewCoin("usdx", sdkmath.NewInt(10e6)),
+	})
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5e6))), res.Deposit)
+	suite.Equal(totalShares.QuoRaw(10), res.Shares)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(11e6)), sdk.NewCoin("usdx", sdkmath.NewInt(55e6))), res.PoolReserves)
+	suite.Equal(totalShares.Add(res.Shares), res.TotalShares)
+	suite.PoolReservesEqual(poolID, reserves)
+
+	// a deposit into a pool that does not exist estimates the creation of an allowed pool
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(
+		types.AllowedPools{types.NewAllowedPool("ukava", "usdx"), types.NewAllowedStablePool("usdc", "usdx", 100)},
+		types.DefaultSwapFee,
+		types.DefaultProtocolFeeFraction,
+		types.DefaultProtocolFeeSweepInterval,
+	))
+	res, err = suite.queryServer.EstimateDeposit(sdk.WrapSDKContext(suite.Ctx), &types.QueryEstimateDepositRequest{
+		TokenA: sdk.NewCoin("usdc", sdkmath.NewInt(10e6)),
+		TokenB: sdk.NewCoin("usdx", sdkmath.NewInt(10e6)),
+	})
+	suite.Require().NoError(err)
+	suite.Equal(sdkmath.NewInt(20e6), res.Shares)
+	suite.Equal(res.Shares, res.TotalShares)
+	_, found = suite.Keeper.GetPool(suite.Ctx, types.PoolID("usdc", "usdx"))
+	suite.False(found)
+
+	_, err = suite.queryServer.EstimateDeposit(sdk.WrapSDKContext(suite.Ctx), &types.QueryEstimateDepositRequest{
+		TokenA: sdk.NewCoin("hard", sdkmath.NewInt(10e6)),
+		TokenB: sdk.NewCoin("usdx", sdkmath.NewInt(10e6)),
+	})
+	suite.ErrorIs(err, types.ErrNotAllowed)
+}
+
+func (suite *grpcQueryTestSuite) TestEstimateWithdraw() {
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(50e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+	poolID := types.PoolIDFromCoins(reserves)
+	totalShares, found := suite.Keeper.GetPoolShares(suite.Ctx, poolID)
+	suite.Require().True(found)
+
+	res, err := suite.queryServer.EstimateWithdraw(sdk.WrapSDKContext(suite.Ctx), &types.QueryEstimateWithdrawRequest{
+		PoolId: poolID,
+		Shares: totalShares.QuoRaw(2),
+	})
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(4999999)), sdk.NewCoin("usdx", sdkmath.NewInt(24999998))), res.Amount)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(5000001)), sdk.NewCoin("usdx", sdkmath.NewInt(25000002))), res.PoolReserves)
+	suite.Equal(totalShares.Sub(totalShares.QuoRaw(2)), res.TotalShares)
+	suite.PoolReservesEqual(poolID, reserves)
+
+	res, err = suite.queryServer.EstimateWithdraw(sdk.WrapSDKContext(suite.Ctx), &types.QueryEstimateWithdrawRequest{
+		PoolId: poolID,
+		Shares: totalShares,
+	})
+	suite.Require().NoError(err)
+	suite.Equal(reserves, res.Amount)
+	suite.True(res.PoolReserves.IsZero())
+	suite.True(res.TotalShares.IsZero())
+
+	_, err = suite.queryServer.EstimateWithdraw(sdk.WrapSDKContext(suite.Ctx), &types.QueryEstimateWithdrawRequest{
+		PoolId: poolID,
+		Shares: totalShares.AddRaw(1),
+	})
+	suite.ErrorIs(err, types.ErrInvalidShares)
+
+	_, err = suite.queryServer.EstimateWithdraw(sdk.WrapSDKContext(suite.Ctx), &types.QueryEstimateWithdrawRequest{
+		PoolId: poolID,
+		Shares: sdkmath.NewInt(1),
+	})
+	suite.ErrorIs(err, types.ErrInsufficientLiquidity)
+
+	_, err = suite.queryServer.EstimateWithdraw(sdk.WrapSDKContext(suite.Ctx), &types.QueryEstimateWithdrawRequest{
+		PoolId: "hard:usdx",
+		Shares: sdkmath.NewInt(1e6),
+	})
+	suite.ErrorIs(err, types.ErrInvalidPool)
+
+	_, err = suite.queryServer.EstimateWithdraw(sdk.WrapSDKContext(suite.Ctx), &types.QueryEstimateWithdrawRequest{PoolId: poolID})
+	suite.EqualError(err, "rpc error: code = InvalidArgument desc = shares must be positive")
+}
+
+func (suite *grpcQueryTestSuite) TestTWAP() {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.Ctx = suite.Ctx.WithBlockTime(start)
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(50e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(time.Hour))
+	res, err := suite.queryServer.TWAP(sdk.WrapSDKContext(suite.Ctx), &types.QueryTWAPRequest{
+		PoolId: types.PoolIDFromCoins(reserves),
+		Window: 30 * time.Minute,
+	})
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewDec(5), res.PriceA)
+	suite.Equal(sdk.MustNewDecFromStr("0.2"), res.PriceB)
+
+	_, err = suite.queryServer.TWAP(sdk.WrapSDKContext(suite.Ctx), &types.QueryTWAPRequest{
+		PoolId: types.PoolIDFromCoins(reserves),
+		Window: 2 * time.Hour,
+	})
+	suite.ErrorIs(err, types.ErrNoPriceHistory)
+
+	_, err = suite.queryServer.TWAP(sdk.WrapSDKContext(suite.Ctx), nil)
+	suite.EqualError(err, "rpc error: code = InvalidArgument desc = empty request")
+}
+
+func (suite *grpcQueryTestSuite) TestProtocolFees() {
+	kavaRecord := types.NewProtocolFeeRecord("ukava:usdx", sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e3))))
+	hardRecord := types.NewProtocolFeeRecord("hard:usdx", sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(2e3)), sdk.NewCoin("usdx", sdkmath.NewInt(5e3))))
+	suite.Keeper.SetProtocolFeeRecord(suite.Ctx, kavaRecord)
+	suite.Keeper.SetProtocolFeeRecord(suite.Ctx, hardRecord)
+
+	res, err := suite.queryServer.ProtocolFees(sdk.WrapSDKContext(suite.Ctx), &types.QueryProtocolFeesRequest{})
+	suite.Require().NoError(err)
+	suite.Equal([]types.ProtocolFeeRecord{hardRecord, kavaRecord}, res.ProtocolFees)
+
+	res, err = suite.queryServer.ProtocolFees(sdk.WrapSDKContext(suite.Ctx), &types.QueryProtocolFeesRequest{PoolId: "ukava:usdx"})
+	suite.Require().NoError(err)
+	suite.Equal([]types.ProtocolFeeRecord{kavaRecord}, res.ProtocolFees)
+
+	res, err = suite.queryServer.ProtocolFees(sdk.WrapSDKContext(suite.Ctx), &types.QueryProtocolFeesRequest{PoolId: "bnb:usdx"})
+	suite.Require().NoError(err)
+	suite.Empty(res.ProtocolFees)
+
+	_, err = suite.queryServer.ProtocolFees(sdk.WrapSDKContext(suite.Ctx), nil)
+	suite.EqualError(err, "rpc error: code = InvalidArgument desc = empty request")
+}
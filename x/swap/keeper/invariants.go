@@ -13,6 +13,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "share-records", ShareRecordsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "pool-reserves", PoolReservesInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "pool-shares", PoolSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "protocol-fees", ProtocolFeesInvariant(k))
 }
 
 // AllInvariants runs all invariants of the swap module
@@ -30,7 +31,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		res, stop := PoolSharesInvariant(k)(ctx)
+		if res, stop := PoolSharesInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		res, stop := ProtocolFeesInvariant(k)(ctx)
 		return res, stop
 	}
 }
@@ -137,3 +142,16 @@ func PoolSharesInvariant(k Keeper) sdk.Invariant {
 		return message, broken
 	}
 }
+
+// ProtocolFeesInvariant iterates all protocol fee records and ensures the total accrued protocol fees match
+// the protocol fee module account coins
+func ProtocolFeesInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "protocol fees broken", "accrued protocol fees do not match protocol fee module account")
+
+	return func(ctx sdk.Context) (string, bool) {
+		balance := k.bankKeeper.GetAllBalances(ctx, k.GetProtocolFeeModuleAccount(ctx).GetAddress())
+
+		broken := !k.GetAllProtocolFeeRecords(ctx).TotalFeesAccrued().IsEqual(balance)
+		return message, broken
+	}
+}
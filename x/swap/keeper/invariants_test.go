@@ -230,6 +230,33 @@ func (suite *invariantTestSuite) TestPoolSharesInvariant() {
 	suite.Equal(true, broken)
 }
 
+func (suite *invariantTestSuite) TestProtocolFeesInvariant() {
+	message, broken := suite.runInvariant("protocol-fees", keeper.ProtocolFeesInvariant)
+	suite.Equal("swap: protocol fees broken invariant\naccrued protocol fees do not match protocol fee module account\n", message)
+	suite.Equal(false, broken)
+
+	// not broken when accrued protocol fees are held in the protocol fee account
+	protocolFees := sdk.NewCoins(sdk.NewCoin("usdx", sdkmath.NewInt(1e3)))
+	suite.AddCoinsToProtocolFeeAccount(protocolFees)
+	suite.Keeper.SetProtocolFeeRecord(suite.Ctx, types.NewProtocolFeeRecord(types.PoolID("ukava", "usdx"), protocolFees))
+	message, broken = suite.runInvariant("protocol-fees", keeper.ProtocolFeesInvariant)
+	suite.Equal("swap: protocol fees broken invariant\naccrued protocol fees do not match protocol fee module account\n", message)
+	suite.Equal(false, broken)
+
+	// broken when accrued protocol fees are not held in the protocol fee account
+	suite.Keeper.SetProtocolFeeRecord(suite.Ctx, types.NewProtocolFeeRecord(types.PoolID("hard", "usdx"), protocolFees))
+	message, broken = suite.runInvariant("protocol-fees", keeper.ProtocolFeesInvariant)
+	suite.Equal("swap: protocol fees broken invariant\naccrued protocol fees do not match protocol fee module account\n", message)
+	suite.Equal(true, broken)
+
+	// broken when the protocol fee account holds more than the accrued protocol fees
+	suite.Keeper.DeleteProtocolFeeRecord(suite.Ctx, types.PoolID("hard", "usdx"))
+	suite.Keeper.DeleteProtocolFeeRecord(suite.Ctx, types.PoolID("ukava", "usdx"))
+	message, broken = suite.runInvariant("protocol-fees", keeper.ProtocolFeesInvariant)
+	suite.Equal("swap: protocol fees broken invariant\naccrued protocol fees do not match protocol fee module account\n", message)
+	suite.Equal(true, broken)
+}
+
 func TestInvariantTestSuite(t *testing.T) {
 	suite.Run(t, new(invariantTestSuite))
 }
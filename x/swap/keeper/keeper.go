@@ -76,11 +76,26 @@ func (k Keeper) GetSwapFee(ctx sdk.Context) sdk.Dec {
 	return k.GetParams(ctx).SwapFee
 }
 
+// GetProtocolFeeFraction returns the fraction of swap fees paid to the community pool set in the module parameters
+func (k Keeper) GetProtocolFeeFraction(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).ProtocolFeeFraction
+}
+
+// GetProtocolFeeSweepInterval returns the number of blocks between protocol fee sweeps set in the module parameters
+func (k Keeper) GetProtocolFeeSweepInterval(ctx sdk.Context) int64 {
+	return k.GetParams(ctx).ProtocolFeeSweepInterval
+}
+
 // GetSwapModuleAccount returns the swap ModuleAccount
 func (k Keeper) GetSwapModuleAccount(ctx sdk.Context) authtypes.ModuleAccountI {
 	return k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
 }
 
+// GetProtocolFeeModuleAccount returns the swap protocol fee ModuleAccount
+func (k Keeper) GetProtocolFeeModuleAccount(ctx sdk.Context) authtypes.ModuleAccountI {
+	return k.accountKeeper.GetModuleAccount(ctx, types.ProtocolFeeAccountName)
+}
+
 // GetPool retrieves a pool record from the store
 func (k Keeper) GetPool(ctx sdk.Context, poolID string) (types.PoolRecord, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolKeyPrefix)
@@ -239,12 +254,16 @@ func (k Keeper) GetDepositorSharesAmount(ctx sdk.Context, depositor sdk.AccAddre
 	return record.SharesOwned, true
 }
 
-// updatePool updates a pool, deleting the pool record if the shares are zero
+// updatePool updates a pool and its price accumulators, deleting the pool record and price history if the
+// shares are zero
 func (k Keeper) updatePool(ctx sdk.Context, poolID string, pool *types.DenominatedPool) {
 	if pool.TotalShares().IsZero() {
 		k.DeletePool(ctx, poolID)
+		k.deletePriceObservations(ctx, poolID)
 	} else {
-		k.SetPool(ctx, types.NewPoolRecordFromPool(pool))
+		record := k.accumulatePrices(ctx, types.NewPoolRecordFromPool(pool))
+		k.SetPool(ctx, record)
+		k.observePrices(ctx, record)
 	}
 }
 
@@ -263,7 +282,7 @@ func (k Keeper) loadDenominatedPool(ctx sdk.Context, poolID string) (*types.Deno
 	if !found {
 		return &types.DenominatedPool{}, types.ErrInvalidPool
 	}
-	denominatedPool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+	denominatedPool, err := types.NewDenominatedPoolFromRecord(poolRecord)
 	if err != nil {
 		return &types.DenominatedPool{}, types.ErrInvalidPool
 	}
@@ -39,10 +39,12 @@ func (suite *keeperTestSuite) setupPool(reserves sdk.Coins, totalShares sdkmath.
 	suite.AddCoinsToModule(reserves)
 
 	poolRecord := types.PoolRecord{
-		PoolID:      poolID,
-		ReservesA:   reserves[0],
-		ReservesB:   reserves[1],
-		TotalShares: totalShares,
+		PoolID:           poolID,
+		ReservesA:        reserves[0],
+		ReservesB:        reserves[1],
+		TotalShares:      totalShares,
+		PriceCumulativeA: sdk.ZeroDec(),
+		PriceCumulativeB: sdk.ZeroDec(),
 	}
 	suite.Keeper.SetPool(suite.Ctx, poolRecord)
 
@@ -63,7 +65,9 @@ func (suite keeperTestSuite) TestParams_Persistance() {
 		AllowedPools: types.AllowedPools{
 			types.NewAllowedPool("ukava", "usdx"),
 		},
-		SwapFee: sdk.MustNewDecFromStr("0.03"),
+		SwapFee:                  sdk.MustNewDecFromStr("0.03"),
+		ProtocolFeeFraction:      types.DefaultProtocolFeeFraction,
+		ProtocolFeeSweepInterval: types.DefaultProtocolFeeSweepInterval,
 	}
 	keeper.SetParams(suite.Ctx, params)
 	suite.Equal(keeper.GetParams(suite.Ctx), params)
@@ -73,7 +77,9 @@ func (suite keeperTestSuite) TestParams_Persistance() {
 		AllowedPools: types.AllowedPools{
 			types.NewAllowedPool("hard", "ukava"),
 		},
-		SwapFee: sdk.MustNewDecFromStr("0.01"),
+		SwapFee:                  sdk.MustNewDecFromStr("0.01"),
+		ProtocolFeeFraction:      types.DefaultProtocolFeeFraction,
+		ProtocolFeeSweepInterval: types.DefaultProtocolFeeSweepInterval,
 	}
 	keeper.SetParams(suite.Ctx, params)
 	suite.NotEqual(keeper.GetParams(suite.Ctx), oldParams)
@@ -84,7 +90,9 @@ func (suite keeperTestSuite) TestParams_GetSwapFee() {
 	keeper := suite.Keeper
 
 	params := types.Params{
-		SwapFee: sdk.MustNewDecFromStr("0.00333"),
+		SwapFee:                  sdk.MustNewDecFromStr("0.00333"),
+		ProtocolFeeFraction:      types.DefaultProtocolFeeFraction,
+		ProtocolFeeSweepInterval: types.DefaultProtocolFeeSweepInterval,
 	}
 	keeper.SetParams(suite.Ctx, params)
 
@@ -133,6 +133,34 @@ func (m msgServer) SwapForExactTokens(goCtx context.Context, msg *types.MsgSwapF
 	return &types.MsgSwapForExactTokensResponse{}, nil
 }
 
+// SwapExactForTokensRoute handles MsgSwapExactForTokensRoute messages
+func (m msgServer) SwapExactForTokensRoute(goCtx context.Context, msg *types.MsgSwapExactForTokensRoute) (*types.MsgSwapExactForTokensRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkDeadline(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	requester, err := sdk.AccAddressFromBech32(msg.Requester)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.SwapExactForTokensRoute(ctx, requester, msg.ExactTokenA, msg.TokenB, msg.Path, msg.Slippage); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, requester.String()),
+		),
+	)
+
+	return &types.MsgSwapExactForTokensRouteResponse{}, nil
+}
+
 // checkDeadline returns an error if block time exceeds an included deadline
 func checkDeadline(ctx sdk.Context, msg sdk.Msg) error {
 	deadlineMsg, ok := msg.(types.MsgWithDeadline)
@@ -33,7 +33,7 @@ func (suite *msgServerTestSuite) SetupTest() {
 func (suite *msgServerTestSuite) TestDeposit_CreatePool() {
 	pool := types.NewAllowedPool("ukava", "usdx")
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee, types.DefaultProtocolFeeFraction, types.DefaultProtocolFeeSweepInterval))
 
 	balance := sdk.NewCoins(
 		sdk.NewCoin(pool.TokenA, sdkmath.NewInt(10e6)),
@@ -83,7 +83,7 @@ func (suite *msgServerTestSuite) TestDeposit_CreatePool() {
 func (suite *msgServerTestSuite) TestDeposit_DeadlineExceeded() {
 	pool := types.NewAllowedPool("ukava", "usdx")
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee, types.DefaultProtocolFeeFraction, types.DefaultProtocolFeeSweepInterval))
 
 	balance := sdk.NewCoins(
 		sdk.NewCoin(pool.TokenA, sdkmath.NewInt(10e6)),
@@ -206,7 +206,7 @@ func (suite *msgServerTestSuite) TestWithdraw_AllShares() {
 	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), reserves)
 	pool := types.NewAllowedPool(reserves[0].Denom, reserves[1].Denom)
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee, types.DefaultProtocolFeeFraction, types.DefaultProtocolFeeSweepInterval))
 
 	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.MustNewDecFromStr("1"))
 	suite.Require().NoError(err)
@@ -259,7 +259,7 @@ func (suite *msgServerTestSuite) TestWithdraw_PartialShares() {
 	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), reserves)
 	pool := types.NewAllowedPool(reserves[0].Denom, reserves[1].Denom)
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee, types.DefaultProtocolFeeFraction, types.DefaultProtocolFeeSweepInterval))
 
 	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.MustNewDecFromStr("1"))
 	suite.Require().NoError(err)
@@ -317,7 +317,7 @@ func (suite *msgServerTestSuite) TestWithdraw_SlippageFailure() {
 	depositor := suite.NewAccountFromAddr(sdk.AccAddress("new depositor-------"), reserves)
 	pool := types.NewAllowedPool(reserves[0].Denom, reserves[1].Denom)
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, types.DefaultSwapFee, types.DefaultProtocolFeeFraction, types.DefaultProtocolFeeSweepInterval))
 
 	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.MustNewDecFromStr("1"))
 	suite.Require().NoError(err)
@@ -420,6 +420,7 @@ func (suite *msgServerTestSuite) TestSwapExactForTokens() {
 		sdk.NewAttribute(types.AttributeKeySwapInput, swapInput.String()),
 		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedSwapOutput.String()),
 		sdk.NewAttribute(types.AttributeKeyFeePaid, "3000ukava"),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, "0ukava"),
 		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
 	))
 }
@@ -533,6 +534,7 @@ func (suite *msgServerTestSuite) TestSwapForExactTokens() {
 		sdk.NewAttribute(types.AttributeKeySwapInput, expectedSwapInput.String()),
 		sdk.NewAttribute(types.AttributeKeySwapOutput, swapOutput.String()),
 		sdk.NewAttribute(types.AttributeKeyFeePaid, "3013ukava"),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, "0ukava"),
 		sdk.NewAttribute(types.AttributeKeyExactDirection, "output"),
 	))
 }
@@ -586,6 +588,80 @@ func (suite *msgServerTestSuite) TestSwapForExactTokens_DeadlineExceeded() {
 	suite.Nil(res)
 }
 
+func (suite *msgServerTestSuite) TestSwapExactForTokensRoute() {
+	err := suite.CreatePool(sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+	))
+	suite.Require().NoError(err)
+	err = suite.CreatePool(sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	))
+	suite.Require().NoError(err)
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	swapInput := sdk.NewCoin("hard", sdkmath.NewInt(2e6))
+	swapMsg := types.NewMsgSwapExactForTokensRoute(
+		requester.GetAddress().String(),
+		swapInput,
+		sdk.NewCoin("usdx", sdkmath.NewInt(5e6)),
+		[]string{"hard", "ukava", "usdx"},
+		sdk.MustNewDecFromStr("0.01"),
+		time.Now().Add(10*time.Minute).Unix(),
+	)
+
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	res, err := suite.msgServer.SwapExactForTokensRoute(sdk.WrapSDKContext(suite.Ctx), swapMsg)
+	suite.Require().Equal(&types.MsgSwapExactForTokensRouteResponse{}, res)
+	suite.Require().NoError(err)
+
+	expectedSwapOutput := sdk.NewCoin("usdx", sdkmath.NewInt(4960159))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(swapInput).Add(expectedSwapOutput))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, requester.GetAddress().String()),
+	))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeSwapRouteTrade,
+		sdk.NewAttribute(types.AttributeKeyRoute, "hard,ukava,usdx"),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, swapInput.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedSwapOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "6000hard,2989ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+}
+
+func (suite *msgServerTestSuite) TestSwapExactForTokensRoute_DeadlineExceeded() {
+	balance := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	swapMsg := types.NewMsgSwapExactForTokensRoute(
+		requester.GetAddress().String(),
+		sdk.NewCoin("hard", sdkmath.NewInt(2e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5e6)),
+		[]string{"hard", "ukava", "usdx"},
+		sdk.MustNewDecFromStr("0.01"),
+		suite.Ctx.BlockTime().Add(-1*time.Second).Unix(),
+	)
+
+	res, err := suite.msgServer.SwapExactForTokensRoute(sdk.WrapSDKContext(suite.Ctx), swapMsg)
+	suite.Require().Nil(res)
+	suite.EqualError(err, fmt.Sprintf("block time %d >= deadline %d: deadline exceeded", suite.Ctx.BlockTime().Unix(), swapMsg.GetDeadline().Unix()))
+	suite.Nil(res)
+}
+
 func TestMsgServerTestSuite(t *testing.T) {
 	suite.Run(t, new(msgServerTestSuite))
 }
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// GetProtocolFeeRecord retrieves the protocol fees accrued by a pool from the store
+func (k Keeper) GetProtocolFeeRecord(ctx sdk.Context, poolID string) (types.ProtocolFeeRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ProtocolFeeKeyPrefix)
+
+	bz := store.Get(types.ProtocolFeeKey(poolID))
+	if bz == nil {
+		return types.ProtocolFeeRecord{}, false
+	}
+
+	var record types.ProtocolFeeRecord
+	k.cdc.MustUnmarshal(bz, &record)
+
+	return record, true
+}
+
+// SetProtocolFeeRecord saves the protocol fees accrued by a pool to the store and panics if the record is invalid
+func (k Keeper) SetProtocolFeeRecord(ctx sdk.Context, record types.ProtocolFeeRecord) {
+	if err := record.Validate(); err != nil {
+		panic(fmt.Sprintf("invalid protocol fee record: %s", err))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ProtocolFeeKeyPrefix)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.ProtocolFeeKey(record.PoolID), bz)
+}
+
+// DeleteProtocolFeeRecord deletes the protocol fees accrued by a pool from the store
+func (k Keeper) DeleteProtocolFeeRecord(ctx sdk.Context, poolID string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ProtocolFeeKeyPrefix)
+	store.Delete(types.ProtocolFeeKey(poolID))
+}
+
+// IterateProtocolFeeRecords iterates over all protocol fee records in the store
+func (k Keeper) IterateProtocolFeeRecords(ctx sdk.Context, cb func(record types.ProtocolFeeRecord) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ProtocolFeeKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ProtocolFeeRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllProtocolFeeRecords returns all protocol fee records from the store
+func (k Keeper) GetAllProtocolFeeRecords(ctx sdk.Context) (records types.ProtocolFeeRecords) {
+	k.IterateProtocolFeeRecords(ctx, func(record types.ProtocolFeeRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return
+}
+
+// SweepProtocolFees sends the protocol fees accrued by all pools from the protocol fee module account
+// to the community pool and clears the accrued fees
+func (k Keeper) SweepProtocolFees(ctx sdk.Context) error {
+	records := k.GetAllProtocolFeeRecords(ctx)
+	total := records.TotalFeesAccrued()
+	if total.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ProtocolFeeAccountName, communitytypes.ModuleAccountName, total); err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		k.DeleteProtocolFeeRecord(ctx, record.PoolID)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSweepProtocolFees,
+			sdk.NewAttribute(types.AttributeKeyAmount, total.String()),
+		),
+	)
+
+	return nil
+}
+
+// takeProtocolFee removes the protocol fee fraction of a swap fee from the pool reserves and accrues it
+// to the pool's protocol fee record. It returns the pool without the protocol fee, and the protocol fee,
+// which is rounded down.
+func (k Keeper) takeProtocolFee(ctx sdk.Context, poolID string, pool *types.DenominatedPool, feePaid sdk.Coin) (*types.DenominatedPool, sdk.Coin) {
+	pool, protocolFee := k.removeProtocolFee(ctx, pool, feePaid)
+	if protocolFee.IsZero() {
+		return pool, protocolFee
+	}
+
+	record, found := k.GetProtocolFeeRecord(ctx, poolID)
+	if !found {
+		record = types.NewProtocolFeeRecord(poolID, sdk.NewCoins())
+	}
+	record.FeesAccrued = record.FeesAccrued.Add(protocolFee)
+	k.SetProtocolFeeRecord(ctx, record)
+
+	return pool, protocolFee
+}
+
+// sendProtocolFees transfers protocol fees taken from the pool reserves from the swap module account
+// to the protocol fee module account
+func (k Keeper) sendProtocolFees(ctx sdk.Context, fees sdk.Coins) error {
+	if fees.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleAccountName, types.ProtocolFeeAccountName, fees)
+}
+
+// removeProtocolFee returns the pool with the protocol fee fraction of a swap fee removed from its
+// reserves, and the protocol fee, which is rounded down
+func (k Keeper) removeProtocolFee(ctx sdk.Context, pool *types.DenominatedPool, feePaid sdk.Coin) (*types.DenominatedPool, sdk.Coin) {
+	protocolFee := sdk.NewCoin(
+		feePaid.Denom,
+		sdk.NewDecFromInt(feePaid.Amount).Mul(k.GetProtocolFeeFraction(ctx)).TruncateInt(),
+	)
+	if protocolFee.IsZero() {
+		return pool, protocolFee
+	}
+
+	record := types.NewPoolRecordFromPool(pool)
+	if record.ReservesA.Denom == protocolFee.Denom {
+		record.ReservesA = record.ReservesA.Sub(protocolFee)
+	} else {
+		record.ReservesB = record.ReservesB.Sub(protocolFee)
+	}
+
+	pool, err := types.NewDenominatedPoolFromRecord(record)
+	if err != nil {
+		panic(fmt.Sprintf("invalid pool %s: %s", record.PoolID, err))
+	}
+
+	return pool, protocolFee
+}
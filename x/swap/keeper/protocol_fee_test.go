@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	communitytypes "github.com/kava-labs/kava/x/community/types"
+	"github.com/kava-labs/kava/x/swap/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *keeperTestSuite) TestProtocolFeeRecord_Persistance() {
+	record := types.NewProtocolFeeRecord("ukava:usdx", sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e3))))
+
+	suite.Keeper.SetProtocolFeeRecord(suite.Ctx, record)
+	savedRecord, found := suite.Keeper.GetProtocolFeeRecord(suite.Ctx, record.PoolID)
+	suite.True(found)
+	suite.Equal(record, savedRecord)
+	suite.Equal(types.ProtocolFeeRecords{record}, suite.Keeper.GetAllProtocolFeeRecords(suite.Ctx))
+
+	suite.Keeper.DeleteProtocolFeeRecord(suite.Ctx, record.PoolID)
+	_, found = suite.Keeper.GetProtocolFeeRecord(suite.Ctx, record.PoolID)
+	suite.False(found)
+
+	suite.PanicsWithValue("invalid protocol fee record: pool 'ukava:usdx' has protocol fees of denom 'hard' not in the pool", func() {
+		suite.Keeper.SetProtocolFeeRecord(suite.Ctx, types.NewProtocolFeeRecord("ukava:usdx", sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(1e3)))))
+	})
+}
+
+func (suite *keeperTestSuite) TestSwap_ProtocolFee() {
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(50e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+	poolID := types.PoolIDFromCoins(reserves)
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.ProtocolFeeFraction = sdk.MustNewDecFromStr("0.5")
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	requester := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6))))
+	coinA := sdk.NewCoin("ukava", sdkmath.NewInt(1e6))
+	err := suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), coinA, sdk.NewCoin("usdx", sdkmath.NewInt(1)), sdk.OneDec())
+	suite.Require().NoError(err)
+
+	// the protocol fee does not change the swap output
+	expectedOutput := sdk.NewCoin("usdx", sdkmath.NewInt(4533054))
+	protocolFee := sdk.NewCoin("ukava", sdkmath.NewInt(1500))
+	suite.AccountBalanceEqual(requester.GetAddress(), sdk.NewCoins(expectedOutput))
+
+	// the protocol fee is removed from the pool reserves and moved to the protocol fee account
+	suite.ModuleAccountBalanceEqual(reserves.Add(coinA).Sub(expectedOutput).Sub(protocolFee))
+	suite.PoolReservesEqual(poolID, reserves.Add(coinA).Sub(expectedOutput).Sub(protocolFee))
+	suite.ProtocolFeeAccountBalanceEqual(sdk.NewCoins(protocolFee))
+
+	record, found := suite.Keeper.GetProtocolFeeRecord(suite.Ctx, poolID)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(protocolFee), record.FeesAccrued)
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, coinA.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, "3000ukava"),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, protocolFee.String()),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+
+	// fees accrue across swaps
+	requester = suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e6))))
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), coinA, sdk.NewCoin("usdx", sdkmath.NewInt(1)), sdk.OneDec())
+	suite.Require().NoError(err)
+
+	record, found = suite.Keeper.GetProtocolFeeRecord(suite.Ctx, poolID)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(protocolFee.Add(protocolFee)), record.FeesAccrued)
+	suite.ProtocolFeeAccountBalanceEqual(sdk.NewCoins(protocolFee.Add(protocolFee)))
+}
+
+func (suite *keeperTestSuite) TestSweepProtocolFees() {
+	communityAddr := suite.AccountKeeper.GetModuleAddress(communitytypes.ModuleAccountName)
+	communityBalance := suite.BankKeeper.GetAllBalances(suite.Ctx, communityAddr)
+
+	// sweeping without accrued fees is a no-op
+	suite.Require().NoError(suite.Keeper.SweepProtocolFees(suite.Ctx))
+	suite.Equal(communityBalance, suite.BankKeeper.GetAllBalances(suite.Ctx, communityAddr))
+
+	kavaFees := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(1e3)))
+	hardFees := sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(2e3)), sdk.NewCoin("usdx", sdkmath.NewInt(5e3)))
+	suite.AddCoinsToProtocolFeeAccount(kavaFees.Add(hardFees...))
+	suite.Keeper.SetProtocolFeeRecord(suite.Ctx, types.NewProtocolFeeRecord("ukava:usdx", kavaFees))
+	suite.Keeper.SetProtocolFeeRecord(suite.Ctx, types.NewProtocolFeeRecord("hard:usdx", hardFees))
+
+	suite.Require().NoError(suite.Keeper.SweepProtocolFees(suite.Ctx))
+
+	suite.ProtocolFeeAccountBalanceEqual(sdk.NewCoins())
+	suite.Equal(communityBalance.Add(kavaFees...).Add(hardFees...), suite.BankKeeper.GetAllBalances(suite.Ctx, communityAddr))
+	suite.Empty(suite.Keeper.GetAllProtocolFeeRecords(suite.Ctx))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSweepProtocolFees,
+		sdk.NewAttribute(types.AttributeKeyAmount, kavaFees.Add(hardFees...).String()),
+	))
+}
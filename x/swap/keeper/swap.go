@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/kava-labs/kava/x/swap/types"
 
@@ -61,6 +62,98 @@ func (k *Keeper) SwapForExactTokens(ctx sdk.Context, requester sdk.AccAddress, c
 	return nil
 }
 
+// SwapExactForTokensRoute swaps an exact coin a input for a coin b output through the pools of each
+// consecutive pair of denoms in a path. The output of each pool is the input of the next, and the
+// slippage limit is applied to the final output.
+func (k *Keeper) SwapExactForTokensRoute(ctx sdk.Context, requester sdk.AccAddress, exactCoinA, coinB sdk.Coin, path []string, slippageLimit sdk.Dec) error {
+	if err := types.ValidateSwapPath(path, exactCoinA.Denom, coinB.Denom); err != nil {
+		return err
+	}
+
+	swapFee := k.GetSwapFee(ctx)
+
+	hops := make([]swapHop, 0, len(path)-1)
+	swapInput := exactCoinA
+	for i := 0; i < len(path)-1; i++ {
+		poolID, pool, err := k.loadPool(ctx, path[i], path[i+1])
+		if err != nil {
+			return err
+		}
+
+		swapOutput, feePaid := pool.SwapWithExactInput(swapInput, swapFee)
+		if swapOutput.IsZero() {
+			return errorsmod.Wrapf(types.ErrInsufficientLiquidity, "swap output of pool %s rounds to zero, increase input amount", poolID)
+		}
+
+		hops = append(hops, swapHop{
+			poolID:  poolID,
+			pool:    pool,
+			input:   swapInput,
+			output:  swapOutput,
+			feePaid: feePaid,
+		})
+		swapInput = swapOutput
+	}
+	swapOutput := swapInput
+
+	priceChange := sdk.NewDecFromInt(swapOutput.Amount).Quo(sdk.NewDecFromInt(coinB.Amount))
+	if err := k.assertSlippageWithinLimit(priceChange, slippageLimit); err != nil {
+		return err
+	}
+
+	// intermediate outputs remain in the module account as the input of the next pool,
+	// so only the route input and output are transferred
+	feesPaid := sdk.NewCoins()
+	protocolFees := sdk.NewCoins()
+	for i, hop := range hops {
+		pool, protocolFee := k.takeProtocolFee(ctx, hop.poolID, hop.pool, hop.feePaid)
+		k.updatePool(ctx, hop.poolID, pool)
+		hops[i].protocolFee = protocolFee
+		feesPaid = feesPaid.Add(hop.feePaid)
+		protocolFees = protocolFees.Add(protocolFee)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, requester, types.ModuleAccountName, sdk.NewCoins(exactCoinA)); err != nil {
+		return err
+	}
+
+	if err := k.sendProtocolFees(ctx, protocolFees); err != nil {
+		panic(err)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, requester, sdk.NewCoins(swapOutput)); err != nil {
+		panic(err)
+	}
+
+	for _, hop := range hops {
+		emitSwapTradeEvent(ctx, hop.poolID, requester, hop.input, hop.output, hop.feePaid, hop.protocolFee, "input")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapRouteTrade,
+			sdk.NewAttribute(types.AttributeKeyRoute, strings.Join(path, ",")),
+			sdk.NewAttribute(types.AttributeKeyRequester, requester.String()),
+			sdk.NewAttribute(types.AttributeKeySwapInput, exactCoinA.String()),
+			sdk.NewAttribute(types.AttributeKeySwapOutput, swapOutput.String()),
+			sdk.NewAttribute(types.AttributeKeyFeePaid, feesPaid.String()),
+			sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+		),
+	)
+
+	return nil
+}
+
+// swapHop is a swap with a single pool of a route
+type swapHop struct {
+	poolID      string
+	pool        *types.DenominatedPool
+	input       sdk.Coin
+	output      sdk.Coin
+	feePaid     sdk.Coin
+	protocolFee sdk.Coin
+}
+
 func (k Keeper) loadPool(ctx sdk.Context, denomA string, denomB string) (string, *types.DenominatedPool, error) {
 	poolID := types.PoolID(denomA, denomB)
 
@@ -69,7 +162,7 @@ func (k Keeper) loadPool(ctx sdk.Context, denomA string, denomB string) (string,
 		return poolID, nil, errorsmod.Wrapf(types.ErrInvalidPool, "pool %s not found", poolID)
 	}
 
-	pool, err := types.NewDenominatedPoolWithExistingShares(poolRecord.Reserves(), poolRecord.TotalShares)
+	pool, err := types.NewDenominatedPoolFromRecord(poolRecord)
 	if err != nil {
 		panic(fmt.Sprintf("invalid pool %s: %s", poolID, err))
 	}
@@ -96,16 +189,36 @@ func (k Keeper) commitSwap(
 	feePaid sdk.Coin,
 	exactDirection string,
 ) error {
-	k.SetPool(ctx, types.NewPoolRecordFromPool(pool))
+	pool, protocolFee := k.takeProtocolFee(ctx, poolID, pool, feePaid)
+	k.updatePool(ctx, poolID, pool)
 
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, requester, types.ModuleAccountName, sdk.NewCoins(swapInput)); err != nil {
 		return err
 	}
 
+	if err := k.sendProtocolFees(ctx, sdk.NewCoins(protocolFee)); err != nil {
+		panic(err)
+	}
+
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, requester, sdk.NewCoins(swapOutput)); err != nil {
 		panic(err)
 	}
 
+	emitSwapTradeEvent(ctx, poolID, requester, swapInput, swapOutput, feePaid, protocolFee, exactDirection)
+
+	return nil
+}
+
+func emitSwapTradeEvent(
+	ctx sdk.Context,
+	poolID string,
+	requester sdk.AccAddress,
+	swapInput sdk.Coin,
+	swapOutput sdk.Coin,
+	feePaid sdk.Coin,
+	protocolFee sdk.Coin,
+	exactDirection string,
+) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSwapTrade,
@@ -114,9 +227,8 @@ func (k Keeper) commitSwap(
 			sdk.NewAttribute(types.AttributeKeySwapInput, swapInput.String()),
 			sdk.NewAttribute(types.AttributeKeySwapOutput, swapOutput.String()),
 			sdk.NewAttribute(types.AttributeKeyFeePaid, feePaid.String()),
+			sdk.NewAttribute(types.AttributeKeyProtocolFee, protocolFee.String()),
 			sdk.NewAttribute(types.AttributeKeyExactDirection, exactDirection),
 		),
 	)
-
-	return nil
 }
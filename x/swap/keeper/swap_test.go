@@ -9,12 +9,15 @@ import (
 	tmtime "github.com/cometbft/cometbft/types/time"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/kava-labs/kava/x/swap/keeper"
 	"github.com/kava-labs/kava/x/swap/types"
 )
 
 func (suite *keeperTestSuite) TestSwapExactForTokens() {
 	suite.Keeper.SetParams(suite.Ctx, types.Params{
-		SwapFee: sdk.MustNewDecFromStr("0.0025"),
+		SwapFee:                  sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFeeFraction:      types.DefaultProtocolFeeFraction,
+		ProtocolFeeSweepInterval: types.DefaultProtocolFeeSweepInterval,
 	})
 	owner := suite.CreateAccount(sdk.Coins{})
 	reserves := sdk.NewCoins(
@@ -47,6 +50,7 @@ func (suite *keeperTestSuite) TestSwapExactForTokens() {
 		sdk.NewAttribute(types.AttributeKeySwapInput, coinA.String()),
 		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedOutput.String()),
 		sdk.NewAttribute(types.AttributeKeyFeePaid, "2500ukava"),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, "0ukava"),
 		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
 	))
 }
@@ -131,7 +135,9 @@ func (suite *keeperTestSuite) TestSwapExactForTokens_Slippage() {
 		suite.Run(fmt.Sprintf("coinA=%s coinB=%s slippage=%s fee=%s", tc.coinA, tc.coinB, tc.slippage, tc.fee), func() {
 			suite.SetupTest()
 			suite.Keeper.SetParams(suite.Ctx, types.Params{
-				SwapFee: tc.fee,
+				SwapFee:                  tc.fee,
+				ProtocolFeeFraction:      types.DefaultProtocolFeeFraction,
+				ProtocolFeeSweepInterval: types.DefaultProtocolFeeSweepInterval,
 			})
 			owner := suite.CreateAccount(sdk.Coins{})
 			reserves := sdk.NewCoins(
@@ -321,7 +327,9 @@ func (suite *keeperTestSuite) TestSwapExactForTokens_PanicOnInsufficientModuleAc
 
 func (suite *keeperTestSuite) TestSwapForExactTokens() {
 	suite.Keeper.SetParams(suite.Ctx, types.Params{
-		SwapFee: sdk.MustNewDecFromStr("0.0025"),
+		SwapFee:                  sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFeeFraction:      types.DefaultProtocolFeeFraction,
+		ProtocolFeeSweepInterval: types.DefaultProtocolFeeSweepInterval,
 	})
 	owner := suite.CreateAccount(sdk.Coins{})
 	reserves := sdk.NewCoins(
@@ -354,6 +362,7 @@ func (suite *keeperTestSuite) TestSwapForExactTokens() {
 		sdk.NewAttribute(types.AttributeKeySwapInput, expectedInput.String()),
 		sdk.NewAttribute(types.AttributeKeySwapOutput, coinB.String()),
 		sdk.NewAttribute(types.AttributeKeyFeePaid, "2509ukava"),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, "0ukava"),
 		sdk.NewAttribute(types.AttributeKeyExactDirection, "output"),
 	))
 }
@@ -442,7 +451,9 @@ func (suite *keeperTestSuite) TestSwapForExactTokens_Slippage() {
 		suite.Run(fmt.Sprintf("coinA=%s coinB=%s slippage=%s fee=%s", tc.coinA, tc.coinB, tc.slippage, tc.fee), func() {
 			suite.SetupTest()
 			suite.Keeper.SetParams(suite.Ctx, types.Params{
-				SwapFee: tc.fee,
+				SwapFee:                  tc.fee,
+				ProtocolFeeFraction:      types.DefaultProtocolFeeFraction,
+				ProtocolFeeSweepInterval: types.DefaultProtocolFeeSweepInterval,
 			})
 			owner := suite.CreateAccount(sdk.Coins{})
 			reserves := sdk.NewCoins(
@@ -629,3 +640,201 @@ func (suite *keeperTestSuite) TestSwapForExactTokens_PanicOnInsufficientModuleAc
 		_ = suite.Keeper.SwapForExactTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.01"))
 	}, "expected panic when module account does not have enough funds")
 }
+
+func (suite *keeperTestSuite) TestSwap_StablePool() {
+	pool := types.NewAllowedStablePool("usdc", "usdx", 100)
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.NewAllowedPools(pool), sdk.MustNewDecFromStr("0.0025"), types.DefaultProtocolFeeFraction, types.DefaultProtocolFeeSweepInterval))
+
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("usdc", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(1000e6)),
+	)
+	depositor := suite.CreateAccount(reserves)
+	err := suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.MustNewDecFromStr("0"))
+	suite.Require().NoError(err)
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("usdc", sdkmath.NewInt(100e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	// a swap of 5% of the reserves has a price impact well within a 0.5% slippage limit
+	coinA := sdk.NewCoin("usdc", sdkmath.NewInt(50e6))
+	coinB := sdk.NewCoin("usdx", sdkmath.NewInt(50e6))
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), coinA, coinB, sdk.MustNewDecFromStr("0.005"))
+	suite.Require().NoError(err)
+
+	expectedOutput := sdk.NewCoin("usdx", sdkmath.NewInt(49862596))
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(expectedOutput))
+	suite.ModuleAccountBalanceEqual(reserves.Add(coinA).Sub(expectedOutput))
+	suite.PoolLiquidityEqual(reserves.Add(coinA).Sub(expectedOutput))
+
+	// an exact output swap back to usdc
+	exactCoinA := sdk.NewCoin("usdc", sdkmath.NewInt(10e6))
+	err = suite.Keeper.SwapForExactTokens(suite.Ctx, requester.GetAddress(), sdk.NewCoin("usdx", sdkmath.NewInt(10e6)), exactCoinA, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	requesterBalance := suite.BankKeeper.GetAllBalances(suite.Ctx, requester.GetAddress())
+	suite.Equal(sdkmath.NewInt(60e6), requesterBalance.AmountOf("usdc"))
+	suite.True(requesterBalance.AmountOf("usdx").LT(expectedOutput.Amount.Sub(sdkmath.NewInt(10e6))))
+
+	// the module account reserves are equal to the pool reserves
+	_, broken := keeper.PoolReservesInvariant(suite.Keeper)(suite.Ctx)
+	suite.False(broken)
+	_, broken = keeper.PoolSharesInvariant(suite.Keeper)(suite.Ctx)
+	suite.False(broken)
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokensRoute() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:                  sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFeeFraction:      types.DefaultProtocolFeeFraction,
+		ProtocolFeeSweepInterval: types.DefaultProtocolFeeSweepInterval,
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	hardReserves := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+	)
+	usdxReserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	hardPoolID := suite.setupPool(hardReserves, sdkmath.NewInt(1000e6), owner.GetAddress())
+	usdxPoolID := suite.setupPool(usdxReserves, sdkmath.NewInt(2000e6), owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+	coinA := sdk.NewCoin("hard", sdkmath.NewInt(2e6))
+	coinB := sdk.NewCoin("usdx", sdkmath.NewInt(5e6))
+
+	// the route output is equal to swapping through each pool in order
+	hardPool, err := types.NewDenominatedPoolWithExistingShares(hardReserves, sdkmath.NewInt(1000e6))
+	suite.Require().NoError(err)
+	intermediateOutput, hardFee := hardPool.SwapWithExactInput(coinA, sdk.MustNewDecFromStr("0.0025"))
+	usdxPool, err := types.NewDenominatedPoolWithExistingShares(usdxReserves, sdkmath.NewInt(2000e6))
+	suite.Require().NoError(err)
+	expectedOutput, ukavaFee := usdxPool.SwapWithExactInput(intermediateOutput, sdk.MustNewDecFromStr("0.0025"))
+
+	err = suite.Keeper.SwapExactForTokensRoute(suite.Ctx, requester.GetAddress(), coinA, coinB, []string{"hard", "ukava", "usdx"}, sdk.MustNewDecFromStr("0.01"))
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance.Sub(coinA).Add(expectedOutput))
+	suite.ModuleAccountBalanceEqual(hardReserves.Add(usdxReserves...).Add(coinA).Sub(expectedOutput))
+	suite.PoolLiquidityEqual(hardReserves.Add(coinA).Sub(intermediateOutput))
+	suite.PoolLiquidityEqual(usdxReserves.Add(intermediateOutput).Sub(expectedOutput))
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, hardPoolID),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, coinA.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, intermediateOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, hardFee.String()),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, "0hard"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapTrade,
+		sdk.NewAttribute(types.AttributeKeyPoolID, usdxPoolID),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, intermediateOutput.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, ukavaFee.String()),
+		sdk.NewAttribute(types.AttributeKeyProtocolFee, "0ukava"),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSwapRouteTrade,
+		sdk.NewAttribute(types.AttributeKeyRoute, "hard,ukava,usdx"),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeySwapInput, coinA.String()),
+		sdk.NewAttribute(types.AttributeKeySwapOutput, expectedOutput.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePaid, sdk.NewCoins(hardFee, ukavaFee).String()),
+		sdk.NewAttribute(types.AttributeKeyExactDirection, "input"),
+	))
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokensRoute_Slippage() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		SwapFee:                  sdk.MustNewDecFromStr("0.0025"),
+		ProtocolFeeFraction:      types.DefaultProtocolFeeFraction,
+		ProtocolFeeSweepInterval: types.DefaultProtocolFeeSweepInterval,
+	})
+	owner := suite.CreateAccount(sdk.Coins{})
+	hardReserves := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+	)
+	usdxReserves := sdk.NewCoins(
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5000e6)),
+	)
+	suite.setupPool(hardReserves, sdkmath.NewInt(1000e6), owner.GetAddress())
+	suite.setupPool(usdxReserves, sdkmath.NewInt(2000e6), owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	// each pool has a fee of 0.25% and a small price impact, so the route output is more than 0.5% below the spot price
+	err := suite.Keeper.SwapExactForTokensRoute(
+		suite.Ctx,
+		requester.GetAddress(),
+		sdk.NewCoin("hard", sdkmath.NewInt(2e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5e6)),
+		[]string{"hard", "ukava", "usdx"},
+		sdk.MustNewDecFromStr("0.005"),
+	)
+	suite.Require().ErrorIs(err, types.ErrSlippageExceeded)
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance)
+	suite.ModuleAccountBalanceEqual(hardReserves.Add(usdxReserves...))
+	suite.PoolLiquidityEqual(hardReserves)
+	suite.PoolLiquidityEqual(usdxReserves)
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokensRoute_PoolNotFound() {
+	owner := suite.CreateAccount(sdk.Coins{})
+	reserves := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(2000e6)),
+		sdk.NewCoin("ukava", sdkmath.NewInt(1000e6)),
+	)
+	suite.setupPool(reserves, sdkmath.NewInt(1000e6), owner.GetAddress())
+
+	balance := sdk.NewCoins(
+		sdk.NewCoin("hard", sdkmath.NewInt(10e6)),
+	)
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), balance)
+
+	err := suite.Keeper.SwapExactForTokensRoute(
+		suite.Ctx,
+		requester.GetAddress(),
+		sdk.NewCoin("hard", sdkmath.NewInt(2e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5e6)),
+		[]string{"hard", "ukava", "usdx"},
+		sdk.MustNewDecFromStr("0.01"),
+	)
+	suite.EqualError(err, "pool ukava:usdx not found: invalid pool")
+
+	suite.AccountBalanceEqual(requester.GetAddress(), balance)
+	suite.PoolLiquidityEqual(reserves)
+}
+
+func (suite *keeperTestSuite) TestSwapExactForTokensRoute_InvalidPath() {
+	requester := suite.NewAccountFromAddr(sdk.AccAddress("requester-----------"), sdk.NewCoins(sdk.NewCoin("hard", sdkmath.NewInt(10e6))))
+
+	err := suite.Keeper.SwapExactForTokensRoute(
+		suite.Ctx,
+		requester.GetAddress(),
+		sdk.NewCoin("hard", sdkmath.NewInt(2e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5e6)),
+		[]string{"ukava", "usdx"},
+		sdk.MustNewDecFromStr("0.01"),
+	)
+	suite.EqualError(err, "path must start with hard, got ukava: invalid route")
+}
@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// TWAP returns the time-weighted average prices of a pool over a window ending at the current block time.
+// The first price is of token a in units of token b, and the second of token b in units of token a.
+//
+// The window must not be longer than the max twap window, and the pool must have price history from
+// the start of the window.
+func (k Keeper) TWAP(ctx sdk.Context, poolID string, window time.Duration) (sdk.Dec, sdk.Dec, error) {
+	if window <= 0 || window > types.MaxTWAPWindow {
+		return sdk.Dec{}, sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidTWAPWindow, "window %s must be positive and at most %s", window, types.MaxTWAPWindow)
+	}
+
+	record, found := k.GetPool(ctx, poolID)
+	if !found {
+		return sdk.Dec{}, sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidPool, "pool %s not found", poolID)
+	}
+
+	end := ctx.BlockTime()
+	endObservation, err := k.priceObservationAt(ctx, record, end)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	startObservation, err := k.priceObservationAt(ctx, record, end.Add(-window))
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	return types.TimeWeightedAveragePrices(startObservation, endObservation)
+}
+
+// priceObservationAt returns the accumulators of a pool at a time, calculated from the pool record if
+// the time is after the last update, otherwise interpolated from the stored observations
+func (k Keeper) priceObservationAt(ctx sdk.Context, record types.PoolRecord, t time.Time) (types.PriceObservation, error) {
+	if !t.Before(record.PriceCumulativeUpdatedAt) {
+		priceCumulativeA, priceCumulativeB := record.CumulativePricesAt(t)
+		return types.NewPriceObservation(record.PoolID, t, priceCumulativeA, priceCumulativeB), nil
+	}
+
+	before, found := k.getPriceObservationAtOrBefore(ctx, record.PoolID, t)
+	if !found {
+		return types.PriceObservation{}, errorsmod.Wrapf(types.ErrNoPriceHistory, "pool %s has no price history at %s", record.PoolID, t)
+	}
+	after, found := k.getPriceObservationAfter(ctx, record.PoolID, t)
+	if !found {
+		return types.PriceObservation{}, errorsmod.Wrapf(types.ErrNoPriceHistory, "pool %s has no price history after %s", record.PoolID, t)
+	}
+
+	return types.InterpolatePriceObservation(before, after, t)
+}
+
+// accumulatePrices returns the record with its price accumulators carried over from the stored record
+// of the pool, advanced to the current block time
+func (k Keeper) accumulatePrices(ctx sdk.Context, record types.PoolRecord) types.PoolRecord {
+	if previous, found := k.GetPool(ctx, record.PoolID); found {
+		record.PriceCumulativeA, record.PriceCumulativeB = previous.CumulativePricesAt(ctx.BlockTime())
+	} else {
+		record.PriceCumulativeA, record.PriceCumulativeB = sdk.ZeroDec(), sdk.ZeroDec()
+	}
+	record.PriceCumulativeUpdatedAt = ctx.BlockTime()
+
+	return record
+}
+
+// GetPriceObservation retrieves a price observation of a pool at a time from the store
+func (k Keeper) GetPriceObservation(ctx sdk.Context, poolID string, t time.Time) (types.PriceObservation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationKeyPrefix)
+
+	bz := store.Get(types.PriceObservationKey(poolID, t))
+	if bz == nil {
+		return types.PriceObservation{}, false
+	}
+
+	var observation types.PriceObservation
+	k.cdc.MustUnmarshal(bz, &observation)
+
+	return observation, true
+}
+
+// SetPriceObservation saves a price observation to the store and panics if the observation is invalid
+func (k Keeper) SetPriceObservation(ctx sdk.Context, observation types.PriceObservation) {
+	if err := observation.Validate(); err != nil {
+		panic(err)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationKeyPrefix)
+	bz := k.cdc.MustMarshal(&observation)
+	store.Set(types.PriceObservationKey(observation.PoolID, observation.Time), bz)
+}
+
+// IteratePriceObservations iterates over all price observations in the store, ordered by pool and time
+func (k Keeper) IteratePriceObservations(ctx sdk.Context, cb func(observation types.PriceObservation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var observation types.PriceObservation
+		k.cdc.MustUnmarshal(iterator.Value(), &observation)
+		if cb(observation) {
+			break
+		}
+	}
+}
+
+// GetAllPriceObservations returns all price observations from the store
+func (k Keeper) GetAllPriceObservations(ctx sdk.Context) (observations types.PriceObservations) {
+	k.IteratePriceObservations(ctx, func(observation types.PriceObservation) bool {
+		observations = append(observations, observation)
+		return false
+	})
+	return
+}
+
+// observePrices stores the accumulators of a pool record as an observation, pruning observations older
+// than the max twap window
+func (k Keeper) observePrices(ctx sdk.Context, record types.PoolRecord) {
+	k.SetPriceObservation(ctx, types.NewPriceObservation(
+		record.PoolID,
+		record.PriceCumulativeUpdatedAt,
+		record.PriceCumulativeA,
+		record.PriceCumulativeB,
+	))
+
+	// the latest observation before the cutoff is kept to interpolate the start of the longest window
+	cutoff := ctx.BlockTime().Add(-types.MaxTWAPWindow)
+	keys := k.priceObservationKeys(ctx, types.PriceObservationPoolKey(record.PoolID), types.PriceObservationKey(record.PoolID, cutoff))
+	if len(keys) <= 1 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationKeyPrefix)
+	for _, key := range keys[:len(keys)-1] {
+		store.Delete(key)
+	}
+}
+
+// deletePriceObservations deletes all price observations of a pool
+func (k Keeper) deletePriceObservations(ctx sdk.Context, poolID string) {
+	poolKey := types.PriceObservationPoolKey(poolID)
+	keys := k.priceObservationKeys(ctx, poolKey, sdk.PrefixEndBytes(poolKey))
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationKeyPrefix)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// priceObservationKeys returns the keys of the price observations in a range, ordered by time
+func (k Keeper) priceObservationKeys(ctx sdk.Context, start, end []byte) (keys [][]byte) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationKeyPrefix)
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	return
+}
+
+// getPriceObservationAtOrBefore returns the latest price observation of a pool at or before a time
+func (k Keeper) getPriceObservationAtOrBefore(ctx sdk.Context, poolID string, t time.Time) (types.PriceObservation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationKeyPrefix)
+	iterator := store.ReverseIterator(types.PriceObservationPoolKey(poolID), sdk.PrefixEndBytes(types.PriceObservationKey(poolID, t)))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.PriceObservation{}, false
+	}
+
+	var observation types.PriceObservation
+	k.cdc.MustUnmarshal(iterator.Value(), &observation)
+
+	return observation, true
+}
+
+// getPriceObservationAfter returns the earliest price observation of a pool after a time
+func (k Keeper) getPriceObservationAfter(ctx sdk.Context, poolID string, t time.Time) (types.PriceObservation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationKeyPrefix)
+	iterator := store.Iterator(sdk.PrefixEndBytes(types.PriceObservationKey(poolID, t)), sdk.PrefixEndBytes(types.PriceObservationPoolKey(poolID)))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.PriceObservation{}, false
+	}
+
+	var observation types.PriceObservation
+	k.cdc.MustUnmarshal(iterator.Value(), &observation)
+
+	return observation, true
+}
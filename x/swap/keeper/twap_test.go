@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/kava-labs/kava/x/swap/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *keeperTestSuite) TestTWAP() {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.Ctx = suite.Ctx.WithBlockTime(start)
+
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(50e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+	poolID := types.PoolIDFromCoins(reserves)
+
+	// a new pool has no price history before it was created
+	_, _, err := suite.Keeper.TWAP(suite.Ctx, poolID, time.Hour)
+	suite.ErrorIs(err, types.ErrNoPriceHistory)
+
+	// prices are constant without swaps
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(time.Hour))
+	priceA, priceB, err := suite.Keeper.TWAP(suite.Ctx, poolID, time.Hour)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewDec(5), priceA)
+	suite.Equal(sdk.MustNewDecFromStr("0.2"), priceB)
+
+	requester := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6))))
+	err = suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(1)), sdk.OneDec())
+	suite.Require().NoError(err)
+	record, found := suite.Keeper.GetPool(suite.Ctx, poolID)
+	suite.Require().True(found)
+	pool, err := types.NewDenominatedPoolFromRecord(record)
+	suite.Require().NoError(err)
+	swappedPriceA := pool.SpotPrice("ukava")
+	swappedPriceB := pool.SpotPrice("usdx")
+
+	// the swap price applies from the block of the swap
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(2 * time.Hour))
+	priceA, priceB, err = suite.Keeper.TWAP(suite.Ctx, poolID, time.Hour)
+	suite.Require().NoError(err)
+	suite.Equal(swappedPriceA, priceA)
+	suite.Equal(swappedPriceB, priceB)
+
+	priceA, priceB, err = suite.Keeper.TWAP(suite.Ctx, poolID, 2*time.Hour)
+	suite.Require().NoError(err)
+	suite.decEqual(sdk.NewDec(5).Add(swappedPriceA).QuoInt64(2), priceA)
+	suite.decEqual(sdk.MustNewDecFromStr("0.2").Add(swappedPriceB).QuoInt64(2), priceB)
+
+	// windows starting between observations are interpolated
+	priceA, priceB, err = suite.Keeper.TWAP(suite.Ctx, poolID, 90*time.Minute)
+	suite.Require().NoError(err)
+	suite.decEqual(sdk.NewDec(5).Add(swappedPriceA.MulInt64(2)).QuoInt64(3), priceA)
+	suite.decEqual(sdk.MustNewDecFromStr("0.2").Add(swappedPriceB.MulInt64(2)).QuoInt64(3), priceB)
+
+	_, _, err = suite.Keeper.TWAP(suite.Ctx, poolID, 3*time.Hour)
+	suite.ErrorIs(err, types.ErrNoPriceHistory)
+}
+
+func (suite *keeperTestSuite) TestTWAP_InvalidRequest() {
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(50e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+	poolID := types.PoolIDFromCoins(reserves)
+
+	_, _, err := suite.Keeper.TWAP(suite.Ctx, poolID, 0)
+	suite.ErrorIs(err, types.ErrInvalidTWAPWindow)
+
+	_, _, err = suite.Keeper.TWAP(suite.Ctx, poolID, types.MaxTWAPWindow+time.Second)
+	suite.ErrorIs(err, types.ErrInvalidTWAPWindow)
+
+	_, _, err = suite.Keeper.TWAP(suite.Ctx, types.PoolID("hard", "usdx"), time.Hour)
+	suite.ErrorIs(err, types.ErrInvalidPool)
+}
+
+func (suite *keeperTestSuite) TestTWAP_PruneAndDeleteObservations() {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.Ctx = suite.Ctx.WithBlockTime(start)
+
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6)), sdk.NewCoin("usdx", sdkmath.NewInt(50e6)))
+	suite.Require().NoError(suite.CreatePool(reserves))
+	poolID := types.PoolIDFromCoins(reserves)
+	depositor := suite.Keeper.GetAllDepositorShares(suite.Ctx)[0].Depositor
+
+	requester := suite.CreateAccount(sdk.NewCoins(sdk.NewCoin("ukava", sdkmath.NewInt(10e6))))
+	for _, offset := range []time.Duration{time.Hour, 2 * time.Hour} {
+		suite.Ctx = suite.Ctx.WithBlockTime(start.Add(offset))
+		err := suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(1)), sdk.OneDec())
+		suite.Require().NoError(err)
+	}
+	suite.Len(suite.Keeper.GetAllPriceObservations(suite.Ctx), 3)
+
+	// observations before the longest window are pruned, except the latest needed to interpolate its start
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(types.MaxTWAPWindow + 150*time.Minute))
+	err := suite.Keeper.SwapExactForTokens(suite.Ctx, requester.GetAddress(), sdk.NewCoin("ukava", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(1)), sdk.OneDec())
+	suite.Require().NoError(err)
+
+	observations := suite.Keeper.GetAllPriceObservations(suite.Ctx)
+	suite.Require().Len(observations, 2)
+	suite.Equal(start.Add(2*time.Hour), observations[0].Time)
+	suite.Equal(suite.Ctx.BlockTime(), observations[1].Time)
+
+	_, _, err = suite.Keeper.TWAP(suite.Ctx, poolID, types.MaxTWAPWindow)
+	suite.NoError(err)
+
+	// withdrawing all liquidity deletes the price history
+	shares, found := suite.Keeper.GetDepositorSharesAmount(suite.Ctx, depositor, poolID)
+	suite.Require().True(found)
+	record, found := suite.Keeper.GetPool(suite.Ctx, poolID)
+	suite.Require().True(found)
+	err = suite.Keeper.Withdraw(suite.Ctx, depositor, shares, sdk.NewCoin("ukava", sdkmath.NewInt(1)), sdk.NewCoin("usdx", sdkmath.NewInt(1)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(shares, record.TotalShares)
+	suite.Empty(suite.Keeper.GetAllPriceObservations(suite.Ctx))
+}
+
+// decEqual asserts that two decimals are equal to within rounding of the price accumulators
+func (suite *keeperTestSuite) decEqual(expected, actual sdk.Dec) {
+	suite.True(expected.Sub(actual).Abs().LTE(sdk.NewDecWithPrec(1, 15)), "expected %s, got %s", expected, actual)
+}
@@ -55,8 +55,13 @@ func (s *migrateTestSuite) TestMigrate_JSON() {
 	// Compare expect v16 swap json with migrated json
 	actual := s.cdc.MustMarshalJSON(genstate)
 	file = filepath.Join("testdata", "v16-swap.json")
-	expected, err := ioutil.ReadFile(file)
+	data, err = ioutil.ReadFile(file)
 	s.Require().NoError(err)
+
+	// Round trip the expected json to include the defaults of fields added after v16
+	var expectedGenState v016swap.GenesisState
+	s.Require().NoError(s.cdc.UnmarshalJSON(data, &expectedGenState))
+	expected := s.cdc.MustMarshalJSON(&expectedGenState)
 	s.Require().JSONEq(string(expected), string(actual))
 }
 
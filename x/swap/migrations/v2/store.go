@@ -0,0 +1,70 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 2
+// V2 adds price accumulators to pool records and stores their first price observation, and adds the
+// protocol_fee_fraction and protocol_fee_sweep_interval params to parameters.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore paramtypes.Subspace) error {
+	migrateParamsStore(ctx, paramstore)
+	return migratePoolRecords(ctx, storeKey, cdc)
+}
+
+// migrateParamsStore ensures the param key table exists and has the protocol fee properties
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	paramstore.Set(ctx, types.KeyProtocolFeeFraction, types.DefaultProtocolFeeFraction)
+	paramstore.Set(ctx, types.KeyProtocolFeeSweepInterval, types.DefaultProtocolFeeSweepInterval)
+}
+
+// migratePoolRecords initializes the price accumulators of each pool to zero at the current block time
+func migratePoolRecords(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	poolStore := prefix.NewStore(ctx.KVStore(storeKey), types.PoolKeyPrefix)
+	observationStore := prefix.NewStore(ctx.KVStore(storeKey), types.PriceObservationKeyPrefix)
+
+	var records types.PoolRecords
+	iterator := sdk.KVStorePrefixIterator(poolStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.PoolRecord
+		if err := cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			iterator.Close()
+			return err
+		}
+		records = append(records, record)
+	}
+	iterator.Close()
+
+	for _, record := range records {
+		record.PriceCumulativeA = sdk.ZeroDec()
+		record.PriceCumulativeB = sdk.ZeroDec()
+		record.PriceCumulativeUpdatedAt = ctx.BlockTime()
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		bz, err := cdc.Marshal(&record)
+		if err != nil {
+			return err
+		}
+		poolStore.Set(types.PoolKey(record.PoolID), bz)
+
+		observation := types.NewPriceObservation(record.PoolID, ctx.BlockTime(), record.PriceCumulativeA, record.PriceCumulativeB)
+		bz, err = cdc.Marshal(&observation)
+		if err != nil {
+			return err
+		}
+		observationStore.Set(types.PriceObservationKey(record.PoolID, ctx.BlockTime()), bz)
+	}
+
+	return nil
+}
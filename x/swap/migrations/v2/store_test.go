@@ -0,0 +1,96 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v2swap "github.com/kava-labs/kava/x/swap/migrations/v2"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+func TestStoreMigrationInitializesPriceAccumulators(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	swapKey := sdk.NewKVStoreKey(types.ModuleName)
+	tSwapKey := sdk.NewTransientStoreKey("transient_test")
+	blockTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testutil.DefaultContext(swapKey, tSwapKey).WithBlockTime(blockTime)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, swapKey, tSwapKey, types.ModuleName)
+
+	// pool records stored before the accumulators were added
+	poolStore := prefix.NewStore(ctx.KVStore(swapKey), types.PoolKeyPrefix)
+	oldRecords := []types.PoolRecord{
+		{
+			PoolID:      types.PoolID("hard", "usdx"),
+			ReservesA:   sdk.NewCoin("hard", sdkmath.NewInt(1e6)),
+			ReservesB:   sdk.NewCoin("usdx", sdkmath.NewInt(2e6)),
+			TotalShares: sdkmath.NewInt(1e6),
+		},
+		{
+			PoolID:      types.PoolID("ukava", "usdx"),
+			ReservesA:   sdk.NewCoin("ukava", sdkmath.NewInt(1e6)),
+			ReservesB:   sdk.NewCoin("usdx", sdkmath.NewInt(5e6)),
+			TotalShares: sdkmath.NewInt(3e6),
+		},
+	}
+	for _, record := range oldRecords {
+		poolStore.Set(types.PoolKey(record.PoolID), encCfg.Codec.MustMarshal(&record))
+	}
+
+	err := v2swap.MigrateStore(ctx, swapKey, encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	observationStore := prefix.NewStore(ctx.KVStore(swapKey), types.PriceObservationKeyPrefix)
+	for _, oldRecord := range oldRecords {
+		var record types.PoolRecord
+		encCfg.Codec.MustUnmarshal(poolStore.Get(types.PoolKey(oldRecord.PoolID)), &record)
+
+		require.NoError(t, record.Validate())
+		require.Equal(t, oldRecord.Reserves(), record.Reserves())
+		require.Equal(t, oldRecord.TotalShares, record.TotalShares)
+		require.Equal(t, sdk.ZeroDec(), record.PriceCumulativeA)
+		require.Equal(t, sdk.ZeroDec(), record.PriceCumulativeB)
+		require.Equal(t, blockTime, record.PriceCumulativeUpdatedAt)
+
+		bz := observationStore.Get(types.PriceObservationKey(record.PoolID, blockTime))
+		require.NotNil(t, bz)
+		var observation types.PriceObservation
+		encCfg.Codec.MustUnmarshal(bz, &observation)
+		require.Equal(t, types.NewPriceObservation(record.PoolID, blockTime, sdk.ZeroDec(), sdk.ZeroDec()), observation)
+	}
+}
+
+func TestStoreMigrationAddsProtocolFeeParams(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	swapKey := sdk.NewKVStoreKey(types.ModuleName)
+	tSwapKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(swapKey, tSwapKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, swapKey, tSwapKey, types.ModuleName)
+
+	// Check params don't exist before
+	require.False(t, paramstore.Has(ctx, types.KeyProtocolFeeFraction))
+	require.False(t, paramstore.Has(ctx, types.KeyProtocolFeeSweepInterval))
+
+	// Run migrations.
+	err := v2swap.MigrateStore(ctx, swapKey, encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set to the default
+	require.True(t, paramstore.Has(ctx, types.KeyProtocolFeeFraction))
+	var result sdk.Dec
+	paramstore.Get(ctx, types.KeyProtocolFeeFraction, &result)
+	require.Equal(t, types.DefaultProtocolFeeFraction, result)
+
+	require.True(t, paramstore.Has(ctx, types.KeyProtocolFeeSweepInterval))
+	var interval int64
+	paramstore.Get(ctx, types.KeyProtocolFeeSweepInterval, &interval)
+	require.Equal(t, types.DefaultProtocolFeeSweepInterval, interval)
+}
@@ -58,6 +58,12 @@ func (suite *Suite) AddCoinsToModule(amount sdk.Coins) {
 	suite.Require().NoError(err)
 }
 
+// AddCoinsToProtocolFeeAccount adds coins to the swap protocol fee module account
+func (suite *Suite) AddCoinsToProtocolFeeAccount(amount sdk.Coins) {
+	err := suite.App.FundModuleAccount(suite.Ctx, types.ProtocolFeeAccountName, amount)
+	suite.Require().NoError(err)
+}
+
 // RemoveCoinsFromModule removes coins to the swap module account
 func (suite *Suite) RemoveCoinsFromModule(amount sdk.Coins) {
 	// Swap module does not have BurnCoins permission so we need to transfer to gov first to burn
@@ -115,7 +121,7 @@ func (suite *Suite) CreatePool(reserves sdk.Coins) error {
 	depositor := suite.CreateAccount(reserves)
 	pool := types.NewAllowedPool(reserves[0].Denom, reserves[1].Denom)
 	suite.Require().NoError(pool.Validate())
-	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, defaultSwapFee))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedPools{pool}, defaultSwapFee, types.DefaultProtocolFeeFraction, types.DefaultProtocolFeeSweepInterval))
 
 	return suite.Keeper.Deposit(suite.Ctx, depositor.GetAddress(), reserves[0], reserves[1], sdk.MustNewDecFromStr("1"))
 }
@@ -135,6 +141,15 @@ func (suite *Suite) ModuleAccountBalanceEqual(coins sdk.Coins) {
 	suite.Equal(coins, balance, fmt.Sprintf("expected module account balance to equal coins %s, but got %s", coins, balance))
 }
 
+// ProtocolFeeAccountBalanceEqual asserts that the swap protocol fee module account balance matches the provided coins
+func (suite *Suite) ProtocolFeeAccountBalanceEqual(coins sdk.Coins) {
+	balance := suite.BankKeeper.GetAllBalances(
+		suite.Ctx,
+		suite.AccountKeeper.GetModuleAddress(types.ProtocolFeeAccountName),
+	)
+	suite.Equal(coins, balance, fmt.Sprintf("expected protocol fee account balance to equal coins %s, but got %s", coins, balance))
+}
+
 // PoolLiquidityEqual asserts that the pool matching the provided coins has those reserves
 func (suite *Suite) PoolLiquidityEqual(coins sdk.Coins) {
 	poolRecord, ok := suite.Keeper.GetPool(suite.Ctx, types.PoolIDFromCoins(coins))
@@ -177,7 +192,7 @@ func (suite *Suite) PoolShareValueEqual(depositor authtypes.AccountI, pool types
 	shares, ok := suite.Keeper.GetDepositorShares(suite.Ctx, depositor.GetAddress(), poolRecord.PoolID)
 	suite.Require().True(ok, fmt.Sprintf("expected shares to exist for depositor %s", depositor.GetAddress()))
 
-	storedPool, err := types.NewDenominatedPoolWithExistingShares(sdk.NewCoins(poolRecord.ReservesA, poolRecord.ReservesB), poolRecord.TotalShares)
+	storedPool, err := types.NewDenominatedPoolFromRecord(poolRecord)
 	suite.Nil(err)
 	value := storedPool.ShareValue(shares.SharesOwned)
 	suite.Equal(coins, value, fmt.Sprintf("expected shares to equal %s, but got %s", coins, value))
@@ -335,6 +335,30 @@ func (p *BasePool) ShareValue(shares sdkmath.Int) (sdkmath.Int, sdkmath.Int) {
 	return sdkmath.NewIntFromBigInt(&resultA), sdkmath.NewIntFromBigInt(&resultB)
 }
 
+// SpotPriceA returns the price of reserve A in units of reserve B, excluding fees.
+// Panics if the pool is empty.
+func (p *BasePool) SpotPriceA() sdk.Dec {
+	p.assertReservesArePositive()
+
+	return quoDec(p.reservesB.BigInt(), p.reservesA.BigInt())
+}
+
+// SpotPriceB returns the price of reserve B in units of reserve A, excluding fees.
+// Panics if the pool is empty.
+func (p *BasePool) SpotPriceB() sdk.Dec {
+	p.assertReservesArePositive()
+
+	return quoDec(p.reservesA.BigInt(), p.reservesB.BigInt())
+}
+
+// quoDec returns the quotient of two positive big ints as a decimal, truncated to the decimal precision
+func quoDec(numerator, denominator *big.Int) sdk.Dec {
+	var quotient big.Int
+	quotient.Mul(numerator, sdk.OneDec().BigInt()).Quo(&quotient, denominator)
+
+	return sdk.NewDecFromBigIntWithPrec(&quotient, sdk.Precision)
+}
+
 // assertInvariantAndUpdateRerserves asserts the constant product invariant is not violated, subtracting
 // any fees first, then updates the pool reserves.  Panics if invariant is violated.
 func (p *BasePool) assertInvariantAndUpdateReserves(newReservesA, feeA, newReservesB, feeB sdkmath.Int) {
@@ -589,3 +589,19 @@ func TestBasePool_Panics_Swap_ExactOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestBasePool_SpotPrice(t *testing.T) {
+	pool, err := types.NewBasePool(i(1e6), i(3e6))
+	require.NoError(t, err)
+
+	assert.Equal(t, d("3"), pool.SpotPriceA())
+	assert.Equal(t, d("0.333333333333333333"), pool.SpotPriceB())
+
+	pool.SwapExactAForB(i(1e6), d("0"))
+	assert.Equal(t, d("0.75"), pool.SpotPriceA())
+	assert.Equal(t, d("1.333333333333333333"), pool.SpotPriceB())
+
+	pool.RemoveLiquidity(pool.TotalShares())
+	assert.Panics(t, func() { pool.SpotPriceA() }, "SpotPriceA did not panic on empty pool")
+	assert.Panics(t, func() { pool.SpotPriceB() }, "SpotPriceB did not panic on empty pool")
+}
@@ -8,10 +8,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// DenominatedPool implements a denominated constant-product liquidity pool
+// DenominatedPool implements a denominated liquidity pool, either constant-product or stable swap
 type DenominatedPool struct {
-	// all pool operations are implemented in a unitless base pool
-	pool *BasePool
+	// all pool operations are implemented in a unitless base or stable swap pool
+	pool LiquidityPool
 	// track units of the reserveA and reserveB in base pool
 	denomA string
 	denomB string
@@ -63,6 +63,67 @@ func NewDenominatedPoolWithExistingShares(reserves sdk.Coins, totalShares sdkmat
 	}, nil
 }
 
+// NewStableDenominatedPool creates a new denominated stable swap pool from reserve coins
+func NewStableDenominatedPool(reserves sdk.Coins, amplification uint64) (*DenominatedPool, error) {
+	if len(reserves) != 2 {
+		return nil, errorsmod.Wrap(ErrInvalidPool, "reserves must have two denominations")
+	}
+
+	reservesA := reserves[0]
+	reservesB := reserves[1]
+
+	pool, err := NewStableSwapPool(reservesA.Amount, reservesB.Amount, amplification)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DenominatedPool{
+		pool:   pool,
+		denomA: reservesA.Denom,
+		denomB: reservesB.Denom,
+	}, nil
+}
+
+// NewStableDenominatedPoolWithExistingShares creates a new denominated stable swap pool from reserve coins
+func NewStableDenominatedPoolWithExistingShares(reserves sdk.Coins, totalShares sdkmath.Int, amplification uint64) (*DenominatedPool, error) {
+	if len(reserves) != 2 {
+		return nil, errorsmod.Wrap(ErrInvalidPool, "reserves must have two denominations")
+	}
+
+	reservesA := reserves[0]
+	reservesB := reserves[1]
+
+	pool, err := NewStableSwapPoolWithExistingShares(reservesA.Amount, reservesB.Amount, totalShares, amplification)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DenominatedPool{
+		pool:   pool,
+		denomA: reservesA.Denom,
+		denomB: reservesB.Denom,
+	}, nil
+}
+
+// NewDenominatedPoolFromRecord creates a denominated pool of the record's pool type from its reserves and shares
+func NewDenominatedPoolFromRecord(record PoolRecord) (*DenominatedPool, error) {
+	if record.AmplificationCoefficient == 0 {
+		return NewDenominatedPoolWithExistingShares(record.Reserves(), record.TotalShares)
+	}
+
+	return NewStableDenominatedPoolWithExistingShares(record.Reserves(), record.TotalShares, record.AmplificationCoefficient)
+}
+
+// AmplificationCoefficient returns the amplification coefficient of a stable swap pool, or zero for a
+// constant-product pool
+func (p *DenominatedPool) AmplificationCoefficient() uint64 {
+	if pool, ok := p.pool.(*StableSwapPool); ok {
+		return pool.AmplificationCoefficient()
+	}
+
+	return 0
+}
+
 // Reserves returns the reserves held in the pool
 func (p *DenominatedPool) Reserves() sdk.Coins {
 	return p.coins(p.pool.ReservesA(), p.pool.ReservesB())
@@ -144,6 +205,19 @@ func (p *DenominatedPool) SwapWithExactOutput(swapOutput sdk.Coin, fee sdk.Dec)
 	}
 }
 
+// SpotPrice returns the price of the provided denom in units of the other pool reserve, excluding fees.
+// Panics if the denom does not match the pool reserves or if the pool is empty.
+func (p *DenominatedPool) SpotPrice(denom string) sdk.Dec {
+	switch denom {
+	case p.denomA:
+		return p.pool.SpotPriceA()
+	case p.denomB:
+		return p.pool.SpotPriceB()
+	default:
+		panic(fmt.Sprintf("invalid denomination: denom '%s' does not match pool reserves", denom))
+	}
+}
+
 // coins returns a new coins slice with correct reserve denoms from ordered sdk.Ints
 func (p *DenominatedPool) coins(amountA, amountB sdkmath.Int) sdk.Coins {
 	return sdk.NewCoins(p.coinA(amountA), p.coinB(amountB))
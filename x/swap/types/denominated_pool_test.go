@@ -92,6 +92,32 @@ func TestDenominatedPool_InitialState_ExistingShares(t *testing.T) {
 	assert.Equal(t, pool.TotalShares(), totalShares)
 }
 
+func TestDenominatedPool_StableSwap(t *testing.T) {
+	reserves := sdk.NewCoins(ukava(10e6), usdx(10e6))
+
+	pool, err := types.NewStableDenominatedPool(reserves, 100)
+	require.NoError(t, err)
+	assert.Equal(t, reserves, pool.Reserves())
+	assert.Equal(t, i(20e6), pool.TotalShares())
+	assert.Equal(t, uint64(100), pool.AmplificationCoefficient())
+
+	record := types.NewPoolRecordFromPool(pool)
+	assert.Equal(t, uint64(100), record.AmplificationCoefficient)
+	assert.True(t, record.IsStableSwap())
+
+	loaded, err := types.NewDenominatedPoolFromRecord(record)
+	require.NoError(t, err)
+	assert.Equal(t, pool, loaded)
+
+	constantProduct, err := types.NewDenominatedPool(reserves)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), constantProduct.AmplificationCoefficient())
+	assert.False(t, types.NewPoolRecordFromPool(constantProduct).IsStableSwap())
+
+	_, err = types.NewStableDenominatedPool(reserves, 0)
+	assert.EqualError(t, err, "amplification coefficient must be between 1 and 1000000, got 0: invalid pool")
+}
+
 func TestDenominatedPool_ShareValue(t *testing.T) {
 	reserves := sdk.NewCoins(ukava(10e6), usdx(50e6))
 
@@ -181,3 +207,13 @@ func TestDenominatedPool_SwapWithExactOuput(t *testing.T) {
 
 	assert.Panics(t, func() { pool.SwapWithExactOutput(hard(1e6), d("0.003")) }, "SwapWithExactOutput did not panic on invalid denomination")
 }
+
+func TestDenominatedPool_SpotPrice(t *testing.T) {
+	pool, err := types.NewDenominatedPool(sdk.NewCoins(ukava(10e6), usdx(50e6)))
+	require.NoError(t, err)
+
+	assert.Equal(t, d("5"), pool.SpotPrice("ukava"))
+	assert.Equal(t, d("0.2"), pool.SpotPrice("usdx"))
+
+	assert.Panics(t, func() { pool.SpotPrice("hard") }, "SpotPrice did not panic on invalid denomination")
+}
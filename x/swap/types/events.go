@@ -6,6 +6,8 @@ const (
 	EventTypeSwapDeposit       = "swap_deposit"
 	EventTypeSwapWithdraw      = "swap_withdraw"
 	EventTypeSwapTrade         = "swap_trade"
+	EventTypeSwapRouteTrade    = "swap_route_trade"
+	EventTypeSweepProtocolFees = "sweep_protocol_fees"
 	AttributeKeyPoolID         = "pool_id"
 	AttributeKeyDepositor      = "depositor"
 	AttributeKeyShares         = "shares"
@@ -15,4 +17,7 @@ const (
 	AttributeKeySwapOutput     = "output"
 	AttributeKeyFeePaid        = "fee"
 	AttributeKeyExactDirection = "exact"
+	AttributeKeyRoute          = "route"
+	AttributeKeyProtocolFee    = "protocol_fee"
+	AttributeKeyAmount         = "amount"
 )
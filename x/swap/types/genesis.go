@@ -17,14 +17,26 @@ var (
 	DefaultPoolRecords = PoolRecords{}
 	// DefaultShareRecords is used to set default records in default genesis state
 	DefaultShareRecords = ShareRecords{}
+	// DefaultPriceObservations is used to set default observations in default genesis state
+	DefaultPriceObservations = PriceObservations{}
+	// DefaultProtocolFeeRecords is used to set default records in default genesis state
+	DefaultProtocolFeeRecords = ProtocolFeeRecords{}
 )
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, poolRecords PoolRecords, shareRecords ShareRecords) GenesisState {
+func NewGenesisState(
+	params Params,
+	poolRecords PoolRecords,
+	shareRecords ShareRecords,
+	priceObservations PriceObservations,
+	protocolFeeRecords ProtocolFeeRecords,
+) GenesisState {
 	return GenesisState{
-		Params:       params,
-		PoolRecords:  poolRecords,
-		ShareRecords: shareRecords,
+		Params:             params,
+		PoolRecords:        poolRecords,
+		ShareRecords:       shareRecords,
+		PriceObservations:  priceObservations,
+		ProtocolFeeRecords: protocolFeeRecords,
 	}
 }
 
@@ -39,6 +51,12 @@ func (gs GenesisState) Validate() error {
 	if err := gs.ShareRecords.Validate(); err != nil {
 		return err
 	}
+	if err := gs.PriceObservations.Validate(); err != nil {
+		return err
+	}
+	if err := gs.ProtocolFeeRecords.Validate(); err != nil {
+		return err
+	}
 
 	totalShares := make(map[string]poolShares)
 	for _, pr := range gs.PoolRecords {
@@ -65,6 +83,20 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	pools := make(map[string]PoolRecord)
+	for _, pr := range gs.PoolRecords {
+		pools[pr.PoolID] = pr
+	}
+	for _, o := range gs.PriceObservations {
+		pool, found := pools[o.PoolID]
+		if !found {
+			return fmt.Errorf("price observation for pool '%s' that does not exist", o.PoolID)
+		}
+		if o.Time.After(pool.PriceCumulativeUpdatedAt) {
+			return fmt.Errorf("price observation for pool '%s' at %s is after the last price update", o.PoolID, o.Time)
+		}
+	}
+
 	return nil
 }
 
@@ -74,5 +106,7 @@ func DefaultGenesisState() GenesisState {
 		DefaultParams(),
 		DefaultPoolRecords,
 		DefaultShareRecords,
+		DefaultPriceObservations,
+		DefaultProtocolFeeRecords,
 	)
 }
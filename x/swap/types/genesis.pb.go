@@ -31,6 +31,10 @@ type GenesisState struct {
 	PoolRecords PoolRecords `protobuf:"bytes,2,rep,name=pool_records,json=poolRecords,proto3,castrepeated=PoolRecords" json:"pool_records"`
 	// share_records defines the owned shares of each pool
 	ShareRecords ShareRecords `protobuf:"bytes,3,rep,name=share_records,json=shareRecords,proto3,castrepeated=ShareRecords" json:"share_records"`
+	// price_observations defines the price accumulator history of each pool
+	PriceObservations PriceObservations `protobuf:"bytes,4,rep,name=price_observations,json=priceObservations,proto3,castrepeated=PriceObservations" json:"price_observations,omitempty"`
+	// protocol_fee_records defines the protocol fees accrued by each pool
+	ProtocolFeeRecords ProtocolFeeRecords `protobuf:"bytes,5,rep,name=protocol_fee_records,json=protocolFeeRecords,proto3,castrepeated=ProtocolFeeRecords" json:"protocol_fee_records,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +91,20 @@ func (m *GenesisState) GetShareRecords() ShareRecords {
 	return nil
 }
 
+func (m *GenesisState) GetPriceObservations() PriceObservations {
+	if m != nil {
+		return m.PriceObservations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProtocolFeeRecords() ProtocolFeeRecords {
+	if m != nil {
+		return m.ProtocolFeeRecords
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kava.swap.v1beta1.GenesisState")
 }
@@ -94,25 +112,32 @@ func init() {
 func init() { proto.RegisterFile("kava/swap/v1beta1/genesis.proto", fileDescriptor_b1a1a1687f484a21) }
 
 var fileDescriptor_b1a1a1687f484a21 = []byte{
-	// 283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x2f, 0x2e, 0x4f, 0x2c, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x29, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58,
-	0x10, 0x85, 0x52, 0x32, 0x98, 0x26, 0x81, 0x75, 0x81, 0x65, 0x95, 0x7e, 0x32, 0x72, 0xf1, 0xb8,
-	0x43, 0x0c, 0x0e, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x32, 0xe7, 0x62, 0x2b, 0x48, 0x2c, 0x4a, 0xcc,
-	0x2d, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd4, 0xc3, 0xb0, 0x48, 0x2f, 0x00, 0xac,
-	0xc0, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x72, 0xa1, 0x50, 0x2e, 0x9e, 0x82, 0xfc,
-	0xfc, 0x9c, 0xf8, 0xa2, 0xd4, 0xe4, 0xfc, 0xa2, 0x94, 0x62, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e,
-	0x23, 0x59, 0x6c, 0xda, 0xf3, 0xf3, 0x73, 0x82, 0xc0, 0xaa, 0x9c, 0x84, 0x41, 0x46, 0xac, 0xba,
-	0x2f, 0xcf, 0x8d, 0x10, 0x2b, 0x0e, 0xe2, 0x2e, 0x40, 0x70, 0x84, 0x22, 0xb9, 0x78, 0x8b, 0x33,
-	0x12, 0x8b, 0x52, 0xe1, 0xe6, 0x32, 0x83, 0xcd, 0x95, 0xc3, 0x62, 0x6e, 0x30, 0x48, 0x1d, 0xd4,
-	0x60, 0x11, 0xa8, 0xc1, 0x3c, 0x48, 0x82, 0xc5, 0x41, 0x3c, 0xc5, 0x48, 0x3c, 0x27, 0x87, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4b, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
-	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0xd9, 0xa3, 0x9b, 0x93, 0x98, 0x54, 0x0c, 0x66, 0xe9, 0x57,
-	0x40, 0x82, 0xb2, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x88, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xca, 0xb8, 0xb9, 0x95, 0xae, 0x01, 0x00, 0x00,
+	// 391 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xb1, 0x4e, 0xdb, 0x40,
+	0x18, 0xc7, 0xed, 0x26, 0xcd, 0x60, 0xbb, 0x43, 0xae, 0x19, 0xd2, 0x28, 0x3d, 0x47, 0x6d, 0x55,
+	0x65, 0x68, 0x6d, 0x25, 0x1d, 0x2a, 0x24, 0x06, 0xe4, 0x01, 0x46, 0x90, 0x23, 0x06, 0x58, 0xa2,
+	0xb3, 0x39, 0x1c, 0x0b, 0x3b, 0x77, 0xf2, 0x1d, 0x81, 0x3c, 0x04, 0x12, 0x03, 0x0f, 0xc0, 0x9c,
+	0x27, 0xc9, 0x98, 0x91, 0x29, 0x41, 0xc9, 0xc6, 0x53, 0xa0, 0x3b, 0x1b, 0x1c, 0x62, 0x6f, 0x77,
+	0xdf, 0xfd, 0xbe, 0xdf, 0xf7, 0xff, 0x64, 0x6b, 0xe6, 0x15, 0x9a, 0x20, 0x9b, 0xdd, 0x20, 0x6a,
+	0x4f, 0x7a, 0x1e, 0xe6, 0xa8, 0x67, 0x07, 0x78, 0x8c, 0x59, 0xc8, 0x2c, 0x9a, 0x10, 0x4e, 0x40,
+	0x5d, 0x00, 0x96, 0x00, 0xac, 0x0c, 0x68, 0x35, 0x02, 0x12, 0x10, 0xf9, 0x6a, 0x8b, 0x53, 0x0a,
+	0xb6, 0xda, 0x45, 0x93, 0xec, 0x92, 0xaf, 0x3f, 0x1e, 0xab, 0x9a, 0x71, 0x94, 0x8a, 0x07, 0x1c,
+	0x71, 0x0c, 0xfe, 0x6b, 0x35, 0x8a, 0x12, 0x14, 0xb3, 0xa6, 0xda, 0x51, 0xbb, 0x7a, 0xff, 0x9b,
+	0x55, 0x18, 0x64, 0x9d, 0x48, 0xc0, 0xa9, 0xce, 0x97, 0xa6, 0xe2, 0x66, 0x38, 0x38, 0xd5, 0x0c,
+	0x4a, 0x48, 0x34, 0x4c, 0xb0, 0x4f, 0x92, 0x0b, 0xd6, 0xfc, 0xd4, 0xa9, 0x74, 0xf5, 0xfe, 0xf7,
+	0xb2, 0x76, 0x42, 0x22, 0x57, 0x52, 0xce, 0x57, 0xa1, 0x98, 0xad, 0x4c, 0x3d, 0xaf, 0x31, 0x57,
+	0xa7, 0xf9, 0x05, 0x9c, 0x69, 0x5f, 0xd8, 0x08, 0x25, 0xf8, 0xdd, 0x5b, 0x91, 0x5e, 0x58, 0xe2,
+	0x1d, 0x08, 0x2e, 0x13, 0x37, 0x32, 0xb1, 0xb1, 0x55, 0x64, 0xae, 0xc1, 0xb6, 0x6e, 0xe0, 0x4e,
+	0xd5, 0x00, 0x4d, 0x42, 0x1f, 0x0f, 0x89, 0xc7, 0x70, 0x32, 0x41, 0x3c, 0x24, 0x63, 0xd6, 0xac,
+	0xca, 0x01, 0x3f, 0xcb, 0x82, 0x0b, 0xf8, 0x38, 0x67, 0x9d, 0x3d, 0x31, 0xe5, 0x65, 0x69, 0xb6,
+	0x8b, 0x9a, 0x3f, 0x24, 0x0e, 0x39, 0x8e, 0x29, 0x9f, 0xce, 0x56, 0x66, 0x7d, 0xb7, 0x93, 0xb9,
+	0x75, 0xba, 0x5b, 0x02, 0x0f, 0xaa, 0xd6, 0x90, 0x5f, 0xc5, 0x27, 0xd1, 0xf0, 0x12, 0xe7, 0x2b,
+	0x7f, 0x96, 0x89, 0x7e, 0x95, 0x26, 0x4a, 0xf1, 0x43, 0xfc, 0xb6, 0xf8, 0x7e, 0x16, 0x09, 0x96,
+	0x99, 0x3e, 0x84, 0x02, 0x85, 0x66, 0xe6, 0x02, 0x5a, 0xa8, 0x39, 0x07, 0xf3, 0x35, 0x54, 0x17,
+	0x6b, 0xa8, 0x3e, 0xaf, 0xa1, 0x7a, 0xbf, 0x81, 0xca, 0x62, 0x03, 0x95, 0xa7, 0x0d, 0x54, 0xce,
+	0x7f, 0x07, 0x21, 0x1f, 0x5d, 0x7b, 0x96, 0x4f, 0x62, 0x5b, 0x64, 0xfb, 0x1b, 0x21, 0x8f, 0xc9,
+	0x93, 0x7d, 0x9b, 0xfe, 0x71, 0x7c, 0x4a, 0x31, 0xf3, 0x6a, 0xd2, 0xfa, 0xef, 0x75, 0x00, 0x1e,
+	0x01, 0xc4, 0x3c, 0xd5, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +160,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProtocolFeeRecords) > 0 {
+		for iNdEx := len(m.ProtocolFeeRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolFeeRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PriceObservations) > 0 {
+		for iNdEx := len(m.PriceObservations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceObservations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.ShareRecords) > 0 {
 		for iNdEx := len(m.ShareRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -207,6 +260,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PriceObservations) > 0 {
+		for _, e := range m.PriceObservations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProtocolFeeRecords) > 0 {
+		for _, e := range m.ProtocolFeeRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -346,6 +411,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceObservations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceObservations = append(m.PriceObservations, PriceObservation{})
+			if err := m.PriceObservations[len(m.PriceObservations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFeeRecords = append(m.ProtocolFeeRecords, ProtocolFeeRecord{})
+			if err := m.ProtocolFeeRecords[len(m.ProtocolFeeRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/kava-labs/kava/x/swap/types"
 
@@ -50,8 +51,10 @@ func TestGenesis_Validate_SwapFee(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			genesisState := types.GenesisState{
 				Params: types.Params{
-					AllowedPools: types.DefaultAllowedPools,
-					SwapFee:      tc.swapFee,
+					AllowedPools:             types.DefaultAllowedPools,
+					SwapFee:                  tc.swapFee,
+					ProtocolFeeFraction:      types.DefaultProtocolFeeFraction,
+					ProtocolFeeSweepInterval: types.DefaultProtocolFeeSweepInterval,
 				},
 			}
 
@@ -94,8 +97,10 @@ func TestGenesis_Validate_AllowedPools(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			genesisState := types.GenesisState{
 				Params: types.Params{
-					AllowedPools: tc.pairs,
-					SwapFee:      types.DefaultSwapFee,
+					AllowedPools:             tc.pairs,
+					SwapFee:                  types.DefaultSwapFee,
+					ProtocolFeeFraction:      types.DefaultProtocolFeeFraction,
+					ProtocolFeeSweepInterval: types.DefaultProtocolFeeSweepInterval,
 				},
 			}
 
@@ -169,9 +174,14 @@ func TestGenesis_YAMLEncoding(t *testing.T) {
     token_b: usdx
   - token_a: hard
     token_b: busd
+  protocol_fee_fraction: "0.100000000000000000"
+  protocol_fee_sweep_interval: 100
   swap_fee: "0.003000000000000000"
 pool_records:
 - pool_id: ukava:usdx
+  price_cumulative_a: "0.000000000000000000"
+  price_cumulative_b: "0.000000000000000000"
+  price_cumulative_updated_at: "0001-01-01T00:00:00Z"
   reserves_a:
     amount: "1000000"
     denom: ukava
@@ -180,6 +190,9 @@ pool_records:
     denom: usdx
   total_shares: "3000000"
 - pool_id: hard:usdx
+  price_cumulative_a: "0.000000000000000000"
+  price_cumulative_b: "0.000000000000000000"
+  price_cumulative_updated_at: "0001-01-01T00:00:00Z"
   reserves_a:
     amount: "1000000"
     denom: hard
@@ -208,6 +221,8 @@ share_records:
 				types.NewAllowedPool("hard", "busd"),
 			),
 			sdk.MustNewDecFromStr("0.003"),
+			sdk.MustNewDecFromStr("0.1"),
+			100,
 		),
 		types.PoolRecords{
 			types.NewPoolRecord(sdk.NewCoins(ukava(1e6), usdx(5e6)), i(3e6)),
@@ -217,6 +232,8 @@ share_records:
 			types.NewShareRecord(depositor_1, types.PoolID("ukava", "usdx"), i(1e5)),
 			types.NewShareRecord(depositor_2, types.PoolID("hard", "usdx"), i(2e5)),
 		},
+		types.PriceObservations{},
+		types.ProtocolFeeRecords{},
 	)
 
 	data, err := yaml.Marshal(state)
@@ -232,6 +249,8 @@ func TestGenesis_ValidatePoolRecords(t *testing.T) {
 		types.DefaultParams(),
 		types.PoolRecords{invalidPoolRecord},
 		types.ShareRecords{},
+		types.PriceObservations{},
+		types.ProtocolFeeRecords{},
 	)
 
 	assert.Error(t, state.Validate())
@@ -247,11 +266,78 @@ func TestGenesis_ValidateShareRecords(t *testing.T) {
 		types.DefaultParams(),
 		types.PoolRecords{},
 		types.ShareRecords{invalidShareRecord},
+		types.PriceObservations{},
+		types.ProtocolFeeRecords{},
 	)
 
 	assert.Error(t, state.Validate())
 }
 
+func TestGenesis_ValidatePriceObservations(t *testing.T) {
+	record := types.NewPoolRecord(sdk.NewCoins(ukava(1e6), usdx(5e6)), i(3e6))
+	record.PriceCumulativeUpdatedAt = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	depositor, err := sdk.AccAddressFromBech32("kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w")
+	require.NoError(t, err)
+	shareRecords := types.ShareRecords{types.NewShareRecord(depositor, record.PoolID, i(3e6))}
+
+	testCases := []struct {
+		name         string
+		observations types.PriceObservations
+		expectedErr  string
+	}{
+		{
+			name: "valid observations",
+			observations: types.PriceObservations{
+				types.NewPriceObservation(record.PoolID, record.PriceCumulativeUpdatedAt.Add(-time.Hour), sdk.ZeroDec(), sdk.ZeroDec()),
+				types.NewPriceObservation(record.PoolID, record.PriceCumulativeUpdatedAt, sdk.ZeroDec(), sdk.ZeroDec()),
+			},
+			expectedErr: "",
+		},
+		{
+			name: "negative accumulator",
+			observations: types.PriceObservations{
+				types.NewPriceObservation(record.PoolID, record.PriceCumulativeUpdatedAt, sdk.NewDec(-1), sdk.ZeroDec()),
+			},
+			expectedErr: "price observation of pool 'ukava:usdx' has invalid price accumulator: -1.000000000000000000",
+		},
+		{
+			name: "duplicate observations",
+			observations: types.PriceObservations{
+				types.NewPriceObservation(record.PoolID, record.PriceCumulativeUpdatedAt, sdk.ZeroDec(), sdk.ZeroDec()),
+				types.NewPriceObservation(record.PoolID, record.PriceCumulativeUpdatedAt, sdk.ZeroDec(), sdk.ZeroDec()),
+			},
+			expectedErr: "duplicate price observation for pool 'ukava:usdx' at 2022-01-01 00:00:00 +0000 UTC",
+		},
+		{
+			name: "missing pool",
+			observations: types.PriceObservations{
+				types.NewPriceObservation(types.PoolID("hard", "usdx"), record.PriceCumulativeUpdatedAt, sdk.ZeroDec(), sdk.ZeroDec()),
+			},
+			expectedErr: "price observation for pool 'hard:usdx' that does not exist",
+		},
+		{
+			name: "observation after last update",
+			observations: types.PriceObservations{
+				types.NewPriceObservation(record.PoolID, record.PriceCumulativeUpdatedAt.Add(time.Second), sdk.ZeroDec(), sdk.ZeroDec()),
+			},
+			expectedErr: "price observation for pool 'ukava:usdx' at 2022-01-01 00:00:01 +0000 UTC is after the last price update",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := types.NewGenesisState(types.DefaultParams(), types.PoolRecords{record}, shareRecords, tc.observations, types.ProtocolFeeRecords{})
+			err := state.Validate()
+
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestGenesis_Validate_PoolShareIntegration(t *testing.T) {
 	depositor_1, err := sdk.AccAddressFromBech32("kava1mq9qxlhze029lm0frzw2xr6hem8c3k9ts54w0w")
 	require.NoError(t, err)
@@ -323,7 +409,7 @@ func TestGenesis_Validate_PoolShareIntegration(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			state := types.NewGenesisState(types.DefaultParams(), tc.poolRecords, tc.shareRecords)
+			state := types.NewGenesisState(types.DefaultParams(), tc.poolRecords, tc.shareRecords, types.PriceObservations{}, types.ProtocolFeeRecords{})
 			err := state.Validate()
 
 			if tc.expectedErr == "" {
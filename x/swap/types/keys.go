@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,6 +13,9 @@ const (
 	// ModuleAccountName name of module account used to hold liquidity
 	ModuleAccountName = "swap"
 
+	// ProtocolFeeAccountName name of module account used to hold accrued protocol fees
+	ProtocolFeeAccountName = "swap_protocol_fee"
+
 	// StoreKey Top level store key where all module items will be stored
 	StoreKey = ModuleName
 
@@ -25,6 +30,8 @@ const (
 var (
 	PoolKeyPrefix             = []byte{0x01}
 	DepositorPoolSharesPrefix = []byte{0x02}
+	PriceObservationKeyPrefix = []byte{0x03}
+	ProtocolFeeKeyPrefix      = []byte{0x04}
 
 	sep = []byte("|")
 )
@@ -34,11 +41,26 @@ func PoolKey(poolID string) []byte {
 	return []byte(poolID)
 }
 
+// ProtocolFeeKey returns a key generated from a poolID
+func ProtocolFeeKey(poolID string) []byte {
+	return []byte(poolID)
+}
+
 // DepositorPoolSharesKey returns a key from a depositor and poolID
 func DepositorPoolSharesKey(depositor sdk.AccAddress, poolID string) []byte {
 	return createKey(depositor, sep, []byte(poolID))
 }
 
+// PriceObservationPoolKey returns a key prefix for all price observations of a poolID
+func PriceObservationPoolKey(poolID string) []byte {
+	return createKey([]byte(poolID), sep)
+}
+
+// PriceObservationKey returns a key from a poolID and observation time
+func PriceObservationKey(poolID string, t time.Time) []byte {
+	return createKey(PriceObservationPoolKey(poolID), sdk.FormatTimeBytes(t))
+}
+
 func createKey(bytes ...[]byte) (r []byte) {
 	for _, b := range bytes {
 		r = append(r, b...)
@@ -18,6 +18,11 @@ const (
 	TypeSwapExactForTokens = "swap_exact_for_tokens"
 	// TypeSwapForExactTokens represents the type string for MsgSwapForExactTokens
 	TypeSwapForExactTokens = "swap_for_exact_tokens"
+	// TypeSwapExactForTokensRoute represents the type string for MsgSwapExactForTokensRoute
+	TypeSwapExactForTokensRoute = "swap_exact_for_tokens_route"
+
+	// MaxSwapPathLength is the maximum number of denoms in a swap route path, allowing up to four pools to be traded through
+	MaxSwapPathLength = 5
 )
 
 var (
@@ -29,6 +34,8 @@ var (
 	_ MsgWithDeadline = &MsgSwapExactForTokens{}
 	_ sdk.Msg         = &MsgSwapForExactTokens{}
 	_ MsgWithDeadline = &MsgSwapForExactTokens{}
+	_ sdk.Msg         = &MsgSwapExactForTokensRoute{}
+	_ MsgWithDeadline = &MsgSwapExactForTokensRoute{}
 )
 
 // MsgWithDeadline allows messages to define a deadline of when they are considered invalid
@@ -340,3 +347,114 @@ func (msg MsgSwapForExactTokens) GetDeadline() time.Time {
 func (msg MsgSwapForExactTokens) DeadlineExceeded(blockTime time.Time) bool {
 	return blockTime.Unix() >= msg.Deadline
 }
+
+// NewMsgSwapExactForTokensRoute returns a new MsgSwapExactForTokensRoute
+func NewMsgSwapExactForTokensRoute(requester string, exactTokenA sdk.Coin, tokenB sdk.Coin, path []string, slippage sdk.Dec, deadline int64) *MsgSwapExactForTokensRoute {
+	return &MsgSwapExactForTokensRoute{
+		Requester:   requester,
+		ExactTokenA: exactTokenA,
+		TokenB:      tokenB,
+		Path:        path,
+		Slippage:    slippage,
+		Deadline:    deadline,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSwapExactForTokensRoute) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSwapExactForTokensRoute) Type() string { return TypeSwapExactForTokensRoute }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSwapExactForTokensRoute) ValidateBasic() error {
+	if msg.Requester == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "requester address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Requester); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid requester address: %s", err)
+	}
+
+	if !msg.ExactTokenA.IsValid() || msg.ExactTokenA.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "exact token a deposit amount %s", msg.ExactTokenA)
+	}
+
+	if !msg.TokenB.IsValid() || msg.TokenB.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "token b deposit amount %s", msg.TokenB)
+	}
+
+	if msg.ExactTokenA.Denom == msg.TokenB.Denom {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "denominations can not be equal")
+	}
+
+	if err := ValidateSwapPath(msg.Path, msg.ExactTokenA.Denom, msg.TokenB.Denom); err != nil {
+		return err
+	}
+
+	if msg.Slippage.IsNil() {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "slippage must be set")
+	}
+
+	if msg.Slippage.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "slippage can not be negative")
+	}
+
+	if msg.Deadline <= 0 {
+		return errorsmod.Wrapf(ErrInvalidDeadline, "deadline %d", msg.Deadline)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSwapExactForTokensRoute) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSwapExactForTokensRoute) GetSigners() []sdk.AccAddress {
+	requester, _ := sdk.AccAddressFromBech32(msg.Requester)
+	return []sdk.AccAddress{requester}
+}
+
+// GetDeadline returns the time at which the msg is considered invalid
+func (msg MsgSwapExactForTokensRoute) GetDeadline() time.Time {
+	return time.Unix(msg.Deadline, 0)
+}
+
+// DeadlineExceeded returns if the msg has exceeded it's deadline
+func (msg MsgSwapExactForTokensRoute) DeadlineExceeded(blockTime time.Time) bool {
+	return blockTime.Unix() >= msg.Deadline
+}
+
+// ValidateSwapPath returns an error if a path does not trade from the input denom to the output denom
+// through at most MaxSwapPathLength denoms, or if it trades through a denom more than once
+func ValidateSwapPath(path []string, denomIn, denomOut string) error {
+	if len(path) < 2 || len(path) > MaxSwapPathLength {
+		return errorsmod.Wrapf(ErrInvalidRoute, "path must contain between 2 and %d denoms, got %d", MaxSwapPathLength, len(path))
+	}
+
+	if path[0] != denomIn {
+		return errorsmod.Wrapf(ErrInvalidRoute, "path must start with %s, got %s", denomIn, path[0])
+	}
+
+	if path[len(path)-1] != denomOut {
+		return errorsmod.Wrapf(ErrInvalidRoute, "path must end with %s, got %s", denomOut, path[len(path)-1])
+	}
+
+	seenDenoms := make(map[string]bool, len(path))
+	for _, denom := range path {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrap(ErrInvalidRoute, err.Error())
+		}
+
+		if seenDenoms[denom] {
+			return errorsmod.Wrapf(ErrInvalidRoute, "duplicate denom %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+
+	return nil
+}
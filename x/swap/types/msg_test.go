@@ -764,3 +764,233 @@ func TestMsgSwapForExactTokens_Deadline(t *testing.T) {
 		assert.Equal(t, time.Unix(tc.deadline, 0), msg.GetDeadline())
 	}
 }
+
+func TestMsgSwapExactForTokensRoute_Attributes(t *testing.T) {
+	msg := types.MsgSwapExactForTokensRoute{}
+	assert.Equal(t, "swap", msg.Route())
+	assert.Equal(t, "swap_exact_for_tokens_route", msg.Type())
+}
+
+func TestMsgSwapExactForTokensRoute_Signing(t *testing.T) {
+	signData := `{"type":"swap/MsgSwapExactForTokensRoute","value":{"deadline":"1623606299","exact_token_a":{"amount":"1000000","denom":"hard"},"path":["hard","ukava","usdx"],"requester":"kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d","slippage":"0.010000000000000000","token_b":{"amount":"5000000","denom":"usdx"}}}`
+	signBytes := []byte(signData)
+
+	addr, err := sdk.AccAddressFromBech32("kava1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+	require.NoError(t, err)
+
+	msg := types.NewMsgSwapExactForTokensRoute(addr.String(), sdk.NewCoin("hard", sdkmath.NewInt(1e6)), sdk.NewCoin("usdx", sdkmath.NewInt(5e6)), []string{"hard", "ukava", "usdx"}, sdk.MustNewDecFromStr("0.01"), 1623606299)
+	assert.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+}
+
+func TestMsgSwapExactForTokensRoute_Validation(t *testing.T) {
+	validMsg := types.NewMsgSwapExactForTokensRoute(
+		sdk.AccAddress("test1").String(),
+		sdk.NewCoin("hard", sdkmath.NewInt(1e6)),
+		sdk.NewCoin("usdx", sdkmath.NewInt(5e6)),
+		[]string{"hard", "ukava", "usdx"},
+		sdk.MustNewDecFromStr("0.01"),
+		1623606299,
+	)
+	require.NoError(t, validMsg.ValidateBasic())
+
+	testCases := []struct {
+		name        string
+		requester   string
+		exactTokenA sdk.Coin
+		tokenB      sdk.Coin
+		path        []string
+		slippage    sdk.Dec
+		deadline    int64
+		expectedErr string
+	}{
+		{
+			name:        "empty address",
+			requester:   sdk.AccAddress("").String(),
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			path:        validMsg.Path,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "requester address cannot be empty: invalid address",
+		},
+		{
+			name:        "invalid address",
+			requester:   "kava1abcde",
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			path:        validMsg.Path,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "invalid requester address: decoding bech32 failed: invalid separator index 4: invalid address",
+		},
+		{
+			name:        "zero token a",
+			requester:   validMsg.Requester,
+			exactTokenA: sdk.Coin{Denom: "hard", Amount: sdkmath.NewInt(0)},
+			tokenB:      validMsg.TokenB,
+			path:        validMsg.Path,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "exact token a deposit amount 0hard: invalid coins",
+		},
+		{
+			name:        "zero token b",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      sdk.Coin{Denom: "usdx", Amount: sdkmath.NewInt(0)},
+			path:        validMsg.Path,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "token b deposit amount 0usdx: invalid coins",
+		},
+		{
+			name:        "denoms can not be the same",
+			requester:   validMsg.Requester,
+			exactTokenA: sdk.Coin{Denom: "usdx", Amount: sdkmath.NewInt(1e6)},
+			tokenB:      validMsg.TokenB,
+			path:        []string{"usdx", "ukava", "usdx"},
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "denominations can not be equal: invalid coins",
+		},
+		{
+			name:        "empty path",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			path:        nil,
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "path must contain between 2 and 5 denoms, got 0: invalid route",
+		},
+		{
+			name:        "path too long",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			path:        []string{"hard", "swp", "bnb", "btcb", "ukava", "usdx"},
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "path must contain between 2 and 5 denoms, got 6: invalid route",
+		},
+		{
+			name:        "path does not start with token a",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			path:        []string{"ukava", "usdx"},
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "path must start with hard, got ukava: invalid route",
+		},
+		{
+			name:        "path does not end with token b",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			path:        []string{"hard", "ukava"},
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "path must end with usdx, got ukava: invalid route",
+		},
+		{
+			name:        "path with duplicate denom",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			path:        []string{"hard", "ukava", "hard", "usdx"},
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "duplicate denom hard: invalid route",
+		},
+		{
+			name:        "path with invalid denom",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			path:        []string{"hard", "", "usdx"},
+			slippage:    validMsg.Slippage,
+			deadline:    validMsg.Deadline,
+			expectedErr: "invalid denom: : invalid route",
+		},
+		{
+			name:        "zero deadline",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			path:        validMsg.Path,
+			slippage:    validMsg.Slippage,
+			deadline:    0,
+			expectedErr: "deadline 0: invalid deadline",
+		},
+		{
+			name:        "negative slippage",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			path:        validMsg.Path,
+			slippage:    sdk.MustNewDecFromStr("-0.01"),
+			deadline:    validMsg.Deadline,
+			expectedErr: "slippage can not be negative: invalid slippage",
+		},
+		{
+			name:        "nil slippage",
+			requester:   validMsg.Requester,
+			exactTokenA: validMsg.ExactTokenA,
+			tokenB:      validMsg.TokenB,
+			path:        validMsg.Path,
+			slippage:    sdk.Dec{},
+			deadline:    validMsg.Deadline,
+			expectedErr: "slippage must be set: invalid slippage",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgSwapExactForTokensRoute(tc.requester, tc.exactTokenA, tc.tokenB, tc.path, tc.slippage, tc.deadline)
+			err := msg.ValidateBasic()
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMsgSwapExactForTokensRoute_Deadline(t *testing.T) {
+	blockTime := time.Now()
+
+	testCases := []struct {
+		name       string
+		deadline   int64
+		isExceeded bool
+	}{
+		{
+			name:       "deadline in future",
+			deadline:   blockTime.Add(1 * time.Second).Unix(),
+			isExceeded: false,
+		},
+		{
+			name:       "deadline in past",
+			deadline:   blockTime.Add(-1 * time.Second).Unix(),
+			isExceeded: true,
+		},
+		{
+			name:       "deadline is equal",
+			deadline:   blockTime.Unix(),
+			isExceeded: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg := types.NewMsgSwapExactForTokensRoute(
+			sdk.AccAddress("test1").String(),
+			sdk.NewCoin("hard", sdkmath.NewInt(1000000)),
+			sdk.NewCoin("usdx", sdkmath.NewInt(2000000)),
+			[]string{"hard", "ukava", "usdx"},
+			sdk.MustNewDecFromStr("0.01"),
+			tc.deadline,
+		)
+		require.NoError(t, msg.ValidateBasic())
+		assert.Equal(t, tc.isExceeded, msg.DeadlineExceeded(blockTime))
+		assert.Equal(t, time.Unix(tc.deadline, 0), msg.GetDeadline())
+	}
+}
@@ -10,18 +10,24 @@ import (
 
 // Parameter keys and default values
 var (
-	KeyAllowedPools     = []byte("AllowedPools")
-	KeySwapFee          = []byte("SwapFee")
-	DefaultAllowedPools = AllowedPools{}
-	DefaultSwapFee      = sdk.ZeroDec()
-	MaxSwapFee          = sdk.OneDec()
+	KeyAllowedPools                 = []byte("AllowedPools")
+	KeySwapFee                      = []byte("SwapFee")
+	KeyProtocolFeeFraction          = []byte("ProtocolFeeFraction")
+	KeyProtocolFeeSweepInterval     = []byte("ProtocolFeeSweepInterval")
+	DefaultAllowedPools             = AllowedPools{}
+	DefaultSwapFee                  = sdk.ZeroDec()
+	DefaultProtocolFeeFraction      = sdk.ZeroDec()
+	DefaultProtocolFeeSweepInterval = int64(600)
+	MaxSwapFee                      = sdk.OneDec()
 )
 
 // NewParams returns a new params object
-func NewParams(pairs AllowedPools, swapFee sdk.Dec) Params {
+func NewParams(pairs AllowedPools, swapFee sdk.Dec, protocolFeeFraction sdk.Dec, protocolFeeSweepInterval int64) Params {
 	return Params{
-		AllowedPools: pairs,
-		SwapFee:      swapFee,
+		AllowedPools:             pairs,
+		SwapFee:                  swapFee,
+		ProtocolFeeFraction:      protocolFeeFraction,
+		ProtocolFeeSweepInterval: protocolFeeSweepInterval,
 	}
 }
 
@@ -30,6 +36,8 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultAllowedPools,
 		DefaultSwapFee,
+		DefaultProtocolFeeFraction,
+		DefaultProtocolFeeSweepInterval,
 	)
 }
 
@@ -37,8 +45,10 @@ func DefaultParams() Params {
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
 	AllowedPools: %s
-	SwapFee: %s`,
-		p.AllowedPools, p.SwapFee)
+	SwapFee: %s
+	ProtocolFeeFraction: %s
+	ProtocolFeeSweepInterval: %d`,
+		p.AllowedPools, p.SwapFee, p.ProtocolFeeFraction, p.ProtocolFeeSweepInterval)
 }
 
 // ParamKeyTable for swap module.
@@ -51,6 +61,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAllowedPools, &p.AllowedPools, validateAllowedPoolsParams),
 		paramtypes.NewParamSetPair(KeySwapFee, &p.SwapFee, validateSwapFee),
+		paramtypes.NewParamSetPair(KeyProtocolFeeFraction, &p.ProtocolFeeFraction, validateProtocolFeeFraction),
+		paramtypes.NewParamSetPair(KeyProtocolFeeSweepInterval, &p.ProtocolFeeSweepInterval, validateProtocolFeeSweepInterval),
 	}
 }
 
@@ -60,7 +72,15 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateSwapFee(p.SwapFee)
+	if err := validateSwapFee(p.SwapFee); err != nil {
+		return err
+	}
+
+	if err := validateProtocolFeeFraction(p.ProtocolFeeFraction); err != nil {
+		return err
+	}
+
+	return validateProtocolFeeSweepInterval(p.ProtocolFeeSweepInterval)
 }
 
 func validateAllowedPoolsParams(i interface{}) error {
@@ -85,7 +105,33 @@ func validateSwapFee(i interface{}) error {
 	return nil
 }
 
-// NewAllowedPool returns a new AllowedPool object
+func validateProtocolFeeFraction(i interface{}) error {
+	fraction, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if fraction.IsNil() || fraction.IsNegative() || fraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid protocol fee fraction: %s", fraction)
+	}
+
+	return nil
+}
+
+func validateProtocolFeeSweepInterval(i interface{}) error {
+	interval, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if interval <= 0 {
+		return fmt.Errorf("protocol fee sweep interval must be positive: %d", interval)
+	}
+
+	return nil
+}
+
+// NewAllowedPool returns a new AllowedPool object for a constant-product pool
 func NewAllowedPool(tokenA, tokenB string) AllowedPool {
 	return AllowedPool{
 		TokenA: tokenA,
@@ -93,6 +139,15 @@ func NewAllowedPool(tokenA, tokenB string) AllowedPool {
 	}
 }
 
+// NewAllowedStablePool returns a new AllowedPool object for a stable swap pool with an amplification coefficient
+func NewAllowedStablePool(tokenA, tokenB string, amplification uint64) AllowedPool {
+	return AllowedPool{
+		TokenA:                   tokenA,
+		TokenB:                   tokenB,
+		AmplificationCoefficient: amplification,
+	}
+}
+
 // Validate validates allowedPool attributes and returns an error if invalid
 func (p AllowedPool) Validate() error {
 	err := sdk.ValidateDenom(p.TokenA)
@@ -129,6 +184,12 @@ func (p AllowedPool) Validate() error {
 		)
 	}
 
+	if p.AmplificationCoefficient != 0 {
+		if err := validateAmplificationCoefficient(p.AmplificationCoefficient); err != nil {
+			return fmt.Errorf("invalid pool %s: %w", p.Name(), err)
+		}
+	}
+
 	return nil
 }
 
@@ -143,7 +204,8 @@ func (p AllowedPool) String() string {
   Name: %s
 	Token A: %s
 	Token B: %s
-`, p.Name(), p.TokenA, p.TokenB)
+	Amplification Coefficient: %d
+`, p.Name(), p.TokenA, p.TokenB, p.AmplificationCoefficient)
 }
 
 // AllowedPools is a slice of AllowedPool
@@ -52,8 +52,10 @@ func TestParams_MarshalYAML(t *testing.T) {
 	require.NoError(t, err)
 
 	p := types.Params{
-		AllowedPools: pools,
-		SwapFee:      fee,
+		AllowedPools:             pools,
+		SwapFee:                  fee,
+		ProtocolFeeFraction:      types.DefaultProtocolFeeFraction,
+		ProtocolFeeSweepInterval: types.DefaultProtocolFeeSweepInterval,
 	}
 
 	data, err := yaml.Marshal(p)
@@ -67,6 +69,10 @@ func TestParams_MarshalYAML(t *testing.T) {
 	require.True(t, ok)
 	_, ok = params["swap_fee"]
 	require.True(t, ok)
+	_, ok = params["protocol_fee_fraction"]
+	require.True(t, ok)
+	_, ok = params["protocol_fee_sweep_interval"]
+	require.True(t, ok)
 }
 
 func TestParams_Default(t *testing.T) {
@@ -76,9 +82,13 @@ func TestParams_Default(t *testing.T) {
 
 	assert.Equal(t, types.DefaultAllowedPools, defaultParams.AllowedPools)
 	assert.Equal(t, types.DefaultSwapFee, defaultParams.SwapFee)
+	assert.Equal(t, types.DefaultProtocolFeeFraction, defaultParams.ProtocolFeeFraction)
+	assert.Equal(t, types.DefaultProtocolFeeSweepInterval, defaultParams.ProtocolFeeSweepInterval)
 
 	assert.Equal(t, 0, len(defaultParams.AllowedPools))
 	assert.Equal(t, sdk.ZeroDec(), defaultParams.SwapFee)
+	assert.Equal(t, sdk.ZeroDec(), defaultParams.ProtocolFeeFraction)
+	assert.Equal(t, int64(600), defaultParams.ProtocolFeeSweepInterval)
 }
 
 func TestParams_ParamSetPairs_AllowedPools(t *testing.T) {
@@ -123,6 +133,48 @@ func TestParams_ParamSetPairs_SwapFee(t *testing.T) {
 	assert.EqualError(t, paramSetPair.ValidatorFn(struct{}{}), "invalid parameter type: struct {}")
 }
 
+func TestParams_ParamSetPairs_ProtocolFeeFraction(t *testing.T) {
+	assert.Equal(t, []byte("ProtocolFeeFraction"), types.KeyProtocolFeeFraction)
+	defaultParams := types.DefaultParams()
+
+	var paramSetPair *paramstypes.ParamSetPair
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyProtocolFeeFraction) {
+			paramSetPair = &pair
+			break
+		}
+	}
+	require.NotNil(t, paramSetPair)
+
+	fraction, ok := paramSetPair.Value.(*sdk.Dec)
+	require.True(t, ok)
+	assert.Equal(t, fraction, &defaultParams.ProtocolFeeFraction)
+
+	assert.Nil(t, paramSetPair.ValidatorFn(*fraction))
+	assert.EqualError(t, paramSetPair.ValidatorFn(struct{}{}), "invalid parameter type: struct {}")
+}
+
+func TestParams_ParamSetPairs_ProtocolFeeSweepInterval(t *testing.T) {
+	assert.Equal(t, []byte("ProtocolFeeSweepInterval"), types.KeyProtocolFeeSweepInterval)
+	defaultParams := types.DefaultParams()
+
+	var paramSetPair *paramstypes.ParamSetPair
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyProtocolFeeSweepInterval) {
+			paramSetPair = &pair
+			break
+		}
+	}
+	require.NotNil(t, paramSetPair)
+
+	interval, ok := paramSetPair.Value.(*int64)
+	require.True(t, ok)
+	assert.Equal(t, interval, &defaultParams.ProtocolFeeSweepInterval)
+
+	assert.Nil(t, paramSetPair.ValidatorFn(*interval))
+	assert.EqualError(t, paramSetPair.ValidatorFn(struct{}{}), "invalid parameter type: struct {}")
+}
+
 func TestParams_Validation(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -178,6 +230,62 @@ func TestParams_Validation(t *testing.T) {
 			},
 			expectedErr: "invalid swap fee: 1.000000000000000000",
 		},
+		{
+			name: "nil protocol fee fraction",
+			key:  types.KeyProtocolFeeFraction,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeFraction = sdk.Dec{}
+			},
+			expectedErr: "invalid protocol fee fraction: <nil>",
+		},
+		{
+			name: "negative protocol fee fraction",
+			key:  types.KeyProtocolFeeFraction,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeFraction = sdk.NewDec(-1)
+			},
+			expectedErr: "invalid protocol fee fraction: -1.000000000000000000",
+		},
+		{
+			name: "protocol fee fraction greater than 1",
+			key:  types.KeyProtocolFeeFraction,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeFraction = sdk.MustNewDecFromStr("1.000000000000000001")
+			},
+			expectedErr: "invalid protocol fee fraction: 1.000000000000000001",
+		},
+		{
+			name: "1 protocol fee fraction",
+			key:  types.KeyProtocolFeeFraction,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeFraction = sdk.OneDec()
+			},
+			expectedErr: "",
+		},
+		{
+			name: "zero protocol fee sweep interval",
+			key:  types.KeyProtocolFeeSweepInterval,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeSweepInterval = 0
+			},
+			expectedErr: "protocol fee sweep interval must be positive: 0",
+		},
+		{
+			name: "negative protocol fee sweep interval",
+			key:  types.KeyProtocolFeeSweepInterval,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeSweepInterval = -1
+			},
+			expectedErr: "protocol fee sweep interval must be positive: -1",
+		},
+		{
+			name: "1 protocol fee sweep interval",
+			key:  types.KeyProtocolFeeSweepInterval,
+			testFn: func(params *types.Params) {
+				params.ProtocolFeeSweepInterval = 1
+			},
+			expectedErr: "",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -216,6 +324,8 @@ func TestParams_String(t *testing.T) {
 			types.NewAllowedPool("ukava", "usdx"),
 		),
 		sdk.MustNewDecFromStr("0.5"),
+		sdk.MustNewDecFromStr("0.1"),
+		100,
 	)
 
 	require.NoError(t, params.Validate())
@@ -309,10 +419,35 @@ func TestAllowedPool_String(t *testing.T) {
   Name: hard:ukava
 	Token A: hard
 	Token B: ukava
+	Amplification Coefficient: 0
 `
 	assert.Equal(t, output, allowedPool.String())
 }
 
+func TestAllowedPool_AmplificationCoefficient(t *testing.T) {
+	testCases := []struct {
+		amplification uint64
+		expectErr     string
+	}{
+		{0, ""},
+		{1, ""},
+		{100, ""},
+		{types.MaxAmplificationCoefficient, ""},
+		{types.MaxAmplificationCoefficient + 1, "invalid pool usdc:usdx: amplification coefficient must be between 1 and 1000000, got 1000001"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%d", tc.amplification), func(t *testing.T) {
+			err := types.NewAllowedStablePool("usdc", "usdx", tc.amplification).Validate()
+			if tc.expectErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectErr)
+			}
+		})
+	}
+}
+
 func TestAllowedPool_Name(t *testing.T) {
 	testCases := []struct {
 		tokens string
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewProtocolFeeRecord returns a new protocol fee record of the fees accrued by a pool
+func NewProtocolFeeRecord(poolID string, feesAccrued sdk.Coins) ProtocolFeeRecord {
+	return ProtocolFeeRecord{
+		PoolID:      poolID,
+		FeesAccrued: feesAccrued,
+	}
+}
+
+// Validate performs basic validation checks of the record data
+func (r ProtocolFeeRecord) Validate() error {
+	tokens := strings.Split(r.PoolID, PoolIDSep)
+	if len(tokens) != 2 || sdk.ValidateDenom(tokens[0]) != nil || sdk.ValidateDenom(tokens[1]) != nil {
+		return fmt.Errorf("poolID '%s' is invalid", r.PoolID)
+	}
+
+	if !r.FeesAccrued.IsValid() {
+		return fmt.Errorf("pool '%s' has invalid protocol fees: %s", r.PoolID, r.FeesAccrued)
+	}
+
+	for _, coin := range r.FeesAccrued {
+		if coin.Denom != tokens[0] && coin.Denom != tokens[1] {
+			return fmt.Errorf("pool '%s' has protocol fees of denom '%s' not in the pool", r.PoolID, coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// ProtocolFeeRecords is a slice of ProtocolFeeRecord
+type ProtocolFeeRecords []ProtocolFeeRecord
+
+// Validate performs basic validation checks on all records in the slice
+func (rs ProtocolFeeRecords) Validate() error {
+	seenPoolIDs := make(map[string]bool)
+
+	for _, r := range rs {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+
+		if seenPoolIDs[r.PoolID] {
+			return fmt.Errorf("duplicate protocol fee record for poolID '%s'", r.PoolID)
+		}
+
+		seenPoolIDs[r.PoolID] = true
+	}
+
+	return nil
+}
+
+// TotalFeesAccrued returns the sum of the protocol fees accrued by all records
+func (rs ProtocolFeeRecords) TotalFeesAccrued() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, r := range rs {
+		total = total.Add(r.FeesAccrued...)
+	}
+	return total
+}
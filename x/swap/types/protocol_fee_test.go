@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/kava-labs/kava/x/swap/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProtocolFeeRecord_Validate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		record      types.ProtocolFeeRecord
+		expectedErr string
+	}{
+		{
+			name:   "valid record",
+			record: types.NewProtocolFeeRecord("ukava:usdx", sdk.NewCoins(ukava(1e3), usdx(5e3))),
+		},
+		{
+			name:   "valid record without fees",
+			record: types.NewProtocolFeeRecord("ukava:usdx", sdk.NewCoins()),
+		},
+		{
+			name:        "invalid pool id",
+			record:      types.NewProtocolFeeRecord("ukava", sdk.NewCoins(ukava(1e3))),
+			expectedErr: "poolID 'ukava' is invalid",
+		},
+		{
+			name:        "invalid fees",
+			record:      types.NewProtocolFeeRecord("ukava:usdx", sdk.Coins{usdx(5e3), ukava(1e3)}),
+			expectedErr: "pool 'ukava:usdx' has invalid protocol fees: 5000usdx,1000ukava",
+		},
+		{
+			name:        "fee denom not in pool",
+			record:      types.NewProtocolFeeRecord("ukava:usdx", sdk.NewCoins(hard(1e3))),
+			expectedErr: "pool 'ukava:usdx' has protocol fees of denom 'hard' not in the pool",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.record.Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestProtocolFeeRecords_Validate(t *testing.T) {
+	records := types.ProtocolFeeRecords{
+		types.NewProtocolFeeRecord("ukava:usdx", sdk.NewCoins(ukava(1e3))),
+		types.NewProtocolFeeRecord("hard:usdx", sdk.NewCoins(hard(2e3))),
+	}
+	assert.NoError(t, records.Validate())
+
+	records = append(records, types.NewProtocolFeeRecord("ukava:usdx", sdk.NewCoins(usdx(5e3))))
+	assert.EqualError(t, records.Validate(), "duplicate protocol fee record for poolID 'ukava:usdx'")
+
+	records = types.ProtocolFeeRecords{types.NewProtocolFeeRecord("ukava:usdx", sdk.NewCoins(hard(2e3)))}
+	assert.EqualError(t, records.Validate(), "pool 'ukava:usdx' has protocol fees of denom 'hard' not in the pool")
+}
+
+func TestProtocolFeeRecords_TotalFeesAccrued(t *testing.T) {
+	assert.Equal(t, sdk.NewCoins(), types.ProtocolFeeRecords{}.TotalFeesAccrued())
+
+	records := types.ProtocolFeeRecords{
+		types.NewProtocolFeeRecord("ukava:usdx", sdk.NewCoins(ukava(1e3), usdx(5e3))),
+		types.NewProtocolFeeRecord("hard:usdx", sdk.NewCoins(hard(2e3), usdx(1e3))),
+	}
+	assert.Equal(t, sdk.NewCoins(hard(2e3), ukava(1e3), usdx(6e3)), records.TotalFeesAccrued())
+}
@@ -0,0 +1,222 @@
+package types_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	types "github.com/kava-labs/kava/x/swap/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStableSwapPool_NewPool_Validation(t *testing.T) {
+	testCases := []struct {
+		reservesA     sdkmath.Int
+		reservesB     sdkmath.Int
+		amplification uint64
+		expectedErr   string
+	}{
+		{i(0), i(1e6), 100, "reserves must be greater than zero: invalid pool"},
+		{i(1e6), i(-1), 100, "reserves must be greater than zero: invalid pool"},
+		{i(1e6), i(1e6), 0, "amplification coefficient must be between 1 and 1000000, got 0: invalid pool"},
+		{i(1e6), i(1e6), 1_000_001, "amplification coefficient must be between 1 and 1000000, got 1000001: invalid pool"},
+		{s("1000000000000000000000000000000"), i(1e6), 100, "invariant did not converge for reserves 1000000000000000000000000000000 and 1000000: invalid pool"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("reservesA=%s reservesB=%s amp=%d", tc.reservesA, tc.reservesB, tc.amplification), func(t *testing.T) {
+			pool, err := types.NewStableSwapPool(tc.reservesA, tc.reservesB, tc.amplification)
+			require.EqualError(t, err, tc.expectedErr)
+			assert.Nil(t, pool)
+		})
+	}
+
+	pool, err := types.NewStableSwapPoolWithExistingShares(i(1e6), i(1e6), i(0), 100)
+	require.EqualError(t, err, "total shares must be greater than zero: invalid pool")
+	assert.Nil(t, pool)
+
+	pool, err = types.NewStableSwapPoolWithExistingShares(i(1e6), i(1e6), i(1e6), 0)
+	require.EqualError(t, err, "amplification coefficient must be between 1 and 1000000, got 0: invalid pool")
+	assert.Nil(t, pool)
+}
+
+func TestStableSwapPool_InitialState(t *testing.T) {
+	// balanced reserves have an invariant equal to their sum
+	pool, err := types.NewStableSwapPool(i(1e6), i(1e6), 100)
+	require.NoError(t, err)
+	assert.Equal(t, i(1e6), pool.ReservesA())
+	assert.Equal(t, i(1e6), pool.ReservesB())
+	assert.Equal(t, i(2e6), pool.TotalShares())
+	assert.Equal(t, uint64(100), pool.AmplificationCoefficient())
+
+	// imbalanced reserves have an invariant between the geometric mean and the sum
+	pool, err = types.NewStableSwapPool(i(1e6), i(4e6), 100)
+	require.NoError(t, err)
+	assert.True(t, pool.TotalShares().GT(i(2*2e6)), "expected invariant to be greater than constant product shares")
+	assert.True(t, pool.TotalShares().LT(i(5e6)), "expected invariant to be less than the sum of reserves")
+}
+
+func TestStableSwapPool_AddLiquidity(t *testing.T) {
+	pool, err := types.NewStableSwapPool(i(10e6), i(20e6), 100)
+	require.NoError(t, err)
+	initialShares := pool.TotalShares()
+
+	// deposits are made in the ratio of the reserves
+	depositA, depositB, shares := pool.AddLiquidity(i(1e6), i(5e6))
+	assert.Equal(t, i(1e6), depositA)
+	assert.Equal(t, i(2e6), depositB)
+
+	// a 10% deposit creates 10% of the shares, truncated
+	expectedShares := initialShares.QuoRaw(10)
+	assert.True(t, shares.LTE(expectedShares), "expected shares %s <= %s", shares, expectedShares)
+	assert.True(t, shares.GTE(expectedShares.SubRaw(2)), "expected shares %s >= %s", shares, expectedShares.SubRaw(2))
+
+	assert.Equal(t, i(11e6), pool.ReservesA())
+	assert.Equal(t, i(22e6), pool.ReservesB())
+	assert.Equal(t, initialShares.Add(shares), pool.TotalShares())
+
+	// withdrawing the deposited shares does not return more than deposited
+	withdrawA, withdrawB := pool.RemoveLiquidity(shares)
+	assert.True(t, withdrawA.LTE(depositA))
+	assert.True(t, withdrawB.LTE(depositB))
+}
+
+func TestStableSwapPool_EmptyAndRefill(t *testing.T) {
+	pool, err := types.NewStableSwapPool(i(1e6), i(1e6), 10)
+	require.NoError(t, err)
+
+	withdrawA, withdrawB := pool.RemoveLiquidity(pool.TotalShares())
+	assert.Equal(t, i(1e6), withdrawA)
+	assert.Equal(t, i(1e6), withdrawB)
+	assert.True(t, pool.IsEmpty())
+
+	depositA, depositB, shares := pool.AddLiquidity(i(2e6), i(3e6))
+	assert.Equal(t, i(2e6), depositA)
+	assert.Equal(t, i(3e6), depositB)
+	assert.Equal(t, pool.TotalShares(), shares)
+}
+
+func TestStableSwapPool_Swap_LowerSlippageThanConstantProduct(t *testing.T) {
+	stablePool, err := types.NewStableSwapPool(i(1_000e6), i(1_000e6), 100)
+	require.NoError(t, err)
+	basePool, err := types.NewBasePool(i(1_000e6), i(1_000e6))
+	require.NoError(t, err)
+
+	stableOut, stableFee := stablePool.SwapExactAForB(i(100e6), d("0.003"))
+	baseOut, baseFee := basePool.SwapExactAForB(i(100e6), d("0.003"))
+
+	assert.Equal(t, baseFee, stableFee)
+	assert.True(t, stableOut.GT(baseOut), "expected stable output %s > constant product output %s", stableOut, baseOut)
+	// a 10% of reserves swap has less than 0.1% slippage with the fee removed
+	assert.True(t, stableOut.GT(i(99_600_000)), "expected stable output %s to have low slippage", stableOut)
+	assert.True(t, stableOut.LT(i(99_700_000)))
+
+	stablePool, err = types.NewStableSwapPool(i(1_000e6), i(1_000e6), 100)
+	require.NoError(t, err)
+	basePool, err = types.NewBasePool(i(1_000e6), i(1_000e6))
+	require.NoError(t, err)
+
+	stableIn, stableFee := stablePool.SwapBForExactA(i(50e6), d("0.003"))
+	baseIn, baseFee := basePool.SwapBForExactA(i(50e6), d("0.003"))
+	assert.True(t, stableIn.LT(baseIn), "expected stable input %s < constant product input %s", stableIn, baseIn)
+	assert.True(t, stableFee.LT(baseFee))
+}
+
+func TestStableSwapPool_Swap_ExactInputAndOutputAgree(t *testing.T) {
+	pool, err := types.NewStableSwapPool(i(500e6), i(700e6), 200)
+	require.NoError(t, err)
+	out, _ := pool.SwapExactAForB(i(10e6), d("0.0025"))
+
+	pool, err = types.NewStableSwapPool(i(500e6), i(700e6), 200)
+	require.NoError(t, err)
+	in, _ := pool.SwapAForExactB(out, d("0.0025"))
+
+	// the input required for the exact output is within rounding of the original input
+	assert.True(t, in.LTE(i(10e6)), "expected input %s <= %s", in, i(10e6))
+	assert.True(t, in.GTE(i(10e6-3)), "expected input %s >= %s", in, i(10e6-3))
+}
+
+func TestStableSwapPool_Swap_Symmetric(t *testing.T) {
+	poolAB, err := types.NewStableSwapPool(i(300e6), i(900e6), 50)
+	require.NoError(t, err)
+	poolBA, err := types.NewStableSwapPool(i(900e6), i(300e6), 50)
+	require.NoError(t, err)
+
+	outAB, feeAB := poolAB.SwapExactAForB(i(25e6), d("0.003"))
+	outBA, feeBA := poolBA.SwapExactBForA(i(25e6), d("0.003"))
+	assert.Equal(t, outAB, outBA)
+	assert.Equal(t, feeAB, feeBA)
+	assert.Equal(t, poolAB.ReservesA(), poolBA.ReservesB())
+	assert.Equal(t, poolAB.ReservesB(), poolBA.ReservesA())
+}
+
+func TestStableSwapPool_Swap_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	fees := []sdk.Dec{d("0"), d("0.003"), d("0.05")}
+	amplifications := []uint64{1, 10, 100, 5_000, types.MaxAmplificationCoefficient}
+
+	for n := 0; n < 200; n++ {
+		reservesA := i(r.Int63n(1e15) + 1e6)
+		reservesB := i(r.Int63n(1e15) + 1e6)
+		amplification := amplifications[r.Intn(len(amplifications))]
+		fee := fees[r.Intn(len(fees))]
+
+		pool, err := types.NewStableSwapPool(reservesA, reservesB, amplification)
+		require.NoError(t, err)
+
+		// swaps panic if the invariant decreases
+		for m := 0; m < 10; m++ {
+			switch r.Intn(4) {
+			case 0:
+				pool.SwapExactAForB(i(r.Int63n(pool.ReservesA().Int64())+1), fee)
+			case 1:
+				pool.SwapExactBForA(i(r.Int63n(pool.ReservesB().Int64())+1), fee)
+			case 2:
+				pool.SwapAForExactB(i(r.Int63n(pool.ReservesB().Int64()-1)+1), fee)
+			case 3:
+				pool.SwapBForExactA(i(r.Int63n(pool.ReservesA().Int64()-1)+1), fee)
+			}
+
+			require.True(t, pool.ReservesA().IsPositive())
+			require.True(t, pool.ReservesB().IsPositive())
+		}
+	}
+}
+
+func TestStableSwapPool_SpotPrice(t *testing.T) {
+	pool, err := types.NewStableSwapPool(i(1e6), i(1e6), 100)
+	require.NoError(t, err)
+	assert.Equal(t, d("1"), pool.SpotPriceA())
+	assert.Equal(t, d("1"), pool.SpotPriceB())
+
+	pool, err = types.NewStableSwapPool(i(300e6), i(900e6), 50)
+	require.NoError(t, err)
+	priceA := pool.SpotPriceA()
+	priceB := pool.SpotPriceB()
+
+	// the scarce reserve is priced above par but far below the constant product price
+	assert.True(t, priceA.GT(d("1")), "expected price %s > 1", priceA)
+	assert.True(t, priceA.LT(d("1.1")), "expected price %s < 1.1", priceA)
+	assert.True(t, priceA.Mul(priceB).Sub(d("1")).Abs().LT(d("0.000000001")), "expected prices %s and %s to be reciprocal", priceA, priceB)
+
+	// a small swap without fees executes at the spot price
+	out, _ := pool.SwapExactAForB(i(1e3), d("0"))
+	executionPrice := sdk.NewDecFromInt(out).QuoInt64(1e3)
+	assert.True(t, executionPrice.Sub(priceA).Abs().LT(d("0.002")), "expected execution price %s to be near spot price %s", executionPrice, priceA)
+
+	pool.RemoveLiquidity(pool.TotalShares())
+	assert.Panics(t, func() { pool.SpotPriceA() }, "SpotPriceA did not panic on empty pool")
+}
+
+func TestStableSwapPool_Panics_Swap(t *testing.T) {
+	pool, err := types.NewStableSwapPool(i(1e6), i(1e6), 100)
+	require.NoError(t, err)
+
+	assert.PanicsWithValue(t, "invalid value: swap input must be positive", func() { pool.SwapExactAForB(i(0), d("0.003")) })
+	assert.PanicsWithValue(t, "invalid value: swap output must be less than reserves", func() { pool.SwapAForExactB(i(1e6), d("0.003")) })
+	assert.PanicsWithValue(t, "invalid value: fee must be between 0 and 1", func() { pool.SwapExactBForA(i(1e3), d("1")) })
+}
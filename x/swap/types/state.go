@@ -37,24 +37,30 @@ func NewPoolRecord(reserves sdk.Coins, totalShares sdkmath.Int) PoolRecord {
 	poolID := PoolIDFromCoins(reserves)
 
 	return PoolRecord{
-		PoolID:      poolID,
-		ReservesA:   reserves[0],
-		ReservesB:   reserves[1],
-		TotalShares: totalShares,
+		PoolID:           poolID,
+		ReservesA:        reserves[0],
+		ReservesB:        reserves[1],
+		TotalShares:      totalShares,
+		PriceCumulativeA: sdk.ZeroDec(),
+		PriceCumulativeB: sdk.ZeroDec(),
 	}
 }
 
 // NewPoolRecordFromPool takes a pointer to a denominated pool and returns a
-// pool record for storage in state.
+// pool record for storage in state. The price accumulators are zero and must
+// be carried over from any previous record of the pool.
 func NewPoolRecordFromPool(pool *DenominatedPool) PoolRecord {
 	reserves := pool.Reserves()
 	poolID := PoolIDFromCoins(reserves)
 
 	return PoolRecord{
-		PoolID:      poolID,
-		ReservesA:   reserves[0],
-		ReservesB:   reserves[1],
-		TotalShares: pool.TotalShares(),
+		PoolID:                   poolID,
+		ReservesA:                reserves[0],
+		ReservesB:                reserves[1],
+		TotalShares:              pool.TotalShares(),
+		AmplificationCoefficient: pool.AmplificationCoefficient(),
+		PriceCumulativeA:         sdk.ZeroDec(),
+		PriceCumulativeB:         sdk.ZeroDec(),
 	}
 }
 
@@ -87,9 +93,26 @@ func (p PoolRecord) Validate() error {
 		return fmt.Errorf("pool '%s' has invalid total shares: %s", p.PoolID, p.TotalShares)
 	}
 
+	if p.AmplificationCoefficient > MaxAmplificationCoefficient {
+		return fmt.Errorf("pool '%s' has invalid amplification coefficient: %d", p.PoolID, p.AmplificationCoefficient)
+	}
+
+	if p.PriceCumulativeA.IsNil() || p.PriceCumulativeA.IsNegative() {
+		return fmt.Errorf("pool '%s' has invalid price accumulator: %s", p.PoolID, p.PriceCumulativeA)
+	}
+
+	if p.PriceCumulativeB.IsNil() || p.PriceCumulativeB.IsNegative() {
+		return fmt.Errorf("pool '%s' has invalid price accumulator: %s", p.PoolID, p.PriceCumulativeB)
+	}
+
 	return nil
 }
 
+// IsStableSwap returns true if the record is a stable swap pool
+func (p PoolRecord) IsStableSwap() bool {
+	return p.AmplificationCoefficient != 0
+}
+
 // Reserves returns the total reserves for a pool
 func (p PoolRecord) Reserves() sdk.Coins {
 	return sdk.NewCoins(p.ReservesA, p.ReservesB)
@@ -98,6 +98,9 @@ func TestState_PoolRecord_JSONEncoding(t *testing.T) {
 
 func TestState_PoolRecord_YamlEncoding(t *testing.T) {
 	expected := `pool_id: ukava:usdx
+price_cumulative_a: "0.000000000000000000"
+price_cumulative_b: "0.000000000000000000"
+price_cumulative_updated_at: "0001-01-01T00:00:00Z"
 reserves_a:
   amount: "1000000"
   denom: ukava
@@ -262,6 +265,18 @@ func TestState_PoolRecord_Validations(t *testing.T) {
 	}
 }
 
+func TestState_PoolRecord_Validate_PriceAccumulators(t *testing.T) {
+	record := types.NewPoolRecord(sdk.NewCoins(ukava(1e6), usdx(5e6)), i(3e6))
+	require.NoError(t, record.Validate())
+
+	record.PriceCumulativeA = sdk.Dec{}
+	assert.EqualError(t, record.Validate(), "pool 'ukava:usdx' has invalid price accumulator: <nil>")
+
+	record.PriceCumulativeA = sdk.ZeroDec()
+	record.PriceCumulativeB = sdk.NewDec(-1)
+	assert.EqualError(t, record.Validate(), "pool 'ukava:usdx' has invalid price accumulator: -1.000000000000000000")
+}
+
 func TestState_PoolRecord_OrderedReserves(t *testing.T) {
 	invalidOrder := types.NewPoolRecord(
 		// force order to not be sorted
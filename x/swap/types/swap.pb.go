@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -32,6 +36,12 @@ type Params struct {
 	AllowedPools AllowedPools `protobuf:"bytes,1,rep,name=allowed_pools,json=allowedPools,proto3,castrepeated=AllowedPools" json:"allowed_pools"`
 	// swap_fee defines the swap fee for all pools
 	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee"`
+	// protocol_fee_fraction defines the fraction of each swap fee that is paid to the community pool
+	// instead of liquidity providers
+	ProtocolFeeFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=protocol_fee_fraction,json=protocolFeeFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_fee_fraction,omitempty"`
+	// protocol_fee_sweep_interval defines the number of blocks between sweeps of accrued protocol fees to
+	// the community pool
+	ProtocolFeeSweepInterval int64 `protobuf:"varint,4,opt,name=protocol_fee_sweep_interval,json=protocolFeeSweepInterval,proto3" json:"protocol_fee_sweep_interval,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,12 +83,22 @@ func (m *Params) GetAllowedPools() AllowedPools {
 	return nil
 }
 
+func (m *Params) GetProtocolFeeSweepInterval() int64 {
+	if m != nil {
+		return m.ProtocolFeeSweepInterval
+	}
+	return 0
+}
+
 // AllowedPool defines a pool that is allowed to be created
 type AllowedPool struct {
 	// token_a represents the a token allowed
 	TokenA string `protobuf:"bytes,1,opt,name=token_a,json=tokenA,proto3" json:"token_a,omitempty"`
 	// token_b represents the b token allowed
 	TokenB string `protobuf:"bytes,2,opt,name=token_b,json=tokenB,proto3" json:"token_b,omitempty"`
+	// amplification_coefficient is the amplification coefficient of a stable swap pool. A zero
+	// value creates a constant product pool.
+	AmplificationCoefficient uint64 `protobuf:"varint,3,opt,name=amplification_coefficient,json=amplificationCoefficient,proto3" json:"amplification_coefficient,omitempty"`
 }
 
 func (m *AllowedPool) Reset()      { *m = AllowedPool{} }
@@ -127,6 +147,13 @@ func (m *AllowedPool) GetTokenB() string {
 	return ""
 }
 
+func (m *AllowedPool) GetAmplificationCoefficient() uint64 {
+	if m != nil {
+		return m.AmplificationCoefficient
+	}
+	return 0
+}
+
 // PoolRecord represents the state of a liquidity pool
 // and is used to store the state of a denominated pool
 type PoolRecord struct {
@@ -138,6 +165,17 @@ type PoolRecord struct {
 	ReservesB types.Coin `protobuf:"bytes,3,opt,name=reserves_b,json=reservesB,proto3" json:"reserves_b"`
 	// total_shares is the total distrubuted shares of the pool
 	TotalShares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_shares,json=totalShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_shares"`
+	// amplification_coefficient is the amplification coefficient of a stable swap pool, set from
+	// the allowed pool when the pool is created. A zero value is a constant product pool.
+	AmplificationCoefficient uint64 `protobuf:"varint,5,opt,name=amplification_coefficient,json=amplificationCoefficient,proto3" json:"amplification_coefficient,omitempty"`
+	// price_cumulative_a is the sum of the spot price of token a in units of token b, multiplied by
+	// the seconds each price was held, up to price_cumulative_updated_at
+	PriceCumulativeA github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=price_cumulative_a,json=priceCumulativeA,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_cumulative_a,omitempty"`
+	// price_cumulative_b is the sum of the spot price of token b in units of token a, multiplied by
+	// the seconds each price was held, up to price_cumulative_updated_at
+	PriceCumulativeB github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=price_cumulative_b,json=priceCumulativeB,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_cumulative_b,omitempty"`
+	// price_cumulative_updated_at is the block time the price accumulators were last updated
+	PriceCumulativeUpdatedAt time.Time `protobuf:"bytes,8,opt,name=price_cumulative_updated_at,json=priceCumulativeUpdatedAt,proto3,stdtime" json:"price_cumulative_updated_at,omitempty"`
 }
 
 func (m *PoolRecord) Reset()         { *m = PoolRecord{} }
@@ -194,6 +232,80 @@ func (m *PoolRecord) GetReservesB() types.Coin {
 	return types.Coin{}
 }
 
+func (m *PoolRecord) GetAmplificationCoefficient() uint64 {
+	if m != nil {
+		return m.AmplificationCoefficient
+	}
+	return 0
+}
+
+func (m *PoolRecord) GetPriceCumulativeUpdatedAt() time.Time {
+	if m != nil {
+		return m.PriceCumulativeUpdatedAt
+	}
+	return time.Time{}
+}
+
+// PriceObservation is a snapshot of the price accumulators of a pool, used to calculate time-weighted
+// average prices
+type PriceObservation struct {
+	// pool_id represents the unique id of the pool
+	PoolID string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// time is the block time of the observation
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// price_cumulative_a is the price accumulator of token a at the time of the observation
+	PriceCumulativeA github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price_cumulative_a,json=priceCumulativeA,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_cumulative_a"`
+	// price_cumulative_b is the price accumulator of token b at the time of the observation
+	PriceCumulativeB github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price_cumulative_b,json=priceCumulativeB,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_cumulative_b"`
+}
+
+func (m *PriceObservation) Reset()         { *m = PriceObservation{} }
+func (m *PriceObservation) String() string { return proto.CompactTextString(m) }
+func (*PriceObservation) ProtoMessage()    {}
+func (*PriceObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df359be90eb28cb, []int{3}
+}
+func (m *PriceObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceObservation.Merge(m, src)
+}
+func (m *PriceObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceObservation proto.InternalMessageInfo
+
+func (m *PriceObservation) GetPoolID() string {
+	if m != nil {
+		return m.PoolID
+	}
+	return ""
+}
+
+func (m *PriceObservation) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 // ShareRecord stores the shares owned for a depositor and pool
 type ShareRecord struct {
 	// depositor represents the owner of the shares
@@ -208,7 +320,7 @@ func (m *ShareRecord) Reset()         { *m = ShareRecord{} }
 func (m *ShareRecord) String() string { return proto.CompactTextString(m) }
 func (*ShareRecord) ProtoMessage()    {}
 func (*ShareRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df359be90eb28cb, []int{3}
+	return fileDescriptor_9df359be90eb28cb, []int{4}
 }
 func (m *ShareRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,50 +363,131 @@ func (m *ShareRecord) GetPoolID() string {
 	return ""
 }
 
+// ProtocolFeeRecord stores the protocol fees accrued by a pool since they were last swept to the
+// community pool
+type ProtocolFeeRecord struct {
+	// pool_id represents the pool the fees were paid to
+	PoolID string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// fees_accrued represents the protocol fees held by the protocol fee module account for the pool
+	FeesAccrued github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fees_accrued,json=feesAccrued,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_accrued"`
+}
+
+func (m *ProtocolFeeRecord) Reset()         { *m = ProtocolFeeRecord{} }
+func (m *ProtocolFeeRecord) String() string { return proto.CompactTextString(m) }
+func (*ProtocolFeeRecord) ProtoMessage()    {}
+func (*ProtocolFeeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df359be90eb28cb, []int{5}
+}
+func (m *ProtocolFeeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtocolFeeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtocolFeeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtocolFeeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtocolFeeRecord.Merge(m, src)
+}
+func (m *ProtocolFeeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtocolFeeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtocolFeeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtocolFeeRecord proto.InternalMessageInfo
+
+func (m *ProtocolFeeRecord) GetPoolID() string {
+	if m != nil {
+		return m.PoolID
+	}
+	return ""
+}
+
+func (m *ProtocolFeeRecord) GetFeesAccrued() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesAccrued
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kava.swap.v1beta1.Params")
 	proto.RegisterType((*AllowedPool)(nil), "kava.swap.v1beta1.AllowedPool")
 	proto.RegisterType((*PoolRecord)(nil), "kava.swap.v1beta1.PoolRecord")
+	proto.RegisterType((*PriceObservation)(nil), "kava.swap.v1beta1.PriceObservation")
 	proto.RegisterType((*ShareRecord)(nil), "kava.swap.v1beta1.ShareRecord")
+	proto.RegisterType((*ProtocolFeeRecord)(nil), "kava.swap.v1beta1.ProtocolFeeRecord")
 }
 
 func init() { proto.RegisterFile("kava/swap/v1beta1/swap.proto", fileDescriptor_9df359be90eb28cb) }
 
 var fileDescriptor_9df359be90eb28cb = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x3f, 0x6f, 0xd3, 0x5e,
-	0x14, 0x8d, 0xd3, 0x28, 0xf9, 0xe5, 0x25, 0xbf, 0x01, 0x53, 0x89, 0xb4, 0x42, 0x76, 0x15, 0x24,
-	0xd4, 0x25, 0xb6, 0x5a, 0x36, 0x84, 0x10, 0x31, 0x01, 0x91, 0x89, 0xca, 0x0c, 0x08, 0x96, 0xa7,
-	0x67, 0xfb, 0x36, 0xb5, 0xe2, 0xf8, 0x5a, 0x7e, 0x8f, 0x84, 0x7e, 0x0b, 0xc4, 0xc4, 0xc8, 0xcc,
-	0xdc, 0x4f, 0xc0, 0xd4, 0xb1, 0xea, 0x84, 0x18, 0x02, 0x4a, 0xbe, 0x05, 0x2c, 0xe8, 0xfd, 0xa1,
-	0x35, 0x42, 0x48, 0x54, 0x4c, 0xbe, 0xf7, 0x9e, 0x77, 0xce, 0xbd, 0xf7, 0x58, 0x97, 0xdc, 0x9c,
-	0xb2, 0x39, 0xf3, 0xf9, 0x82, 0x15, 0xfe, 0x7c, 0x2f, 0x02, 0xc1, 0xf6, 0x54, 0xe2, 0x15, 0x25,
-	0x0a, 0xb4, 0xaf, 0x49, 0xd4, 0x53, 0x05, 0x83, 0x6e, 0x3b, 0x31, 0xf2, 0x19, 0x72, 0x3f, 0x62,
-	0x1c, 0x2e, 0x28, 0x31, 0xa6, 0xb9, 0xa6, 0x6c, 0x6f, 0x69, 0x9c, 0xaa, 0xcc, 0xd7, 0x89, 0x81,
-	0x36, 0x27, 0x38, 0x41, 0x5d, 0x97, 0x91, 0xae, 0xf6, 0x3f, 0x5a, 0xa4, 0x79, 0xc0, 0x4a, 0x36,
-	0xe3, 0xf6, 0x0b, 0xf2, 0x3f, 0xcb, 0x32, 0x5c, 0x40, 0x42, 0x0b, 0xc4, 0x8c, 0xf7, 0xac, 0x9d,
-	0x8d, 0xdd, 0xce, 0xbe, 0xe3, 0xfd, 0x36, 0x86, 0x37, 0xd4, 0xef, 0x0e, 0x10, 0xb3, 0x60, 0xf3,
-	0x74, 0xe9, 0xd6, 0x3e, 0x7c, 0x71, 0xbb, 0x95, 0x22, 0x0f, 0xbb, 0xac, 0x92, 0xd9, 0xcf, 0xc9,
-	0x7f, 0x92, 0x4f, 0x0f, 0x01, 0x7a, 0xf5, 0x1d, 0x6b, 0xb7, 0x1d, 0xdc, 0x93, 0xac, 0xcf, 0x4b,
-	0xf7, 0xf6, 0x24, 0x15, 0x47, 0xaf, 0x22, 0x2f, 0xc6, 0x99, 0x19, 0xd7, 0x7c, 0x06, 0x3c, 0x99,
-	0xfa, 0xe2, 0xb8, 0x00, 0xee, 0x8d, 0x20, 0x3e, 0x3f, 0x19, 0x10, 0xb3, 0xcd, 0x08, 0xe2, 0xb0,
-	0x25, 0xd5, 0x1e, 0x03, 0xdc, 0x6d, 0xbc, 0x7b, 0xef, 0xd6, 0xfa, 0x8f, 0x48, 0xa7, 0xd2, 0xdc,
-	0xbe, 0x41, 0x5a, 0x02, 0xa7, 0x90, 0x53, 0xd6, 0xb3, 0x64, 0xb3, 0xb0, 0xa9, 0xd2, 0xe1, 0x25,
-	0x10, 0xe9, 0x29, 0x0c, 0x10, 0x18, 0x99, 0xb7, 0x75, 0x42, 0xa4, 0x40, 0x08, 0x31, 0x96, 0x89,
-	0x7d, 0x8b, 0xb4, 0xa4, 0x0f, 0x34, 0x4d, 0xb4, 0x4c, 0x40, 0x56, 0x4b, 0xb7, 0x29, 0x1f, 0x8c,
-	0x47, 0x61, 0x53, 0x42, 0xe3, 0xc4, 0xbe, 0x4f, 0x48, 0x09, 0x1c, 0xca, 0x39, 0x70, 0xca, 0x94,
-	0x6a, 0x67, 0x7f, 0xcb, 0x33, 0xa3, 0xca, 0xbf, 0x74, 0xe1, 0xd9, 0x43, 0x4c, 0xf3, 0xa0, 0x21,
-	0xd7, 0x0e, 0xdb, 0x3f, 0x29, 0xc3, 0x5f, 0xf8, 0x51, 0x6f, 0xe3, 0x8a, 0xfc, 0xc0, 0xa6, 0xa4,
-	0x2b, 0x50, 0xb0, 0x8c, 0xf2, 0x23, 0x56, 0x02, 0xef, 0x35, 0xae, 0xec, 0xee, 0x38, 0x17, 0x15,
-	0x77, 0xc7, 0xb9, 0x08, 0x3b, 0x4a, 0xf1, 0x99, 0x12, 0xec, 0x7f, 0xb7, 0x48, 0x47, 0x85, 0xc6,
-	0x95, 0x43, 0xd2, 0x4e, 0xa0, 0x40, 0x9e, 0x0a, 0x2c, 0x95, 0x2f, 0xdd, 0xe0, 0xc9, 0xb7, 0xa5,
-	0x3b, 0xf8, 0x8b, 0x4e, 0xc3, 0x38, 0x1e, 0x26, 0x49, 0x09, 0x9c, 0x9f, 0x9f, 0x0c, 0xae, 0x9b,
-	0x86, 0xa6, 0x12, 0x1c, 0x0b, 0xe0, 0xe1, 0xa5, 0x74, 0xd5, 0xfd, 0xfa, 0x1f, 0xdd, 0xa7, 0xa4,
-	0xab, 0xf7, 0xa6, 0xb8, 0xc8, 0x21, 0x51, 0xfe, 0xfd, 0xf3, 0xf6, 0x5a, 0xf1, 0xa9, 0x14, 0x0c,
-	0x1e, 0x9c, 0xae, 0x1c, 0xeb, 0x6c, 0xe5, 0x58, 0x5f, 0x57, 0x8e, 0xf5, 0x66, 0xed, 0xd4, 0xce,
-	0xd6, 0x4e, 0xed, 0xd3, 0xda, 0xa9, 0xbd, 0xac, 0x8a, 0xcb, 0x03, 0x19, 0x64, 0x2c, 0xe2, 0x2a,
-	0xf2, 0x5f, 0xeb, 0x8b, 0x56, 0x0d, 0xa2, 0xa6, 0xba, 0xb3, 0x3b, 0x3f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xee, 0x4a, 0x16, 0x69, 0xeb, 0x03, 0x00, 0x00,
+	// 885 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x5f, 0xef, 0x2e, 0x9b, 0x64, 0x76, 0x91, 0x5a, 0xb7, 0x08, 0x27, 0xad, 0xec, 0x68, 0x2b,
+	0x20, 0x48, 0xac, 0x4d, 0xca, 0x05, 0x21, 0x84, 0xb0, 0x13, 0x45, 0xec, 0xa9, 0x91, 0x0b, 0x42,
+	0x20, 0xa4, 0xd1, 0x78, 0xfc, 0xbc, 0x71, 0x63, 0x7b, 0x2c, 0xcf, 0xec, 0x86, 0x7c, 0x05, 0xc4,
+	0xa1, 0x12, 0x17, 0x8e, 0x70, 0x43, 0x70, 0xed, 0x27, 0xe0, 0x54, 0x6e, 0x55, 0x4f, 0x88, 0xc3,
+	0x16, 0x25, 0xb7, 0x7c, 0x04, 0xb8, 0xa0, 0x19, 0x3b, 0x59, 0xa7, 0xf9, 0x43, 0x56, 0x4a, 0x4f,
+	0xeb, 0x99, 0xf7, 0x7b, 0xbf, 0xf7, 0x9b, 0x79, 0x7f, 0x76, 0xd0, 0xdd, 0x5d, 0x32, 0x21, 0x0e,
+	0xdf, 0x23, 0xb9, 0x33, 0x59, 0x0f, 0x40, 0x90, 0x75, 0xb5, 0xb0, 0xf3, 0x82, 0x09, 0xa6, 0xdf,
+	0x94, 0x56, 0x5b, 0x6d, 0x54, 0xd6, 0x15, 0x93, 0x32, 0x9e, 0x32, 0xee, 0x04, 0x84, 0xc3, 0x89,
+	0x0b, 0x65, 0x71, 0x56, 0xba, 0xac, 0x2c, 0x97, 0x76, 0xac, 0x56, 0x4e, 0xb9, 0xa8, 0x4c, 0xb7,
+	0x47, 0x6c, 0xc4, 0xca, 0x7d, 0xf9, 0x55, 0xed, 0x5a, 0x23, 0xc6, 0x46, 0x09, 0x38, 0x6a, 0x15,
+	0x8c, 0x23, 0x47, 0xc4, 0x29, 0x70, 0x41, 0xd2, 0x4a, 0x44, 0xff, 0xf7, 0x16, 0xea, 0x6c, 0x93,
+	0x82, 0xa4, 0x5c, 0xff, 0x0a, 0xbd, 0x4e, 0x92, 0x84, 0xed, 0x41, 0x88, 0x73, 0xc6, 0x12, 0x6e,
+	0x68, 0xab, 0xad, 0xb5, 0xee, 0x7d, 0xd3, 0x3e, 0xa3, 0xd3, 0x76, 0x4b, 0xdc, 0x36, 0x63, 0x89,
+	0x77, 0xfb, 0xe9, 0xd4, 0x6a, 0xfc, 0xfa, 0xc2, 0xea, 0xd5, 0x36, 0xb9, 0xdf, 0x23, 0xb5, 0x95,
+	0xfe, 0x25, 0x5a, 0x94, 0xfe, 0x38, 0x02, 0x30, 0x9a, 0xab, 0xda, 0xda, 0x92, 0xf7, 0xb1, 0xf4,
+	0xfa, 0x6b, 0x6a, 0xbd, 0x3d, 0x8a, 0xc5, 0xce, 0x38, 0xb0, 0x29, 0x4b, 0xab, 0xf3, 0x54, 0x3f,
+	0x03, 0x1e, 0xee, 0x3a, 0x62, 0x3f, 0x07, 0x6e, 0x6f, 0x02, 0x7d, 0xfe, 0x64, 0x80, 0xaa, 0xe3,
+	0x6e, 0x02, 0xf5, 0x17, 0x24, 0xdb, 0x16, 0x80, 0xfe, 0x83, 0x86, 0xde, 0x50, 0x07, 0xa1, 0x2c,
+	0x91, 0xec, 0x38, 0x2a, 0x08, 0x15, 0x31, 0xcb, 0x8c, 0x96, 0x0a, 0x83, 0xe7, 0x0b, 0x73, 0x34,
+	0xb5, 0xac, 0x73, 0xe9, 0xde, 0x63, 0x69, 0x2c, 0x20, 0xcd, 0xc5, 0xfe, 0x4b, 0x4a, 0x6e, 0x1d,
+	0xc3, 0xb7, 0x00, 0xb6, 0x2a, 0xb0, 0xbe, 0x83, 0xee, 0x9c, 0x62, 0xe1, 0x7b, 0x00, 0x39, 0x8e,
+	0x33, 0x01, 0xc5, 0x84, 0x24, 0x46, 0x7b, 0x55, 0x5b, 0x6b, 0x79, 0xef, 0x1e, 0x4d, 0xad, 0xb7,
+	0x2e, 0x81, 0xcd, 0x42, 0xfa, 0x46, 0x2d, 0xc8, 0x43, 0x09, 0x1a, 0x56, 0x98, 0x8f, 0xda, 0x3f,
+	0xfe, 0x64, 0x35, 0xfa, 0xbf, 0x69, 0xa8, 0x5b, 0xbb, 0x7d, 0xfd, 0x4d, 0xb4, 0x20, 0xd8, 0x2e,
+	0x64, 0x98, 0x18, 0x9a, 0xbc, 0x06, 0xbf, 0xa3, 0x96, 0xee, 0xcc, 0x10, 0x18, 0xcd, 0x9a, 0xc1,
+	0xd3, 0x43, 0xb4, 0x4c, 0xd2, 0x3c, 0x89, 0xa3, 0x98, 0x12, 0x79, 0x04, 0x4c, 0x19, 0x44, 0x51,
+	0x4c, 0x63, 0xc8, 0x84, 0xba, 0xca, 0xb6, 0xf7, 0xce, 0xd1, 0xd4, 0xba, 0x77, 0x21, 0xa8, 0xae,
+	0xf6, 0x14, 0x68, 0x63, 0x86, 0xa9, 0xd4, 0xfe, 0xdc, 0x41, 0x48, 0xca, 0xf4, 0x81, 0xb2, 0x22,
+	0xd4, 0xef, 0xa1, 0x05, 0x59, 0x6e, 0x38, 0x0e, 0x4b, 0xb1, 0x1e, 0x3a, 0x98, 0x5a, 0x1d, 0x09,
+	0x18, 0x6e, 0xfa, 0x1d, 0x69, 0x1a, 0x86, 0xfa, 0x27, 0x08, 0x15, 0xc0, 0xa1, 0x98, 0x00, 0xc7,
+	0x44, 0x69, 0xef, 0xde, 0x5f, 0xb6, 0xab, 0x3c, 0xc8, 0x6e, 0x39, 0x29, 0xcd, 0x0d, 0x16, 0x67,
+	0x5e, 0x5b, 0xa6, 0xdd, 0x5f, 0x3a, 0x76, 0x71, 0x4f, 0xf9, 0x07, 0x46, 0x6b, 0x4e, 0x7f, 0x4f,
+	0xc7, 0xa8, 0x27, 0x98, 0x20, 0x09, 0xe6, 0x3b, 0xa4, 0x00, 0x6e, 0xb4, 0xe7, 0x2e, 0xe2, 0x61,
+	0x26, 0x6a, 0xa5, 0x33, 0xcc, 0x84, 0xdf, 0x55, 0x8c, 0x0f, 0x15, 0xe1, 0xe5, 0x09, 0x78, 0xed,
+	0x9a, 0x12, 0xa0, 0x7f, 0xa7, 0x21, 0x3d, 0x2f, 0x62, 0x0a, 0x98, 0x8e, 0xd3, 0x71, 0x42, 0x44,
+	0x3c, 0x01, 0x4c, 0x8c, 0x8e, 0x3a, 0xcd, 0x37, 0x73, 0xf7, 0xca, 0xdd, 0xb3, 0x5c, 0x17, 0x36,
+	0xca, 0x0d, 0x85, 0xdd, 0x38, 0x81, 0xba, 0xe7, 0x8b, 0x09, 0x8c, 0x85, 0x6b, 0x13, 0x13, 0x5c,
+	0x59, 0x8c, 0xa7, 0x7f, 0xaf, 0xa1, 0x3b, 0x67, 0x08, 0xc6, 0x79, 0x48, 0x04, 0x84, 0x98, 0x08,
+	0x63, 0x51, 0x95, 0xcc, 0x8a, 0x5d, 0xce, 0x53, 0xfb, 0x78, 0x9e, 0xda, 0x9f, 0x1f, 0xcf, 0x53,
+	0x6f, 0x5d, 0x2a, 0x2e, 0x7b, 0xfa, 0x42, 0x9a, 0x99, 0xa0, 0xc7, 0x2f, 0x2c, 0xcd, 0x37, 0x5e,
+	0x92, 0xf1, 0x45, 0x09, 0x74, 0x45, 0xff, 0x8f, 0x26, 0xba, 0xb1, 0x2d, 0x8d, 0x0f, 0x02, 0x59,
+	0x82, 0x2a, 0x91, 0x57, 0xeb, 0x94, 0x0f, 0x51, 0x5b, 0xce, 0x78, 0xa3, 0xf9, 0xbf, 0x82, 0x17,
+	0xa5, 0x60, 0xa5, 0x43, 0x79, 0xe8, 0x8f, 0xce, 0xad, 0x8d, 0xd6, 0x35, 0x8c, 0xeb, 0xb3, 0xb9,
+	0x7f, 0x74, 0x6e, 0xea, 0xdb, 0xaf, 0x20, 0x96, 0xd7, 0xff, 0x57, 0x43, 0x5d, 0xd5, 0x65, 0xd5,
+	0xc0, 0x89, 0xd0, 0x52, 0x08, 0x39, 0xe3, 0xb1, 0x60, 0x85, 0xba, 0xc8, 0x9e, 0xf7, 0xd9, 0x3f,
+	0x53, 0x6b, 0x70, 0x85, 0x70, 0x2e, 0xa5, 0x6e, 0x18, 0x16, 0xc0, 0xf9, 0xf3, 0x27, 0x83, 0x5b,
+	0x55, 0xd4, 0x6a, 0xc7, 0xdb, 0x17, 0xc0, 0xfd, 0x19, 0x75, 0x3d, 0x5d, 0xcd, 0x0b, 0xd3, 0x85,
+	0x51, 0xaf, 0x1c, 0x29, 0x98, 0xed, 0x65, 0x10, 0x1a, 0xad, 0xeb, 0x18, 0x2c, 0x25, 0xe3, 0x03,
+	0x49, 0xd8, 0xff, 0x45, 0x43, 0x37, 0xb7, 0x67, 0x7f, 0x1f, 0xf3, 0x0c, 0xdd, 0x0c, 0xf5, 0x22,
+	0x90, 0x03, 0x97, 0xd2, 0x62, 0x0c, 0xf2, 0x14, 0xad, 0xcb, 0xc7, 0xe6, 0xfb, 0xd5, 0x53, 0x60,
+	0xed, 0x0a, 0xb2, 0xa5, 0x03, 0xf7, 0xbb, 0x32, 0x80, 0x5b, 0xf2, 0x7b, 0x9f, 0x3e, 0x3d, 0x30,
+	0xb5, 0x67, 0x07, 0xa6, 0xf6, 0xf7, 0x81, 0xa9, 0x3d, 0x3e, 0x34, 0x1b, 0xcf, 0x0e, 0xcd, 0xc6,
+	0x9f, 0x87, 0x66, 0xe3, 0xeb, 0xfa, 0x3d, 0xc8, 0xd7, 0xc8, 0x20, 0x21, 0x01, 0x57, 0x5f, 0xce,
+	0xb7, 0xe5, 0xfb, 0x4a, 0x91, 0x06, 0x1d, 0x55, 0xe6, 0x1f, 0xfc, 0x37, 0x00, 0xf0, 0x18, 0x0e,
+	0x42, 0x79, 0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -317,6 +510,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProtocolFeeSweepInterval != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.ProtocolFeeSweepInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ProtocolFeeFraction.Size()
+		i -= size
+		if _, err := m.ProtocolFeeFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.SwapFee.Size()
 		i -= size
@@ -364,6 +572,11 @@ func (m *AllowedPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AmplificationCoefficient != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.AmplificationCoefficient))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.TokenB) > 0 {
 		i -= len(m.TokenB)
 		copy(dAtA[i:], m.TokenB)
@@ -401,6 +614,39 @@ func (m *PoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PriceCumulativeUpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PriceCumulativeUpdatedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSwap(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.PriceCumulativeB.Size()
+		i -= size
+		if _, err := m.PriceCumulativeB.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.PriceCumulativeA.Size()
+		i -= size
+		if _, err := m.PriceCumulativeA.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.AmplificationCoefficient != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.AmplificationCoefficient))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.TotalShares.Size()
 		i -= size
@@ -441,6 +687,64 @@ func (m *PoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PriceObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PriceCumulativeB.Size()
+		i -= size
+		if _, err := m.PriceCumulativeB.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PriceCumulativeA.Size()
+		i -= size
+		if _, err := m.PriceCumulativeA.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSwap(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolID) > 0 {
+		i -= len(m.PoolID)
+		copy(dAtA[i:], m.PoolID)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.PoolID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ShareRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -488,6 +792,50 @@ func (m *ShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProtocolFeeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtocolFeeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtocolFeeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeesAccrued) > 0 {
+		for iNdEx := len(m.FeesAccrued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesAccrued[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PoolID) > 0 {
+		i -= len(m.PoolID)
+		copy(dAtA[i:], m.PoolID)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.PoolID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSwap(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSwap(v)
 	base := offset
@@ -513,6 +861,11 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.SwapFee.Size()
 	n += 1 + l + sovSwap(uint64(l))
+	l = m.ProtocolFeeFraction.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	if m.ProtocolFeeSweepInterval != 0 {
+		n += 1 + sovSwap(uint64(m.ProtocolFeeSweepInterval))
+	}
 	return n
 }
 
@@ -530,6 +883,9 @@ func (m *AllowedPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSwap(uint64(l))
 	}
+	if m.AmplificationCoefficient != 0 {
+		n += 1 + sovSwap(uint64(m.AmplificationCoefficient))
+	}
 	return n
 }
 
@@ -549,6 +905,34 @@ func (m *PoolRecord) Size() (n int) {
 	n += 1 + l + sovSwap(uint64(l))
 	l = m.TotalShares.Size()
 	n += 1 + l + sovSwap(uint64(l))
+	if m.AmplificationCoefficient != 0 {
+		n += 1 + sovSwap(uint64(m.AmplificationCoefficient))
+	}
+	l = m.PriceCumulativeA.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.PriceCumulativeB.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PriceCumulativeUpdatedAt)
+	n += 1 + l + sovSwap(uint64(l))
+	return n
+}
+
+func (m *PriceObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.PriceCumulativeA.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	l = m.PriceCumulativeB.Size()
+	n += 1 + l + sovSwap(uint64(l))
 	return n
 }
 
@@ -571,6 +955,25 @@ func (m *ShareRecord) Size() (n int) {
 	return n
 }
 
+func (m *ProtocolFeeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolID)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	if len(m.FeesAccrued) > 0 {
+		for _, e := range m.FeesAccrued {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovSwap(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -674,26 +1077,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSwap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSwap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeFraction", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFeeFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeSweepInterval", wireType)
+			}
+			m.ProtocolFeeSweepInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolFeeSweepInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *AllowedPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -788,6 +1244,25 @@ func (m *AllowedPool) Unmarshal(dAtA []byte) error {
 			}
 			m.TokenB = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmplificationCoefficient", wireType)
+			}
+			m.AmplificationCoefficient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmplificationCoefficient |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwap(dAtA[iNdEx:])
@@ -970,6 +1445,309 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmplificationCoefficient", wireType)
+			}
+			m.AmplificationCoefficient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmplificationCoefficient |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceCumulativeA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceCumulativeA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceCumulativeB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceCumulativeB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceCumulativeUpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PriceCumulativeUpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceObservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceObservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceCumulativeA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceCumulativeA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceCumulativeB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceCumulativeB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwap(dAtA[iNdEx:])
@@ -1141,6 +1919,122 @@ func (m *ShareRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProtocolFeeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtocolFeeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtocolFeeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesAccrued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesAccrued = append(m.FeesAccrued, types.Coin{})
+			if err := m.FeesAccrued[len(m.FeesAccrued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSwap(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTWAPWindow is the longest window a time-weighted average price can be calculated over. Price
+// observations older than the window are pruned.
+const MaxTWAPWindow = 24 * time.Hour
+
+// CumulativePricesAt returns the price accumulators of the pool advanced to the provided time, adding the
+// spot prices of the current reserves multiplied by the seconds since the accumulators were last updated.
+// The accumulators are returned unchanged if the time is not after the last update.
+func (p PoolRecord) CumulativePricesAt(t time.Time) (sdk.Dec, sdk.Dec) {
+	if !t.After(p.PriceCumulativeUpdatedAt) {
+		return p.PriceCumulativeA, p.PriceCumulativeB
+	}
+
+	pool, err := NewDenominatedPoolFromRecord(p)
+	if err != nil {
+		panic(fmt.Sprintf("invalid pool %s: %s", p.PoolID, err))
+	}
+
+	elapsed := durationSeconds(t.Sub(p.PriceCumulativeUpdatedAt))
+
+	return p.PriceCumulativeA.Add(pool.SpotPrice(p.ReservesA.Denom).Mul(elapsed)),
+		p.PriceCumulativeB.Add(pool.SpotPrice(p.ReservesB.Denom).Mul(elapsed))
+}
+
+// NewPriceObservation returns a new price observation of a pool's accumulators
+func NewPriceObservation(poolID string, t time.Time, priceCumulativeA, priceCumulativeB sdk.Dec) PriceObservation {
+	return PriceObservation{
+		PoolID:           poolID,
+		Time:             t,
+		PriceCumulativeA: priceCumulativeA,
+		PriceCumulativeB: priceCumulativeB,
+	}
+}
+
+// Validate performs basic validation checks of the observation data
+func (o PriceObservation) Validate() error {
+	tokens := strings.Split(o.PoolID, PoolIDSep)
+	if len(tokens) != 2 || sdk.ValidateDenom(tokens[0]) != nil || sdk.ValidateDenom(tokens[1]) != nil {
+		return fmt.Errorf("poolID '%s' is invalid", o.PoolID)
+	}
+
+	if o.PriceCumulativeA.IsNil() || o.PriceCumulativeA.IsNegative() {
+		return fmt.Errorf("price observation of pool '%s' has invalid price accumulator: %s", o.PoolID, o.PriceCumulativeA)
+	}
+
+	if o.PriceCumulativeB.IsNil() || o.PriceCumulativeB.IsNegative() {
+		return fmt.Errorf("price observation of pool '%s' has invalid price accumulator: %s", o.PoolID, o.PriceCumulativeB)
+	}
+
+	return nil
+}
+
+// PriceObservations is a slice of PriceObservation
+type PriceObservations []PriceObservation
+
+// Validate performs basic validation checks on all observations in the slice
+func (pos PriceObservations) Validate() error {
+	seen := make(map[string]bool)
+
+	for _, o := range pos {
+		if err := o.Validate(); err != nil {
+			return err
+		}
+
+		key := string(PriceObservationKey(o.PoolID, o.Time))
+		if seen[key] {
+			return fmt.Errorf("duplicate price observation for pool '%s' at %s", o.PoolID, o.Time)
+		}
+
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// TimeWeightedAveragePrices returns the average prices between two observations of a pool's
+// accumulators, weighted by the time each price was held
+func TimeWeightedAveragePrices(start, end PriceObservation) (sdk.Dec, sdk.Dec, error) {
+	if !end.Time.After(start.Time) {
+		return sdk.Dec{}, sdk.Dec{}, errors.New("end observation must be after start observation")
+	}
+
+	elapsed := durationSeconds(end.Time.Sub(start.Time))
+
+	return end.PriceCumulativeA.Sub(start.PriceCumulativeA).Quo(elapsed),
+		end.PriceCumulativeB.Sub(start.PriceCumulativeB).Quo(elapsed), nil
+}
+
+// InterpolatePriceObservation returns the observation of a pool's accumulators at a time between two
+// consecutive observations. The prices of a pool are constant between observations, so the accumulators
+// increase linearly.
+func InterpolatePriceObservation(before, after PriceObservation, t time.Time) (PriceObservation, error) {
+	if t.Before(before.Time) || t.After(after.Time) {
+		return PriceObservation{}, fmt.Errorf("time %s is not between observations at %s and %s", t, before.Time, after.Time)
+	}
+	if t.Equal(before.Time) {
+		return before, nil
+	}
+
+	priceA, priceB, err := TimeWeightedAveragePrices(before, after)
+	if err != nil {
+		return PriceObservation{}, err
+	}
+
+	elapsed := durationSeconds(t.Sub(before.Time))
+
+	return NewPriceObservation(
+		before.PoolID,
+		t,
+		before.PriceCumulativeA.Add(priceA.Mul(elapsed)),
+		before.PriceCumulativeB.Add(priceB.Mul(elapsed)),
+	), nil
+}
+
+// durationSeconds returns a duration in seconds as a decimal
+func durationSeconds(d time.Duration) sdk.Dec {
+	return sdk.NewDec(d.Nanoseconds()).QuoInt64(int64(time.Second))
+}
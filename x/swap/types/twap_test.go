@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kava-labs/kava/x/swap/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTWAP_CumulativePricesAt(t *testing.T) {
+	updatedAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	record := types.NewPoolRecord(sdk.NewCoins(ukava(1e6), usdx(5e6)), i(3e6))
+	record.PriceCumulativeA = sdk.NewDec(100)
+	record.PriceCumulativeB = sdk.NewDec(10)
+	record.PriceCumulativeUpdatedAt = updatedAt
+
+	// accumulators are not changed at or before the last update
+	cumulativeA, cumulativeB := record.CumulativePricesAt(updatedAt)
+	assert.Equal(t, sdk.NewDec(100), cumulativeA)
+	assert.Equal(t, sdk.NewDec(10), cumulativeB)
+	cumulativeA, cumulativeB = record.CumulativePricesAt(updatedAt.Add(-time.Hour))
+	assert.Equal(t, sdk.NewDec(100), cumulativeA)
+	assert.Equal(t, sdk.NewDec(10), cumulativeB)
+
+	// the spot prices are added for each second since the last update
+	cumulativeA, cumulativeB = record.CumulativePricesAt(updatedAt.Add(10 * time.Second))
+	assert.Equal(t, sdk.NewDec(150), cumulativeA)
+	assert.Equal(t, sdk.NewDec(12), cumulativeB)
+
+	cumulativeA, cumulativeB = record.CumulativePricesAt(updatedAt.Add(1500 * time.Millisecond))
+	assert.Equal(t, d("107.5"), cumulativeA)
+	assert.Equal(t, d("10.3"), cumulativeB)
+}
+
+func TestTWAP_TimeWeightedAveragePrices(t *testing.T) {
+	start := types.NewPriceObservation("ukava:usdx", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.NewDec(100), sdk.NewDec(10))
+	end := types.NewPriceObservation("ukava:usdx", start.Time.Add(time.Minute), sdk.NewDec(400), sdk.NewDec(40))
+
+	priceA, priceB, err := types.TimeWeightedAveragePrices(start, end)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(5), priceA)
+	assert.Equal(t, d("0.5"), priceB)
+
+	_, _, err = types.TimeWeightedAveragePrices(end, start)
+	assert.EqualError(t, err, "end observation must be after start observation")
+	_, _, err = types.TimeWeightedAveragePrices(start, start)
+	assert.EqualError(t, err, "end observation must be after start observation")
+}
+
+func TestTWAP_InterpolatePriceObservation(t *testing.T) {
+	before := types.NewPriceObservation("ukava:usdx", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.NewDec(100), sdk.NewDec(10))
+	after := types.NewPriceObservation("ukava:usdx", before.Time.Add(time.Minute), sdk.NewDec(400), sdk.NewDec(40))
+
+	observation, err := types.InterpolatePriceObservation(before, after, before.Time.Add(20*time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, types.NewPriceObservation("ukava:usdx", before.Time.Add(20*time.Second), sdk.NewDec(200), sdk.NewDec(20)), observation)
+
+	observation, err = types.InterpolatePriceObservation(before, after, before.Time)
+	require.NoError(t, err)
+	assert.Equal(t, before, observation)
+
+	observation, err = types.InterpolatePriceObservation(before, after, after.Time)
+	require.NoError(t, err)
+	assert.Equal(t, after.PriceCumulativeA, observation.PriceCumulativeA)
+	assert.Equal(t, after.PriceCumulativeB, observation.PriceCumulativeB)
+
+	_, err = types.InterpolatePriceObservation(before, after, after.Time.Add(time.Second))
+	assert.Error(t, err)
+	_, err = types.InterpolatePriceObservation(before, after, before.Time.Add(-time.Second))
+	assert.Error(t, err)
+}
+
+func TestTWAP_PriceObservation_Validate(t *testing.T) {
+	valid := types.NewPriceObservation("ukava:usdx", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), sdk.ZeroDec(), sdk.ZeroDec())
+	require.NoError(t, valid.Validate())
+
+	invalid := valid
+	invalid.PoolID = "ukava"
+	assert.EqualError(t, invalid.Validate(), "poolID 'ukava' is invalid")
+
+	invalid = valid
+	invalid.PriceCumulativeB = sdk.Dec{}
+	assert.EqualError(t, invalid.Validate(), "price observation of pool 'ukava:usdx' has invalid price accumulator: <nil>")
+
+	observations := types.PriceObservations{valid, valid}
+	assert.EqualError(t, observations.Validate(), "duplicate price observation for pool 'ukava:usdx' at 2022-01-01 00:00:00 +0000 UTC")
+}
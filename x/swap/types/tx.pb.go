@@ -370,6 +370,95 @@ func (m *MsgSwapForExactTokensResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSwapForExactTokensResponse proto.InternalMessageInfo
 
+// MsgSwapExactForTokensRoute represents a message for trading exact coinA for
+// coinB through a route of pools
+type MsgSwapExactForTokensRoute struct {
+	// represents the address swaping the tokens
+	Requester string `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+	// exact_token_a represents the exact amount to swap for token_b
+	ExactTokenA types.Coin `protobuf:"bytes,2,opt,name=exact_token_a,json=exactTokenA,proto3" json:"exact_token_a"`
+	// token_b represents the desired token_b to swap for
+	TokenB types.Coin `protobuf:"bytes,3,opt,name=token_b,json=tokenB,proto3" json:"token_b"`
+	// path represents the denoms traded through, starting with the exact_token_a
+	// denom and ending with the token_b denom
+	Path []string `protobuf:"bytes,4,rep,name=path,proto3" json:"path,omitempty"`
+	// slippage represents the maximum change in token_b allowed
+	Slippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slippage"`
+	// deadline represents the unix timestamp to complete the swap by
+	Deadline int64 `protobuf:"varint,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *MsgSwapExactForTokensRoute) Reset()         { *m = MsgSwapExactForTokensRoute{} }
+func (m *MsgSwapExactForTokensRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactForTokensRoute) ProtoMessage()    {}
+func (*MsgSwapExactForTokensRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b753029ccc8a1ef, []int{8}
+}
+func (m *MsgSwapExactForTokensRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactForTokensRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactForTokensRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapExactForTokensRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactForTokensRoute.Merge(m, src)
+}
+func (m *MsgSwapExactForTokensRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactForTokensRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactForTokensRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapExactForTokensRoute proto.InternalMessageInfo
+
+// MsgSwapExactForTokensRouteResponse defines the Msg/SwapExactForTokensRoute
+// response type.
+type MsgSwapExactForTokensRouteResponse struct {
+}
+
+func (m *MsgSwapExactForTokensRouteResponse) Reset()         { *m = MsgSwapExactForTokensRouteResponse{} }
+func (m *MsgSwapExactForTokensRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactForTokensRouteResponse) ProtoMessage()    {}
+func (*MsgSwapExactForTokensRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b753029ccc8a1ef, []int{9}
+}
+func (m *MsgSwapExactForTokensRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactForTokensRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactForTokensRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapExactForTokensRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactForTokensRouteResponse.Merge(m, src)
+}
+func (m *MsgSwapExactForTokensRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactForTokensRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactForTokensRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapExactForTokensRouteResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgDeposit)(nil), "kava.swap.v1beta1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "kava.swap.v1beta1.MsgDepositResponse")
@@ -379,51 +468,56 @@ func init() {
 	proto.RegisterType((*MsgSwapExactForTokensResponse)(nil), "kava.swap.v1beta1.MsgSwapExactForTokensResponse")
 	proto.RegisterType((*MsgSwapForExactTokens)(nil), "kava.swap.v1beta1.MsgSwapForExactTokens")
 	proto.RegisterType((*MsgSwapForExactTokensResponse)(nil), "kava.swap.v1beta1.MsgSwapForExactTokensResponse")
+	proto.RegisterType((*MsgSwapExactForTokensRoute)(nil), "kava.swap.v1beta1.MsgSwapExactForTokensRoute")
+	proto.RegisterType((*MsgSwapExactForTokensRouteResponse)(nil), "kava.swap.v1beta1.MsgSwapExactForTokensRouteResponse")
 }
 
 func init() { proto.RegisterFile("kava/swap/v1beta1/tx.proto", fileDescriptor_5b753029ccc8a1ef) }
 
 var fileDescriptor_5b753029ccc8a1ef = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcd, 0x6a, 0xdb, 0x4c,
-	0x14, 0xb5, 0x6c, 0x7f, 0x89, 0x3d, 0xe6, 0x5b, 0x74, 0xea, 0x80, 0x22, 0x88, 0x6c, 0x0c, 0x0d,
-	0x5e, 0xd4, 0x52, 0x93, 0x42, 0x29, 0xa5, 0xd0, 0x46, 0x71, 0x0c, 0x5d, 0x98, 0x82, 0x12, 0x68,
-	0xe9, 0xc6, 0x8c, 0xa4, 0xa9, 0x2c, 0x6c, 0x6b, 0x54, 0xcd, 0x24, 0x76, 0xdf, 0xa0, 0xab, 0xd2,
-	0x47, 0xe8, 0xae, 0x2f, 0xe0, 0x87, 0x08, 0x5d, 0x85, 0xac, 0x4a, 0x17, 0xa1, 0xd8, 0x2f, 0x52,
-	0x34, 0xfa, 0xf1, 0x4f, 0x84, 0x6b, 0x67, 0xd5, 0xae, 0x3c, 0xa3, 0x73, 0xcf, 0xb9, 0x33, 0xe7,
-	0x5e, 0xdf, 0x01, 0x52, 0x0f, 0x5d, 0x20, 0x95, 0x0e, 0x91, 0xa7, 0x5e, 0x1c, 0x18, 0x98, 0xa1,
-	0x03, 0x95, 0x8d, 0x14, 0xcf, 0x27, 0x8c, 0xc0, 0x7b, 0x01, 0xa6, 0x04, 0x98, 0x12, 0x61, 0x92,
-	0x6c, 0x12, 0x3a, 0x20, 0x54, 0x35, 0x10, 0xc5, 0x09, 0xc1, 0x24, 0x8e, 0x1b, 0x52, 0xa4, 0xdd,
-	0x10, 0xef, 0xf0, 0x9d, 0x1a, 0x6e, 0x22, 0xa8, 0x6c, 0x13, 0x9b, 0x84, 0xdf, 0x83, 0x55, 0xf8,
-	0xb5, 0x36, 0xce, 0x02, 0xd0, 0xa6, 0x76, 0x13, 0x7b, 0x84, 0x3a, 0x0c, 0x3e, 0x01, 0x45, 0x2b,
-	0x5c, 0x12, 0x5f, 0x14, 0xaa, 0x42, 0xbd, 0xa8, 0x89, 0xd7, 0xe3, 0x46, 0x39, 0x52, 0x3a, 0xb2,
-	0x2c, 0x1f, 0x53, 0x7a, 0xca, 0x7c, 0xc7, 0xb5, 0xf5, 0x59, 0x28, 0x7c, 0x0a, 0xb6, 0x19, 0xe9,
-	0x61, 0xb7, 0x83, 0xc4, 0x6c, 0x55, 0xa8, 0x97, 0x0e, 0x77, 0x95, 0x88, 0x12, 0x9c, 0x34, 0x3e,
-	0xbe, 0x72, 0x4c, 0x1c, 0x57, 0xcb, 0x5f, 0xde, 0x54, 0x32, 0xfa, 0x16, 0x8f, 0x3f, 0x9a, 0x31,
-	0x0d, 0x31, 0xb7, 0x09, 0x53, 0x83, 0x6f, 0x41, 0x81, 0xf6, 0x1d, 0xcf, 0x43, 0x36, 0x16, 0xf3,
-	0xfc, 0xa8, 0xcf, 0x03, 0xfc, 0xe7, 0x4d, 0x65, 0xdf, 0x76, 0x58, 0xf7, 0xdc, 0x50, 0x4c, 0x32,
-	0x88, 0x3c, 0x88, 0x7e, 0x1a, 0xd4, 0xea, 0xa9, 0xec, 0xa3, 0x87, 0xa9, 0xd2, 0xc4, 0xe6, 0xf5,
-	0xb8, 0x01, 0xa2, 0x5c, 0x4d, 0x6c, 0xea, 0x89, 0x1a, 0x94, 0x40, 0xc1, 0xc2, 0xc8, 0xea, 0x3b,
-	0x2e, 0x16, 0xff, 0xab, 0x0a, 0xf5, 0x9c, 0x9e, 0xec, 0x9f, 0xe5, 0x3f, 0x7d, 0xad, 0x64, 0x6a,
-	0x65, 0x00, 0x67, 0xae, 0xe9, 0x98, 0x7a, 0xc4, 0xa5, 0xb8, 0xf6, 0x2d, 0x0b, 0x4a, 0x6d, 0x6a,
-	0xbf, 0x71, 0x58, 0xd7, 0xf2, 0xd1, 0x10, 0x3e, 0x04, 0xf9, 0xf7, 0x3e, 0x19, 0xfc, 0xd1, 0x48,
-	0x1e, 0x05, 0x5b, 0x60, 0x8b, 0x76, 0x91, 0x8f, 0x29, 0xb7, 0xb0, 0xa8, 0x29, 0x1b, 0xdc, 0xe6,
-	0x95, 0xcb, 0xf4, 0x88, 0x0d, 0x5f, 0x80, 0xd2, 0xc0, 0x71, 0x3b, 0x71, 0x3d, 0xd6, 0x74, 0xb5,
-	0x38, 0x70, 0xdc, 0xb3, 0xb0, 0x24, 0x0b, 0x02, 0x06, 0xf7, 0x76, 0x13, 0x01, 0x6d, 0x0d, 0xff,
-	0x76, 0xc0, 0xfd, 0x39, 0xa3, 0x12, 0x03, 0xbf, 0x67, 0xc1, 0x4e, 0x9b, 0xda, 0xa7, 0x43, 0xe4,
-	0x9d, 0x8c, 0x90, 0xc9, 0x5a, 0xc4, 0xe7, 0x92, 0x34, 0x68, 0x4c, 0x1f, 0x7f, 0x38, 0xc7, 0x94,
-	0xe1, 0x35, 0x1a, 0x33, 0x09, 0x85, 0xc7, 0xe0, 0x7f, 0x1c, 0x28, 0x75, 0x36, 0x6c, 0xcf, 0x12,
-	0x67, 0x9d, 0xfd, 0xcb, 0x3d, 0x5a, 0x01, 0x7b, 0xa9, 0x5e, 0xa6, 0xb9, 0xdd, 0x22, 0xfe, 0x49,
-	0x72, 0xe1, 0xbb, 0xbb, 0x7d, 0xf7, 0x31, 0xb0, 0x54, 0xa7, 0xb5, 0x8d, 0x9e, 0xab, 0xd3, 0xdf,
-	0xe2, 0xf6, 0xa2, 0x97, 0xb1, 0xdb, 0x87, 0x9f, 0x73, 0x20, 0xd7, 0xa6, 0x36, 0x7c, 0x0d, 0xb6,
-	0xe3, 0x69, 0xbb, 0xa7, 0xdc, 0x9a, 0xf0, 0xca, 0x6c, 0xac, 0x48, 0x0f, 0x56, 0xc2, 0xb1, 0x30,
-	0xd4, 0x41, 0x21, 0x99, 0x38, 0x72, 0x3a, 0x25, 0xc6, 0xa5, 0xfd, 0xd5, 0x78, 0xa2, 0xe9, 0x01,
-	0x98, 0xf2, 0x27, 0xac, 0xa7, 0xb3, 0x6f, 0x47, 0x4a, 0x8f, 0xd6, 0x8d, 0x5c, 0xce, 0xb8, 0xd4,
-	0x88, 0x2b, 0x32, 0x2e, 0x46, 0xae, 0xca, 0x98, 0x5e, 0x10, 0xed, 0xe5, 0xe5, 0x44, 0x16, 0xae,
-	0x26, 0xb2, 0xf0, 0x6b, 0x22, 0x0b, 0x5f, 0xa6, 0x72, 0xe6, 0x6a, 0x2a, 0x67, 0x7e, 0x4c, 0xe5,
-	0xcc, 0xbb, 0xf9, 0x6e, 0x09, 0x54, 0x1b, 0x7d, 0x64, 0x50, 0xbe, 0x52, 0x47, 0xe1, 0x5b, 0xcd,
-	0x3b, 0xc6, 0xd8, 0xe2, 0x6f, 0xe8, 0xe3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd0, 0xde, 0xef,
-	0x15, 0xc5, 0x07, 0x00, 0x00,
+	// 671 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x13, 0x37, 0x6d, 0x6e, 0xf4, 0x2d, 0xbe, 0xa1, 0x15, 0xae, 0xa5, 0x3a, 0x51, 0x04,
+	0x55, 0x16, 0xc4, 0x6e, 0x8b, 0x40, 0x08, 0x21, 0x41, 0xd3, 0x1f, 0x89, 0x45, 0x84, 0xe4, 0x56,
+	0x02, 0xb1, 0x89, 0xc6, 0xf6, 0xe0, 0x58, 0x6d, 0x3c, 0xc6, 0x33, 0xfd, 0x61, 0xc5, 0x96, 0x1d,
+	0x3c, 0x02, 0x3b, 0x5e, 0xa0, 0x0f, 0x51, 0xb1, 0xaa, 0xba, 0x42, 0x2c, 0x2a, 0xd4, 0xbc, 0x08,
+	0xf2, 0x6f, 0x9a, 0xd6, 0x0d, 0x4e, 0x25, 0x24, 0xba, 0xca, 0x8c, 0xef, 0xb9, 0x67, 0xae, 0xcf,
+	0xb9, 0xb9, 0x1e, 0x90, 0x77, 0xf0, 0x3e, 0xd6, 0xd8, 0x01, 0xf6, 0xb4, 0xfd, 0x65, 0x83, 0x70,
+	0xbc, 0xac, 0xf1, 0x43, 0xd5, 0xf3, 0x29, 0xa7, 0xe8, 0xff, 0x20, 0xa6, 0x06, 0x31, 0x35, 0x8e,
+	0xc9, 0x8a, 0x49, 0x59, 0x9f, 0x32, 0xcd, 0xc0, 0x8c, 0xa4, 0x09, 0x26, 0x75, 0xdc, 0x28, 0x45,
+	0x9e, 0x8f, 0xe2, 0xdd, 0x70, 0xa7, 0x45, 0x9b, 0x38, 0x34, 0x6b, 0x53, 0x9b, 0x46, 0xcf, 0x83,
+	0x55, 0xf4, 0xb4, 0x71, 0x54, 0x04, 0xe8, 0x30, 0x7b, 0x9d, 0x78, 0x94, 0x39, 0x1c, 0x3d, 0x86,
+	0x8a, 0x15, 0x2d, 0xa9, 0x2f, 0x09, 0x75, 0xa1, 0x59, 0x69, 0x4b, 0xa7, 0x47, 0xad, 0xd9, 0x98,
+	0x69, 0xd5, 0xb2, 0x7c, 0xc2, 0xd8, 0x16, 0xf7, 0x1d, 0xd7, 0xd6, 0x87, 0x50, 0xf4, 0x04, 0xa6,
+	0x39, 0xdd, 0x21, 0x6e, 0x17, 0x4b, 0xc5, 0xba, 0xd0, 0xac, 0xae, 0xcc, 0xab, 0x71, 0x4a, 0x50,
+	0x69, 0x52, 0xbe, 0xba, 0x46, 0x1d, 0xb7, 0x2d, 0x1e, 0x9f, 0xd5, 0x0a, 0x7a, 0x39, 0xc4, 0xaf,
+	0x0e, 0x33, 0x0d, 0xa9, 0x34, 0x49, 0x66, 0x1b, 0xbd, 0x81, 0x19, 0xb6, 0xeb, 0x78, 0x1e, 0xb6,
+	0x89, 0x24, 0x86, 0xa5, 0x3e, 0x0b, 0xe2, 0x3f, 0xcf, 0x6a, 0x8b, 0xb6, 0xc3, 0x7b, 0x7b, 0x86,
+	0x6a, 0xd2, 0x7e, 0xac, 0x41, 0xfc, 0xd3, 0x62, 0xd6, 0x8e, 0xc6, 0x3f, 0x78, 0x84, 0xa9, 0xeb,
+	0xc4, 0x3c, 0x3d, 0x6a, 0x41, 0x7c, 0xd6, 0x3a, 0x31, 0xf5, 0x94, 0x0d, 0xc9, 0x30, 0x63, 0x11,
+	0x6c, 0xed, 0x3a, 0x2e, 0x91, 0xa6, 0xea, 0x42, 0xb3, 0xa4, 0xa7, 0xfb, 0xa7, 0xe2, 0xa7, 0xaf,
+	0xb5, 0x42, 0x63, 0x16, 0xd0, 0x50, 0x35, 0x9d, 0x30, 0x8f, 0xba, 0x8c, 0x34, 0xbe, 0x15, 0xa1,
+	0xda, 0x61, 0xf6, 0x6b, 0x87, 0xf7, 0x2c, 0x1f, 0x1f, 0xa0, 0x07, 0x20, 0xbe, 0xf3, 0x69, 0xff,
+	0x8f, 0x42, 0x86, 0x28, 0xb4, 0x09, 0x65, 0xd6, 0xc3, 0x3e, 0x61, 0xa1, 0x84, 0x95, 0xb6, 0x3a,
+	0xc1, 0xdb, 0xbc, 0x74, 0xb9, 0x1e, 0x67, 0xa3, 0xe7, 0x50, 0xed, 0x3b, 0x6e, 0x37, 0xf1, 0x23,
+	0xa7, 0xaa, 0x95, 0xbe, 0xe3, 0x6e, 0x47, 0x96, 0x8c, 0x10, 0x18, 0x92, 0x38, 0x21, 0x41, 0x3b,
+	0x87, 0x7e, 0x73, 0x70, 0xe7, 0x82, 0x50, 0xa9, 0x80, 0xdf, 0x8b, 0x30, 0xd7, 0x61, 0xf6, 0xd6,
+	0x01, 0xf6, 0x36, 0x0e, 0xb1, 0xc9, 0x37, 0xa9, 0x1f, 0x52, 0xb2, 0xa0, 0x31, 0x7d, 0xf2, 0x7e,
+	0x8f, 0x30, 0x4e, 0x72, 0x34, 0x66, 0x0a, 0x45, 0x6b, 0xf0, 0x1f, 0x09, 0x98, 0xba, 0x13, 0xb6,
+	0x67, 0x35, 0xcc, 0xda, 0xbe, 0xcd, 0x3d, 0x5a, 0x83, 0x85, 0x4c, 0x2d, 0xb3, 0xd4, 0xde, 0xa4,
+	0xfe, 0x46, 0xfa, 0xc2, 0x37, 0x57, 0xfb, 0xe6, 0x63, 0xe0, 0x92, 0x4f, 0xb9, 0x85, 0xbe, 0xe0,
+	0xd3, 0xbf, 0xa2, 0xf6, 0xa8, 0x96, 0xa9, 0xda, 0x83, 0x22, 0xc8, 0xd9, 0x7e, 0xd0, 0x3d, 0x4e,
+	0x6e, 0x6b, 0x83, 0x23, 0x10, 0x3d, 0xcc, 0x7b, 0x92, 0x58, 0x2f, 0x35, 0x2b, 0x7a, 0xb8, 0x1e,
+	0xb1, 0x61, 0xea, 0xaf, 0xd9, 0x50, 0xce, 0xb4, 0xe1, 0x1e, 0x34, 0xae, 0x17, 0x39, 0xf1, 0x62,
+	0xe5, 0xb3, 0x08, 0xa5, 0x0e, 0xb3, 0xd1, 0x2b, 0x98, 0x4e, 0xbe, 0x7c, 0x0b, 0xea, 0x95, 0xaf,
+	0xad, 0x3a, 0x1c, 0xf1, 0xf2, 0xfd, 0xb1, 0xe1, 0x84, 0x18, 0xe9, 0x30, 0x93, 0x4e, 0x7f, 0x25,
+	0x3b, 0x25, 0x89, 0xcb, 0x8b, 0xe3, 0xe3, 0x29, 0xa7, 0x07, 0x28, 0x63, 0x20, 0x36, 0xb3, 0xb3,
+	0xaf, 0x22, 0xe5, 0xa5, 0xbc, 0xc8, 0xcb, 0x27, 0x5e, 0x1a, 0x0a, 0x63, 0x4e, 0x1c, 0x45, 0xca,
+	0x4b, 0x79, 0x91, 0xe9, 0x89, 0x1f, 0xe1, 0xee, 0x75, 0x7f, 0x8c, 0x56, 0xee, 0xf2, 0x03, 0xb8,
+	0xfc, 0x68, 0x22, 0x78, 0x52, 0x40, 0xfb, 0xc5, 0xf1, 0xb9, 0x22, 0x9c, 0x9c, 0x2b, 0xc2, 0xaf,
+	0x73, 0x45, 0xf8, 0x32, 0x50, 0x0a, 0x27, 0x03, 0xa5, 0xf0, 0x63, 0xa0, 0x14, 0xde, 0x5e, 0xec,
+	0xd9, 0x80, 0xba, 0xb5, 0x8b, 0x0d, 0x16, 0xae, 0xb4, 0xc3, 0xe8, 0xe2, 0x16, 0xf6, 0xad, 0x51,
+	0x0e, 0x2f, 0x54, 0x0f, 0x7f, 0x0f, 0x00, 0xe4, 0x75, 0x2e, 0x71, 0xd2, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -446,6 +540,9 @@ type MsgClient interface {
 	SwapExactForTokens(ctx context.Context, in *MsgSwapExactForTokens, opts ...grpc.CallOption) (*MsgSwapExactForTokensResponse, error)
 	// SwapForExactTokens represents a message for trading coinA for an exact coinB
 	SwapForExactTokens(ctx context.Context, in *MsgSwapForExactTokens, opts ...grpc.CallOption) (*MsgSwapForExactTokensResponse, error)
+	// SwapExactForTokensRoute represents a message for trading exact coinA for
+	// coinB through a route of pools
+	SwapExactForTokensRoute(ctx context.Context, in *MsgSwapExactForTokensRoute, opts ...grpc.CallOption) (*MsgSwapExactForTokensRouteResponse, error)
 }
 
 type msgClient struct {
@@ -492,6 +589,15 @@ func (c *msgClient) SwapForExactTokens(ctx context.Context, in *MsgSwapForExactT
 	return out, nil
 }
 
+func (c *msgClient) SwapExactForTokensRoute(ctx context.Context, in *MsgSwapExactForTokensRoute, opts ...grpc.CallOption) (*MsgSwapExactForTokensRouteResponse, error) {
+	out := new(MsgSwapExactForTokensRouteResponse)
+	err := c.cc.Invoke(ctx, "/kava.swap.v1beta1.Msg/SwapExactForTokensRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Deposit defines a method for depositing liquidity into a pool
@@ -502,6 +608,9 @@ type MsgServer interface {
 	SwapExactForTokens(context.Context, *MsgSwapExactForTokens) (*MsgSwapExactForTokensResponse, error)
 	// SwapForExactTokens represents a message for trading coinA for an exact coinB
 	SwapForExactTokens(context.Context, *MsgSwapForExactTokens) (*MsgSwapForExactTokensResponse, error)
+	// SwapExactForTokensRoute represents a message for trading exact coinA for
+	// coinB through a route of pools
+	SwapExactForTokensRoute(context.Context, *MsgSwapExactForTokensRoute) (*MsgSwapExactForTokensRouteResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -520,6 +629,9 @@ func (*UnimplementedMsgServer) SwapExactForTokens(ctx context.Context, req *MsgS
 func (*UnimplementedMsgServer) SwapForExactTokens(ctx context.Context, req *MsgSwapForExactTokens) (*MsgSwapForExactTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapForExactTokens not implemented")
 }
+func (*UnimplementedMsgServer) SwapExactForTokensRoute(ctx context.Context, req *MsgSwapExactForTokensRoute) (*MsgSwapExactForTokensRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapExactForTokensRoute not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -597,6 +709,24 @@ func _Msg_SwapForExactTokens_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SwapExactForTokensRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapExactForTokensRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapExactForTokensRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kava.swap.v1beta1.Msg/SwapExactForTokensRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapExactForTokensRoute(ctx, req.(*MsgSwapExactForTokensRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kava.swap.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -617,6 +747,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SwapForExactTokens",
 			Handler:    _Msg_SwapForExactTokens_Handler,
 		},
+		{
+			MethodName: "SwapExactForTokensRoute",
+			Handler:    _Msg_SwapExactForTokensRoute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kava/swap/v1beta1/tx.proto",
@@ -974,6 +1108,103 @@ func (m *MsgSwapForExactTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSwapExactForTokensRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactForTokensRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactForTokensRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Slippage.Size()
+		i -= size
+		if _, err := m.Slippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Path) > 0 {
+		for iNdEx := len(m.Path) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Path[iNdEx])
+			copy(dAtA[i:], m.Path[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Path[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.TokenB.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.ExactTokenA.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactForTokensRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactForTokensRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactForTokensRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1109,6 +1340,43 @@ func (m *MsgSwapForExactTokensResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSwapExactForTokensRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ExactTokenA.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenB.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Path) > 0 {
+		for _, s := range m.Path {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Slippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *MsgSwapExactForTokensRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2119,6 +2387,289 @@ func (m *MsgSwapForExactTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSwapExactForTokensRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactForTokensRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactForTokensRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExactTokenA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExactTokenA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = append(m.Path, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactForTokensRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactForTokensRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactForTokensRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0